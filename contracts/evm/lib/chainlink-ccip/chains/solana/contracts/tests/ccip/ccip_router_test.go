@@ -1199,7 +1199,7 @@ func TestCCIPRouter(t *testing.T) {
 					MaxPerMsgGasLimit:   validFqDestChainConfig.MaxPerMsgGasLimit,
 					ChainFamilySelector: [4]uint8{0, 0, 0, 0},
 				},
-				Error: ccip.InvalidChainFamilySelector_FeeQuoterError.String(),
+				Error: ccip.InvalidChainFamilySelector_CommonCcipError.String(),
 			},
 			{
 				Name:     "DefaultTxGasLimit > MaxPerMsgGasLimit",
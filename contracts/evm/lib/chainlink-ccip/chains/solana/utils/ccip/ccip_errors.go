@@ -8,6 +8,9 @@ import (
  * This Errors file should be automatically generated by Anchor-Go but they don't have full support for errors.
  * So, this file is created manually but adhering to the conventions of anchor-go, which is why many definitions
  * have a nolint:all comment given that anchor conventions use underscores in names which isn't idiomatic in Go.
+ *
+ * The enums follow the order of the errors in the program IDLs, the value of an error is its code minus the
+ * CodeOffset of its program. TestErrorsMatchIDL fails when a program error is added, removed or reordered.
  */
 
 ////////////
@@ -17,9 +20,13 @@ import (
 //nolint:all
 type CcipRouterError ag_binary.BorshEnum
 
+// CcipRouterErrorCodeOffset is the custom program error code of the first CcipRouterError.
+const CcipRouterErrorCodeOffset = 7000
+
 //nolint:all
 const (
 	Unauthorized_CcipRouterError CcipRouterError = iota
+	InvalidRMNRemoteAddress_CcipRouterError
 	InvalidInputsMint_CcipRouterError
 	InvalidVersion_CcipRouterError
 	FeeTokenMismatch_CcipRouterError
@@ -28,7 +35,6 @@ const (
 	InvalidInputsTokenIndices_CcipRouterError
 	InvalidInputsPoolAccounts_CcipRouterError
 	InvalidInputsTokenAccounts_CcipRouterError
-	InvalidInputsConfigAccounts_CcipRouterError
 	InvalidInputsTokenAdminRegistryAccounts_CcipRouterError
 	InvalidInputsLookupTableAccounts_CcipRouterError
 	InvalidInputsLookupTableAccountWritable_CcipRouterError
@@ -51,6 +57,8 @@ func (value CcipRouterError) String() string {
 	switch value {
 	case Unauthorized_CcipRouterError:
 		return "Unauthorized"
+	case InvalidRMNRemoteAddress_CcipRouterError:
+		return "InvalidRMNRemoteAddress"
 	case InvalidInputsMint_CcipRouterError:
 		return "InvalidInputsMint"
 	case InvalidVersion_CcipRouterError:
@@ -67,8 +75,6 @@ func (value CcipRouterError) String() string {
 		return "InvalidInputsPoolAccounts"
 	case InvalidInputsTokenAccounts_CcipRouterError:
 		return "InvalidInputsTokenAccounts"
-	case InvalidInputsConfigAccounts_CcipRouterError:
-		return "InvalidInputsConfigAccounts"
 	case InvalidInputsTokenAdminRegistryAccounts_CcipRouterError:
 		return "InvalidInputsTokenAdminRegistryAccounts"
 	case InvalidInputsLookupTableAccounts_CcipRouterError:
@@ -113,6 +119,9 @@ func (value CcipRouterError) String() string {
 //nolint:all
 type FeeQuoterError ag_binary.BorshEnum
 
+// FeeQuoterErrorCodeOffset is the custom program error code of the first FeeQuoterError.
+const FeeQuoterErrorCodeOffset = 8000
+
 //nolint:all
 const (
 	Unauthorized_FeeQuoterError FeeQuoterError = iota
@@ -138,8 +147,6 @@ const (
 	FeeTokenDisabled_FeeQuoterError
 	MessageTooLarge_FeeQuoterError
 	UnsupportedNumberOfTokens_FeeQuoterError
-	InvalidEVMAddress_FeeQuoterError
-	InvalidEncoding_FeeQuoterError
 	InvalidTokenPrice_FeeQuoterError
 	StaleGasPrice_FeeQuoterError
 	InvalidInputsMissingTokenConfig_FeeQuoterError
@@ -147,10 +154,12 @@ const (
 	MessageGasLimitTooHigh_FeeQuoterError
 	ExtraArgOutOfOrderExecutionMustBeTrue_FeeQuoterError
 	InvalidExtraArgsTag_FeeQuoterError
-	InvalidChainFamilySelector_FeeQuoterError
+	InvalidExtraArgsAccounts_FeeQuoterError
+	InvalidExtraArgsWritabilityBitmap_FeeQuoterError
 	InvalidTokenReceiver_FeeQuoterError
-	InvalidSVMAddress_FeeQuoterError
 	UnauthorizedPriceUpdater_FeeQuoterError
+	InvalidTokenTransferFeeMaxMin_FeeQuoterError
+	InvalidTokenTransferFeeDestBytesOverhead_FeeQuoterError
 	InvalidCodeVersion_FeeQuoterError
 )
 
@@ -202,10 +211,6 @@ func (value FeeQuoterError) String() string {
 		return "MessageTooLarge"
 	case UnsupportedNumberOfTokens_FeeQuoterError:
 		return "UnsupportedNumberOfTokens"
-	case InvalidEVMAddress_FeeQuoterError:
-		return "InvalidEVMAddress"
-	case InvalidEncoding_FeeQuoterError:
-		return "InvalidEncoding"
 	case InvalidTokenPrice_FeeQuoterError:
 		return "InvalidTokenPrice"
 	case StaleGasPrice_FeeQuoterError:
@@ -220,14 +225,18 @@ func (value FeeQuoterError) String() string {
 		return "ExtraArgOutOfOrderExecutionMustBeTrue"
 	case InvalidExtraArgsTag_FeeQuoterError:
 		return "InvalidExtraArgsTag"
-	case InvalidChainFamilySelector_FeeQuoterError:
-		return "InvalidChainFamilySelector"
+	case InvalidExtraArgsAccounts_FeeQuoterError:
+		return "InvalidExtraArgsAccounts"
+	case InvalidExtraArgsWritabilityBitmap_FeeQuoterError:
+		return "InvalidExtraArgsWritabilityBitmap"
 	case InvalidTokenReceiver_FeeQuoterError:
 		return "InvalidTokenReceiver"
-	case InvalidSVMAddress_FeeQuoterError:
-		return "InvalidSVMAddress"
 	case UnauthorizedPriceUpdater_FeeQuoterError:
 		return "UnauthorizedPriceUpdater"
+	case InvalidTokenTransferFeeMaxMin_FeeQuoterError:
+		return "InvalidTokenTransferFeeMaxMin"
+	case InvalidTokenTransferFeeDestBytesOverhead_FeeQuoterError:
+		return "InvalidTokenTransferFeeDestBytesOverhead"
 	case InvalidCodeVersion_FeeQuoterError:
 		return "InvalidCodeVersion"
 	default:
@@ -242,10 +251,14 @@ func (value FeeQuoterError) String() string {
 //nolint:all
 type CcipOfframpError ag_binary.BorshEnum
 
+// CcipOfframpErrorCodeOffset is the custom program error code of the first CcipOfframpError.
+const CcipOfframpErrorCodeOffset = 9000
+
 //nolint:all
 const (
 	InvalidSequenceInterval_CcipOfframpError CcipOfframpError = iota
 	RootNotCommitted_CcipOfframpError
+	InvalidRMNRemoteAddress_CcipOfframpError
 	ExistingMerkleRoot_CcipOfframpError
 	Unauthorized_CcipOfframpError
 	InvalidNonce_CcipOfframpError
@@ -272,7 +285,6 @@ const (
 	InvalidInputsSysvarAccount_CcipOfframpError
 	InvalidInputsFeeQuoterAccount_CcipOfframpError
 	InvalidInputsAllowedOfframpAccount_CcipOfframpError
-	InvalidInputsConfigAccounts_CcipOfframpError
 	InvalidInputsTokenAdminRegistryAccounts_CcipOfframpError
 	InvalidInputsLookupTableAccounts_CcipOfframpError
 	InvalidInputsLookupTableAccountWritable_CcipOfframpError
@@ -283,6 +295,7 @@ const (
 	InvalidCodeVersion_CcipOfframpError
 	Ocr3InvalidConfigFMustBePositive_CcipOfframpError
 	Ocr3InvalidConfigTooManyTransmitters_CcipOfframpError
+	Ocr3InvalidConfigNoTransmitters_CcipOfframpError
 	Ocr3InvalidConfigTooManySigners_CcipOfframpError
 	Ocr3InvalidConfigFIsTooHigh_CcipOfframpError
 	Ocr3InvalidConfigRepeatedOracle_CcipOfframpError
@@ -308,6 +321,8 @@ func (value CcipOfframpError) String() string {
 		return "InvalidSequenceInterval"
 	case RootNotCommitted_CcipOfframpError:
 		return "RootNotCommitted"
+	case InvalidRMNRemoteAddress_CcipOfframpError:
+		return "InvalidRMNRemoteAddress"
 	case ExistingMerkleRoot_CcipOfframpError:
 		return "ExistingMerkleRoot"
 	case Unauthorized_CcipOfframpError:
@@ -360,8 +375,6 @@ func (value CcipOfframpError) String() string {
 		return "InvalidInputsFeeQuoterAccount"
 	case InvalidInputsAllowedOfframpAccount_CcipOfframpError:
 		return "InvalidInputsAllowedOfframpAccount"
-	case InvalidInputsConfigAccounts_CcipOfframpError:
-		return "InvalidInputsConfigAccounts"
 	case InvalidInputsTokenAdminRegistryAccounts_CcipOfframpError:
 		return "InvalidInputsTokenAdminRegistryAccounts"
 	case InvalidInputsLookupTableAccounts_CcipOfframpError:
@@ -382,6 +395,8 @@ func (value CcipOfframpError) String() string {
 		return "Ocr3InvalidConfigFMustBePositive"
 	case Ocr3InvalidConfigTooManyTransmitters_CcipOfframpError:
 		return "Ocr3InvalidConfigTooManyTransmitters"
+	case Ocr3InvalidConfigNoTransmitters_CcipOfframpError:
+		return "Ocr3InvalidConfigNoTransmitters"
 	case Ocr3InvalidConfigTooManySigners_CcipOfframpError:
 		return "Ocr3InvalidConfigTooManySigners"
 	case Ocr3InvalidConfigFIsTooHigh_CcipOfframpError:
@@ -409,7 +424,7 @@ func (value CcipOfframpError) String() string {
 	case Ocr3InvalidSignature_CcipOfframpError:
 		return "Ocr3InvalidSignature"
 	case Ocr3SignaturesOutOfRegistration_CcipOfframpError:
-		return "Ocr3Ocr3SignaturesOutOfRegistration"
+		return "Ocr3SignaturesOutOfRegistration"
 	case InvalidOnrampAddress_CcipOfframpError:
 		return "InvalidOnrampAddress"
 	case InvalidInputsExternalExecutionSignerAccount_CcipOfframpError:
@@ -428,6 +443,9 @@ func (value CcipOfframpError) String() string {
 //nolint:all
 type RmnRemoteError ag_binary.BorshEnum
 
+// RmnRemoteErrorCodeOffset is the custom program error code of the first RmnRemoteError.
+const RmnRemoteErrorCodeOffset = 9000
+
 //nolint:all
 const (
 	Unauthorized_RmnRemoteError RmnRemoteError = iota
@@ -462,3 +480,57 @@ func (value RmnRemoteError) String() string {
 		return ""
 	}
 }
+
+////////////
+// Common //
+////////////
+
+//nolint:all
+type CommonCcipError ag_binary.BorshEnum
+
+// CommonCcipErrorCodeOffset is the custom program error code of the first CommonCcipError.
+const CommonCcipErrorCodeOffset = 10000
+
+//nolint:all
+const (
+	InvalidSequenceInterval_CommonCcipError CommonCcipError = iota
+	InvalidInputsPoolAccounts_CommonCcipError
+	InvalidInputsTokenAccounts_CommonCcipError
+	InvalidInputsTokenAdminRegistryAccounts_CommonCcipError
+	InvalidInputsLookupTableAccounts_CommonCcipError
+	InvalidInputsLookupTableAccountWritable_CommonCcipError
+	InvalidInputsPoolSignerAccounts_CommonCcipError
+	InvalidChainFamilySelector_CommonCcipError
+	InvalidEncoding_CommonCcipError
+	InvalidEVMAddress_CommonCcipError
+	InvalidSVMAddress_CommonCcipError
+)
+
+func (value CommonCcipError) String() string {
+	switch value {
+	case InvalidSequenceInterval_CommonCcipError:
+		return "InvalidSequenceInterval"
+	case InvalidInputsPoolAccounts_CommonCcipError:
+		return "InvalidInputsPoolAccounts"
+	case InvalidInputsTokenAccounts_CommonCcipError:
+		return "InvalidInputsTokenAccounts"
+	case InvalidInputsTokenAdminRegistryAccounts_CommonCcipError:
+		return "InvalidInputsTokenAdminRegistryAccounts"
+	case InvalidInputsLookupTableAccounts_CommonCcipError:
+		return "InvalidInputsLookupTableAccounts"
+	case InvalidInputsLookupTableAccountWritable_CommonCcipError:
+		return "InvalidInputsLookupTableAccountWritable"
+	case InvalidInputsPoolSignerAccounts_CommonCcipError:
+		return "InvalidInputsPoolSignerAccounts"
+	case InvalidChainFamilySelector_CommonCcipError:
+		return "InvalidChainFamilySelector"
+	case InvalidEncoding_CommonCcipError:
+		return "InvalidEncoding"
+	case InvalidEVMAddress_CommonCcipError:
+		return "InvalidEVMAddress"
+	case InvalidSVMAddress_CommonCcipError:
+		return "InvalidSVMAddress"
+	default:
+		return ""
+	}
+}
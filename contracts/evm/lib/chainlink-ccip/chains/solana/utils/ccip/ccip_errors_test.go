@@ -0,0 +1,49 @@
+package ccip
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana"
+)
+
+func TestErrorsMatchIDL(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name      string
+		idl       string
+		offset    uint32
+		errorName func(value uint32) string
+	}{
+		{"router", solana.FetchCCIPRouterIDL(), CcipRouterErrorCodeOffset, func(v uint32) string { return CcipRouterError(v).String() }},
+		{"feequoter", solana.FetchFeeQuoterIDL(), FeeQuoterErrorCodeOffset, func(v uint32) string { return FeeQuoterError(v).String() }},
+		{"offramp", solana.FetchCCIPOfframpIDL(), CcipOfframpErrorCodeOffset, func(v uint32) string { return CcipOfframpError(v).String() }},
+		{"rmnremote", solana.FetchRMNRemoteIDL(), RmnRemoteErrorCodeOffset, func(v uint32) string { return RmnRemoteError(v).String() }},
+		{"common", solana.FetchCommonIDL(), CommonCcipErrorCodeOffset, func(v uint32) string { return CommonCcipError(v).String() }},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var idl struct {
+				Errors []struct {
+					Code uint32 `json:"code"`
+					Name string `json:"name"`
+				} `json:"errors"`
+			}
+			require.NoError(t, json.Unmarshal([]byte(tc.idl), &idl))
+			require.NotEmpty(t, idl.Errors)
+
+			for _, idlError := range idl.Errors {
+				require.Equal(t, idlError.Name, tc.errorName(idlError.Code-tc.offset), fmt.Sprintf("error code %d", idlError.Code))
+			}
+			// no enum value past the last program error.
+			require.Empty(t, tc.errorName(uint32(len(idl.Errors))))
+		})
+	}
+}
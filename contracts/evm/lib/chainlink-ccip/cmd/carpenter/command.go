@@ -6,13 +6,17 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"os/signal"
 	"slices"
 	"strings"
+	"syscall"
+	"time"
 
 	"github.com/urfave/cli/v3"
 
 	"github.com/smartcontractkit/chainlink-ccip/cmd/carpenter/internal/filter"
 	"github.com/smartcontractkit/chainlink-ccip/cmd/carpenter/internal/format"
+	"github.com/smartcontractkit/chainlink-ccip/cmd/carpenter/internal/format/rate"
 	"github.com/smartcontractkit/chainlink-ccip/cmd/carpenter/internal/parse"
 	"github.com/smartcontractkit/chainlink-ccip/cmd/carpenter/internal/stream"
 )
@@ -24,6 +28,10 @@ type arguments struct {
 
 	filter.CompiledFilterFields
 	filterOP filter.FilterOP
+	scope    filter.Scope
+
+	follow       bool
+	rateInterval time.Duration
 }
 
 func makeCommand() *cli.Command {
@@ -37,6 +45,11 @@ func makeCommand() *cli.Command {
 				Usage:       "Provide one or more files to read. If not provided, reads from stdin.",
 				Destination: &args.files,
 			},
+			&cli.BoolFlag{
+				Name:        "follow",
+				Usage:       "Keep reading the file as it grows, following it when it is rotated, like 'tail -F'.",
+				Destination: &args.follow,
+			},
 			&cli.StringFlag{
 				Name:             "logType",
 				Usage:            "Specify the type of log to parse, valid options: json, mixed, ci",
@@ -103,22 +116,59 @@ func makeCommand() *cli.Command {
 					return nil
 				},
 			},
+			&cli.StringFlag{
+				Name:     "since",
+				Usage:    "Only show logs at or after this time. Either an RFC3339 timestamp or a duration ago, i.e. 15m",
+				Category: "filters",
+				Validator: func(s string) error {
+					var err error
+					args.scope.Since, err = filter.ParseTime(s, time.Now())
+					return err
+				},
+			},
+			&cli.StringFlag{
+				Name:     "until",
+				Usage:    "Only show logs at or before this time. Either an RFC3339 timestamp or a duration ago, i.e. 5m",
+				Category: "filters",
+				Validator: func(s string) error {
+					var err error
+					args.scope.Until, err = filter.ParseTime(s, time.Now())
+					return err
+				},
+			},
+			&cli.StringSliceFlag{
+				Name:        "component",
+				Usage:       "Only show logs of these components, combined with the other filters using AND.",
+				Category:    "filters",
+				Destination: &args.scope.Components,
+			},
+			&cli.DurationFlag{
+				Name:        "rate-interval",
+				Usage:       "Window used to compute the log rates of the 'rate' formatter.",
+				Value:       rate.DefaultInterval,
+				Destination: &args.rateInterval,
+			},
 		},
 		Action: func(ctx context.Context, cmd *cli.Command) error {
-			return run(args)
+			return run(ctx, args)
 		},
 	}
 }
 
-func run(args arguments) error {
-	var options stream.InputOptions
+func run(ctx context.Context, args arguments) error {
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	options := stream.InputOptions{Follow: args.follow}
 
 	// If no files are provided the stream will read from stdin.
 	if len(args.files) != 0 {
 		options.Filenames = args.files
 	}
 
-	formatter, err := format.GetFormatter(args.formatterName, format.Options{})
+	formatter, err := format.GetFormatter(args.formatterName, format.Options{
+		RateInterval: args.rateInterval,
+	})
 	if err != nil {
 		return fmt.Errorf("failed to get formatter: %w", err)
 	}
@@ -127,6 +177,12 @@ func run(args arguments) error {
 	if err != nil {
 		return fmt.Errorf("failed to initialize input stream: %w", err)
 	}
+	// Closing the input stops a follower waiting for new data, so the formatters are still closed
+	// and print what they aggregated when interrupted.
+	go func() {
+		<-ctx.Done()
+		_ = inputStream.Close()
+	}()
 
 	scanner := bufio.NewScanner(inputStream)
 	for scanner.Scan() {
@@ -135,11 +191,22 @@ func run(args arguments) error {
 		if err != nil {
 			return fmt.Errorf("ParseLine: %w", err)
 		}
+		if data == nil {
+			continue
+		}
+		inScope, err := filter.InScope(data, args.scope)
+		if err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "Skipping line: %s\n", err)
+			continue
+		}
+		if !inScope {
+			continue
+		}
 
 		include, err := filter.Filter(data, args.CompiledFilterFields, args.filterOP)
 		if err != nil {
 			msg := fmt.Sprintf("Unable to get data: %s\n", err)
-			_, err2 := fmt.Fprintf(os.Stderr, msg)
+			_, err2 := fmt.Fprint(os.Stderr, msg)
 			if err2 != nil {
 				panic(msg)
 			}
@@ -152,6 +219,11 @@ func run(args arguments) error {
 
 		formatter.Format(data)
 	}
+	scanErr := scanner.Err()
+	if ctx.Err() != nil {
+		// the input was closed by the interrupt.
+		scanErr = nil
+	}
 
 	// Check if formatter implements io.Closer and call Close if it does
 	if closer, ok := formatter.(io.Closer); ok {
@@ -160,5 +232,8 @@ func run(args arguments) error {
 		}
 	}
 
+	if scanErr != nil {
+		return fmt.Errorf("failed to read input: %w", scanErr)
+	}
 	return nil
 }
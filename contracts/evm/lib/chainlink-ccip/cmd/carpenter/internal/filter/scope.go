@@ -0,0 +1,63 @@
+package filter
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-ccip/cmd/carpenter/internal/parse"
+)
+
+// Scope restricts logs to a time range and a set of components.
+// It is applied in addition to the field filters, regardless of the filter operation.
+type Scope struct {
+	// Since and Until bound the parsed timestamp of the logs, a zero value leaves that side open.
+	Since time.Time
+	Until time.Time
+	// Components selects the logs of these components, compared case-insensitively. Empty selects all.
+	Components []string
+}
+
+// InScope decides if the data is within the scope. An error is returned when the timestamp
+// of the data can't be parsed to compare it with the time range.
+func InScope(data *parse.Data, scope Scope) (bool, error) {
+	if len(scope.Components) > 0 {
+		found := false
+		for _, component := range scope.Components {
+			if strings.EqualFold(component, data.Component) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false, nil
+		}
+	}
+
+	if scope.Since.IsZero() && scope.Until.IsZero() {
+		return true, nil
+	}
+	ts, err := data.ParseTimestamp()
+	if err != nil {
+		return false, err
+	}
+	if !scope.Since.IsZero() && ts.Before(scope.Since) {
+		return false, nil
+	}
+	if !scope.Until.IsZero() && ts.After(scope.Until) {
+		return false, nil
+	}
+	return true, nil
+}
+
+// ParseTime parses an RFC3339 timestamp or a duration relative to now, i.e. "15m" is 15 minutes ago.
+func ParseTime(value string, now time.Time) (time.Time, error) {
+	if ts, err := time.Parse(time.RFC3339, value); err == nil {
+		return ts, nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("expected an RFC3339 timestamp or a duration, got %s", value)
+	}
+	return now.Add(-d), nil
+}
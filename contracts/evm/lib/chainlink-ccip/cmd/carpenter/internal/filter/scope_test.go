@@ -0,0 +1,60 @@
+package filter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-ccip/cmd/carpenter/internal/parse"
+)
+
+func TestInScope(t *testing.T) {
+	data := &parse.Data{ProdTimestamp: "2025-01-16T15:00:00Z", Component: "MerkleRoot"}
+	ts := data.GetTimestamp()
+
+	tests := []struct {
+		name  string
+		scope Scope
+		want  bool
+	}{
+		{name: "empty scope", want: true},
+		{name: "component", scope: Scope{Components: []string{"ChainFee", "merkleroot"}}, want: true},
+		{name: "other component", scope: Scope{Components: []string{"ChainFee"}}, want: false},
+		{name: "since", scope: Scope{Since: ts}, want: true},
+		{name: "after since", scope: Scope{Since: ts.Add(time.Second)}, want: false},
+		{name: "until", scope: Scope{Until: ts}, want: true},
+		{name: "before until", scope: Scope{Until: ts.Add(-time.Second)}, want: false},
+		{name: "range", scope: Scope{Since: ts.Add(-time.Minute), Until: ts.Add(time.Minute)}, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inScope, err := InScope(data, tt.scope)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, inScope)
+		})
+	}
+
+	// a malformed timestamp is only parsed when a time range is set.
+	malformed := &parse.Data{ProdTimestamp: "not a timestamp"}
+	inScope, err := InScope(malformed, Scope{})
+	require.NoError(t, err)
+	require.True(t, inScope)
+	_, err = InScope(malformed, Scope{Since: ts})
+	require.Error(t, err)
+}
+
+func TestParseTime(t *testing.T) {
+	now := time.Date(2025, 1, 16, 15, 0, 0, 0, time.UTC)
+
+	ts, err := ParseTime("2025-01-16T14:00:00Z", now)
+	require.NoError(t, err)
+	require.Equal(t, now.Add(-time.Hour), ts)
+
+	ts, err = ParseTime("15m", now)
+	require.NoError(t, err)
+	require.Equal(t, now.Add(-15*time.Minute), ts)
+
+	_, err = ParseTime("yesterday", now)
+	require.Error(t, err)
+}
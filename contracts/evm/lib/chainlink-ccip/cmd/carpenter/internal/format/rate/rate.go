@@ -0,0 +1,126 @@
+// Package rate prints the number of logs per second of each component, for consecutive time windows.
+// Combined with --follow and --component it gives a live view of a node during an incident.
+package rate
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/smartcontractkit/chainlink-ccip/cmd/carpenter/internal/format"
+	"github.com/smartcontractkit/chainlink-ccip/cmd/carpenter/internal/parse"
+)
+
+func init() {
+	format.Register("rate", rateFormatterFactory,
+		"Print the log and error rates of each component for every rate interval.")
+}
+
+func rateFormatterFactory(options format.Options) format.Formatter {
+	return newRateFormatter(os.Stdout, options.RateInterval)
+}
+
+const (
+	// DefaultInterval is used when no rate interval is configured.
+	DefaultInterval = 10 * time.Second
+
+	// unknown is used for logs without a component.
+	unknown = "unknown"
+
+	timeLayout = "15:04:05"
+	padding    = "    "
+)
+
+var header = lipgloss.NewStyle().Bold(true)
+
+type componentCount struct {
+	logs   int
+	errors int
+}
+
+// rateFormatter counts logs in windows of the parsed timestamp rather than the wall clock,
+// so the rates are the same whether the logs are followed live or read afterwards.
+// A window is printed when the first log past its end is seen, or when the formatter is closed.
+type rateFormatter struct {
+	out      io.Writer
+	interval time.Duration
+
+	windowStart time.Time
+	counts      map[string]*componentCount
+}
+
+func newRateFormatter(out io.Writer, interval time.Duration) *rateFormatter {
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+	return &rateFormatter{
+		out:      out,
+		interval: interval,
+		counts:   make(map[string]*componentCount),
+	}
+}
+
+func (rf *rateFormatter) Format(data *parse.Data) {
+	if data == nil {
+		return
+	}
+
+	ts := data.GetTimestamp()
+	if rf.windowStart.IsZero() {
+		rf.windowStart = ts.Truncate(rf.interval)
+	}
+	if !ts.Before(rf.windowStart.Add(rf.interval)) {
+		rf.flush()
+		// Windows without logs are skipped.
+		rf.windowStart = ts.Truncate(rf.interval)
+	}
+
+	component := data.Component
+	if component == "" {
+		component = unknown
+	}
+	count, ok := rf.counts[component]
+	if !ok {
+		count = &componentCount{}
+		rf.counts[component] = count
+	}
+	count.logs++
+	if data.IsError() {
+		count.errors++
+	}
+}
+
+// flush prints the current window and clears the counts.
+func (rf *rateFormatter) flush() {
+	if len(rf.counts) == 0 {
+		return
+	}
+
+	components := make([]string, 0, len(rf.counts))
+	for component := range rf.counts {
+		components = append(components, component)
+	}
+	sort.Strings(components)
+
+	seconds := rf.interval.Seconds()
+	fmt.Fprintln(rf.out, header.Render(fmt.Sprintf("%s - %s",
+		rf.windowStart.Format(timeLayout), rf.windowStart.Add(rf.interval).Format(timeLayout))))
+	fmt.Fprintf(rf.out, "%s%-30s %10s %10s %8s\n", padding, "component", "logs/s", "errors/s", "logs")
+	for _, component := range components {
+		count := rf.counts[component]
+		fmt.Fprintf(rf.out, "%s%-30s %10.2f %10.2f %8d\n", padding, component,
+			float64(count.logs)/seconds, float64(count.errors)/seconds, count.logs)
+	}
+	fmt.Fprintln(rf.out)
+
+	rf.counts = make(map[string]*componentCount)
+}
+
+func (rf *rateFormatter) Close() error {
+	rf.flush()
+	return nil
+}
@@ -0,0 +1,41 @@
+package rate
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-ccip/cmd/carpenter/internal/parse"
+)
+
+func Test_rateFormatter(t *testing.T) {
+	var out bytes.Buffer
+	rf := newRateFormatter(&out, 10*time.Second)
+
+	for _, log := range []struct{ ts, level, component string }{
+		{"2025-01-16T15:00:01Z", "info", "MerkleRoot"},
+		{"2025-01-16T15:00:02Z", "error", "MerkleRoot"},
+		{"2025-01-16T15:00:09Z", "info", ""},
+		// the next window is empty and skipped
+		{"2025-01-16T15:00:25Z", "info", "ChainFee"},
+	} {
+		rf.Format(&parse.Data{ProdTimestamp: log.ts, ProdLevel: log.level, Component: log.component})
+	}
+	require.NoError(t, rf.Close())
+
+	windows := strings.Split(strings.TrimSpace(out.String()), "\n\n")
+	require.Len(t, windows, 2)
+
+	first := strings.Split(windows[0], "\n")
+	require.Equal(t, "15:00:00 - 15:00:10", first[0])
+	require.Len(t, first, 4)
+	require.Equal(t, []string{"MerkleRoot", "0.20", "0.10", "2"}, strings.Fields(first[2]))
+	require.Equal(t, []string{unknown, "0.10", "0.00", "1"}, strings.Fields(first[3]))
+
+	second := strings.Split(windows[1], "\n")
+	require.Equal(t, "15:00:20 - 15:00:30", second[0])
+	require.Equal(t, []string{"ChainFee", "0.10", "0.00", "1"}, strings.Fields(second[2]))
+}
@@ -4,12 +4,15 @@ import (
 	"fmt"
 	"maps"
 	"slices"
+	"time"
 
 	"github.com/smartcontractkit/chainlink-ccip/cmd/carpenter/internal/parse"
 )
 
 // Options is a struct that holds options for all formatters.
 type Options struct {
+	// RateInterval is the window used by formatters which display log rates.
+	RateInterval time.Duration
 }
 
 // FormatterFactory is a function that returns a Formatter, implemented by formatter to apply options.
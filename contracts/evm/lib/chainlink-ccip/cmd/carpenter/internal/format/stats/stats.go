@@ -0,0 +1,226 @@
+// Package stats aggregates logs into counts, error rates and OCR phase durations
+// and prints them as a single JSON document.
+package stats
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-ccip/cmd/carpenter/internal/format"
+	"github.com/smartcontractkit/chainlink-ccip/cmd/carpenter/internal/parse"
+)
+
+func init() {
+	format.Register("stats", statsFormatterFactory,
+		"Print log counts, error rates by caller and OCR phase duration percentiles as JSON.")
+}
+
+func statsFormatterFactory(options format.Options) format.Formatter {
+	return newStatsFormatter(os.Stdout)
+}
+
+// unknown is used for logs which do not have the field being aggregated.
+const unknown = "unknown"
+
+// percentiles reported for the phase durations.
+var percentiles = []int{50, 90, 99}
+
+// phaseRoundKey identifies an OCR phase of a round on a single oracle.
+type phaseRoundKey struct {
+	plugin   string
+	phase    string
+	donID    int
+	oracleID int
+	seqNr    int
+}
+
+// phaseRound is the time span covered by the logs of a phaseRoundKey.
+type phaseRound struct {
+	first time.Time
+	last  time.Time
+}
+
+type callerCount struct {
+	logs   int
+	errors int
+}
+
+// statsFormatter collects statistics across all log lines and prints them when closed.
+type statsFormatter struct {
+	out io.Writer
+
+	total      int
+	plugins    map[string]int
+	components map[string]map[string]int
+	callers    map[string]*callerCount
+	phases     map[phaseRoundKey]*phaseRound
+}
+
+func newStatsFormatter(out io.Writer) *statsFormatter {
+	return &statsFormatter{
+		out:        out,
+		plugins:    make(map[string]int),
+		components: make(map[string]map[string]int),
+		callers:    make(map[string]*callerCount),
+		phases:     make(map[phaseRoundKey]*phaseRound),
+	}
+}
+
+func orUnknown(value string) string {
+	if value == "" {
+		return unknown
+	}
+	return value
+}
+
+func (sf *statsFormatter) Format(data *parse.Data) {
+	if data == nil {
+		return
+	}
+	sf.total++
+
+	plugin := orUnknown(data.Plugin)
+	sf.plugins[plugin]++
+	if _, ok := sf.components[plugin]; !ok {
+		sf.components[plugin] = make(map[string]int)
+	}
+	sf.components[plugin][orUnknown(data.Component)]++
+
+	caller := orUnknown(data.GetCaller())
+	if _, ok := sf.callers[caller]; !ok {
+		sf.callers[caller] = &callerCount{}
+	}
+	sf.callers[caller].logs++
+	if data.IsError() {
+		sf.callers[caller].errors++
+	}
+
+	if data.Plugin == "" || data.OCRPhase == "" {
+		return
+	}
+	key := phaseRoundKey{
+		plugin:   data.Plugin,
+		phase:    data.OCRPhase,
+		donID:    data.DONID,
+		oracleID: data.OracleID,
+		seqNr:    data.SequenceNumber,
+	}
+	ts := data.GetTimestamp()
+	round, ok := sf.phases[key]
+	if !ok {
+		sf.phases[key] = &phaseRound{first: ts, last: ts}
+		return
+	}
+	if ts.Before(round.first) {
+		round.first = ts
+	}
+	if ts.After(round.last) {
+		round.last = ts
+	}
+}
+
+// callerStats is the error rate of the logs written by a caller.
+type callerStats struct {
+	Caller    string  `json:"caller"`
+	Logs      int     `json:"logs"`
+	Errors    int     `json:"errors"`
+	ErrorRate float64 `json:"errorRate"`
+}
+
+// phaseStats holds the duration percentiles of an OCR phase, in milliseconds.
+// The duration of a phase is the time between the first and last log of the phase in a round.
+type phaseStats struct {
+	Plugin      string             `json:"plugin"`
+	Phase       string             `json:"phase"`
+	Rounds      int                `json:"rounds"`
+	Percentiles map[string]float64 `json:"percentilesMs"`
+	Max         float64            `json:"maxMs"`
+}
+
+// report is the document printed by the stats formatter.
+type report struct {
+	Total      int                       `json:"total"`
+	Plugins    map[string]int            `json:"plugins"`
+	Components map[string]map[string]int `json:"components"`
+	Callers    []callerStats             `json:"callers"`
+	Phases     []phaseStats              `json:"phases"`
+}
+
+func (sf *statsFormatter) report() report {
+	callers := make([]callerStats, 0, len(sf.callers))
+	for caller, count := range sf.callers {
+		callers = append(callers, callerStats{
+			Caller:    caller,
+			Logs:      count.logs,
+			Errors:    count.errors,
+			ErrorRate: float64(count.errors) / float64(count.logs),
+		})
+	}
+	// Callers with the highest error rate first.
+	sort.Slice(callers, func(i, j int) bool {
+		if callers[i].ErrorRate != callers[j].ErrorRate {
+			return callers[i].ErrorRate > callers[j].ErrorRate
+		}
+		return callers[i].Caller < callers[j].Caller
+	})
+
+	type pluginPhase struct{ plugin, phase string }
+	durations := make(map[pluginPhase][]time.Duration)
+	for key, round := range sf.phases {
+		pp := pluginPhase{plugin: key.plugin, phase: key.phase}
+		durations[pp] = append(durations[pp], round.last.Sub(round.first))
+	}
+	phases := make([]phaseStats, 0, len(durations))
+	for pp, ds := range durations {
+		sort.Slice(ds, func(i, j int) bool { return ds[i] < ds[j] })
+		ps := phaseStats{
+			Plugin:      pp.plugin,
+			Phase:       pp.phase,
+			Rounds:      len(ds),
+			Percentiles: make(map[string]float64, len(percentiles)),
+			Max:         milliseconds(ds[len(ds)-1]),
+		}
+		for _, p := range percentiles {
+			ps.Percentiles[fmt.Sprintf("p%d", p)] = milliseconds(percentile(ds, p))
+		}
+		phases = append(phases, ps)
+	}
+	sort.Slice(phases, func(i, j int) bool {
+		if phases[i].Plugin != phases[j].Plugin {
+			return phases[i].Plugin < phases[j].Plugin
+		}
+		return phases[i].Phase < phases[j].Phase
+	})
+
+	return report{
+		Total:      sf.total,
+		Plugins:    sf.plugins,
+		Components: sf.components,
+		Callers:    callers,
+		Phases:     phases,
+	}
+}
+
+// percentile returns the nearest-rank percentile p of the sorted durations.
+func percentile(sorted []time.Duration, p int) time.Duration {
+	rank := int(math.Ceil(float64(p) / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
+
+func milliseconds(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
+
+func (sf *statsFormatter) Close() error {
+	encoder := json.NewEncoder(sf.out)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(sf.report())
+}
@@ -0,0 +1,61 @@
+package stats
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-ccip/cmd/carpenter/internal/parse"
+)
+
+//nolint:lll // long test data
+var logs = []string{
+	`{"level":"info","ts":"2025-01-16T15:00:00.000Z","caller":"commit/plugin.go:10","msg":"a","plugin":"Commit","component":"MerkleRoot","donID":1,"oracleID":0,"ocrSeqNr":1,"ocrPhase":"obs"}`,
+	`{"level":"error","ts":"2025-01-16T15:00:00.100Z","caller":"commit/plugin.go:10","msg":"b","plugin":"Commit","component":"MerkleRoot","donID":1,"oracleID":0,"ocrSeqNr":1,"ocrPhase":"obs"}`,
+	`{"level":"info","ts":"2025-01-16T15:00:01.000Z","caller":"commit/plugin.go:20","msg":"c","plugin":"Commit","component":"ChainFee","donID":1,"oracleID":0,"ocrSeqNr":2,"ocrPhase":"obs"}`,
+	`{"level":"info","ts":"2025-01-16T15:00:01.300Z","caller":"commit/plugin.go:20","msg":"d","plugin":"Commit","component":"ChainFee","donID":1,"oracleID":0,"ocrSeqNr":2,"ocrPhase":"obs"}`,
+	`{"level":"info","ts":"2025-01-16T15:00:02.000Z","caller":"execute/plugin.go:30","msg":"e","plugin":"Execute","donID":2,"oracleID":0,"ocrSeqNr":5}`,
+}
+
+func Test_statsFormatter(t *testing.T) {
+	var out bytes.Buffer
+	sf := newStatsFormatter(&out)
+	for _, line := range logs {
+		data, err := parse.ParseLine(line, parse.LogTypeJSON)
+		require.NoError(t, err)
+		sf.Format(data)
+	}
+	require.NoError(t, sf.Close())
+
+	var got report
+	require.NoError(t, json.Unmarshal(out.Bytes(), &got))
+
+	require.Equal(t, 5, got.Total)
+	require.Equal(t, map[string]int{"Commit": 4, "Execute": 1}, got.Plugins)
+	require.Equal(t, map[string]map[string]int{
+		"Commit":  {"MerkleRoot": 2, "ChainFee": 2},
+		"Execute": {unknown: 1},
+	}, got.Components)
+
+	require.Len(t, got.Callers, 3)
+	require.Equal(t, callerStats{Caller: "commit/plugin.go:10", Logs: 2, Errors: 1, ErrorRate: 0.5}, got.Callers[0])
+
+	require.Equal(t, []phaseStats{{
+		Plugin:      "Commit",
+		Phase:       "obs",
+		Rounds:      2,
+		Percentiles: map[string]float64{"p50": 100, "p90": 300, "p99": 300},
+		Max:         300,
+	}}, got.Phases)
+}
+
+func Test_percentile(t *testing.T) {
+	durations := []time.Duration{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	require.Equal(t, time.Duration(5), percentile(durations, 50))
+	require.Equal(t, time.Duration(9), percentile(durations, 90))
+	require.Equal(t, time.Duration(10), percentile(durations, 99))
+	require.Equal(t, time.Duration(1), percentile(durations[:1], 50))
+}
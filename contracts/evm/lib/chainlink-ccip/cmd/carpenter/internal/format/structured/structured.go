@@ -0,0 +1,153 @@
+// Package structured prints the parsed log fields in machine-readable formats
+// which can be ingested by other tools.
+package structured
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-ccip/cmd/carpenter/internal/format"
+	"github.com/smartcontractkit/chainlink-ccip/cmd/carpenter/internal/parse"
+)
+
+func init() {
+	format.Register("ndjson", ndjsonFormatterFactory,
+		"Print one JSON object per log with the parsed fields and the raw logger fields.")
+	format.Register("csv", csvFormatterFactory, "Print the parsed fields of each log as CSV with a header row.")
+}
+
+func ndjsonFormatterFactory(options format.Options) format.Formatter {
+	return newNDJSONFormatter(os.Stdout)
+}
+
+func csvFormatterFactory(options format.Options) format.Formatter {
+	return newCSVFormatter(os.Stdout)
+}
+
+// record holds the parsed fields shared by all structured formats.
+type record struct {
+	Timestamp      time.Time      `json:"timestamp"`
+	Level          string         `json:"level"`
+	Logger         string         `json:"logger"`
+	Caller         string         `json:"caller"`
+	Message        string         `json:"message"`
+	Plugin         string         `json:"plugin"`
+	Component      string         `json:"component"`
+	DONID          int            `json:"donID"`
+	OracleID       int            `json:"oracleID"`
+	SequenceNumber int            `json:"ocrSeqNr"`
+	OCRPhase       string         `json:"ocrPhase"`
+	Version        string         `json:"version"`
+	ConfigDigest   string         `json:"configDigest"`
+	Fields         map[string]any `json:"fields,omitempty"`
+}
+
+func newRecord(data *parse.Data) record {
+	return record{
+		Timestamp:      data.GetTimestamp(),
+		Level:          data.GetLevel(),
+		Logger:         data.GetLoggerName(),
+		Caller:         data.GetCaller(),
+		Message:        data.GetMessage(),
+		Plugin:         data.Plugin,
+		Component:      data.Component,
+		DONID:          data.DONID,
+		OracleID:       data.OracleID,
+		SequenceNumber: data.SequenceNumber,
+		OCRPhase:       data.OCRPhase,
+		Version:        data.Version,
+		ConfigDigest:   data.ConfigDigest,
+		Fields:         data.RawLoggerFields,
+	}
+}
+
+// csvHeader lists the columns written by the csv formatter, the raw logger fields are not included.
+var csvHeader = []string{
+	"timestamp", "level", "logger", "caller", "message", "plugin", "component",
+	"donID", "oracleID", "ocrSeqNr", "ocrPhase", "version", "configDigest",
+}
+
+func (r record) csvRow() []string {
+	return []string{
+		r.Timestamp.Format(time.RFC3339Nano),
+		r.Level,
+		r.Logger,
+		r.Caller,
+		r.Message,
+		r.Plugin,
+		r.Component,
+		strconv.Itoa(r.DONID),
+		strconv.Itoa(r.OracleID),
+		strconv.Itoa(r.SequenceNumber),
+		r.OCRPhase,
+		r.Version,
+		r.ConfigDigest,
+	}
+}
+
+// ndjsonFormatter writes one JSON object per line.
+// Format cannot return an error, the first write error is returned by Close instead.
+type ndjsonFormatter struct {
+	encoder *json.Encoder
+	err     error
+}
+
+func newNDJSONFormatter(out io.Writer) *ndjsonFormatter {
+	return &ndjsonFormatter{encoder: json.NewEncoder(out)}
+}
+
+func (nf *ndjsonFormatter) Format(data *parse.Data) {
+	if data == nil || nf.err != nil {
+		return
+	}
+	if err := nf.encoder.Encode(newRecord(data)); err != nil {
+		nf.err = fmt.Errorf("failed to encode log: %w", err)
+	}
+}
+
+func (nf *ndjsonFormatter) Close() error {
+	return nf.err
+}
+
+// csvFormatter writes the header before the first log and flushes after every row so the
+// output can be consumed while logs are still being processed.
+type csvFormatter struct {
+	writer        *csv.Writer
+	headerWritten bool
+	err           error
+}
+
+func newCSVFormatter(out io.Writer) *csvFormatter {
+	return &csvFormatter{writer: csv.NewWriter(out)}
+}
+
+func (cf *csvFormatter) Format(data *parse.Data) {
+	if data == nil || cf.err != nil {
+		return
+	}
+	if !cf.headerWritten {
+		cf.write(csvHeader)
+		cf.headerWritten = true
+	}
+	cf.write(newRecord(data).csvRow())
+}
+
+func (cf *csvFormatter) write(row []string) {
+	if err := cf.writer.Write(row); err != nil {
+		cf.err = fmt.Errorf("failed to write csv row: %w", err)
+		return
+	}
+	cf.writer.Flush()
+	cf.err = cf.writer.Error()
+}
+
+func (cf *csvFormatter) Close() error {
+	cf.writer.Flush()
+	return errors.Join(cf.err, cf.writer.Error())
+}
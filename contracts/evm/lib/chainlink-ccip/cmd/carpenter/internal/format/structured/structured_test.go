@@ -0,0 +1,69 @@
+package structured
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-ccip/cmd/carpenter/internal/parse"
+)
+
+//nolint:lll // long test data
+var logs = []string{
+	`{"level":"info","ts":"2025-01-16T15:00:00.000Z","logger":"CCIPCommitPlugin","caller":"commit/plugin.go:10","msg":"hello, world","plugin":"Commit","component":"MerkleRoot","donID":1,"oracleID":2,"ocrSeqNr":3,"ocrPhase":"otcm","extra":"value"}`,
+	`{"level":"error","ts":"2025-01-16T15:00:01.500Z","logger":"CCIPExecPlugin","caller":"execute/plugin.go:20","msg":"failed","plugin":"Execute","donID":1,"oracleID":2,"ocrSeqNr":4}`,
+}
+
+func parseLogs(t *testing.T) []*parse.Data {
+	var result []*parse.Data
+	for _, line := range logs {
+		data, err := parse.ParseLine(line, parse.LogTypeJSON)
+		require.NoError(t, err)
+		result = append(result, data)
+	}
+	return result
+}
+
+func Test_ndjsonFormatter(t *testing.T) {
+	var out bytes.Buffer
+	nf := newNDJSONFormatter(&out)
+	for _, data := range parseLogs(t) {
+		nf.Format(data)
+	}
+	require.NoError(t, nf.Close())
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	require.Len(t, lines, 2)
+
+	var first map[string]any
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &first))
+	require.Equal(t, "2025-01-16T15:00:00Z", first["timestamp"])
+	require.Equal(t, "hello, world", first["message"])
+	require.Equal(t, "MerkleRoot", first["component"])
+	require.Equal(t, float64(3), first["ocrSeqNr"])
+	require.Equal(t, "value", first["fields"].(map[string]any)["extra"])
+}
+
+func Test_csvFormatter(t *testing.T) {
+	var out bytes.Buffer
+	cf := newCSVFormatter(&out)
+	for _, data := range parseLogs(t) {
+		cf.Format(data)
+	}
+	require.NoError(t, cf.Close())
+
+	rows, err := csv.NewReader(&out).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, rows, 3)
+	require.Equal(t, csvHeader, rows[0])
+	require.Equal(t, []string{
+		"2025-01-16T15:00:00Z", "info", "CCIPCommitPlugin", "commit/plugin.go:10", "hello, world", "Commit",
+		"MerkleRoot", "1", "2", "3", "otcm", "", "",
+	}, rows[1])
+	require.Equal(t, "2025-01-16T15:00:01.5Z", rows[2][0])
+	require.Equal(t, "error", rows[2][1])
+}
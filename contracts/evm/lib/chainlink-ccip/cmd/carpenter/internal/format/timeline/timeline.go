@@ -0,0 +1,453 @@
+// Package timeline correlates commit and execute plugin logs by message and prints the
+// stage transitions of every message for each oracle.
+package timeline
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/smartcontractkit/chainlink-ccip/cmd/carpenter/internal/format"
+	"github.com/smartcontractkit/chainlink-ccip/cmd/carpenter/internal/parse"
+	"github.com/smartcontractkit/chainlink-ccip/commit/merkleroot"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+)
+
+func init() {
+	format.Register("timeline", timelineFormatterFactory,
+		"Group logs by message and print the stage transitions of each oracle.")
+}
+
+func timelineFormatterFactory(options format.Options) format.Formatter {
+	return newTimelineFormatter(os.Stdout)
+}
+
+const (
+	// execObservationMsg and execOutcomeMsg are logged by the execute plugin at the end of each phase.
+	execObservationMsg = "execute plugin got observation"
+	execOutcomeMsg     = "generated outcome"
+
+	// maxExpandedRange guards against listing every message of a malformed range.
+	maxExpandedRange = 1024
+
+	timeLayout = "15:04:05.000"
+	padding    = "    "
+)
+
+var header = lipgloss.NewStyle().Bold(true)
+
+// messageKey identifies a message on its source chain.
+type messageKey struct {
+	chain  cciptypes.ChainSelector
+	seqNum cciptypes.SeqNum
+}
+
+// transition is a stage reached by an oracle in an OCR round.
+type transition struct {
+	stage     string
+	donID     int
+	oracleID  int
+	round     int
+	timestamp time.Time
+}
+
+// rangeTransition is a transition which applies to every message of a sequence number range.
+// When expand is set the range also introduces the messages it covers into the timeline,
+// otherwise it only annotates messages which are known from other logs.
+type rangeTransition struct {
+	transition
+	chain       cciptypes.ChainSelector
+	seqNumRange cciptypes.SeqNumRange
+	expand      bool
+}
+
+// timelineFormatter collects transitions across all log lines and prints them when closed.
+type timelineFormatter struct {
+	out io.Writer
+
+	msgTransitions   map[messageKey][]transition
+	rangeTransitions []rangeTransition
+	msgIDs           map[messageKey]cciptypes.Bytes32
+}
+
+func newTimelineFormatter(out io.Writer) *timelineFormatter {
+	return &timelineFormatter{
+		out:            out,
+		msgTransitions: make(map[messageKey][]transition),
+		msgIDs:         make(map[messageKey]cciptypes.Bytes32),
+	}
+}
+
+func (tf *timelineFormatter) Format(data *parse.Data) {
+	if data == nil {
+		return
+	}
+
+	switch data.GetMessage() {
+	case merkleroot.SendingObservation:
+		tf.commitObservation(data)
+	case merkleroot.SendingOutcome:
+		tf.commitOutcome(data)
+	case execObservationMsg:
+		tf.execObservation(data)
+	case execOutcomeMsg:
+		tf.execOutcome(data)
+	}
+}
+
+// seqNumChain is the subset of plugintypes.SeqNumChain used by the timeline.
+type seqNumChain struct {
+	ChainSel cciptypes.ChainSelector `json:"chainSel"`
+	SeqNum   cciptypes.SeqNum        `json:"seqNum"`
+}
+
+// merkleRootChain is the subset of cciptypes.MerkleRootChain used by the timeline.
+type merkleRootChain struct {
+	ChainSel     cciptypes.ChainSelector `json:"chain"`
+	SeqNumsRange cciptypes.SeqNumRange   `json:"seqNumsRange"`
+}
+
+// commitObservation is the subset of merkleroot.Observation used by the timeline.
+type commitObservation struct {
+	MerkleRoots        []merkleRootChain `json:"merkleRoots"`
+	OnRampMaxSeqNums   []seqNumChain     `json:"onRampMaxSeqNums"`
+	OffRampNextSeqNums []seqNumChain     `json:"offRampNextSeqNums"`
+}
+
+// commitOutcome is the subset of merkleroot.Outcome used by the timeline.
+type commitOutcome struct {
+	OutcomeType             merkleroot.OutcomeType `json:"outcomeType"`
+	RangesSelectedForReport []struct {
+		ChainSel    cciptypes.ChainSelector `json:"chain"`
+		SeqNumRange cciptypes.SeqNumRange   `json:"seqNumRange"`
+	} `json:"rangesSelectedForReport"`
+	RootsToReport []merkleRootChain `json:"rootsToReport"`
+}
+
+// commitData is the subset of exectypes.CommitData used by the timeline.
+type commitData struct {
+	SourceChain         cciptypes.ChainSelector `json:"chainSelector"`
+	SequenceNumberRange cciptypes.SeqNumRange   `json:"sequenceNumberRange"`
+}
+
+// message is the subset of cciptypes.Message used by the timeline.
+type message struct {
+	Header struct {
+		MessageID           cciptypes.Bytes32       `json:"messageId"`
+		SourceChainSelector cciptypes.ChainSelector `json:"sourceChainSelector,string"`
+		SequenceNumber      cciptypes.SeqNum        `json:"seqNum,string"`
+	} `json:"header"`
+}
+
+// execObservation is the subset of exectypes.Observation used by the timeline.
+type execObservation struct {
+	CommitReports map[cciptypes.ChainSelector][]commitData                 `json:"commitReports"`
+	Messages      map[cciptypes.ChainSelector]map[cciptypes.SeqNum]message `json:"messages"`
+}
+
+// execOutcome is the subset of exectypes.Outcome used by the timeline.
+type execOutcome struct {
+	CommitReports []commitData `json:"commitReports"`
+	Report        struct {
+		ChainReports []struct {
+			Messages []message `json:"messages"`
+		} `json:"chainReports"`
+	} `json:"report"`
+}
+
+// decodeField decodes a raw logger field into one of the structs above.
+// Fields which cannot be decoded are ignored, the line is simply not part of the timeline.
+func decodeField(data *parse.Data, field string, target any) bool {
+	raw, ok := data.RawLoggerFields[field]
+	if !ok {
+		return false
+	}
+	encoded, err := json.Marshal(raw)
+	if err != nil {
+		return false
+	}
+	return json.Unmarshal(encoded, target) == nil
+}
+
+func newTransition(data *parse.Data, stage string) transition {
+	return transition{
+		stage:     stage,
+		donID:     data.DONID,
+		oracleID:  data.OracleID,
+		round:     data.SequenceNumber,
+		timestamp: data.GetTimestamp(),
+	}
+}
+
+func (tf *timelineFormatter) addRange(
+	t transition, chain cciptypes.ChainSelector, seqNumRange cciptypes.SeqNumRange, expand bool,
+) {
+	tf.rangeTransitions = append(tf.rangeTransitions, rangeTransition{
+		transition:  t,
+		chain:       chain,
+		seqNumRange: seqNumRange,
+		expand:      expand,
+	})
+}
+
+func (tf *timelineFormatter) addMessage(t transition, msg message) {
+	key := messageKey{chain: msg.Header.SourceChainSelector, seqNum: msg.Header.SequenceNumber}
+	tf.msgTransitions[key] = append(tf.msgTransitions[key], t)
+	if !msg.Header.MessageID.IsEmpty() {
+		tf.msgIDs[key] = msg.Header.MessageID
+	}
+}
+
+func (tf *timelineFormatter) commitObservation(data *parse.Data) {
+	var obs commitObservation
+	if !decodeField(data, "observation", &obs) {
+		return
+	}
+
+	// Messages between the next offRamp and the max onRamp sequence numbers are waiting to be committed.
+	pending := newTransition(data, "commit observation: pending")
+	for _, next := range obs.OffRampNextSeqNums {
+		for _, maxSeqNum := range obs.OnRampMaxSeqNums {
+			if next.ChainSel == maxSeqNum.ChainSel && maxSeqNum.SeqNum >= next.SeqNum {
+				tf.addRange(pending, next.ChainSel, cciptypes.NewSeqNumRange(next.SeqNum, maxSeqNum.SeqNum), false)
+			}
+		}
+	}
+
+	observed := newTransition(data, "commit observation: merkle root")
+	for _, root := range obs.MerkleRoots {
+		tf.addRange(observed, root.ChainSel, root.SeqNumsRange, true)
+	}
+}
+
+func (tf *timelineFormatter) commitOutcome(data *parse.Data) {
+	var outcome commitOutcome
+	if !decodeField(data, "outcome", &outcome) {
+		return
+	}
+
+	t := newTransition(data, "commit outcome: "+outcomeTypeName(outcome.OutcomeType))
+	for _, r := range outcome.RangesSelectedForReport {
+		tf.addRange(t, r.ChainSel, r.SeqNumRange, true)
+	}
+	for _, root := range outcome.RootsToReport {
+		tf.addRange(t, root.ChainSel, root.SeqNumsRange, true)
+	}
+}
+
+func (tf *timelineFormatter) execObservation(data *parse.Data) {
+	var obs execObservation
+	if !decodeField(data, "observationWithoutMsgDataAndDiscoveryObs", &obs) {
+		return
+	}
+
+	state, _ := data.RawLoggerFields["state"].(string)
+	t := newTransition(data, fmt.Sprintf("exec %s observation", state))
+	for chain, reports := range obs.CommitReports {
+		for _, report := range reports {
+			tf.addRange(t, chain, report.SequenceNumberRange, true)
+		}
+	}
+	for _, msgs := range obs.Messages {
+		for _, msg := range msgs {
+			tf.addMessage(t, msg)
+		}
+	}
+}
+
+func (tf *timelineFormatter) execOutcome(data *parse.Data) {
+	var outcome execOutcome
+	if !decodeField(data, "outcomeWithoutMsgData", &outcome) {
+		return
+	}
+
+	state, _ := data.RawLoggerFields["execPluginState"].(string)
+	t := newTransition(data, fmt.Sprintf("exec %s outcome", state))
+	for _, report := range outcome.CommitReports {
+		tf.addRange(t, report.SourceChain, report.SequenceNumberRange, true)
+	}
+	for _, chainReport := range outcome.Report.ChainReports {
+		for _, msg := range chainReport.Messages {
+			tf.addMessage(t, msg)
+		}
+	}
+}
+
+func outcomeTypeName(outcomeType merkleroot.OutcomeType) string {
+	switch outcomeType {
+	case merkleroot.ReportIntervalsSelected:
+		return "ReportIntervalsSelected"
+	case merkleroot.ReportGenerated:
+		return "ReportGenerated"
+	case merkleroot.ReportEmpty:
+		return "ReportEmpty"
+	case merkleroot.ReportInFlight:
+		return "ReportInFlight"
+	case merkleroot.ReportTransmitted:
+		return "ReportTransmitted"
+	case merkleroot.ReportTransmissionFailed:
+		return "ReportTransmissionFailed"
+	default:
+		return fmt.Sprintf("OutcomeType(%d)", outcomeType)
+	}
+}
+
+// timeline returns the transitions of every message sorted by time.
+func (tf *timelineFormatter) timeline() map[messageKey][]transition {
+	timeline := make(map[messageKey][]transition, len(tf.msgTransitions))
+	for key, transitions := range tf.msgTransitions {
+		timeline[key] = append([]transition(nil), transitions...)
+	}
+
+	// Ranges only name the messages they cover once the range is known to contain them.
+	for _, rt := range tf.rangeTransitions {
+		if !rt.expand || rt.seqNumRange.Length() > maxExpandedRange {
+			continue
+		}
+		for _, seqNum := range rt.seqNumRange.ToSlice() {
+			key := messageKey{chain: rt.chain, seqNum: seqNum}
+			if _, ok := timeline[key]; !ok {
+				timeline[key] = nil
+			}
+		}
+	}
+	for key := range timeline {
+		for _, rt := range tf.rangeTransitions {
+			if rt.chain == key.chain && rt.seqNumRange.Contains(key.seqNum) {
+				timeline[key] = append(timeline[key], rt.transition)
+			}
+		}
+	}
+
+	for _, transitions := range timeline {
+		sort.SliceStable(transitions, func(i, j int) bool {
+			return transitions[i].timestamp.Before(transitions[j].timestamp)
+		})
+	}
+	return timeline
+}
+
+// oracleKey identifies an oracle across DONs.
+type oracleKey struct {
+	donID    int
+	oracleID int
+}
+
+// step is a transition followed by the number of consecutive rounds the oracle stayed in that stage.
+type step struct {
+	transition
+	rounds int
+}
+
+// steps collapses consecutive transitions of an oracle into the same stage.
+func steps(transitions []transition) map[oracleKey][]step {
+	result := make(map[oracleKey][]step)
+	for _, t := range transitions {
+		key := oracleKey{donID: t.donID, oracleID: t.oracleID}
+		oracleSteps := result[key]
+		if n := len(oracleSteps); n > 0 && oracleSteps[n-1].stage == t.stage {
+			if oracleSteps[n-1].round != t.round {
+				oracleSteps[n-1].rounds++
+			}
+			continue
+		}
+		result[key] = append(oracleSteps, step{transition: t, rounds: 1})
+	}
+	return result
+}
+
+// firstSeen returns the first transition into each stage across all oracles, in order.
+func firstSeen(transitions []transition) []transition {
+	seen := make(map[string]bool)
+	var result []transition
+	for _, t := range transitions {
+		if !seen[t.stage] {
+			seen[t.stage] = true
+			result = append(result, t)
+		}
+	}
+	return result
+}
+
+func formatDuration(previous, current time.Time) string {
+	if previous.IsZero() {
+		return ""
+	}
+	return "+" + current.Sub(previous).Round(time.Millisecond).String()
+}
+
+func (tf *timelineFormatter) Close() error {
+	timeline := tf.timeline()
+
+	keys := make([]messageKey, 0, len(timeline))
+	for key := range timeline {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].chain != keys[j].chain {
+			return keys[i].chain < keys[j].chain
+		}
+		return keys[i].seqNum < keys[j].seqNum
+	})
+
+	for _, key := range keys {
+		if err := tf.printMessage(key, timeline[key]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (tf *timelineFormatter) printMessage(key messageKey, transitions []transition) error {
+	title := fmt.Sprintf("Message %d:%d", key.chain, key.seqNum)
+	if msgID, ok := tf.msgIDs[key]; ok {
+		title += " " + msgID.String()
+	}
+	lines := []string{header.Render(title)}
+
+	var previous time.Time
+	for _, t := range firstSeen(transitions) {
+		lines = append(lines, fmt.Sprintf("%s%s %10s  %s",
+			padding, t.timestamp.Format(timeLayout), formatDuration(previous, t.timestamp), t.stage))
+		previous = t.timestamp
+	}
+
+	oracleSteps := steps(transitions)
+	oracles := make([]oracleKey, 0, len(oracleSteps))
+	for oracle := range oracleSteps {
+		oracles = append(oracles, oracle)
+	}
+	sort.Slice(oracles, func(i, j int) bool {
+		if oracles[i].donID != oracles[j].donID {
+			return oracles[i].donID < oracles[j].donID
+		}
+		return oracles[i].oracleID < oracles[j].oracleID
+	})
+
+	for _, oracle := range oracles {
+		lines = append(lines, fmt.Sprintf("%sDON %d oracle %d", padding, oracle.donID, oracle.oracleID))
+		previous = time.Time{}
+		for _, s := range oracleSteps[oracle] {
+			line := fmt.Sprintf("%s%sround %-6d %s %10s  %s", padding, padding,
+				s.round, s.timestamp.Format(timeLayout), formatDuration(previous, s.timestamp), s.stage)
+			if s.rounds > 1 {
+				line += fmt.Sprintf(" (%d rounds)", s.rounds)
+			}
+			lines = append(lines, line)
+			previous = s.timestamp
+		}
+	}
+
+	for _, line := range lines {
+		if _, err := fmt.Fprintln(tf.out, line); err != nil {
+			return err
+		}
+	}
+	_, err := fmt.Fprintln(tf.out)
+	return err
+}
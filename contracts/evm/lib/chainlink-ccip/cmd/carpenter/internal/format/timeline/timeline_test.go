@@ -0,0 +1,61 @@
+package timeline
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-ccip/cmd/carpenter/internal/parse"
+)
+
+//nolint:lll // long test data
+var timelineLogs = []string{
+	`{"level":"info","ts":"2025-01-16T15:00:00.000Z","msg":"sending merkle root processor observation","plugin":"Commit","donID":1,"oracleID":0,"ocrSeqNr":10,"observation":{"onRampMaxSeqNums":[{"chainSel":5,"seqNum":2}],"offRampNextSeqNums":[{"chainSel":5,"seqNum":1}]}}`,
+	`{"level":"info","ts":"2025-01-16T15:00:01.000Z","msg":"Sending Outcome","plugin":"Commit","donID":1,"oracleID":0,"ocrSeqNr":10,"outcome":{"outcomeType":1,"rangesSelectedForReport":[{"chain":5,"seqNumRange":[1,2]}]}}`,
+	`{"level":"info","ts":"2025-01-16T15:00:02.000Z","msg":"Sending Outcome","plugin":"Commit","donID":1,"oracleID":0,"ocrSeqNr":11,"outcome":{"outcomeType":2,"rootsToReport":[{"chain":5,"seqNumsRange":[1,2],"merkleRoot":"0x0100000000000000000000000000000000000000000000000000000000000000"}]}}`,
+	`{"level":"info","ts":"2025-01-16T15:00:03.000Z","msg":"Sending Outcome","plugin":"Commit","donID":1,"oracleID":0,"ocrSeqNr":12,"outcome":{"outcomeType":2,"rootsToReport":[{"chain":5,"seqNumsRange":[1,2],"merkleRoot":"0x0100000000000000000000000000000000000000000000000000000000000000"}]}}`,
+	`{"level":"info","ts":"2025-01-16T15:00:10.000Z","msg":"execute plugin got observation","plugin":"Execute","donID":2,"oracleID":3,"ocrSeqNr":20,"state":"GetCommitReports","observationWithoutMsgDataAndDiscoveryObs":{"commitReports":{"5":[{"chainSelector":5,"sequenceNumberRange":[1,2]}]}}}`,
+	`{"level":"info","ts":"2025-01-16T15:00:11.500Z","msg":"execute plugin got observation","plugin":"Execute","donID":2,"oracleID":3,"ocrSeqNr":21,"state":"GetMessages","observationWithoutMsgDataAndDiscoveryObs":{"messages":{"5":{"2":{"header":{"messageId":"0x0200000000000000000000000000000000000000000000000000000000000000","sourceChainSelector":"5","seqNum":"2"}}}}}}`,
+	`{"level":"info","ts":"2025-01-16T15:00:12.000Z","msg":"generated outcome","plugin":"Execute","donID":2,"oracleID":3,"ocrSeqNr":22,"execPluginState":"Filter","outcomeWithoutMsgData":{"report":{"chainReports":[{"sourceChainSelector":5,"messages":[{"header":{"messageId":"0x0200000000000000000000000000000000000000000000000000000000000000","sourceChainSelector":"5","seqNum":"2"}}]}]}}}`,
+	`{"level":"info","ts":"2025-01-16T15:00:13.000Z","msg":"unrelated","plugin":"Execute","donID":2,"oracleID":3,"ocrSeqNr":22}`,
+}
+
+func Test_timelineFormatter(t *testing.T) {
+	var out bytes.Buffer
+	tf := newTimelineFormatter(&out)
+	for _, line := range timelineLogs {
+		data, err := parse.ParseLine(line, parse.LogTypeJSON)
+		require.NoError(t, err)
+		tf.Format(data)
+	}
+	require.NoError(t, tf.Close())
+
+	messages := strings.Split(strings.TrimSpace(out.String()), "\n\n")
+	require.Len(t, messages, 2)
+
+	// the first message is only known from the commit ranges and the exec commit reports.
+	require.Contains(t, messages[0], "Message 5:1\n")
+	require.Contains(t, messages[0], "commit observation: pending")
+	require.Contains(t, messages[0], "commit outcome: ReportGenerated (2 rounds)")
+	require.NotContains(t, messages[0], "exec GetMessages observation")
+
+	require.Contains(t, messages[1], "Message 5:2 0x0200000000000000000000000000000000000000000000000000000000000000")
+	require.Contains(t, messages[1], "DON 1 oracle 0")
+	require.Contains(t, messages[1], "DON 2 oracle 3")
+
+	stages := []string{
+		"commit observation: pending",
+		"commit outcome: ReportIntervalsSelected",
+		"commit outcome: ReportGenerated",
+		"exec GetCommitReports observation",
+		"exec GetMessages observation",
+		"exec Filter outcome",
+	}
+	lines := strings.Split(messages[1], "\n")
+	for i, stage := range stages {
+		require.True(t, strings.HasSuffix(lines[i+1], stage), "line %d: %q", i+1, lines[i+1])
+	}
+	require.Contains(t, lines[5], "+1.5s")
+}
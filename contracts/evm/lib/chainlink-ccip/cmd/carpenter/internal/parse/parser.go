@@ -312,7 +312,19 @@ type Data struct {
 	Details string
 }
 
+// timeStringLayout is the layout used by time.Time.String().
+const timeStringLayout = "2006-01-02 15:04:05.999999999 -0700 MST"
+
 func (data Data) GetTimestamp() time.Time {
+	ts, err := data.ParseTimestamp()
+	if err != nil {
+		panic(err.Error())
+	}
+	return ts
+}
+
+// ParseTimestamp parses the timestamp of the log, logged as RFC3339, time only or time.Time.String().
+func (data Data) ParseTimestamp() (time.Time, error) {
 	str := data.TestTimestamp
 	if data.ProdTimestamp != "" {
 		str = data.ProdTimestamp
@@ -323,13 +335,16 @@ func (data Data) GetTimestamp() time.Time {
 		var err2 error
 		parsedTs, err2 = time.Parse(time.TimeOnly, str)
 		if err2 != nil {
-			panic("could not parse timestamp: " + err1.Error())
-			return time.Time{}
+			// mixed logs store the timestamp using time.Time.String().
+			var err3 error
+			parsedTs, err3 = time.Parse(timeStringLayout, str)
+			if err3 != nil {
+				return time.Time{}, fmt.Errorf("could not parse timestamp: %w", err1)
+			}
 		}
 	}
 
-	return parsedTs
-	return parsedTs
+	return parsedTs, nil
 }
 
 func (data Data) GetLevel() string {
@@ -339,6 +354,16 @@ func (data Data) GetLevel() string {
 	return data.TestLevel
 }
 
+// IsError reports whether the log level is error or more severe.
+func (data Data) IsError() bool {
+	switch strings.ToLower(data.GetLevel()) {
+	case "error", "crit", "dpanic", "panic", "fatal":
+		return true
+	default:
+		return false
+	}
+}
+
 func (data Data) GetLoggerName() string {
 	if data.ProdLoggerName != "" {
 		return data.ProdLoggerName
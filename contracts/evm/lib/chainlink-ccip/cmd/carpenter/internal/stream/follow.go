@@ -0,0 +1,130 @@
+package stream
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+// DefaultPollInterval is how often a followed file is checked for new data once the end is reached.
+const DefaultPollInterval = 250 * time.Millisecond
+
+// follower reads a file like 'tail -F': when the end of the file is reached it waits for more data
+// instead of returning io.EOF. If the file is rotated (replaced by a new file with the same name)
+// or truncated, reading continues from the start of the new content.
+type follower struct {
+	filename     string
+	pollInterval time.Duration
+
+	mu     sync.Mutex
+	file   *os.File
+	closed chan struct{}
+	once   sync.Once
+}
+
+func newFollower(filename string, pollInterval time.Duration) (*follower, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, fmt.Errorf("error opening %s: %w", filename, err)
+	}
+	if pollInterval <= 0 {
+		pollInterval = DefaultPollInterval
+	}
+	return &follower{
+		filename:     filename,
+		pollInterval: pollInterval,
+		file:         f,
+		closed:       make(chan struct{}),
+	}, nil
+}
+
+// Read blocks until data is available or the follower is closed, in which case io.EOF is returned.
+func (fl *follower) Read(p []byte) (int, error) {
+	for {
+		select {
+		case <-fl.closed:
+			return 0, io.EOF
+		default:
+		}
+
+		fl.mu.Lock()
+		n, err := fl.file.Read(p)
+		fl.mu.Unlock()
+		if n > 0 {
+			return n, nil
+		}
+		if err != nil && !errors.Is(err, io.EOF) {
+			return 0, err
+		}
+
+		// Everything was read from the current file, check if it was rotated before waiting.
+		reopened, err := fl.reopenIfRotated()
+		if err != nil {
+			return 0, err
+		}
+		if reopened {
+			continue
+		}
+
+		select {
+		case <-fl.closed:
+			return 0, io.EOF
+		case <-time.After(fl.pollInterval):
+		}
+	}
+}
+
+// reopenIfRotated opens the file again if the name points to a different file, or seeks to the
+// start if the file was truncated. A missing file is not an error, it may be in the middle of being rotated.
+func (fl *follower) reopenIfRotated() (bool, error) {
+	fl.mu.Lock()
+	defer fl.mu.Unlock()
+
+	current, err := fl.file.Stat()
+	if err != nil {
+		return false, fmt.Errorf("error reading %s: %w", fl.filename, err)
+	}
+	latest, err := os.Stat(fl.filename)
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("error reading %s: %w", fl.filename, err)
+	}
+
+	if !os.SameFile(current, latest) {
+		f, err := os.Open(fl.filename)
+		if errors.Is(err, os.ErrNotExist) {
+			return false, nil
+		}
+		if err != nil {
+			return false, fmt.Errorf("error opening %s: %w", fl.filename, err)
+		}
+		_ = fl.file.Close()
+		fl.file = f
+		return true, nil
+	}
+
+	offset, err := fl.file.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return false, fmt.Errorf("error reading %s: %w", fl.filename, err)
+	}
+	if latest.Size() < offset {
+		if _, err := fl.file.Seek(0, io.SeekStart); err != nil {
+			return false, fmt.Errorf("error reading %s: %w", fl.filename, err)
+		}
+		return true, nil
+	}
+	return false, nil
+}
+
+// Close stops waiting for new data and closes the file.
+func (fl *follower) Close() error {
+	fl.once.Do(func() { close(fl.closed) })
+	fl.mu.Lock()
+	defer fl.mu.Unlock()
+	return fl.file.Close()
+}
@@ -0,0 +1,66 @@
+package stream
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_follower(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "node.log")
+	require.NoError(t, os.WriteFile(filename, []byte("first\n"), 0o600))
+
+	input, err := InitializeInputStream(InputOptions{
+		Filenames:    []string{filename},
+		Follow:       true,
+		PollInterval: 5 * time.Millisecond,
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, input.Close()) })
+
+	lines := make(chan string)
+	go func() {
+		scanner := bufio.NewScanner(input)
+		for scanner.Scan() {
+			lines <- scanner.Text()
+		}
+		close(lines)
+	}()
+	next := func() string {
+		select {
+		case line := <-lines:
+			return line
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for line")
+			return ""
+		}
+	}
+
+	require.Equal(t, "first", next())
+
+	// appended data
+	f, err := os.OpenFile(filename, os.O_APPEND|os.O_WRONLY, 0o600)
+	require.NoError(t, err)
+	_, err = f.WriteString("second\n")
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+	require.Equal(t, "second", next())
+
+	// rotation: the file is moved away and a new one is created with the same name
+	require.NoError(t, os.Rename(filename, filename+".1"))
+	require.NoError(t, os.WriteFile(filename, []byte("third\n"), 0o600))
+	require.Equal(t, "third", next())
+
+	// truncation
+	require.NoError(t, os.WriteFile(filename, []byte("4\n"), 0o600))
+	require.Equal(t, "4", next())
+}
+
+func Test_follower_requiresFile(t *testing.T) {
+	_, err := InitializeInputStream(InputOptions{Follow: true})
+	require.Error(t, err)
+}
@@ -4,14 +4,23 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"time"
 )
 
 type InputOptions struct {
 	Filenames []string
+
+	// Follow keeps reading the file as it grows, following it when it is rotated.
+	Follow bool
+	// PollInterval is how often a followed file is checked for new data, DefaultPollInterval if unset.
+	PollInterval time.Duration
 }
 
 func InitializeInputStream(opt InputOptions) (io.ReadCloser, error) {
 	if len(opt.Filenames) == 0 {
+		if opt.Follow {
+			return nil, fmt.Errorf("follow requires a file to read")
+		}
 		return os.Stdin, nil
 	}
 	if len(opt.Filenames) > 1 {
@@ -19,31 +28,14 @@ func InitializeInputStream(opt InputOptions) (io.ReadCloser, error) {
 	}
 
 	filename := opt.Filenames[0]
+	if opt.Follow {
+		return newFollower(filename, opt.PollInterval)
+	}
+
 	f, err := os.Open(filename)
 	if err != nil {
 		return nil, fmt.Errorf("error opening %s: %w", filename, err)
 	}
 
 	return f, nil
-
-	// TODO: tail the file.
-	/*
-		if *cat {
-			inputStream = f
-		} else {
-			// Close the handle - we just wanted to verify it was valid
-			f.Close()
-
-			cmd := exec.Command("tail", "-n", "-1000", "-F", *filename)
-			var err error
-			inputStream, err = cmd.StdoutPipe()
-			if err != nil {
-				errorf("cannot collect tail -F output of file: %v", err)
-			}
-			err = cmd.Start()
-			if err != nil {
-				errorf("cannot collect tail -F output of file: %v", err)
-			}
-		}
-	*/
 }
@@ -8,7 +8,11 @@ import (
 	// Register the formatters
 	_ "github.com/smartcontractkit/chainlink-ccip/cmd/carpenter/internal/format/basic"
 	_ "github.com/smartcontractkit/chainlink-ccip/cmd/carpenter/internal/format/fancy"
+	_ "github.com/smartcontractkit/chainlink-ccip/cmd/carpenter/internal/format/rate"
+	_ "github.com/smartcontractkit/chainlink-ccip/cmd/carpenter/internal/format/stats"
+	_ "github.com/smartcontractkit/chainlink-ccip/cmd/carpenter/internal/format/structured"
 	_ "github.com/smartcontractkit/chainlink-ccip/cmd/carpenter/internal/format/summary"
+	_ "github.com/smartcontractkit/chainlink-ccip/cmd/carpenter/internal/format/timeline"
 )
 
 func main() {
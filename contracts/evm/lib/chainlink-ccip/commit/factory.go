@@ -187,19 +187,26 @@ func (p *PluginFactory) NewReportingPlugin(ctx context.Context, config ocr3types
 		p.addrCodec,
 	)
 
-	// The node supports the chain that the token prices are on.
-	_, ok := readers[offchainConfig.PriceFeedChainSelector]
-	if ok {
-		// Bind all token aggregate contracts
-		var bcs []types.BoundContract
-		for _, info := range offchainConfig.TokenInfo {
-			bcs = append(bcs, types.BoundContract{
-				Address: string(info.AggregatorAddress),
-				Name:    consts.ContractNamePriceAggregator,
-			})
+	// Bind the token aggregator contracts on the feed chains supported by the node.
+	aggregators := make(map[cciptypes.ChainSelector][]types.BoundContract)
+	for _, info := range offchainConfig.TokenInfo {
+		if info.PriceSource() != pluginconfig.TokenPriceSourceAggregator {
+			continue
 		}
-		if err1 := readers[offchainConfig.PriceFeedChainSelector].Bind(ctx, bcs); err1 != nil {
-			return nil, ocr3types.ReportingPluginInfo{}, fmt.Errorf("failed to bind token price contracts: %w", err1)
+		chain := info.AggregatorChain(offchainConfig.PriceFeedChainSelector)
+		aggregators[chain] = append(aggregators[chain], types.BoundContract{
+			Address: string(info.AggregatorAddress),
+			Name:    consts.ContractNamePriceAggregator,
+		})
+	}
+	for chain, bcs := range aggregators {
+		feedChainReader, ok := readers[chain]
+		if !ok {
+			continue
+		}
+		if err1 := feedChainReader.Bind(ctx, bcs); err1 != nil {
+			return nil, ocr3types.ReportingPluginInfo{},
+				fmt.Errorf("failed to bind token price contracts on chain %d: %w", chain, err1)
 		}
 	}
 
@@ -217,11 +224,7 @@ func (p *PluginFactory) NewReportingPlugin(ctx context.Context, config ocr3types
 		return nil, ocr3types.ReportingPluginInfo{}, fmt.Errorf("failed to create metrics reporter: %w", err)
 	}
 
-	reportBuilder, err := builder.NewReportBuilder(
-		offchainConfig.RMNEnabled,
-		offchainConfig.MaxMerkleRootsPerReport,
-		offchainConfig.MaxPricesPerReport,
-	)
+	reportBuilder, err := NewReportBuilder(offchainConfig)
 	if err != nil {
 		return nil, ocr3types.ReportingPluginInfo{}, fmt.Errorf("failed to create report builder: %w", err)
 	}
@@ -290,3 +293,12 @@ func (p PluginFactory) HealthReport() map[string]error {
 
 // Interface compatibility checks.
 var _ core.OCR3ReportingPluginFactory = &PluginFactory{}
+
+// NewReportBuilder returns the report builder of the plugin for the given offchain config.
+func NewReportBuilder(offchainConfig pluginconfig.CommitOffchainConfig) (builder.ReportBuilderFunc, error) {
+	return builder.NewReportBuilder(
+		offchainConfig.RMNEnabled,
+		offchainConfig.MaxMerkleRootsPerReport,
+		offchainConfig.MaxPricesPerReport,
+	)
+}
@@ -55,6 +55,9 @@ var (
 
 	// ErrNotFound is returned when the RMN node is not found in the RMN home or the Remote contracts.
 	ErrNotFound = errors.New("rmn node not found")
+
+	// errInvalidSignature is wrapped by the validation errors of responses with an invalid signature.
+	errInvalidSignature = errors.New("invalid signature")
 )
 
 // Controller contains the high-level functionality required by the plugin to interact with the RMN nodes.
@@ -84,6 +87,10 @@ type Controller interface {
 		requestedUpdates []*rmnpb.FixedDestLaneUpdateRequest,
 		rmnRemoteCfg cciptypes.RemoteConfig,
 	) (*ReportSignatures, error)
+
+	// NodeScores returns the reliability scores of the RMN nodes the controller sent requests to, sorted by node ID.
+	// Nodes are picked from the healthiest, which helps explaining insufficient responses.
+	NodeScores() []NodeScore
 }
 
 type ReportSignatures struct {
@@ -122,6 +129,9 @@ type controller struct {
 	reportsInitialRequestTimerDuration time.Duration
 
 	metricsReporter MetricsReporter
+
+	// scores tracks the reliability of the RMN nodes, used to send the requests to the healthiest nodes first.
+	scores *nodeScores
 }
 
 // NewController creates a new RMN Controller instance.
@@ -150,6 +160,7 @@ func NewController(
 		observationsInitialRequestTimerDuration: observationsInitialRequestTimerDuration,
 		reportsInitialRequestTimerDuration:      reportsInitialRequestTimerDuration,
 		metricsReporter:                         metricsReporter,
+		scores:                                  newNodeScores(),
 	}
 }
 
@@ -172,6 +183,7 @@ func (c *controller) ComputeReportSignatures(
 	}
 
 	lggr.Infow("got RMN nodes info", "nodes", rmnNodeInfo)
+	c.reportNodeScores()
 	lggr.Infow("requested updates", "updates", updateRequests)
 
 	updatesPerChain, err := populateUpdatesPerChain(updateRequests, rmnNodes)
@@ -215,6 +227,9 @@ func (c *controller) ComputeReportSignatures(
 		homeFMap,
 		rmnNodeInfo)
 	if err != nil {
+		if errors.Is(err, ErrAllChainsNotReady) {
+			lggr.Warnw("not enough RMN observations", "nodeScores", c.NodeScores())
+		}
 		return nil, fmt.Errorf("get rmn signed observations: %w", err)
 	}
 	lggr.Infow("received RMN signed observations",
@@ -233,6 +248,9 @@ func (c *controller) ComputeReportSignatures(
 		rmnRemoteCfg,
 		rmnNodeInfo)
 	if err != nil {
+		if errors.Is(err, ErrInsufficientSignatureResponses) {
+			lggr.Warnw("not enough RMN report signatures", "nodeScores", c.NodeScores())
+		}
 		return nil, fmt.Errorf("get rmn report signatures: %w", err)
 	}
 	lggr.Infow("received RMN report signatures",
@@ -244,6 +262,24 @@ func (c *controller) ComputeReportSignatures(
 	return rmnReportSignatures, nil
 }
 
+func (c *controller) NodeScores() []NodeScore {
+	return c.scores.all()
+}
+
+// trackRmnRequest tracks the result of a request in the metrics and in the score of the node.
+func (c *controller) trackRmnRequest(method string, latency float64, nodeID uint64, err string) {
+	c.metricsReporter.TrackRmnRequest(method, latency, nodeID, err)
+	c.metricsReporter.TrackRmnNodeScore(c.scores.track(nodeID, latency, err))
+}
+
+// reportNodeScores reports the current score of every node, the score of a node that is not sent requests anymore
+// changes as its results expire.
+func (c *controller) reportNodeScores() {
+	for _, score := range c.scores.all() {
+		c.metricsReporter.TrackRmnNodeScore(score)
+	}
+}
+
 // populateUpdatesPerChain processes a list of update requests, groups the lane updates by their source chain
 // and populates the items with metadata for each update request, including a set of RMN nodes supporting that request.
 func populateUpdatesPerChain(
@@ -304,7 +340,7 @@ func (c *controller) getRmnSignedObservations(
 	// of initial observers. Upon timer expiration, additional requests are sent to the rest of the RMN nodes.
 
 	chainsWithEnoughRequests := mapset.NewSet[uint64]()
-	for nodeID := range rmnNodeInfo {
+	for _, nodeID := range rankNodes(c.scores, maps.Keys(rmnNodeInfo), nodeIDToUint64) {
 		if chainsWithEnoughRequests.Cardinality() == len(updateRequestsPerChain) {
 			break // We have enough initial observers for all source chains.
 		}
@@ -426,7 +462,7 @@ func (c *controller) sendObservationRequests(
 		lggr := logger.With(lggr, "node", nodeID, "requestID", req.RequestId)
 		lggr.Infow("sending observation request", "laneUpdateRequests", requests)
 		if err := c.marshalAndSend(req, rmnNode); err != nil {
-			c.metricsReporter.TrackRmnRequest(RmnMethodObservation, 0, uint64(nodeID), "failed_to_send_request")
+			c.trackRmnRequest(RmnMethodObservation, 0, uint64(nodeID), rmnErrFailedToSend)
 			lggr.Errorw("failed to send observation request", "err", err)
 			continue
 		}
@@ -483,11 +519,11 @@ func (c *controller) listenForRmnObservationResponses(
 			)
 
 			if err != nil {
-				c.metricsReporter.TrackRmnRequest(RmnMethodObservation, latency, uint64(resp.RMNNodeID), "invalid_response")
+				c.trackRmnRequest(RmnMethodObservation, latency, uint64(resp.RMNNodeID), rmnErrorOf(err))
 				lggr.Warnw("skipping an invalid RMN observation response", "err", err)
 				initialObservationRequestTimer.Reset(0) // immediately schedule the additional requests
 			} else {
-				c.metricsReporter.TrackRmnRequest(RmnMethodObservation, latency, uint64(resp.RMNNodeID), "")
+				c.trackRmnRequest(RmnMethodObservation, latency, uint64(resp.RMNNodeID), "")
 				rmnObservationResponses = append(rmnObservationResponses, rmnSignedObservationWithMeta{
 					SignedObservation: parsedResp.GetSignedObservation(),
 					RMNNodeID:         resp.RMNNodeID,
@@ -526,7 +562,7 @@ func (c *controller) listenForRmnObservationResponses(
 			lggr.Warn("sending additional RMN observation requests")
 			requestsPerNode := make(map[rmntypes.NodeID][]*rmnpb.FixedDestLaneUpdateRequest)
 			for sourceChain, updateReq := range lursPerChain {
-				for _, nodeID := range randomShuffle(updateReq.RmnNodes.ToSlice()) {
+				for _, nodeID := range rankNodes(c.scores, updateReq.RmnNodes.ToSlice(), nodeIDToUint64) {
 					if requestedNodes[sourceChain].Contains(nodeID) {
 						continue
 					}
@@ -540,8 +576,7 @@ func (c *controller) listenForRmnObservationResponses(
 			// Report metrics for requests we never received responses for
 			for requestID, requestInfo := range inFlightRequests {
 				if !finishedRequestIDs.Contains(requestID) {
-					c.metricsReporter.TrackRmnRequest(RmnMethodObservation, requestInfo.Latency(),
-						requestInfo.nodeID, "timeout")
+					c.trackRmnRequest(RmnMethodObservation, requestInfo.Latency(), requestInfo.nodeID, rmnErrTimeout)
 					lggr.Warnw("Timed out waiting for an observation response from RMN",
 						"requestID", requestID, "nodeID", requestInfo.nodeID, "latency", requestInfo.Latency())
 				}
@@ -676,7 +711,7 @@ func (c *controller) validateSignedObservationResponse(
 	}
 
 	if err := verifyObservationSignature(rmnNode, c.signObservationPrefix, signedObs, c.ed25519Verifier); err != nil {
-		return fmt.Errorf("failed to verify observation signature: %w", err)
+		return fmt.Errorf("failed to verify observation signature: %w: %w", errInvalidSignature, err)
 	}
 	return nil
 }
@@ -874,7 +909,7 @@ func selectRoots(
 	return selectedRoots, nil
 }
 
-// sendReportSignatureRequest sends the report signature request to #remoteF+1 random RMN nodes.
+// sendReportSignatureRequest sends the report signature request to the #remoteF+1 healthiest RMN nodes.
 // If not enough requests were sent, it returns an error.
 func (c *controller) sendReportSignatureRequest(
 	lggr logger.Logger,
@@ -888,7 +923,7 @@ func (c *controller) sendReportSignatureRequest(
 	signersRequested = mapset.NewSet[rmntypes.NodeID]()
 
 	// Send the report signature request to at least #remoteF+1
-	for _, node := range randomShuffle(remoteSigners) {
+	for _, node := range rankNodes(c.scores, remoteSigners, signerNodeIndex) {
 		if consensus.GteFPlusOne(remoteF, len(inFlightRequests)) {
 			break
 		}
@@ -911,7 +946,7 @@ func (c *controller) sendReportSignatureRequest(
 		err := c.marshalAndSend(req, rmnNode)
 		if err != nil {
 			lggr.Warnw("failed to send report signature request", "node", node.NodeIndex, "err", err)
-			c.metricsReporter.TrackRmnRequest(RmnMethodReportSignature, 0, node.NodeIndex, "failed_to_send_request")
+			c.trackRmnRequest(RmnMethodReportSignature, 0, node.NodeIndex, rmnErrFailedToSend)
 			continue
 		}
 
@@ -967,11 +1002,11 @@ func (c *controller) listenForRmnReportSignatures(
 			reportSig, err := c.validateReportSigResponse(ctx, responseTyp, resp.RMNNodeID, signers, rmnReport)
 
 			if err != nil {
-				c.metricsReporter.TrackRmnRequest(RmnMethodReportSignature, latency, uint64(resp.RMNNodeID), "invalid_response")
+				c.trackRmnRequest(RmnMethodReportSignature, latency, uint64(resp.RMNNodeID), rmnErrorOf(err))
 				lggr.Warnw("skipping an invalid RMN report signature response", "err", err)
 				tReportsInitialRequest.Reset(0) // schedule additional requests if any
 			} else {
-				c.metricsReporter.TrackRmnRequest(RmnMethodReportSignature, latency, uint64(resp.RMNNodeID), "")
+				c.trackRmnRequest(RmnMethodReportSignature, latency, uint64(resp.RMNNodeID), "")
 				lggr.Infow("received valid report signature", "node", resp.RMNNodeID, "requestID", responseTyp.RequestId)
 				reportSigs = append(reportSigs, *reportSig)
 			}
@@ -993,7 +1028,7 @@ func (c *controller) listenForRmnReportSignatures(
 
 			lggr.Warnw("sending additional RMN signature requests")
 
-			for _, node := range randomShuffle(signers) {
+			for _, node := range rankNodes(c.scores, signers, signerNodeIndex) {
 				nodeIndex := node.NodeIndex
 				if signersRequested.Contains(rmntypes.NodeID(nodeIndex)) {
 					continue
@@ -1023,8 +1058,7 @@ func (c *controller) listenForRmnReportSignatures(
 			// Report metrics for requests we never received responses for
 			for requestID, requestInfo := range inFlightRequests {
 				if !finishedRequests.Contains(requestID) {
-					c.metricsReporter.TrackRmnRequest(RmnMethodReportSignature, requestInfo.Latency(),
-						requestInfo.nodeID, "timeout")
+					c.trackRmnRequest(RmnMethodReportSignature, requestInfo.Latency(), requestInfo.nodeID, rmnErrTimeout)
 					lggr.Warnw("Timed out waiting for a report signature response from RMN",
 						"requestID", requestID, "nodeID", requestInfo.nodeID, "latency", requestInfo.Latency())
 				}
@@ -1078,7 +1112,7 @@ func (c *controller) validateReportSigResponse(
 	)
 
 	if err != nil {
-		return nil, fmt.Errorf("failed to verify report signature: %w", err)
+		return nil, fmt.Errorf("failed to verify report signature: %w: %w", errInvalidSignature, err)
 	}
 
 	return &reportSigWithSignerAddress{
@@ -1174,6 +1208,22 @@ func (c *controller) parseResponse(
 	return responseTyp, requestInfo.Latency(), nil
 }
 
+// rmnErrorOf returns the tracked error of an invalid response.
+func rmnErrorOf(validationErr error) string {
+	if errors.Is(validationErr, errInvalidSignature) {
+		return rmnErrInvalidSignature
+	}
+	return rmnErrInvalidResponse
+}
+
+func nodeIDToUint64(nodeID rmntypes.NodeID) uint64 {
+	return uint64(nodeID)
+}
+
+func signerNodeIndex(signer cciptypes.RemoteSignerInfo) uint64 {
+	return signer.NodeIndex
+}
+
 func randomShuffle[T any](s []T) []T {
 	ret := make([]T, len(s))
 	for i, randIndex := range rand.Perm(len(s)) {
@@ -433,6 +433,7 @@ func TestClient_ComputeReportSignatures(t *testing.T) {
 			ed25519Verifier:                         signatureVerifierAlwaysTrue{},
 			rmnCrypto:                               signatureVerifierAlwaysTrue{},
 			metricsReporter:                         NoopMetrics{},
+			scores:                                  newNodeScores(),
 		}
 
 		updateRequests := []*rmnpb.FixedDestLaneUpdateRequest{
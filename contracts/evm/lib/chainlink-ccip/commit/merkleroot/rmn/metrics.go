@@ -5,10 +5,22 @@ const (
 	RmnMethodReportSignature = "report_signature"
 )
 
+// Errors of an RMN request as tracked by the MetricsReporter, the error is empty for a valid response.
+const (
+	rmnErrFailedToSend     = "failed_to_send_request"
+	rmnErrInvalidResponse  = "invalid_response"
+	rmnErrInvalidSignature = "invalid_signature"
+	rmnErrTimeout          = "timeout"
+)
+
 type MetricsReporter interface {
 	TrackRmnRequest(method string, latency float64, nodeID uint64, err string)
+	// TrackRmnNodeScore tracks the reliability score of an RMN node, updated after each of its requests.
+	TrackRmnNodeScore(score NodeScore)
 }
 
 type NoopMetrics struct{}
 
 func (n NoopMetrics) TrackRmnRequest(string, float64, uint64, string) {}
+
+func (n NoopMetrics) TrackRmnNodeScore(NodeScore) {}
@@ -0,0 +1,164 @@
+package rmn
+
+import (
+	"math"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+)
+
+// nodeScoreWindow is the number of most recent requests of an RMN node its score is computed over,
+// so that a node recovering from an outage gets back to its healthy score.
+const nodeScoreWindow = 100
+
+// nodeScoreMaxAge is the age after which the result of a request no longer counts in the score of its node.
+// Nodes with a low score are picked last and may get no request for a long time, expiring their failures
+// gets them back to a healthy score so that they are probed again.
+const nodeScoreMaxAge = 15 * time.Minute
+
+// nodeScoreResolution is the resolution at which nodes are considered equally healthy. Nodes with the same
+// score at this resolution are picked in random order, which spreads the load on the healthy nodes.
+const nodeScoreResolution = 0.1
+
+// NodeScore is the reliability of an RMN node over its last nodeScoreWindow requests of the last nodeScoreMaxAge.
+type NodeScore struct {
+	NodeID uint64 `json:"nodeID"`
+	// Requests is the number of requests sent to the node, or that failed to be sent.
+	Requests int `json:"requests"`
+	// Successes is the number of valid responses.
+	Successes int `json:"successes"`
+	// InvalidResponses is the number of responses that failed the validation, including InvalidSignatures.
+	InvalidResponses int `json:"invalidResponses"`
+	// InvalidSignatures is the number of responses with an invalid observation or report signature.
+	InvalidSignatures int `json:"invalidSignatures"`
+	// Timeouts is the number of requests the node did not respond to in time.
+	Timeouts int `json:"timeouts"`
+	// FailedSends is the number of requests that could not be sent to the node.
+	FailedSends int `json:"failedSends"`
+	// SuccessRate is Successes / Requests, 0 when no request was sent yet.
+	SuccessRate float64 `json:"successRate"`
+	// LatencyP50 and LatencyP99 are the latency percentiles in milliseconds of the valid responses.
+	LatencyP50 float64 `json:"latencyP50"`
+	LatencyP99 float64 `json:"latencyP99"`
+	// Score is the success rate with one successful request assumed, so that a node without history
+	// is considered healthy, 1 being the best score.
+	Score float64 `json:"score"`
+}
+
+type requestResult struct {
+	at      time.Time
+	latency float64
+	err     string
+}
+
+// nodeScores tracks the results of the requests sent to each RMN node and scores the nodes.
+// It is safe for concurrent use.
+type nodeScores struct {
+	mu      sync.RWMutex
+	results map[uint64][]requestResult
+	now     func() time.Time
+}
+
+func newNodeScores() *nodeScores {
+	return &nodeScores{results: make(map[uint64][]requestResult), now: time.Now}
+}
+
+// track records the result of a request, err is empty for a valid response or one of the rmnErr* values.
+func (s *nodeScores) track(nodeID uint64, latency float64, err string) NodeScore {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	results := append(recentResults(s.results[nodeID], now), requestResult{at: now, latency: latency, err: err})
+	if len(results) > nodeScoreWindow {
+		results = results[len(results)-nodeScoreWindow:]
+	}
+	s.results[nodeID] = results
+	return computeNodeScore(nodeID, results)
+}
+
+// get returns the score of the node, a node without history has a Score of 1.
+func (s *nodeScores) get(nodeID uint64) NodeScore {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return computeNodeScore(nodeID, recentResults(s.results[nodeID], s.now()))
+}
+
+// all returns the scores of all the nodes a request was tracked for, sorted by node ID. Nodes whose results
+// all expired are included with the score of a node without history, so that their reported score recovers too.
+func (s *nodeScores) all() []NodeScore {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	now := s.now()
+	scores := make([]NodeScore, 0, len(s.results))
+	for nodeID, results := range s.results {
+		scores = append(scores, computeNodeScore(nodeID, recentResults(results, now)))
+	}
+	sort.Slice(scores, func(i, j int) bool { return scores[i].NodeID < scores[j].NodeID })
+	return scores
+}
+
+// rankNodes returns the nodes ordered from the healthiest to the least healthy, nodes that are equally
+// healthy are shuffled. The failures of a node expire after nodeScoreMaxAge, a node ranked last recovers its rank
+// once they did and gets requests again. Callers keep sending to at least F+1 nodes, only the order in which nodes are picked changes.
+func rankNodes[T any](s *nodeScores, nodes []T, nodeID func(T) uint64) []T {
+	ranked := randomShuffle(nodes)
+	buckets := make(map[uint64]float64, len(ranked))
+	for _, node := range ranked {
+		id := nodeID(node)
+		buckets[id] = math.Floor(s.get(id).Score / nodeScoreResolution)
+	}
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return buckets[nodeID(ranked[i])] > buckets[nodeID(ranked[j])]
+	})
+	return ranked
+}
+
+// recentResults returns the results not older than nodeScoreMaxAge, results are tracked in chronological order.
+func recentResults(results []requestResult, now time.Time) []requestResult {
+	cutoff := now.Add(-nodeScoreMaxAge)
+	i := sort.Search(len(results), func(i int) bool { return results[i].at.After(cutoff) })
+	return results[i:]
+}
+
+func computeNodeScore(nodeID uint64, results []requestResult) NodeScore {
+	score := NodeScore{NodeID: nodeID, Requests: len(results)}
+	latencies := make([]float64, 0, len(results))
+	for _, result := range results {
+		switch result.err {
+		case "":
+			score.Successes++
+			latencies = append(latencies, result.latency)
+		case rmnErrInvalidSignature:
+			score.InvalidSignatures++
+			score.InvalidResponses++
+		case rmnErrInvalidResponse:
+			score.InvalidResponses++
+		case rmnErrTimeout:
+			score.Timeouts++
+		case rmnErrFailedToSend:
+			score.FailedSends++
+		}
+	}
+
+	if score.Requests > 0 {
+		score.SuccessRate = float64(score.Successes) / float64(score.Requests)
+	}
+	score.Score = float64(score.Successes+1) / float64(score.Requests+1)
+
+	slices.Sort(latencies)
+	score.LatencyP50 = percentile(latencies, 0.5)
+	score.LatencyP99 = percentile(latencies, 0.99)
+	return score
+}
+
+// percentile returns the nearest-rank percentile of sorted values, 0 when there are none.
+func percentile(sorted []float64, p float64) float64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := int(math.Ceil(p * float64(len(sorted))))
+	return sorted[max(rank-1, 0)]
+}
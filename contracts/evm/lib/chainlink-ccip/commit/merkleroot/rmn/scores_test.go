@@ -0,0 +1,133 @@
+package rmn
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNodeScores_Track(t *testing.T) {
+	scores := newNodeScores()
+
+	assert.Equal(t, NodeScore{NodeID: 1, Score: 1}, scores.get(1))
+
+	for i := 1; i <= 8; i++ {
+		scores.track(1, float64(i*10), "")
+	}
+	scores.track(1, 5, rmnErrInvalidSignature)
+	scores.track(1, 5, rmnErrInvalidResponse)
+	last := scores.track(1, 1000, rmnErrTimeout)
+	scores.track(2, 0, rmnErrFailedToSend)
+
+	assert.Equal(t, last, scores.get(1))
+	assert.Equal(t, NodeScore{
+		NodeID:            1,
+		Requests:          11,
+		Successes:         8,
+		InvalidResponses:  2,
+		InvalidSignatures: 1,
+		Timeouts:          1,
+		SuccessRate:       8.0 / 11,
+		LatencyP50:        40,
+		LatencyP99:        80,
+		Score:             9.0 / 12,
+	}, last)
+
+	all := scores.all()
+	require.Len(t, all, 2)
+	assert.Equal(t, uint64(1), all[0].NodeID)
+	assert.Equal(t, NodeScore{NodeID: 2, Requests: 1, FailedSends: 1, Score: 0.5}, all[1])
+}
+
+func TestNodeScores_TrackWindow(t *testing.T) {
+	scores := newNodeScores()
+
+	for i := 0; i < nodeScoreWindow; i++ {
+		scores.track(1, 0, rmnErrTimeout)
+	}
+	assert.Equal(t, 0.0, scores.get(1).SuccessRate)
+
+	// the node recovers once its failures are out of the window
+	for i := 0; i < nodeScoreWindow; i++ {
+		scores.track(1, 10, "")
+	}
+	score := scores.get(1)
+	assert.Equal(t, nodeScoreWindow, score.Requests)
+	assert.Equal(t, 0, score.Timeouts)
+	assert.Equal(t, 1.0, score.SuccessRate)
+}
+
+func TestNodeScores_Expiry(t *testing.T) {
+	scores := newNodeScores()
+	now := time.Now()
+	scores.now = func() time.Time { return now }
+
+	for i := 0; i < 10; i++ {
+		scores.track(1, 0, rmnErrTimeout)
+	}
+	scores.track(2, 10, "")
+	nodes := []uint64{1, 2}
+	identity := func(nodeID uint64) uint64 { return nodeID }
+	require.Equal(t, []uint64{2, 1}, rankNodes(scores, nodes, identity))
+
+	// the node is not picked anymore, its failures expire and it gets back to the healthy nodes
+	now = now.Add(nodeScoreMaxAge / 2)
+	scores.track(1, 10, "")
+	assert.Equal(t, 11, scores.get(1).Requests)
+
+	now = now.Add(nodeScoreMaxAge/2 + time.Second)
+	recovered := NodeScore{NodeID: 1, Requests: 1, Successes: 1, SuccessRate: 1, LatencyP50: 10, LatencyP99: 10, Score: 1}
+	assert.Equal(t, recovered, scores.get(1))
+	assert.Equal(t, []NodeScore{recovered, {NodeID: 2, Score: 1}}, scores.all())
+
+	seen := make(map[uint64]bool)
+	for i := 0; i < 100; i++ {
+		seen[rankNodes(scores, nodes, identity)[0]] = true
+	}
+	assert.Len(t, seen, 2)
+}
+
+func TestRankNodes(t *testing.T) {
+	scores := newNodeScores()
+	for i := 0; i < 10; i++ {
+		scores.track(1, 10, rmnErrTimeout)
+		scores.track(2, 10, "")
+		scores.track(3, 10, rmnErrInvalidSignature)
+	}
+	scores.track(3, 10, "")
+
+	nodes := []uint64{1, 2, 3, 4}
+	identity := func(nodeID uint64) uint64 { return nodeID }
+
+	// nodes without history are as healthy as the nodes without failures, the order between them is random
+	seen := make(map[uint64]bool)
+	for i := 0; i < 100; i++ {
+		ranked := rankNodes(scores, nodes, identity)
+		require.ElementsMatch(t, []uint64{2, 4}, ranked[:2])
+		require.Equal(t, []uint64{3, 1}, ranked[2:])
+		seen[ranked[0]] = true
+	}
+	assert.Len(t, seen, 2)
+}
+
+func TestPercentile(t *testing.T) {
+	assert.Equal(t, 0.0, percentile(nil, 0.5))
+	assert.Equal(t, 7.0, percentile([]float64{7}, 0.99))
+
+	values := make([]float64, 100)
+	for i := range values {
+		values[i] = float64(i + 1)
+	}
+	assert.Equal(t, 50.0, percentile(values, 0.5))
+	assert.Equal(t, 99.0, percentile(values, 0.99))
+}
+
+func TestRmnErrorOf(t *testing.T) {
+	assert.Equal(t, rmnErrInvalidSignature,
+		rmnErrorOf(fmt.Errorf("failed to verify report signature: %w: %w", errInvalidSignature, errors.New("bad sig"))))
+	assert.Equal(t, rmnErrInvalidResponse, rmnErrorOf(errors.New("unexpected closed interval")))
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 
 	"github.com/smartcontractkit/chainlink-ccip/commit/committypes"
+	"github.com/smartcontractkit/chainlink-ccip/commit/merkleroot/rmn"
 	"github.com/smartcontractkit/chainlink-ccip/internal/plugincommon"
 	"github.com/smartcontractkit/chainlink-ccip/internal/plugintypes"
 	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
@@ -77,6 +78,34 @@ var (
 		},
 		[]string{"method", "nodeID", "error"},
 	)
+	promRmnNodeSuccessRate = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "ccip_commit_rmn_node_success_rate",
+			Help: "This metric tracks the ratio of valid responses of an RMN node over its recent requests",
+		},
+		[]string{"nodeID"},
+	)
+	promRmnNodeLatency = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "ccip_commit_rmn_node_latency_ms",
+			Help: "This metric tracks the latency percentiles of the valid responses of an RMN node over its recent requests",
+		},
+		[]string{"nodeID", "quantile"},
+	)
+	promRmnNodeInvalidSignatures = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "ccip_commit_rmn_node_invalid_signatures",
+			Help: "This metric tracks the number of responses with an invalid signature of an RMN node over its recent requests",
+		},
+		[]string{"nodeID"},
+	)
+	promRmnNodeScore = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "ccip_commit_rmn_node_score",
+			Help: "This metric tracks the reliability score of an RMN node used to pick the nodes to send requests to",
+		},
+		[]string{"nodeID"},
+	)
 )
 
 type PromReporter struct {
@@ -85,6 +114,10 @@ type PromReporter struct {
 	// Prometheus components
 	merkleProcessorRmnReportHistogram *prometheus.HistogramVec
 	rmnControllerRmnRequestHistogram  *prometheus.HistogramVec
+	rmnNodeSuccessRate                *prometheus.GaugeVec
+	rmnNodeLatency                    *prometheus.GaugeVec
+	rmnNodeInvalidSignatures          *prometheus.GaugeVec
+	rmnNodeScore                      *prometheus.GaugeVec
 	processorLatencyHistogram         *prometheus.HistogramVec
 	processorOutputCounter            *prometheus.CounterVec
 	processorErrors                   *prometheus.CounterVec
@@ -103,6 +136,10 @@ func NewPromReporter(lggr logger.Logger, selector cciptypes.ChainSelector) (*Pro
 
 		merkleProcessorRmnReportHistogram: promMerkleProcessorRmnReportLatency,
 		rmnControllerRmnRequestHistogram:  promRmnControllerRmnRequestLatency,
+		rmnNodeSuccessRate:                promRmnNodeSuccessRate,
+		rmnNodeLatency:                    promRmnNodeLatency,
+		rmnNodeInvalidSignatures:          promRmnNodeInvalidSignatures,
+		rmnNodeScore:                      promRmnNodeScore,
 
 		sequenceNumbers: promSequenceNumbers,
 
@@ -168,6 +205,15 @@ func (p *PromReporter) TrackRmnRequest(method string, latency float64, nodeID ui
 	p.rmnControllerRmnRequestHistogram.WithLabelValues(method, nodeIDStr, err).Observe(latency)
 }
 
+func (p *PromReporter) TrackRmnNodeScore(score rmn.NodeScore) {
+	nodeIDStr := strconv.FormatUint(score.NodeID, 10)
+	p.rmnNodeSuccessRate.WithLabelValues(nodeIDStr).Set(score.SuccessRate)
+	p.rmnNodeLatency.WithLabelValues(nodeIDStr, "0.5").Set(score.LatencyP50)
+	p.rmnNodeLatency.WithLabelValues(nodeIDStr, "0.99").Set(score.LatencyP99)
+	p.rmnNodeInvalidSignatures.WithLabelValues(nodeIDStr).Set(float64(score.InvalidSignatures))
+	p.rmnNodeScore.WithLabelValues(nodeIDStr).Set(score.Score)
+}
+
 func (p *PromReporter) TrackProcessorLatency(
 	processor string,
 	method plugincommon.MethodType,
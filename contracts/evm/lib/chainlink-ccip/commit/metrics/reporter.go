@@ -5,6 +5,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink-ccip/commit/committypes"
 	"github.com/smartcontractkit/chainlink-ccip/commit/merkleroot"
+	"github.com/smartcontractkit/chainlink-ccip/commit/merkleroot/rmn"
 	"github.com/smartcontractkit/chainlink-ccip/internal/plugincommon"
 	"github.com/smartcontractkit/chainlink-ccip/internal/plugintypes"
 )
@@ -24,6 +25,7 @@ type Reporter interface {
 
 	TrackRmnReport(latency float64, success bool)
 	TrackRmnRequest(method string, latency float64, nodeID uint64, err string)
+	TrackRmnNodeScore(score rmn.NodeScore)
 
 	TrackProcessorLatency(processor string, method plugincommon.MethodType, latency time.Duration, err error)
 	TrackProcessorOutput(processor string, method plugincommon.MethodType, obs plugintypes.Trackable)
@@ -44,6 +46,8 @@ func (n *Noop) TrackRmnReport(float64, bool) {}
 
 func (n *Noop) TrackRmnRequest(string, float64, uint64, string) {}
 
+func (n *Noop) TrackRmnNodeScore(rmn.NodeScore) {}
+
 func (n *Noop) TrackProcessorLatency(string, plugincommon.MethodType, time.Duration, error) {}
 
 func (n *Noop) TrackProcessorOutput(string, plugincommon.MethodType, plugintypes.Trackable) {}
@@ -51,3 +55,4 @@ func (n *Noop) TrackProcessorOutput(string, plugincommon.MethodType, plugintypes
 var _ Reporter = &PromReporter{}
 var _ CommitPluginReporter = &PromReporter{}
 var _ merkleroot.MetricsReporter = &PromReporter{}
+var _ rmn.MetricsReporter = &PromReporter{}
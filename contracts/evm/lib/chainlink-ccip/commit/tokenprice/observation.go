@@ -6,8 +6,6 @@ import (
 	"sync"
 	"time"
 
-	"golang.org/x/exp/maps"
-
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 	"github.com/smartcontractkit/libocr/commontypes"
 
@@ -116,12 +114,12 @@ func (b *baseObserver) observeFeedTokenPrices(ctx context.Context, lggr logger.L
 		return cciptypes.TokenPriceMap{}
 	}
 
-	if !supportedChains.Contains(b.offChainCfg.PriceFeedChainSelector) {
-		lggr.Debugf("oracle does not support feed chain %d", b.offChainCfg.PriceFeedChainSelector)
+	tokensToQuery := feedTokensToQuery(b.offChainCfg, supportedChains)
+	if len(tokensToQuery) == 0 {
+		lggr.Debugw("oracle does not support the feed chain of any token")
 		return cciptypes.TokenPriceMap{}
 	}
 
-	tokensToQuery := maps.Keys(b.offChainCfg.TokenInfo)
 	lggr.Infow("observing feed token prices", "tokens", tokensToQuery)
 	tokenPrices, err := b.tokenPriceReader.GetFeedPricesUSD(ctx, tokensToQuery)
 	if err != nil {
@@ -151,7 +149,7 @@ func (b *baseObserver) observeFeeQuoterTokenUpdates(
 		return map[cciptypes.UnknownEncodedAddress]cciptypes.TimestampedBig{}
 	}
 
-	tokensToQuery := maps.Keys(b.offChainCfg.TokenInfo)
+	tokensToQuery := feeQuoterTokensToQuery(b.offChainCfg).ToSlice()
 	// sort tokens to query to ensure deterministic order
 	sort.Slice(tokensToQuery, func(i, j int) bool { return tokensToQuery[i] < tokensToQuery[j] })
 	lggr.Infow("observing fee quoter token updates")
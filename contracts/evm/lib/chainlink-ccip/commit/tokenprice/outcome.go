@@ -37,17 +37,11 @@ func (p *processor) getConsensusObservation(
 	}
 	timestamp := consensus.Median(aggObs.Timestamps, consensus.TimestampComparator)
 
-	fFeedChain, exists := fChains[p.offChainCfg.PriceFeedChainSelector]
-	if !exists {
-		return ConsensusObservation{},
-			fmt.Errorf("no consensus value for f for FeedChain: %d", p.offChainCfg.PriceFeedChainSelector)
-	}
-
 	feedPricesConsensus := consensus.GetConsensusMapAggregator(
 		lggr,
 		"FeedTokenPrices",
 		aggObs.FeedTokenPrices,
-		consensus.MakeConstantThreshold[cciptypes.UnknownEncodedAddress](consensus.TwoFPlus1(fFeedChain)),
+		consensus.MakeMultiThreshold(p.feedTokenPriceThresholds(lggr, fChains), consensus.TwoFPlus1),
 		func(vals []cciptypes.TokenPrice) cciptypes.TokenPrice {
 			return consensus.Median(vals, consensus.TokenPriceComparator)
 		},
@@ -73,8 +67,8 @@ func (p *processor) getConsensusObservation(
 	return consensusObs, nil
 }
 
-// selectTokensForUpdate checks which tokens need to be updated based on the observed token prices and
-// the fee quoter updates
+// selectTokensForUpdate checks which tokens need to be updated based on the observed token prices, including
+// the prices copied from the fee quoter, and the fee quoter updates
 // a token is selected for update if it meets one of 2 conditions:
 // 1. if time passed since the last update is greater than the stale threshold
 // 2. if deviation between the fee quoter and feed exceeds token's configured threshold
@@ -86,7 +80,7 @@ func (p *processor) selectTokensForUpdate(
 	cfg := p.offChainCfg
 	tokenInfo := cfg.TokenInfo
 
-	for token, feedPrice := range obs.FeedTokenPrices {
+	for token, feedPrice := range p.sourceTokenPrices(obs) {
 		lastUpdate, exists := obs.FeeQuoterTokenUpdates[token]
 		lggr := logger.With(lggr,
 			"token", token,
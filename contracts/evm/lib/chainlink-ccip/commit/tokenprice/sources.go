@@ -0,0 +1,127 @@
+package tokenprice
+
+import (
+	"fmt"
+	"sort"
+
+	mapset "github.com/deckarep/golang-set/v2"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	pkgreader "github.com/smartcontractkit/chainlink-ccip/pkg/reader"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+	"github.com/smartcontractkit/chainlink-ccip/pluginconfig"
+)
+
+// feedTokensToQuery returns the tokens with a feed price the oracle can observe. Aggregator and derived prices are
+// observed by the oracles supporting their feed chain, fixed prices by every oracle. Prices copied from the
+// FeeQuoter are observed as fee quoter token updates.
+func feedTokensToQuery(
+	cfg pluginconfig.CommitOffchainConfig,
+	supportedChains mapset.Set[cciptypes.ChainSelector],
+) []cciptypes.UnknownEncodedAddress {
+	tokens := make([]cciptypes.UnknownEncodedAddress, 0, len(cfg.TokenInfo))
+	for token, tokenInfo := range cfg.TokenInfo {
+		if tokenInfo.PriceSource() == pluginconfig.TokenPriceSourceFeeQuoter {
+			continue
+		}
+		if chain, ok := cfg.TokenPriceFeedChain(token); ok && !supportedChains.Contains(chain) {
+			continue
+		}
+		tokens = append(tokens, token)
+	}
+	// sort tokens to query to ensure deterministic order
+	sort.Slice(tokens, func(i, j int) bool { return tokens[i] < tokens[j] })
+	return tokens
+}
+
+// feeQuoterTokensToQuery returns the configured tokens together with the tokens whose FeeQuoter price is copied.
+func feeQuoterTokensToQuery(cfg pluginconfig.CommitOffchainConfig) mapset.Set[cciptypes.UnknownEncodedAddress] {
+	tokens := mapset.NewSet[cciptypes.UnknownEncodedAddress]()
+	for token, tokenInfo := range cfg.TokenInfo {
+		tokens.Add(token)
+		if tokenInfo.PriceSource() == pluginconfig.TokenPriceSourceFeeQuoter {
+			tokens.Add(tokenInfo.UnderlyingToken)
+		}
+	}
+	return tokens
+}
+
+// validateFeedTokenPriceSources checks that the observed feed prices can be observed by the oracle and match
+// their price source.
+func validateFeedTokenPriceSources(
+	tokenPrices cciptypes.TokenPriceMap,
+	supportedChains mapset.Set[cciptypes.ChainSelector],
+	cfg pluginconfig.CommitOffchainConfig,
+) error {
+	for token, price := range tokenPrices {
+		tokenInfo := cfg.TokenInfo[token]
+		if chain, ok := cfg.TokenPriceFeedChain(token); ok && !supportedChains.Contains(chain) {
+			return fmt.Errorf("feed chain %d must be supported to read the price of token %v", chain, token)
+		}
+
+		switch tokenInfo.PriceSource() {
+		case pluginconfig.TokenPriceSourceFeeQuoter:
+			return fmt.Errorf("price of token %v is copied from the fee quoter and can't be observed", token)
+		case pluginconfig.TokenPriceSourceFixed:
+			if expected := pkgreader.FixedTokenPriceUSD(tokenInfo); price.Cmp(expected) != 0 {
+				return fmt.Errorf("fixed price of token %v is %s, observed %s", token, expected, price)
+			}
+		case pluginconfig.TokenPriceSourceDerived:
+			underlyingPrice, ok := tokenPrices[tokenInfo.UnderlyingToken]
+			if !ok {
+				return fmt.Errorf("derived price of token %v observed without the price of underlying token %v",
+					token, tokenInfo.UnderlyingToken)
+			}
+			expected := pkgreader.DeriveTokenPriceUSD(
+				underlyingPrice.Int, cfg.TokenInfo[tokenInfo.UnderlyingToken], tokenInfo)
+			if price.Cmp(expected) != 0 {
+				return fmt.Errorf("derived price of token %v is %s, observed %s", token, expected, price)
+			}
+		}
+	}
+	return nil
+}
+
+// feedTokenPriceThresholds returns the f used to reach consensus on the feed price of each token, which is the f of
+// its feed chain or the f of the role DON for prices not read from a feed chain.
+func (p *processor) feedTokenPriceThresholds(
+	lggr logger.Logger,
+	fChains map[cciptypes.ChainSelector]int,
+) map[cciptypes.UnknownEncodedAddress]int {
+	fTokens := make(map[cciptypes.UnknownEncodedAddress]int, len(p.offChainCfg.TokenInfo))
+	for token := range p.offChainCfg.TokenInfo {
+		chain, ok := p.offChainCfg.TokenPriceFeedChain(token)
+		if !ok {
+			fTokens[token] = p.fRoleDON
+			continue
+		}
+		f, ok := fChains[chain]
+		if !ok {
+			lggr.Warnw("no consensus value for f of feed chain, token price skipped", "token", token, "chain", chain)
+			continue
+		}
+		fTokens[token] = f
+	}
+	return fTokens
+}
+
+// sourceTokenPrices returns the consensus price of each token from its price source, the feed prices and the prices
+// copied from the FeeQuoter entries of their underlying token, rescaled to the token decimals.
+func (p *processor) sourceTokenPrices(
+	obs ConsensusObservation,
+) map[cciptypes.UnknownEncodedAddress]cciptypes.TokenPrice {
+	prices := make(map[cciptypes.UnknownEncodedAddress]cciptypes.TokenPrice, len(obs.FeedTokenPrices))
+	for token, price := range obs.FeedTokenPrices {
+		prices[token] = price
+	}
+	for token, tokenInfo := range p.offChainCfg.TokenInfo {
+		if tokenInfo.PriceSource() != pluginconfig.TokenPriceSourceFeeQuoter {
+			continue
+		}
+		if update, ok := obs.FeeQuoterTokenUpdates[tokenInfo.UnderlyingToken]; ok {
+			prices[token] = cciptypes.NewTokenPrice(token, pkgreader.CopyTokenPriceUSD(update.Value.Int, tokenInfo))
+		}
+	}
+	return prices
+}
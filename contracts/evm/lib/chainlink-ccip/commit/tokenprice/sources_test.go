@@ -0,0 +1,201 @@
+package tokenprice
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	mapset "github.com/deckarep/golang-set/v2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+	"github.com/smartcontractkit/chainlink-ccip/pluginconfig"
+)
+
+const otherFeedChainSel = cciptypes.ChainSelector(3)
+
+var (
+	ratio15    = cciptypes.NewBigInt(big.NewInt(15e17))
+	fixedPrice = cciptypes.NewBigInt(big.NewInt(1e18))
+	decimals18 = uint8(18)
+
+	sourcesOffChainCfg = pluginconfig.CommitOffchainConfig{
+		PriceFeedChainSelector: feedChainSel,
+		TokenInfo: map[cciptypes.UnknownEncodedAddress]pluginconfig.TokenInfo{
+			// aggregator on the default feed chain
+			tokenA: {DeviationPPB: cbi(1), Decimals: 18},
+			// aggregator on another feed chain
+			tokenB: {DeviationPPB: cbi(1), Decimals: 18, FeedChainSelector: otherFeedChainSel},
+			// 1 tokenC is worth 1.5 tokenB
+			tokenC: {
+				Source:          pluginconfig.TokenPriceSourceDerived,
+				UnderlyingToken: tokenB,
+				Ratio:           &ratio15,
+				DeviationPPB:    cbi(1),
+				Decimals:        18,
+			},
+			tokenD: {
+				Source:       pluginconfig.TokenPriceSourceFixed,
+				FixedPrice:   &fixedPrice,
+				DeviationPPB: cbi(1),
+				Decimals:     18,
+			},
+			"0xe": {
+				Source:             pluginconfig.TokenPriceSourceFeeQuoter,
+				UnderlyingToken:    "0xf",
+				UnderlyingDecimals: &decimals18,
+				DeviationPPB:       cbi(1),
+				Decimals:           18,
+			},
+		},
+	}
+)
+
+func Test_feedTokensToQuery(t *testing.T) {
+	tokens := feedTokensToQuery(sourcesOffChainCfg, mapset.NewSet(feedChainSel, destChainSel))
+	require.ElementsMatch(t, []cciptypes.UnknownEncodedAddress{tokenA, tokenD}, tokens)
+
+	tokens = feedTokensToQuery(sourcesOffChainCfg, mapset.NewSet(otherFeedChainSel))
+	require.ElementsMatch(t, []cciptypes.UnknownEncodedAddress{tokenB, tokenC, tokenD}, tokens)
+}
+
+func Test_feeQuoterTokensToQuery(t *testing.T) {
+	tokens := feeQuoterTokensToQuery(sourcesOffChainCfg)
+	require.ElementsMatch(t, []cciptypes.UnknownEncodedAddress{tokenA, tokenB, tokenC, tokenD, "0xe", "0xf"},
+		tokens.ToSlice())
+}
+
+func Test_validateFeedTokenPriceSources(t *testing.T) {
+	allChains := mapset.NewSet(feedChainSel, otherFeedChainSel)
+
+	testCases := []struct {
+		name            string
+		tokenPrices     cciptypes.TokenPriceMap
+		supportedChains mapset.Set[cciptypes.ChainSelector]
+		expErr          string
+	}{
+		{
+			name: "valid prices",
+			tokenPrices: cciptypes.TokenPriceMap{
+				tokenA: cbi100,
+				tokenB: cbi200,
+				tokenC: cbi(300),
+				tokenD: fixedPrice,
+			},
+			supportedChains: allChains,
+		},
+		{
+			name:            "unsupported feed chain",
+			tokenPrices:     cciptypes.TokenPriceMap{tokenB: cbi200},
+			supportedChains: mapset.NewSet(feedChainSel),
+			expErr:          "feed chain 3 must be supported",
+		},
+		{
+			name:            "unsupported feed chain of underlying token",
+			tokenPrices:     cciptypes.TokenPriceMap{tokenC: cbi(300)},
+			supportedChains: mapset.NewSet(feedChainSel),
+			expErr:          "feed chain 3 must be supported",
+		},
+		{
+			name:            "derived price without underlying price",
+			tokenPrices:     cciptypes.TokenPriceMap{tokenC: cbi(300)},
+			supportedChains: allChains,
+			expErr:          "without the price of underlying token",
+		},
+		{
+			name:            "wrong derived price",
+			tokenPrices:     cciptypes.TokenPriceMap{tokenB: cbi200, tokenC: cbi200},
+			supportedChains: allChains,
+			expErr:          "derived price of token",
+		},
+		{
+			name:            "wrong fixed price",
+			tokenPrices:     cciptypes.TokenPriceMap{tokenD: cbi100},
+			supportedChains: allChains,
+			expErr:          "fixed price of token",
+		},
+		{
+			name:            "price copied from the fee quoter",
+			tokenPrices:     cciptypes.TokenPriceMap{"0xe": cbi100},
+			supportedChains: allChains,
+			expErr:          "copied from the fee quoter",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateFeedTokenPriceSources(tc.tokenPrices, tc.supportedChains, sourcesOffChainCfg)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func Test_feedTokenPriceThresholds(t *testing.T) {
+	p := &processor{offChainCfg: sourcesOffChainCfg, fRoleDON: 5}
+
+	fTokens := p.feedTokenPriceThresholds(logger.Test(t), map[cciptypes.ChainSelector]int{
+		feedChainSel:      1,
+		otherFeedChainSel: 2,
+	})
+	require.Equal(t, map[cciptypes.UnknownEncodedAddress]int{
+		tokenA: 1,
+		tokenB: 2,
+		tokenC: 2,
+		tokenD: 5,
+		"0xe":  5,
+	}, fTokens)
+
+	// tokens of a feed chain without consensus on f are skipped
+	fTokens = p.feedTokenPriceThresholds(logger.Test(t), map[cciptypes.ChainSelector]int{feedChainSel: 1})
+	require.NotContains(t, fTokens, tokenB)
+	require.NotContains(t, fTokens, tokenC)
+}
+
+func Test_sourceTokenPrices(t *testing.T) {
+	p := &processor{offChainCfg: sourcesOffChainCfg}
+
+	prices := p.sourceTokenPrices(ConsensusObservation{
+		FeedTokenPrices: map[cciptypes.UnknownEncodedAddress]cciptypes.TokenPrice{
+			tokenA: {TokenID: tokenA, Price: cbi100},
+		},
+		FeeQuoterTokenUpdates: map[cciptypes.UnknownEncodedAddress]cciptypes.TimestampedBig{
+			"0xf": {Timestamp: time.Now(), Value: cbi200},
+		},
+	})
+	require.Equal(t, map[cciptypes.UnknownEncodedAddress]cciptypes.TokenPrice{
+		tokenA: {TokenID: tokenA, Price: cbi100},
+		"0xe":  {TokenID: "0xe", Price: cbi200},
+	}, prices)
+}
+
+func Test_sourceTokenPrices_FeeQuoterDecimals(t *testing.T) {
+	// a 6 decimals token copying the FeeQuoter price of an 18 decimals underlying token worth $1.
+	p := &processor{offChainCfg: pluginconfig.CommitOffchainConfig{
+		TokenInfo: map[cciptypes.UnknownEncodedAddress]pluginconfig.TokenInfo{
+			"0xe": {
+				Source:             pluginconfig.TokenPriceSourceFeeQuoter,
+				UnderlyingToken:    "0xf",
+				UnderlyingDecimals: &decimals18,
+				DeviationPPB:       cbi(1),
+				Decimals:           6,
+			},
+		},
+	}}
+
+	prices := p.sourceTokenPrices(ConsensusObservation{
+		FeeQuoterTokenUpdates: map[cciptypes.UnknownEncodedAddress]cciptypes.TimestampedBig{
+			"0xf": {Timestamp: time.Now(), Value: cbi(1e18)},
+		},
+	})
+
+	// $1 per full token is 1e12 USD per 1e18 of the 6 decimals denomination, with 18 decimal precision.
+	expected, ok := new(big.Int).SetString("1000000000000000000000000000000", 10)
+	require.True(t, ok)
+	require.Equal(t, cciptypes.NewTokenPrice("0xe", expected), prices["0xe"])
+}
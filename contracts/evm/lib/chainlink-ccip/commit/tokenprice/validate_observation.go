@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"time"
 
+	mapset "github.com/deckarep/golang-set/v2"
+
 	"github.com/smartcontractkit/chainlink-ccip/internal/plugincommon"
 	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
 	"github.com/smartcontractkit/chainlink-ccip/pluginconfig"
@@ -29,22 +31,21 @@ func (p *processor) ValidateObservation(
 		return fmt.Errorf("failed to get supported chains: %w", err)
 	}
 
-	if len(obs.FeedTokenPrices) > 0 && !supportedChains.Contains(p.offChainCfg.PriceFeedChainSelector) {
-		return fmt.Errorf("feed chain must be supported to read feed token prices, oreacleID: %d feedChain: %d",
-			ao.OracleID, p.offChainCfg.PriceFeedChainSelector)
-
-	}
-
 	if err = validateObservedTokenPrices(obs.FeedTokenPrices, p.offChainCfg.TokenInfo); err != nil {
 		return fmt.Errorf("failed to validate observed token prices: %w", err)
 	}
 
+	if err = validateFeedTokenPriceSources(obs.FeedTokenPrices, supportedChains, p.offChainCfg); err != nil {
+		return fmt.Errorf("failed to validate observed token prices, oracleID: %d: %w", ao.OracleID, err)
+	}
+
 	if len(obs.FeeQuoterTokenUpdates) > 0 && !supportedChains.Contains(p.destChain) {
 		return fmt.Errorf("dest chain must be supported to read fee quoter token updates "+
 			"oracleID: %d destChain: %d", ao.OracleID, p.destChain)
 	}
 
-	if err = validateObservedTokenUpdates(obs.FeeQuoterTokenUpdates, p.offChainCfg.TokenInfo); err != nil {
+	err = validateObservedTokenUpdates(obs.FeeQuoterTokenUpdates, feeQuoterTokensToQuery(p.offChainCfg))
+	if err != nil {
 		return fmt.Errorf("failed to validate observed fee quoter token updates: %w", err)
 	}
 	if obs.Timestamp.IsZero() || obs.Timestamp.After(time.Now().UTC()) {
@@ -70,9 +71,9 @@ func validateObservedTokenPrices(
 
 func validateObservedTokenUpdates(
 	tokenUpdates map[cciptypes.UnknownEncodedAddress]cciptypes.TimestampedBig,
-	tokensToQuery map[cciptypes.UnknownEncodedAddress]pluginconfig.TokenInfo) error {
+	tokensToQuery mapset.Set[cciptypes.UnknownEncodedAddress]) error {
 	for tokenID, update := range tokenUpdates {
-		if _, ok := tokensToQuery[tokenID]; !ok {
+		if !tokensToQuery.Contains(tokenID) {
 			return fmt.Errorf("observed token %v is not in the list of tokens to query", tokenID)
 		}
 		if !update.Value.IsPositive() {
@@ -271,7 +271,7 @@ func TestValidateObservedTokenUpdates(t *testing.T) {
 	testCases := []struct {
 		name          string
 		tokenUpdates  map[cciptypes.UnknownEncodedAddress]cciptypes.TimestampedBig
-		tokensToQuery map[cciptypes.UnknownEncodedAddress]pluginconfig.TokenInfo
+		tokensToQuery mapset.Set[cciptypes.UnknownEncodedAddress]
 		expErr        bool
 	}{
 		{
@@ -285,7 +285,7 @@ func TestValidateObservedTokenUpdates(t *testing.T) {
 				"0x1": {Value: oneBig, Timestamp: time.Now().Add(-time.Hour)},
 				"0xa": {Value: oneBig, Timestamp: time.Now().Add(-time.Hour)},
 			},
-			tokensToQuery: defaultTokensToQuery,
+			tokensToQuery: feeQuoterTokensToQuery(defaultOffChainConfig),
 			expErr:        false,
 		},
 		{
@@ -294,7 +294,7 @@ func TestValidateObservedTokenUpdates(t *testing.T) {
 				"0x1": {Value: oneBig, Timestamp: time.Now().Add(-time.Hour)},
 				"0x3": {Value: nilBig, Timestamp: time.Now().Add(-time.Hour)}, // nil value
 			},
-			tokensToQuery: defaultTokensToQuery,
+			tokensToQuery: feeQuoterTokensToQuery(defaultOffChainConfig),
 			expErr:        true,
 		},
 		{
@@ -303,7 +303,7 @@ func TestValidateObservedTokenUpdates(t *testing.T) {
 				"0x1": {Value: oneBig, Timestamp: time.Now().Add(-time.Hour)},
 				"0x3": {Value: oneBig, Timestamp: time.Now().Add(time.Hour)}, // future timestamp
 			},
-			tokensToQuery: defaultTokensToQuery,
+			tokensToQuery: feeQuoterTokensToQuery(defaultOffChainConfig),
 			expErr:        true,
 		},
 		{
@@ -311,7 +311,7 @@ func TestValidateObservedTokenUpdates(t *testing.T) {
 			tokenUpdates: map[cciptypes.UnknownEncodedAddress]cciptypes.TimestampedBig{
 				"0x5": {Value: oneBig, Timestamp: time.Now().Add(-time.Hour)},
 			},
-			tokensToQuery: defaultTokensToQuery,
+			tokensToQuery: feeQuoterTokensToQuery(defaultOffChainConfig),
 			expErr:        true,
 		},
 	}
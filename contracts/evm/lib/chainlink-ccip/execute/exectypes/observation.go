@@ -96,6 +96,10 @@ func GetEncodedMsgAndTokenDataSizes(mo MessageObservations, tds TokenDataObserva
 // must be encoding according to the destination chain requirements with typeconv.AddressBytesToString.
 type NonceObservations map[cciptypes.ChainSelector]map[string]uint64
 
+// SimulationFailureObservations contain the IDs of messages which would fail on the destination chain when the
+// report built from them is simulated, organized by source chain selector and sequence number.
+type SimulationFailureObservations map[cciptypes.ChainSelector]map[cciptypes.SeqNum]cciptypes.Bytes32
+
 // TokenDataObservations contain token data for messages organized by source chain selector and sequence number.
 // There could be multiple tokens per a single message, so MessageTokenData is a slice of TokenData.
 // TokenDataObservations are populated during the Observation phase and depend on previously fetched
@@ -130,6 +134,10 @@ type Observation struct {
 	// It contains the nonces of senders who are being considered for the final report.
 	Nonces NonceObservations `json:"nonces"`
 
+	// SimulationFailures are determined during the third phase of execute.
+	// It contains the messages which failed when the report of this oracle was simulated on the destination chain.
+	SimulationFailures SimulationFailureObservations `json:"simulationFailures"`
+
 	// Contracts are part of the initial discovery phase which runs to initialize the CCIP Reader.
 	Contracts dt.Observation `json:"contracts"`
 
@@ -146,13 +154,14 @@ func (o Observation) ToLogFormat() Observation {
 		}
 	}
 	cleanedObs := Observation{
-		CommitReports: o.CommitReports,
-		Hashes:        o.Hashes,
-		TokenData:     o.TokenData,
-		Nonces:        o.Nonces,
-		FChain:        o.FChain,
-		Messages:      msgsWithEmptyData,
-		Contracts:     dt.Observation{},
+		CommitReports:      o.CommitReports,
+		Hashes:             o.Hashes,
+		TokenData:          o.TokenData,
+		Nonces:             o.Nonces,
+		SimulationFailures: o.SimulationFailures,
+		FChain:             o.FChain,
+		Messages:           msgsWithEmptyData,
+		Contracts:          dt.Observation{},
 	}
 
 	return cleanedObs
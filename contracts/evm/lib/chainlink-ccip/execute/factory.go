@@ -15,6 +15,7 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/types/core"
 
 	"github.com/smartcontractkit/chainlink-ccip/execute/metrics"
+	"github.com/smartcontractkit/chainlink-ccip/execute/report"
 	"github.com/smartcontractkit/chainlink-ccip/execute/tokendata/observer"
 	"github.com/smartcontractkit/chainlink-ccip/internal/plugintypes"
 	"github.com/smartcontractkit/chainlink-ccip/internal/reader"
@@ -77,9 +78,12 @@ type PluginFactory struct {
 	addrCodec        cciptypes.AddressCodec
 	homeChainReader  reader.HomeChain
 	estimateProvider cciptypes.EstimateProvider
+	extraArgsDecoder cciptypes.ExtraArgsDecoder
+	reportSimulator  cciptypes.ExecuteReportSimulator
 	tokenDataEncoder cciptypes.TokenDataEncoder
 	contractReaders  map[cciptypes.ChainSelector]types.ContractReader
 	chainWriters     map[cciptypes.ChainSelector]types.ContractWriter
+	tokenDataConfig  observer.NodeConfig
 }
 
 type PluginFactoryParams struct {
@@ -94,6 +98,14 @@ type PluginFactoryParams struct {
 	EstimateProvider cciptypes.EstimateProvider
 	ContractReaders  map[cciptypes.ChainSelector]types.ContractReader
 	ContractWriters  map[cciptypes.ChainSelector]types.ContractWriter
+	// ExtraArgsDecoder decodes the gas limit requested by the messages, it is required by the minGasLimit check.
+	ExtraArgsDecoder cciptypes.ExtraArgsDecoder
+	// TokenDataNodeConfig is the node local configuration of the token data observers, e.g. the secrets
+	// referenced by name from the offchain config.
+	TokenDataNodeConfig observer.NodeConfig
+	// ReportSimulator is optional, reports are simulated during the Filter observation when it is set and
+	// simulation is enabled in the offchain config.
+	ReportSimulator cciptypes.ExecuteReportSimulator
 }
 
 // NewExecutePluginFactory creates a new PluginFactory instance. For execute plugin, oracle instances are not managed by
@@ -108,6 +120,9 @@ func NewExecutePluginFactory(params PluginFactoryParams) *PluginFactory {
 		addrCodec:        params.AddrCodec,
 		homeChainReader:  params.HomeChainReader,
 		estimateProvider: params.EstimateProvider,
+		extraArgsDecoder: params.ExtraArgsDecoder,
+		reportSimulator:  params.ReportSimulator,
+		tokenDataConfig:  params.TokenDataNodeConfig,
 		tokenDataEncoder: params.TokenDataEncoder,
 		contractReaders:  params.ContractReaders,
 		chainWriters:     params.ContractWriters,
@@ -128,6 +143,16 @@ func (p PluginFactory) NewReportingPlugin(
 		return nil, ocr3types.ReportingPluginInfo{}, fmt.Errorf("failed to validate exec offchain config: %w", err)
 	}
 
+	// Message checks are created in every round, they are created here to reject invalid check params early.
+	if _, err = report.NewConfiguredChecks(offchainConfig.MessageChecks, report.CheckEnv{
+		DestChain:        p.ocrConfig.Config.ChainSelector,
+		AddressCodec:     p.addrCodec,
+		EstimateProvider: p.estimateProvider,
+		ExtraArgsDecoder: p.extraArgsDecoder,
+	}); err != nil {
+		return nil, ocr3types.ReportingPluginInfo{}, fmt.Errorf("failed to create exec message checks: %w", err)
+	}
+
 	var oracleIDToP2PID = make(map[commontypes.OracleID]ragep2ptypes.PeerID)
 	for oracleID, node := range p.ocrConfig.Config.Nodes {
 		oracleIDToP2PID[commontypes.OracleID(oracleID)] = node.P2pID
@@ -160,10 +185,12 @@ func (p PluginFactory) NewReportingPlugin(
 		ctx,
 		logutil.WithComponent(lggr, "TokenDataObserver"),
 		p.ocrConfig.Config.ChainSelector,
+		cciptypes.Bytes32(config.ConfigDigest),
 		offchainConfig.TokenDataObservers,
 		p.tokenDataEncoder,
 		readers,
 		p.addrCodec,
+		p.tokenDataConfig,
 	)
 	if err != nil {
 		return nil, ocr3types.ReportingPluginInfo{}, fmt.Errorf("failed to create token data observer: %w", err)
@@ -186,6 +213,8 @@ func (p PluginFactory) NewReportingPlugin(
 			p.homeChainReader,
 			tokenDataObserver,
 			p.estimateProvider,
+			p.extraArgsDecoder,
+			p.reportSimulator,
 			lggr,
 			metricsReporter,
 			p.addrCodec,
@@ -85,6 +85,42 @@ var (
 		},
 		[]string{"chainID", "sourceChain", "method"},
 	)
+	PromExecReportSimulations = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "ccip_exec_report_simulations",
+			Help: "This metric tracks the number of execute report simulations by their result",
+		},
+		[]string{"chainID", "result"},
+	)
+	PromExecSimulationFailedMessages = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "ccip_exec_simulation_failed_messages",
+			Help: "This metric tracks the number of messages removed from execute reports because their simulation failed",
+		},
+		[]string{"chainID", "sourceChain"},
+	)
+	PromExecReportSimulationLatencyHistogram = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name: "ccip_exec_report_simulation_latency",
+			Help: "This metric tracks the client-observed latency of a single execute report simulation",
+			Buckets: []float64{
+				float64(50 * time.Millisecond),
+				float64(100 * time.Millisecond),
+				float64(200 * time.Millisecond),
+				float64(500 * time.Millisecond),
+				float64(time.Second),
+				float64(2 * time.Second),
+				float64(5 * time.Second),
+			},
+		},
+		[]string{"chainID"},
+	)
+)
+
+const (
+	simulationSucceeded      = "succeeded"
+	simulationMessagesFailed = "messages_failed"
+	simulationError          = "error"
 )
 
 type PromReporter struct {
@@ -98,6 +134,9 @@ type PromReporter struct {
 	sequenceNumbers           *prometheus.GaugeVec
 	processorLatencyHistogram *prometheus.HistogramVec
 	processorErrors           *prometheus.CounterVec
+	simulations               *prometheus.CounterVec
+	simulationFailedMessages  *prometheus.CounterVec
+	simulationLatency         *prometheus.HistogramVec
 }
 
 func NewPromReporter(lggr logger.Logger, selector cciptypes.ChainSelector) (*PromReporter, error) {
@@ -116,6 +155,9 @@ func NewPromReporter(lggr logger.Logger, selector cciptypes.ChainSelector) (*Pro
 		sequenceNumbers:           PromSequenceNumbers,
 		processorLatencyHistogram: PromExecProcessorLatencyHistogram,
 		processorErrors:           PromExecProcessorErrors,
+		simulations:               PromExecReportSimulations,
+		simulationFailedMessages:  PromExecSimulationFailedMessages,
+		simulationLatency:         PromExecReportSimulationLatencyHistogram,
 	}, nil
 }
 
@@ -180,6 +222,33 @@ func (p *PromReporter) TrackProcessorOutput(
 	// noop
 }
 
+func (p *PromReporter) TrackReportSimulation(
+	failures []cciptypes.MessageSimulationFailure,
+	latency time.Duration,
+	err error,
+) {
+	if err != nil {
+		p.simulations.WithLabelValues(p.chainID, simulationError).Inc()
+		return
+	}
+	p.simulationLatency.WithLabelValues(p.chainID).Observe(float64(latency))
+
+	if len(failures) == 0 {
+		p.simulations.WithLabelValues(p.chainID, simulationSucceeded).Inc()
+		return
+	}
+	p.simulations.WithLabelValues(p.chainID, simulationMessagesFailed).Inc()
+
+	for _, failure := range failures {
+		sourceChain, err := sel.GetChainIDFromSelector(uint64(failure.SourceChainSelector))
+		if err != nil {
+			p.lggr.Errorw("failed to get chain ID from selector", "err", err)
+			continue
+		}
+		p.simulationFailedMessages.WithLabelValues(p.chainID, sourceChain).Inc()
+	}
+}
+
 func (p *PromReporter) trackMaxSequenceNumber(
 	sourceChainSelector cciptypes.ChainSelector,
 	maxSeqNr int,
@@ -469,6 +469,30 @@ func Test_LatencyAndErrors(t *testing.T) {
 	})
 }
 
+func Test_TrackReportSimulation(t *testing.T) {
+	reporter, err := NewPromReporter(logger.Test(t), selector)
+	require.NoError(t, err)
+	t.Cleanup(cleanupMetrics(reporter))
+
+	reporter.TrackReportSimulation(nil, time.Second, nil)
+	reporter.TrackReportSimulation(nil, time.Second, fmt.Errorf("rpc error"))
+	reporter.TrackReportSimulation([]cciptypes.MessageSimulationFailure{
+		{SourceChainSelector: selector, SequenceNumber: 1},
+		{SourceChainSelector: selector, SequenceNumber: 2},
+		{SourceChainSelector: cciptypes.ChainSelector(1), SequenceNumber: 3},
+	}, time.Second, nil)
+
+	for result, expected := range map[string]float64{
+		simulationSucceeded:      1,
+		simulationError:          1,
+		simulationMessagesFailed: 1,
+	} {
+		require.Equal(t, expected, testutil.ToFloat64(reporter.simulations.WithLabelValues(chainID, result)), result)
+	}
+	require.Equal(t, float64(2), testutil.ToFloat64(reporter.simulationFailedMessages.WithLabelValues(chainID, chainID)))
+	require.Equal(t, 2, internal.CounterFromHistogramByLabels(t, reporter.simulationLatency, chainID))
+}
+
 func cleanupMetrics(p *PromReporter) func() {
 	return func() {
 		p.sequenceNumbers.Reset()
@@ -477,5 +501,8 @@ func cleanupMetrics(p *PromReporter) func() {
 		p.execErrors.Reset()
 		p.processorLatencyHistogram.Reset()
 		p.processorErrors.Reset()
+		p.simulations.Reset()
+		p.simulationFailedMessages.Reset()
+		p.simulationLatency.Reset()
 	}
 }
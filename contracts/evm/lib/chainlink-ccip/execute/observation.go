@@ -29,7 +29,8 @@ import (
 // Phase 2: Gather messages from the source chains and build the execution
 // report.
 //
-// Phase 3: observe nonce for each unique source/sender pair.
+// Phase 3: observe nonce for each unique source/sender pair, and the messages
+// which would fail when the report built with them is simulated.
 //
 //nolint:gocyclo
 func (p *Plugin) Observation(
@@ -102,7 +103,7 @@ func (p *Plugin) Observation(
 			return nil, nil
 		}
 	case exectypes.Filter:
-		// Phase 3: observe nonce for each unique source/sender pair.
+		// Phase 3: observe nonce for each unique source/sender pair and the simulation failures.
 		observation, err = p.getFilterObservation(ctx, lggr, previousOutcome, observation)
 		if err != nil {
 			lggr.Errorw("failed to getFilterObservation", "err", err)
@@ -542,6 +543,7 @@ func (p *Plugin) getFilterObservation(
 		// It would only work for ordered messages.
 
 		observation.Nonces = nonceObservations
+		observation.SimulationFailures = p.observeSimulationFailures(ctx, lggr, previousOutcome, nonceObservations)
 	}
 	return observation, nil
 }
@@ -173,7 +173,66 @@ func (p *Plugin) getFilterOutcome(
 	observation exectypes.Observation,
 	previousOutcome exectypes.Outcome,
 ) (exectypes.Outcome, error) {
-	commitReports := previousOutcome.CommitReports
+	execReport, selectedCommitReports, err := p.buildReport(
+		ctx,
+		lggr,
+		previousOutcome.CommitReports,
+		observation.Nonces,
+		observation.SimulationFailures,
+	)
+	if err != nil {
+		return exectypes.Outcome{}, err
+	}
+
+	// Must use 'NewOutcome' rather than direct struct initialization to ensure the outcome is sorted.
+	// TODO: sort in the encoder.
+	return exectypes.NewOutcome(exectypes.Filter, selectedCommitReports, execReport), nil
+}
+
+// buildReport selects the messages of the commit reports which can be executed and builds the execute report.
+// The messages in simulationFailures are skipped. It is used to build the outcome report, and by each oracle to
+// build the report it simulates during the Filter observation.
+func (p *Plugin) buildReport(
+	ctx context.Context,
+	lggr logger.Logger,
+	commitReports []exectypes.CommitData,
+	nonces exectypes.NonceObservations,
+	simulationFailures exectypes.SimulationFailureObservations,
+) (cciptypes.ExecutePluginReport, []exectypes.CommitData, error) {
+	priorityPolicy, err := report.NewPriorityPolicy(p.offchainCfg.MessagePriority, p.addrCodec)
+	if err != nil {
+		return cciptypes.ExecutePluginReport{}, nil, fmt.Errorf("unable to create message priority policy: %w", err)
+	}
+
+	configuredChecks, err := report.NewConfiguredChecks(p.offchainCfg.MessageChecks, report.CheckEnv{
+		DestChain:        p.destChain,
+		AddressCodec:     p.addrCodec,
+		EstimateProvider: p.estimateProvider,
+		ExtraArgsDecoder: p.extraArgsDecoder,
+	})
+	if err != nil {
+		return cciptypes.ExecutePluginReport{}, nil, fmt.Errorf("unable to create message checks: %w", err)
+	}
+
+	// Configured checks and simulation failures run before the nonce check, so a skipped message is not counted
+	// as executed and the following messages of the same sender are skipped as well.
+	options := make([]report.Option, 0, len(configuredChecks)+1)
+	for _, check := range configuredChecks {
+		options = append(options, report.WithExtraMessageCheck(check))
+	}
+	if len(simulationFailures) > 0 {
+		options = append(options, report.WithExtraMessageCheck(report.CheckSimulationFailures(simulationFailures)))
+	}
+	options = append(options,
+		report.WithMaxReportSizeBytes(maxReportLength),
+		report.WithMaxGas(p.offchainCfg.BatchGasLimit),
+		report.WithExtraMessageCheck(report.CheckNonces(nonces, p.addrCodec)),
+		//TODO: remove as we already check it in GetMessages phase
+		report.WithExtraMessageCheck(report.CheckIfInflight(p.inflightMessageCache.IsInflight)),
+		report.WithMaxMessages(p.offchainCfg.MaxReportMessages),
+		report.WithMaxSingleChainReports(p.offchainCfg.MaxSingleChainReports),
+		report.WithPriorityPolicy(priorityPolicy),
+	)
 
 	builder := report.NewBuilder(
 		lggr,
@@ -182,13 +241,7 @@ func (p *Plugin) getFilterOutcome(
 		p.estimateProvider,
 		p.destChain,
 		p.addrCodec,
-		report.WithMaxReportSizeBytes(maxReportLength),
-		report.WithMaxGas(p.offchainCfg.BatchGasLimit),
-		report.WithExtraMessageCheck(report.CheckNonces(observation.Nonces, p.addrCodec)),
-		//TODO: remove as we already check it in GetMessages phase
-		report.WithExtraMessageCheck(report.CheckIfInflight(p.inflightMessageCache.IsInflight)),
-		report.WithMaxMessages(p.offchainCfg.MaxReportMessages),
-		report.WithMaxSingleChainReports(p.offchainCfg.MaxSingleChainReports),
+		options...,
 	)
 
 	outcomeReports, selectedCommitReports, err := selectReport(
@@ -197,14 +250,8 @@ func (p *Plugin) getFilterOutcome(
 		commitReports,
 		builder)
 	if err != nil {
-		return exectypes.Outcome{}, fmt.Errorf("unable to select report: %w", err)
+		return cciptypes.ExecutePluginReport{}, nil, fmt.Errorf("unable to select report: %w", err)
 	}
 
-	execReport := cciptypes.ExecutePluginReport{
-		ChainReports: outcomeReports,
-	}
-
-	// Must use 'NewOutcome' rather than direct struct initialization to ensure the outcome is sorted.
-	// TODO: sort in the encoder.
-	return exectypes.NewOutcome(exectypes.Filter, selectedCommitReports, execReport), nil
+	return cciptypes.ExecutePluginReport{ChainReports: outcomeReports}, selectedCommitReports, nil
 }
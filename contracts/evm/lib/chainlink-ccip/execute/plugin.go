@@ -77,6 +77,8 @@ type Plugin struct {
 	oracleIDToP2pID   map[commontypes.OracleID]libocrtypes.PeerID
 	tokenDataObserver observer.TokenDataObserver
 	estimateProvider  cciptypes.EstimateProvider
+	extraArgsDecoder  cciptypes.ExtraArgsDecoder
+	reportSimulator   cciptypes.ExecuteReportSimulator
 	lggr              logger.Logger
 	ocrTypeCodec      ocrtypecodec.ExecCodec
 	addrCodec         cciptypes.AddressCodec
@@ -100,6 +102,8 @@ func NewPlugin(
 	homeChain reader.HomeChain,
 	tokenDataObserver observer.TokenDataObserver,
 	estimateProvider cciptypes.EstimateProvider,
+	extraArgsDecoder cciptypes.ExtraArgsDecoder,
+	reportSimulator cciptypes.ExecuteReportSimulator,
 	lggr logger.Logger,
 	metricsReporter metrics.Reporter,
 	addrCodec cciptypes.AddressCodec,
@@ -128,6 +132,8 @@ func NewPlugin(
 		homeChain:         homeChain,
 		tokenDataObserver: tokenDataObserver,
 		estimateProvider:  estimateProvider,
+		extraArgsDecoder:  extraArgsDecoder,
+		reportSimulator:   reportSimulator,
 		lggr:              logutil.WithComponent(lggr, "ExecutePlugin"),
 		discovery: discovery.NewContractDiscoveryProcessor(
 			logutil.WithComponent(lggr, "Discovery"),
@@ -392,6 +398,15 @@ func (p *Plugin) ValidateObservation(
 		return err
 	}
 
+	if len(decodedObservation.SimulationFailures) > 0 {
+		if nextState != exectypes.Filter {
+			return fmt.Errorf("simulation failures are only expected in the Filter state")
+		}
+		if !supportedChains.Contains(p.destChain) {
+			return fmt.Errorf("simulation failures observed by an oracle which does not support the destination chain")
+		}
+	}
+
 	// check message related validations when states can contain messages
 	if nextState == exectypes.GetMessages || nextState == exectypes.Filter {
 		if err = validateMsgsReadingEligibility(supportedChains, decodedObservation.Messages); err != nil {
@@ -505,6 +520,9 @@ func selectReport(
 	// TODO: It may be desirable for this entire function to be an interface so that
 	//       different selection algorithms can be used.
 
+	// Reports with prioritized messages are added first, they are not starved by the gas or size limits.
+	commitReports = builder.Prioritize(commitReports)
+
 	pendingReports := 0
 	for i, commitReport := range commitReports {
 		// handle incomplete observations.
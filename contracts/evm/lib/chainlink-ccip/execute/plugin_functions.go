@@ -756,6 +756,48 @@ func computeNoncesConsensus(
 	return consensusNonces
 }
 
+// computeSimulationFailuresConsensus returns the messages which at least fChainDest+1 oracles observed to fail when
+// simulating their report. At least one honest oracle observed the failure, so a faulty oracle cannot exclude messages.
+func computeSimulationFailuresConsensus(
+	lggr logger.Logger,
+	observations []plugincommon.AttributedObservation[exectypes.Observation],
+	fChainDest int,
+) exectypes.SimulationFailureObservations {
+	type failedMessage struct {
+		chain     cciptypes.ChainSelector
+		seqNum    cciptypes.SeqNum
+		messageID cciptypes.Bytes32
+	}
+
+	counts := make(map[failedMessage]int)
+	for _, obs := range observations {
+		for chain, failures := range obs.Observation.SimulationFailures {
+			for seqNum, messageID := range failures {
+				counts[failedMessage{chain: chain, seqNum: seqNum, messageID: messageID}]++
+			}
+		}
+	}
+
+	var consensusFailures exectypes.SimulationFailureObservations
+	for msg, count := range counts {
+		if count < fChainDest+1 {
+			lggr.Debugw("no consensus on simulation failure",
+				"chain", msg.chain, "seqNum", msg.seqNum, "messageID", msg.messageID, "count", count)
+			continue
+		}
+
+		if consensusFailures == nil {
+			consensusFailures = make(exectypes.SimulationFailureObservations)
+		}
+		if _, ok := consensusFailures[msg.chain]; !ok {
+			consensusFailures[msg.chain] = make(map[cciptypes.SeqNum]cciptypes.Bytes32)
+		}
+		consensusFailures[msg.chain][msg.seqNum] = msg.messageID
+	}
+
+	return consensusFailures
+}
+
 // computeConsensusObservation aggregates multiple attributed observations to produce a single consensus observation.
 // The provided f is required for computing the consensus on fChain prior to computing the observation consensus.
 func computeConsensusObservation(
@@ -779,6 +821,7 @@ func computeConsensusObservation(
 		dt.Observation{},
 		computeMessageHashesConsensus(lggr, observations, fChain),
 	)
+	consensusObservation.SimulationFailures = computeSimulationFailuresConsensus(lggr, observations, destFChain)
 
 	lggr.Debugw("computeConsensusObservation has finished computing the consensus observation",
 		"fChain", fChain,
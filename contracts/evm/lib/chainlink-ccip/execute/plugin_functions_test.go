@@ -1699,6 +1699,81 @@ func Test_computeNoncesConsensus(t *testing.T) {
 	}
 }
 
+func Test_computeSimulationFailuresConsensus(t *testing.T) {
+	lggr := logger.Test(t)
+	msgID := cciptypes.Bytes32{1}
+
+	testCases := []struct {
+		name         string
+		allFailures  []exectypes.SimulationFailureObservations
+		fChain       int
+		expConsensus exectypes.SimulationFailureObservations
+	}{
+		{
+			name:         "empty",
+			allFailures:  []exectypes.SimulationFailureObservations{},
+			fChain:       1,
+			expConsensus: nil,
+		},
+		{
+			name: "one observation does not reach threshold",
+			allFailures: []exectypes.SimulationFailureObservations{
+				{1: {10: msgID}},
+				nil,
+			},
+			fChain:       1,
+			expConsensus: nil,
+		},
+		{
+			name: "two observations reach threshold",
+			allFailures: []exectypes.SimulationFailureObservations{
+				{1: {10: msgID, 11: cciptypes.Bytes32{2}}},
+				{1: {10: msgID}},
+				nil,
+			},
+			fChain: 1,
+			expConsensus: exectypes.SimulationFailureObservations{
+				1: {10: msgID},
+			},
+		},
+		{
+			name: "different message ids are not counted together",
+			allFailures: []exectypes.SimulationFailureObservations{
+				{1: {10: msgID}},
+				{1: {10: cciptypes.Bytes32{2}}},
+			},
+			fChain:       1,
+			expConsensus: nil,
+		},
+		{
+			name: "multiple chains",
+			allFailures: []exectypes.SimulationFailureObservations{
+				{1: {10: msgID}, 2: {20: msgID}},
+				{1: {10: msgID}, 2: {20: msgID}},
+			},
+			fChain: 1,
+			expConsensus: exectypes.SimulationFailureObservations{
+				1: {10: msgID},
+				2: {20: msgID},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			observations := make([]plugincommon.AttributedObservation[exectypes.Observation], len(tc.allFailures))
+			for i, obs := range tc.allFailures {
+				observations[i] = plugincommon.AttributedObservation[exectypes.Observation]{
+					Observation: exectypes.Observation{SimulationFailures: obs},
+					OracleID:    commontypes.OracleID(i),
+				}
+			}
+			obs := computeSimulationFailuresConsensus(lggr, observations, tc.fChain)
+			assert.Equal(t, tc.expConsensus, obs)
+		})
+	}
+}
+
 func Test_computeMessageHashesConsensus(t *testing.T) {
 	testCases := []struct {
 		name           string
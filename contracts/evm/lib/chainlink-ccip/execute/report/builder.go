@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 
@@ -14,6 +15,8 @@ import (
 var _ ExecReportBuilder = &execReportBuilder{}
 
 type ExecReportBuilder interface {
+	// Prioritize orders the commit reports in the order they should be added to the builder.
+	Prioritize(commitReports []exectypes.CommitData) []exectypes.CommitData
 	Add(ctx context.Context, report exectypes.CommitData) (exectypes.CommitData, error)
 	Build() ([]cciptypes.ExecutePluginReportSingleChain, []exectypes.CommitData, error)
 }
@@ -49,6 +52,14 @@ func WithMaxSingleChainReports(maxSingleChainReports uint64) Option {
 	}
 }
 
+// WithPriorityPolicy configures the order in which ready messages are added to reports.
+// By default, messages are added in the order they were committed.
+func WithPriorityPolicy(policy PriorityPolicy) Option {
+	return func(erb *execReportBuilder) {
+		erb.priority = policy
+	}
+}
+
 // WithExtraMessageCheck adds additional message checks to the default ones.
 func WithExtraMessageCheck(check Check) Option {
 	return func(erb *execReportBuilder) {
@@ -132,9 +143,12 @@ type execReportBuilder struct {
 	maxGas                uint64
 	maxMessages           uint64
 	maxSingleChainReports uint64
+	priority              PriorityPolicy
 
 	// State
 	accumulated validationMetadata
+	// newestCommit is the timestamp of the newest commit report being prioritized, used to compute message ages.
+	newestCommit time.Time
 
 	// Result
 	execReports   []cciptypes.ExecutePluginReportSingleChain
@@ -0,0 +1,298 @@
+package report
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink-ccip/execute/exectypes"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+	"github.com/smartcontractkit/chainlink-ccip/pluginconfig"
+)
+
+// Names of the checks which can be enabled from the offchain config.
+const (
+	// BlockedSendersCheck skips messages from the given senders, per source chain.
+	//	{"senders": {"<source chain selector>": ["<sender>"]}}
+	BlockedSendersCheck = "blockedSenders"
+	// BlockedReceiversCheck skips messages to the given receivers.
+	//	{"receivers": ["<receiver>"]}
+	BlockedReceiversCheck = "blockedReceivers"
+	// MaxDataSizeCheck skips messages with more data than allowed for their receiver.
+	//	{"receivers": {"<receiver>": <max data size in bytes>}}
+	MaxDataSizeCheck = "maxDataSize"
+	// MinGasLimitCheck skips messages requesting a lower gas limit in their extra args than required by their
+	// receiver. On Solana destinations the requested compute units are compared.
+	//	{"receivers": {"<receiver>": <min gas>}}
+	MinGasLimitCheck = "minGasLimit"
+)
+
+// CheckEnv contains the providers available to checks created from the offchain config.
+type CheckEnv struct {
+	DestChain        ccipocr3.ChainSelector
+	AddressCodec     ccipocr3.AddressCodec
+	EstimateProvider ccipocr3.EstimateProvider
+	ExtraArgsDecoder ccipocr3.ExtraArgsDecoder
+}
+
+// CheckFactory creates a check from its JSON encoded params.
+type CheckFactory func(params json.RawMessage, env CheckEnv) (Check, error)
+
+var checkRegistry = struct {
+	sync.RWMutex
+	factories map[string]CheckFactory
+}{
+	factories: map[string]CheckFactory{
+		BlockedSendersCheck:   newBlockedSendersCheck,
+		BlockedReceiversCheck: newBlockedReceiversCheck,
+		MaxDataSizeCheck:      newMaxDataSizeCheck,
+		MinGasLimitCheck:      newMinGasLimitCheck,
+	},
+}
+
+// RegisterCheck registers a check factory so the check can be enabled by name from the offchain config.
+func RegisterCheck(name string, factory CheckFactory) error {
+	if name == "" || factory == nil {
+		return errors.New("check name and factory are required")
+	}
+
+	checkRegistry.Lock()
+	defer checkRegistry.Unlock()
+	if _, ok := checkRegistry.factories[name]; ok {
+		return fmt.Errorf("check %s already registered", name)
+	}
+	checkRegistry.factories[name] = factory
+	return nil
+}
+
+// NewConfiguredChecks creates the checks enabled in the offchain config, in the configured order.
+func NewConfiguredChecks(cfgs []pluginconfig.MessageCheckConfig, env CheckEnv) ([]Check, error) {
+	checkRegistry.RLock()
+	defer checkRegistry.RUnlock()
+
+	checks := make([]Check, 0, len(cfgs))
+	for _, cfg := range cfgs {
+		factory, ok := checkRegistry.factories[cfg.Name]
+		if !ok {
+			return nil, fmt.Errorf("unknown message check %s", cfg.Name)
+		}
+		check, err := factory(cfg.Params, env)
+		if err != nil {
+			return nil, fmt.Errorf("unable to create message check %s: %w", cfg.Name, err)
+		}
+		checks = append(checks, check)
+	}
+	return checks, nil
+}
+
+func decodeCheckParams(params json.RawMessage, v any) error {
+	if len(params) == 0 {
+		return errors.New("params not set")
+	}
+	dec := json.NewDecoder(bytes.NewReader(params))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(v); err != nil {
+		return fmt.Errorf("invalid params: %w", err)
+	}
+	return nil
+}
+
+// decodeAddresses returns the addresses as a set keyed by their bytes.
+func decodeAddresses(
+	addresses []string, chain ccipocr3.ChainSelector, addressCodec ccipocr3.AddressCodec,
+) (map[string]struct{}, error) {
+	set := make(map[string]struct{}, len(addresses))
+	for _, address := range addresses {
+		addr, err := addressCodec.AddressStringToBytes(address, chain)
+		if err != nil {
+			return nil, fmt.Errorf("invalid address %s for chain %d: %w", address, chain, err)
+		}
+		set[string(addr)] = struct{}{}
+	}
+	return set, nil
+}
+
+// decodeReceiverLimits returns the limits keyed by the bytes of the receiver.
+func decodeReceiverLimits(limits map[string]uint64, env CheckEnv) (map[string]uint64, error) {
+	if len(limits) == 0 {
+		return nil, errors.New("no receivers configured")
+	}
+	decoded := make(map[string]uint64, len(limits))
+	for receiver, limit := range limits {
+		addr, err := env.AddressCodec.AddressStringToBytes(receiver, env.DestChain)
+		if err != nil {
+			return nil, fmt.Errorf("invalid receiver %s: %w", receiver, err)
+		}
+		decoded[string(addr)] = limit
+	}
+	return decoded, nil
+}
+
+func newBlockedSendersCheck(params json.RawMessage, env CheckEnv) (Check, error) {
+	var p struct {
+		Senders map[ccipocr3.ChainSelector][]string `json:"senders"`
+	}
+	if err := decodeCheckParams(params, &p); err != nil {
+		return nil, err
+	}
+
+	blocked := make(map[ccipocr3.ChainSelector]map[string]struct{}, len(p.Senders))
+	for chain, senders := range p.Senders {
+		set, err := decodeAddresses(senders, chain, env.AddressCodec)
+		if err != nil {
+			return nil, err
+		}
+		blocked[chain] = set
+	}
+
+	return func(lggr logger.Logger, msg ccipocr3.Message, idx int, report exectypes.CommitData) (messageStatus, error) {
+		if _, ok := blocked[report.SourceChain][string(msg.Sender)]; ok {
+			lggr.Warnw("Skipping message - sender is blocked",
+				"messageID", msg.Header.MessageID,
+				"sourceChain", report.SourceChain,
+				"seqNum", msg.Header.SequenceNumber,
+				"sender", msg.Sender,
+				"messageState", Blocked)
+			return Blocked, nil
+		}
+		return None, nil
+	}, nil
+}
+
+func newBlockedReceiversCheck(params json.RawMessage, env CheckEnv) (Check, error) {
+	var p struct {
+		Receivers []string `json:"receivers"`
+	}
+	if err := decodeCheckParams(params, &p); err != nil {
+		return nil, err
+	}
+	blocked, err := decodeAddresses(p.Receivers, env.DestChain, env.AddressCodec)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(lggr logger.Logger, msg ccipocr3.Message, idx int, report exectypes.CommitData) (messageStatus, error) {
+		if _, ok := blocked[string(msg.Receiver)]; ok {
+			lggr.Warnw("Skipping message - receiver is blocked",
+				"messageID", msg.Header.MessageID,
+				"sourceChain", report.SourceChain,
+				"seqNum", msg.Header.SequenceNumber,
+				"receiver", msg.Receiver,
+				"messageState", Blocked)
+			return Blocked, nil
+		}
+		return None, nil
+	}, nil
+}
+
+func newMaxDataSizeCheck(params json.RawMessage, env CheckEnv) (Check, error) {
+	var p struct {
+		Receivers map[string]uint64 `json:"receivers"`
+	}
+	if err := decodeCheckParams(params, &p); err != nil {
+		return nil, err
+	}
+	maxDataSize, err := decodeReceiverLimits(p.Receivers, env)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(lggr logger.Logger, msg ccipocr3.Message, idx int, report exectypes.CommitData) (messageStatus, error) {
+		limit, ok := maxDataSize[string(msg.Receiver)]
+		if ok && uint64(len(msg.Data)) > limit {
+			lggr.Warnw("Skipping message - data size exceeds the receiver limit",
+				"messageID", msg.Header.MessageID,
+				"sourceChain", report.SourceChain,
+				"seqNum", msg.Header.SequenceNumber,
+				"dataSize", len(msg.Data),
+				"maxDataSize", limit,
+				"messageState", DataSizeExceeded)
+			return DataSizeExceeded, nil
+		}
+		return None, nil
+	}, nil
+}
+
+func newMinGasLimitCheck(params json.RawMessage, env CheckEnv) (Check, error) {
+	if env.ExtraArgsDecoder == nil {
+		return nil, errors.New("extra args decoder is required")
+	}
+	var p struct {
+		Receivers map[string]uint64 `json:"receivers"`
+	}
+	if err := decodeCheckParams(params, &p); err != nil {
+		return nil, err
+	}
+	minGas, err := decodeReceiverLimits(p.Receivers, env)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(lggr logger.Logger, msg ccipocr3.Message, idx int, report exectypes.CommitData) (messageStatus, error) {
+		limit, ok := minGas[string(msg.Receiver)]
+		if !ok {
+			return None, nil
+		}
+		gasLimit, err := requestedGasLimit(env.ExtraArgsDecoder, msg)
+		if err != nil {
+			// the message can't be shown to request enough gas.
+			lggr.Warnw("Skipping message - unable to read the gas limit from the extra args",
+				"messageID", msg.Header.MessageID,
+				"sourceChain", report.SourceChain,
+				"seqNum", msg.Header.SequenceNumber,
+				"err", err,
+				"messageState", InsufficientGasLimit)
+			return InsufficientGasLimit, nil
+		}
+		if !gasLimit.IsUint64() || gasLimit.Uint64() < limit {
+			lggr.Warnw("Skipping message - gas limit is below the receiver minimum",
+				"messageID", msg.Header.MessageID,
+				"sourceChain", report.SourceChain,
+				"seqNum", msg.Header.SequenceNumber,
+				"gasLimit", gasLimit,
+				"minGas", limit,
+				"messageState", InsufficientGasLimit)
+			return InsufficientGasLimit, nil
+		}
+		return None, nil
+	}, nil
+}
+
+// requestedGasLimit returns the gas limit, or the compute units on Solana destinations, requested in the extra args
+// of the message.
+func requestedGasLimit(decoder ccipocr3.ExtraArgsDecoder, msg ccipocr3.Message) (*big.Int, error) {
+	decoded, err := decoder.DecodeExtraArgs(msg.ExtraArgs, msg.Header.SourceChainSelector)
+	if err != nil {
+		return nil, fmt.Errorf("decode extra args: %w", err)
+	}
+	for name, value := range decoded {
+		switch strings.ToLower(name) {
+		case "gaslimit", "computeunits":
+		default:
+			continue
+		}
+		switch v := value.(type) {
+		case *big.Int:
+			if v == nil || v.Sign() < 0 {
+				return nil, fmt.Errorf("invalid %s %v", name, v)
+			}
+			return v, nil
+		case uint32:
+			return new(big.Int).SetUint64(uint64(v)), nil
+		case uint64:
+			return new(big.Int).SetUint64(v), nil
+		case interface{ BigInt() *big.Int }:
+			// the gas limit of messages sent from Solana is a 128 bits integer.
+			return v.BigInt(), nil
+		default:
+			return nil, fmt.Errorf("unexpected type %T for %s", value, name)
+		}
+	}
+	return nil, errors.New("gas limit not found in the extra args")
+}
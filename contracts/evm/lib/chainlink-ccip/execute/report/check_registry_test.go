@@ -0,0 +1,227 @@
+package report
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink-ccip/execute/exectypes"
+	"github.com/smartcontractkit/chainlink-ccip/internal"
+	gasmock "github.com/smartcontractkit/chainlink-ccip/mocks/pkg/types/ccipocr3"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+	"github.com/smartcontractkit/chainlink-ccip/pluginconfig"
+)
+
+func Test_NewConfiguredChecks(t *testing.T) {
+	lggr := logger.Test(t)
+	ep := gasmock.NewMockEstimateProvider(t)
+	ep.EXPECT().CalculateMessageMaxGas(mock.Anything).RunAndReturn(func(msg cciptypes.Message) uint64 {
+		return uint64(msg.Header.Nonce)
+	}).Maybe()
+	env := CheckEnv{
+		DestChain:        2,
+		AddressCodec:     internal.NewMockAddressCodecHex(t),
+		EstimateProvider: ep,
+		ExtraArgsDecoder: fakeExtraArgsDecoder{},
+	}
+	report := exectypes.CommitData{SourceChain: 1}
+	// the estimate is the requested gas limit plus the exec overhead, only the requested gas limit is checked.
+	msg := func(sender, receiver byte, dataSize int, gas uint64) cciptypes.Message {
+		return cciptypes.Message{
+			Header:    cciptypes.RampMessageHeader{SourceChainSelector: 1, Nonce: gas + 1000},
+			Sender:    cciptypes.UnknownAddress{sender},
+			Receiver:  cciptypes.UnknownAddress{receiver},
+			Data:      make([]byte, dataSize),
+			ExtraArgs: big.NewInt(int64(gas)).Bytes(),
+		}
+	}
+
+	tests := []struct {
+		name     string
+		check    string
+		params   string
+		msg      cciptypes.Message
+		expected messageStatus
+	}{
+		{
+			name:     "blocked sender",
+			check:    BlockedSendersCheck,
+			params:   `{"senders": {"1": ["0x01"]}}`,
+			msg:      msg(0x1, 0x2, 0, 0),
+			expected: Blocked,
+		},
+		{
+			name:     "sender blocked on another chain",
+			check:    BlockedSendersCheck,
+			params:   `{"senders": {"3": ["0x01"]}}`,
+			msg:      msg(0x1, 0x2, 0, 0),
+			expected: None,
+		},
+		{
+			name:     "blocked receiver",
+			check:    BlockedReceiversCheck,
+			params:   `{"receivers": ["0x02"]}`,
+			msg:      msg(0x1, 0x2, 0, 0),
+			expected: Blocked,
+		},
+		{
+			name:     "receiver not blocked",
+			check:    BlockedReceiversCheck,
+			params:   `{"receivers": ["0x03"]}`,
+			msg:      msg(0x1, 0x2, 0, 0),
+			expected: None,
+		},
+		{
+			name:     "data size exceeded",
+			check:    MaxDataSizeCheck,
+			params:   `{"receivers": {"0x02": 10}}`,
+			msg:      msg(0x1, 0x2, 11, 0),
+			expected: DataSizeExceeded,
+		},
+		{
+			name:     "data size within limit",
+			check:    MaxDataSizeCheck,
+			params:   `{"receivers": {"0x02": 10}}`,
+			msg:      msg(0x1, 0x2, 10, 0),
+			expected: None,
+		},
+		{
+			name:     "gas limit below minimum",
+			check:    MinGasLimitCheck,
+			params:   `{"receivers": {"0x02": 100}}`,
+			msg:      msg(0x1, 0x2, 0, 99),
+			expected: InsufficientGasLimit,
+		},
+		{
+			name:     "gas limit at minimum",
+			check:    MinGasLimitCheck,
+			params:   `{"receivers": {"0x02": 100}}`,
+			msg:      msg(0x1, 0x2, 0, 100),
+			expected: None,
+		},
+		{
+			name:     "gas limit below minimum with estimate above it",
+			check:    MinGasLimitCheck,
+			params:   `{"receivers": {"0x02": 100}}`,
+			msg:      msg(0x1, 0x2, 0, 1),
+			expected: InsufficientGasLimit,
+		},
+		{
+			name:     "undecodable extra args",
+			check:    MinGasLimitCheck,
+			params:   `{"receivers": {"0x02": 100}}`,
+			msg:      cciptypes.Message{Receiver: cciptypes.UnknownAddress{0x2}},
+			expected: InsufficientGasLimit,
+		},
+		{
+			name:     "gas limit of another receiver",
+			check:    MinGasLimitCheck,
+			params:   `{"receivers": {"0x03": 100}}`,
+			msg:      msg(0x1, 0x2, 0, 99),
+			expected: None,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checks, err := NewConfiguredChecks([]pluginconfig.MessageCheckConfig{
+				{Name: tt.check, Params: json.RawMessage(tt.params)},
+			}, env)
+			require.NoError(t, err)
+			require.Len(t, checks, 1)
+
+			status, err := checks[0](lggr, tt.msg, 0, report)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, status)
+		})
+	}
+}
+
+func Test_NewConfiguredChecks_Errors(t *testing.T) {
+	env := CheckEnv{DestChain: 2, AddressCodec: internal.NewMockAddressCodecHex(t)}
+
+	tests := []struct {
+		name    string
+		cfg     pluginconfig.MessageCheckConfig
+		wantErr string
+	}{
+		{
+			name:    "unknown check",
+			cfg:     pluginconfig.MessageCheckConfig{Name: "unknown"},
+			wantErr: "unknown message check unknown",
+		},
+		{
+			name:    "missing params",
+			cfg:     pluginconfig.MessageCheckConfig{Name: BlockedReceiversCheck},
+			wantErr: "params not set",
+		},
+		{
+			name: "unknown param",
+			cfg: pluginconfig.MessageCheckConfig{
+				Name: BlockedReceiversCheck, Params: json.RawMessage(`{"receiver": ["0x02"]}`)},
+			wantErr: "invalid params",
+		},
+		{
+			name: "invalid address",
+			cfg: pluginconfig.MessageCheckConfig{
+				Name: BlockedReceiversCheck, Params: json.RawMessage(`{"receivers": ["zz"]}`)},
+			wantErr: "invalid address zz",
+		},
+		{
+			name: "no receiver limits",
+			cfg: pluginconfig.MessageCheckConfig{
+				Name: MaxDataSizeCheck, Params: json.RawMessage(`{"receivers": {}}`)},
+			wantErr: "no receivers configured",
+		},
+		{
+			name: "missing extra args decoder",
+			cfg: pluginconfig.MessageCheckConfig{
+				Name: MinGasLimitCheck, Params: json.RawMessage(`{"receivers": {"0x02": 1}}`)},
+			wantErr: "extra args decoder is required",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewConfiguredChecks([]pluginconfig.MessageCheckConfig{tt.cfg}, env)
+			require.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func Test_RegisterCheck(t *testing.T) {
+	const name = "testRegisterCheck"
+	factory := func(json.RawMessage, CheckEnv) (Check, error) {
+		return func(logger.Logger, cciptypes.Message, int, exectypes.CommitData) (messageStatus, error) {
+			return Blocked, nil
+		}, nil
+	}
+
+	require.NoError(t, RegisterCheck(name, factory))
+	t.Cleanup(func() {
+		checkRegistry.Lock()
+		defer checkRegistry.Unlock()
+		delete(checkRegistry.factories, name)
+	})
+	require.ErrorContains(t, RegisterCheck(name, factory), "already registered")
+	require.ErrorContains(t, RegisterCheck(BlockedSendersCheck, factory), "already registered")
+	require.Error(t, RegisterCheck("", factory))
+
+	checks, err := NewConfiguredChecks([]pluginconfig.MessageCheckConfig{{Name: name}}, CheckEnv{})
+	require.NoError(t, err)
+	require.Len(t, checks, 1)
+}
+
+// fakeExtraArgsDecoder decodes the extra args as the big endian gas limit.
+type fakeExtraArgsDecoder struct{}
+
+func (fakeExtraArgsDecoder) DecodeExtraArgs(extraArgs cciptypes.Bytes, _ cciptypes.ChainSelector) (map[string]any, error) {
+	if extraArgs == nil {
+		return nil, errors.New("empty extra args")
+	}
+	return map[string]any{"gasLimit": new(big.Int).SetBytes(extraArgs)}, nil
+}
@@ -0,0 +1,277 @@
+package report
+
+import (
+	"bytes"
+	"cmp"
+	"fmt"
+	"math/big"
+	"slices"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-ccip/execute/exectypes"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+	"github.com/smartcontractkit/chainlink-ccip/pluginconfig"
+)
+
+// PriorityCandidate is a message which may be selected for execution, along with the
+// information a PriorityPolicy can use to rank it.
+type PriorityCandidate struct {
+	Message ccipocr3.Message
+	// Gas is the estimated gas needed to execute the message.
+	Gas uint64
+	// Age is how long before the newest pending commit report the message was committed.
+	Age time.Duration
+}
+
+// PriorityPolicy compares two candidates. It returns a negative number when a should be selected before b,
+// a positive number when b should be selected before a and 0 when the policy has no preference.
+//
+// Policies must only depend on the candidates so that all oracles select the same messages. The builder
+// breaks ties using the commit order and keeps the nonce ordering of sequenced messages from the same sender.
+type PriorityPolicy func(a, b PriorityCandidate) int
+
+// CombinePriorities applies the policies in order, the first policy with a preference decides.
+func CombinePriorities(policies ...PriorityPolicy) PriorityPolicy {
+	return func(a, b PriorityCandidate) int {
+		for _, policy := range policies {
+			if c := policy(a, b); c != 0 {
+				return c
+			}
+		}
+		return 0
+	}
+}
+
+// SenderPriority selects the messages of the given senders, per source chain, first.
+func SenderPriority(senders map[ccipocr3.ChainSelector][]ccipocr3.UnknownAddress) PriorityPolicy {
+	isPrioritized := func(msg ccipocr3.Message) bool {
+		return slices.ContainsFunc(senders[msg.Header.SourceChainSelector], func(sender ccipocr3.UnknownAddress) bool {
+			return bytes.Equal(sender, msg.Sender)
+		})
+	}
+	return func(a, b PriorityCandidate) int {
+		return preferTrue(isPrioritized(a.Message), isPrioritized(b.Message))
+	}
+}
+
+// FeePerGasPriority selects the messages which paid the highest fee per unit of gas first.
+// The fee is the FeeValueJuels of the message, so fees paid in different tokens are comparable.
+func FeePerGasPriority() PriorityPolicy {
+	return func(a, b PriorityCandidate) int {
+		// compare feeA / gasA with feeB / gasB without dividing: feeA * gasB with feeB * gasA.
+		left := new(big.Int).Mul(feeValue(a.Message), new(big.Int).SetUint64(b.Gas))
+		right := new(big.Int).Mul(feeValue(b.Message), new(big.Int).SetUint64(a.Gas))
+		return right.Cmp(left)
+	}
+}
+
+// AgePriority selects the messages committed at least boostAfter ago first,
+// so messages with a low priority are eventually executed.
+func AgePriority(boostAfter time.Duration) PriorityPolicy {
+	return func(a, b PriorityCandidate) int {
+		return preferTrue(a.Age >= boostAfter, b.Age >= boostAfter)
+	}
+}
+
+// NewPriorityPolicy creates the priority policy described by the config, nil if no policy is configured.
+func NewPriorityPolicy(
+	cfg pluginconfig.MessagePriorityConfig,
+	addressCodec ccipocr3.AddressCodec,
+) (PriorityPolicy, error) {
+	if !cfg.IsEnabled() {
+		return nil, nil
+	}
+
+	var policies []PriorityPolicy
+	if cfg.AgeBoostAfter.Duration() > 0 {
+		policies = append(policies, AgePriority(cfg.AgeBoostAfter.Duration()))
+	}
+	if len(cfg.PrioritySenders) > 0 {
+		senders := make(map[ccipocr3.ChainSelector][]ccipocr3.UnknownAddress, len(cfg.PrioritySenders))
+		for chain, chainSenders := range cfg.PrioritySenders {
+			for _, sender := range chainSenders {
+				addr, err := addressCodec.AddressStringToBytes(sender, chain)
+				if err != nil {
+					return nil, fmt.Errorf("invalid priority sender %s for chain %d: %w", sender, chain, err)
+				}
+				senders[chain] = append(senders[chain], addr)
+			}
+		}
+		policies = append(policies, SenderPriority(senders))
+	}
+	if cfg.OrderByFeePerGas {
+		policies = append(policies, FeePerGasPriority())
+	}
+	return CombinePriorities(policies...), nil
+}
+
+func preferTrue(a, b bool) int {
+	switch {
+	case a == b:
+		return 0
+	case a:
+		return -1
+	default:
+		return 1
+	}
+}
+
+func feeValue(msg ccipocr3.Message) *big.Int {
+	if msg.FeeValueJuels.Int == nil {
+		return big.NewInt(0)
+	}
+	return msg.FeeValueJuels.Int
+}
+
+// candidate returns the PriorityCandidate of a message in the commit report.
+func (b *execReportBuilder) candidate(msg ccipocr3.Message, report exectypes.CommitData) PriorityCandidate {
+	var gas uint64
+	if b.estimateProvider != nil {
+		gas = b.estimateProvider.CalculateMessageMaxGas(msg)
+	}
+	var age time.Duration
+	if b.newestCommit.After(report.Timestamp) {
+		age = b.newestCommit.Sub(report.Timestamp)
+	}
+	return PriorityCandidate{Message: msg, Gas: gas, Age: age}
+}
+
+// Prioritize orders the commit reports by their highest priority message, so the reports with
+// prioritized messages are added to the builder first. Without a priority policy the order is unchanged.
+// Reports are only reordered across source chains, the reports of a source chain are kept in sequence number
+// order, otherwise sequenced messages of a sender could be selected before the lower nonces committed earlier.
+func (b *execReportBuilder) Prioritize(commitReports []exectypes.CommitData) []exectypes.CommitData {
+	if b.priority == nil || len(commitReports) == 0 {
+		return commitReports
+	}
+
+	for _, report := range commitReports {
+		if report.Timestamp.After(b.newestCommit) {
+			b.newestCommit = report.Timestamp
+		}
+	}
+
+	best := make([]*PriorityCandidate, len(commitReports))
+	for i, report := range commitReports {
+		for _, msg := range report.Messages {
+			if msg.IsPseudoDeleted() || slices.Contains(report.ExecutedMessages, msg.Header.SequenceNumber) {
+				continue
+			}
+			c := b.candidate(msg, report)
+			if best[i] == nil || b.priority(c, *best[i]) < 0 {
+				best[i] = &c
+			}
+		}
+	}
+
+	order := make([]int, len(commitReports))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(i, j int) int {
+		switch {
+		case best[i] == nil || best[j] == nil:
+			// reports without messages to execute go last.
+			return preferTrue(best[i] != nil, best[j] != nil)
+		default:
+			return b.priority(*best[i], *best[j])
+		}
+	})
+
+	order = keepSeqNumOrder(commitReports, order)
+
+	result := make([]exectypes.CommitData, len(commitReports))
+	for i, idx := range order {
+		result[i] = commitReports[idx]
+	}
+	return result
+}
+
+// keepSeqNumOrder reorders the commit reports of each source chain so they are selected in sequence number order.
+// Each source chain keeps the positions its reports were given, only the reports in them are swapped.
+func keepSeqNumOrder(commitReports []exectypes.CommitData, order []int) []int {
+	positions := make(map[ccipocr3.ChainSelector][]int)
+	var chains []ccipocr3.ChainSelector
+	for pos, i := range order {
+		chain := commitReports[i].SourceChain
+		if _, ok := positions[chain]; !ok {
+			chains = append(chains, chain)
+		}
+		positions[chain] = append(positions[chain], pos)
+	}
+
+	result := slices.Clone(order)
+	for _, chain := range chains {
+		indexes := make([]int, 0, len(positions[chain]))
+		for _, pos := range positions[chain] {
+			indexes = append(indexes, order[pos])
+		}
+		slices.SortStableFunc(indexes, func(i, j int) int {
+			return cmp.Compare(
+				commitReports[i].SequenceNumberRange.Start(), commitReports[j].SequenceNumberRange.Start())
+		})
+		for k, pos := range positions[chain] {
+			result[pos] = indexes[k]
+		}
+	}
+	return result
+}
+
+// selectionOrder returns the indexes of the ready messages in the order they should be added to the report.
+// The indexes are in commit order unless a priority policy is configured.
+func (b *execReportBuilder) selectionOrder(report exectypes.CommitData, readyMessages map[int]struct{}) []int {
+	order := make([]int, 0, len(readyMessages))
+	for i := range report.Messages {
+		if _, ok := readyMessages[i]; ok {
+			order = append(order, i)
+		}
+	}
+	if b.priority == nil {
+		return order
+	}
+
+	candidates := make(map[int]PriorityCandidate, len(order))
+	for _, i := range order {
+		candidates[i] = b.candidate(report.Messages[i], report)
+	}
+	slices.SortStableFunc(order, func(i, j int) int {
+		if c := b.priority(candidates[i], candidates[j]); c != 0 {
+			return c
+		}
+		return cmp.Compare(i, j)
+	})
+
+	return keepNonceOrder(report, order)
+}
+
+// keepNonceOrder reorders the sequenced messages of each sender so they are selected in nonce order.
+// Each sender keeps the positions its messages were given, only the messages in them are swapped.
+// Messages are committed in nonce order, so sorting the indexes of a sender sorts its nonces.
+func keepNonceOrder(report exectypes.CommitData, order []int) []int {
+	positions := make(map[string][]int)
+	var senders []string
+	for pos, i := range order {
+		msg := report.Messages[i]
+		if msg.Header.Nonce == 0 {
+			continue
+		}
+		sender := string(msg.Sender)
+		if _, ok := positions[sender]; !ok {
+			senders = append(senders, sender)
+		}
+		positions[sender] = append(positions[sender], pos)
+	}
+
+	result := slices.Clone(order)
+	for _, sender := range senders {
+		indexes := make([]int, 0, len(positions[sender]))
+		for _, pos := range positions[sender] {
+			indexes = append(indexes, order[pos])
+		}
+		slices.Sort(indexes)
+		for k, pos := range positions[sender] {
+			result[pos] = indexes[k]
+		}
+	}
+	return result
+}
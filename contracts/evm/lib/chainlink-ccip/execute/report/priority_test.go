@@ -0,0 +1,225 @@
+package report
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink-ccip/execute/exectypes"
+	"github.com/smartcontractkit/chainlink-ccip/internal"
+	"github.com/smartcontractkit/chainlink-ccip/internal/mocks"
+	gasmock "github.com/smartcontractkit/chainlink-ccip/mocks/pkg/types/ccipocr3"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+	"github.com/smartcontractkit/chainlink-ccip/pluginconfig"
+)
+
+func Test_FeePerGasPriority(t *testing.T) {
+	candidate := func(fee int64, gas uint64) PriorityCandidate {
+		return PriorityCandidate{
+			Message: cciptypes.Message{FeeValueJuels: cciptypes.NewBigIntFromInt64(fee)},
+			Gas:     gas,
+		}
+	}
+	policy := FeePerGasPriority()
+
+	require.Positive(t, policy(candidate(100, 10), candidate(50, 2)))
+	require.Negative(t, policy(candidate(50, 2), candidate(100, 10)))
+	require.Zero(t, policy(candidate(20, 2), candidate(10, 1)))
+	require.Positive(t, policy(PriorityCandidate{Gas: 1}, candidate(1, 1)), "a missing fee is the lowest fee")
+}
+
+func Test_CombinePriorities(t *testing.T) {
+	prioritized := cciptypes.UnknownAddress{0x1}
+	policy := CombinePriorities(
+		AgePriority(time.Hour),
+		SenderPriority(map[cciptypes.ChainSelector][]cciptypes.UnknownAddress{1: {prioritized}}),
+	)
+
+	old := PriorityCandidate{Age: 2 * time.Hour, Message: makeMessageWithSender(1, 1, 0, cciptypes.UnknownAddress{0x2})}
+	fresh := PriorityCandidate{Message: makeMessageWithSender(1, 2, 0, prioritized)}
+	other := PriorityCandidate{Message: makeMessageWithSender(1, 3, 0, cciptypes.UnknownAddress{0x2})}
+
+	require.Negative(t, policy(old, fresh), "age boosting is applied first")
+	require.Negative(t, policy(fresh, other))
+	require.Zero(t, policy(other, other))
+
+	otherChain := PriorityCandidate{Message: makeMessageWithSender(2, 2, 0, prioritized)}
+	require.Zero(t, policy(otherChain, other), "senders are prioritized per source chain")
+}
+
+func Test_NewPriorityPolicy(t *testing.T) {
+	addrCodec := internal.NewMockAddressCodecHex(t)
+
+	policy, err := NewPriorityPolicy(pluginconfig.MessagePriorityConfig{}, addrCodec)
+	require.NoError(t, err)
+	require.Nil(t, policy)
+
+	_, err = NewPriorityPolicy(pluginconfig.MessagePriorityConfig{
+		PrioritySenders: map[cciptypes.ChainSelector][]string{1: {"not hex"}},
+	}, addrCodec)
+	require.ErrorContains(t, err, "invalid priority sender")
+
+	policy, err = NewPriorityPolicy(pluginconfig.MessagePriorityConfig{
+		AgeBoostAfter:   *commonconfig.MustNewDuration(time.Minute),
+		PrioritySenders: map[cciptypes.ChainSelector][]string{1: {"0x01"}},
+	}, addrCodec)
+	require.NoError(t, err)
+	require.Negative(t, policy(
+		PriorityCandidate{Message: makeMessageWithSender(1, 1, 0, cciptypes.UnknownAddress{0x1})},
+		PriorityCandidate{Message: makeMessageWithSender(1, 2, 0, cciptypes.UnknownAddress{0x2})},
+	))
+}
+
+func Test_keepNonceOrder(t *testing.T) {
+	x, y := cciptypes.UnknownAddress{0x1}, cciptypes.UnknownAddress{0x2}
+	report := exectypes.CommitData{
+		Messages: []cciptypes.Message{
+			makeMessageWithSender(1, 1, 1, x),
+			makeMessageWithSender(1, 2, 0, y),
+			makeMessageWithSender(1, 3, 0, x),
+			makeMessageWithSender(1, 4, 2, x),
+		},
+	}
+
+	// x's out of order message (index 2) keeps its position, the sequenced ones are swapped.
+	require.Equal(t, []int{0, 2, 1, 3}, keepNonceOrder(report, []int{3, 2, 1, 0}))
+	require.Equal(t, []int{1, 0, 2, 3}, keepNonceOrder(report, []int{1, 0, 2, 3}))
+}
+
+func newPriorityTestBuilder(t *testing.T, policy PriorityPolicy, options ...Option) *execReportBuilder {
+	ep := gasmock.NewMockEstimateProvider(t)
+	ep.EXPECT().CalculateMessageMaxGas(mock.Anything).Return(uint64(1)).Maybe()
+	ep.EXPECT().CalculateMerkleTreeGas(mock.Anything).Return(uint64(0)).Maybe()
+
+	options = append(options,
+		WithMaxReportSizeBytes(100000),
+		WithMaxGas(10000000),
+		WithPriorityPolicy(policy),
+	)
+	return newBuilderInternal(
+		logger.Test(t),
+		mocks.NewMessageHasher(),
+		mocks.NewExecutePluginJSONReportCodec(),
+		ep,
+		1,
+		internal.NewMockAddressCodecHex(t),
+		options...,
+	)
+}
+
+func Test_Builder_Prioritize(t *testing.T) {
+	hasher := mocks.NewMessageHasher()
+	prioritized := cciptypes.UnknownAddress{0x1}
+	other := cciptypes.UnknownAddress{0x2}
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	older := makeTestCommitReport(hasher, 2, 1, 100, 999, start.UnixMilli(), other, cciptypes.Bytes32{}, nil, true)
+	newer := makeTestCommitReport(
+		hasher, 2, 2, 100, 999, start.Add(time.Hour).UnixMilli(), prioritized, cciptypes.Bytes32{}, nil, true)
+	executed := makeTestCommitReport(hasher, 1, 3, 100, 999, start.UnixMilli(), prioritized, cciptypes.Bytes32{},
+		[]cciptypes.SeqNum{100}, true)
+	reports := []exectypes.CommitData{executed, older, newer}
+	senderPolicy := SenderPriority(map[cciptypes.ChainSelector][]cciptypes.UnknownAddress{2: {prioritized}})
+
+	builder := newPriorityTestBuilder(t, nil)
+	require.Equal(t, reports, builder.Prioritize(reports), "no policy keeps the order")
+
+	builder = newPriorityTestBuilder(t, senderPolicy)
+	require.Equal(t, []exectypes.CommitData{newer, older, executed}, builder.Prioritize(reports))
+
+	builder = newPriorityTestBuilder(t, CombinePriorities(AgePriority(30*time.Minute), senderPolicy))
+	require.Equal(t, []exectypes.CommitData{older, newer, executed}, builder.Prioritize(reports))
+}
+
+func Test_Builder_Prioritize_KeepsSeqNumOrderWithinSource(t *testing.T) {
+	hasher := mocks.NewMessageHasher()
+	sender := cciptypes.UnknownAddress{0x1}
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// withFeesAndNonces sets the fee and the nonce of the messages and recomputes the merkle root.
+	withFeesAndNonces := func(report exectypes.CommitData, fee int64, firstNonce uint64) exectypes.CommitData {
+		report.Hashes = nil
+		for i := range report.Messages {
+			report.Messages[i].FeeValueJuels = cciptypes.NewBigIntFromInt64(fee)
+			report.Messages[i].Header.Nonce = firstNonce + uint64(i)
+			hash, err := hasher.Hash(context.Background(), report.Messages[i])
+			require.NoError(t, err)
+			report.Hashes = append(report.Hashes, hash)
+		}
+		tree, err := ConstructMerkleTree(report, logger.Test(t))
+		require.NoError(t, err)
+		report.MerkleRoot = tree.Root()
+		return report
+	}
+
+	// two reports of the same source chain, carrying consecutive nonces of the sender. The later one pays more.
+	first := withFeesAndNonces(makeTestCommitReport(
+		hasher, 2, 1, 100, 999, start.UnixMilli(), sender, cciptypes.Bytes32{}, nil, false), 10, 1)
+	second := withFeesAndNonces(makeTestCommitReport(
+		hasher, 2, 1, 102, 999, start.UnixMilli(), sender, cciptypes.Bytes32{}, nil, false), 100, 3)
+	// a report of another source chain, paying the most.
+	otherSource := withFeesAndNonces(makeTestCommitReport(
+		hasher, 1, 2, 100, 999, start.UnixMilli(), sender, cciptypes.Bytes32{}, nil, false), 1000, 1)
+
+	builder := newPriorityTestBuilder(t, FeePerGasPriority())
+	prioritized := builder.Prioritize([]exectypes.CommitData{first, second, otherSource})
+	// reports are reordered across source chains, but the source chain keeps its reports in seqnum order.
+	require.Equal(t, []exectypes.CommitData{otherSource, first, second}, prioritized)
+
+	for _, report := range prioritized {
+		_, err := builder.Add(context.Background(), report)
+		require.NoError(t, err)
+	}
+	execReports, _, err := builder.Build()
+	require.NoError(t, err)
+
+	var nonces []uint64
+	for _, report := range execReports {
+		for _, msg := range report.Messages {
+			if msg.Header.SourceChainSelector == 1 {
+				nonces = append(nonces, msg.Header.Nonce)
+			}
+		}
+	}
+	require.Equal(t, []uint64{1, 2, 3, 4}, nonces, "the sender's messages are executed in nonce order")
+}
+
+func Test_Builder_PriorityPolicy(t *testing.T) {
+	hasher := mocks.NewMessageHasher()
+	senders := []cciptypes.UnknownAddress{{0x1}, {0x2}, {0x3}, {0x4}, {0x5}}
+	commitReport := makeTestCommitReportWithSenders(
+		hasher, 5, 1, 100, 999, 10101010101, senders, cciptypes.Bytes32{}, nil)
+	for i := range commitReport.Messages {
+		commitReport.Messages[i].Header.Nonce = 0
+	}
+	commitReport.Hashes = nil
+	for _, msg := range commitReport.Messages {
+		hash, err := hasher.Hash(context.Background(), msg)
+		require.NoError(t, err)
+		commitReport.Hashes = append(commitReport.Hashes, hash)
+	}
+	tree, err := ConstructMerkleTree(commitReport, logger.Test(t))
+	require.NoError(t, err)
+	commitReport.MerkleRoot = tree.Root()
+
+	builder := newPriorityTestBuilder(t,
+		SenderPriority(map[cciptypes.ChainSelector][]cciptypes.UnknownAddress{1: {senders[3]}}),
+		WithMaxMessages(2),
+	)
+	updated, err := builder.Add(context.Background(), commitReport)
+	require.NoError(t, err)
+	require.Equal(t, []cciptypes.SeqNum{100, 103}, updated.ExecutedMessages)
+
+	execReports, _, err := builder.Build()
+	require.NoError(t, err)
+	require.Len(t, execReports, 1)
+	require.Len(t, execReports[0].Messages, 2)
+	require.Equal(t, senders[0], execReports[0].Messages[0].Sender)
+	require.Equal(t, senders[3], execReports[0].Messages[1].Sender)
+}
@@ -134,6 +134,10 @@ const (
 	MissingNoncesForChain         messageStatus = "missing_nonces_for_chain"
 	MissingNonce                  messageStatus = "missing_nonce"
 	InvalidNonce                  messageStatus = "invalid_nonce"
+	Blocked                       messageStatus = "blocked"
+	DataSizeExceeded              messageStatus = "data_size_exceeded"
+	InsufficientGasLimit          messageStatus = "insufficient_gas_limit"
+	SimulationFailed              messageStatus = "simulation_failed"
 	/*
 		SenderAlreadySkipped                 messageStatus = "sender_already_skipped"
 		MessageMaxGasCalcError               messageStatus = "message_max_gas_calc_error"
@@ -300,6 +304,24 @@ func CheckNonces(sendersNonce map[ccipocr3.ChainSelector]map[string]uint64, addr
 	}
 }
 
+// CheckSimulationFailures skips the messages which the oracles agreed would fail on the destination chain when their
+// report was simulated. Skipped messages are not marked inflight, they are simulated again in the following rounds.
+func CheckSimulationFailures(failures exectypes.SimulationFailureObservations) Check {
+	return func(lggr logger.Logger, msg ccipocr3.Message, idx int, report exectypes.CommitData) (messageStatus, error) {
+		if messageID, ok := failures[report.SourceChain][msg.Header.SequenceNumber]; ok &&
+			messageID == msg.Header.MessageID {
+			lggr.Warnw(
+				"Skipping message - simulation failed",
+				"messageID", msg.Header.MessageID,
+				"sourceChain", report.SourceChain,
+				"seqNum", msg.Header.SequenceNumber,
+				"messageState", SimulationFailed)
+			return SimulationFailed, nil
+		}
+		return None, nil
+	}
+}
+
 type IsInflight func(src ccipocr3.ChainSelector, msgID ccipocr3.Bytes32) bool
 
 func CheckIfInflight(inflight IsInflight) Check {
@@ -550,8 +572,11 @@ func (b *execReportBuilder) buildSingleChainReport(
 	var finalReport ccipocr3.ExecutePluginReportSingleChain
 	var meta validationMetadata
 	msgs := make(map[int]struct{})
-	for i := range commitData.Messages {
-		if _, ok := readyMessages[i]; !ok {
+	// senders with a sequenced message which did not fit, their later nonces cannot be executed either.
+	skippedSenders := make(map[string]struct{})
+	for _, i := range b.selectionOrder(commitData, readyMessages) {
+		msg := commitData.Messages[i]
+		if _, ok := skippedSenders[string(msg.Sender)]; ok && msg.Header.Nonce != 0 {
 			continue
 		}
 
@@ -600,6 +625,9 @@ func (b *execReportBuilder) buildSingleChainReport(
 				"seqNum", commitData.Messages[i].Header.SequenceNumber,
 			)
 			delete(msgs, i)
+			if msg.Header.Nonce != 0 {
+				skippedSenders[string(msg.Sender)] = struct{}{}
+			}
 		}
 	}
 
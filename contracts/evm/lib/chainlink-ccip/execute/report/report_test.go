@@ -1399,6 +1399,37 @@ func Test_execReportBuilder_checkMessage(t *testing.T) {
 	}
 }
 
+func Test_CheckSimulationFailures(t *testing.T) {
+	lggr := logger.Test(t)
+	report := exectypes.CommitData{SourceChain: 1}
+	msg := func(seqNum cciptypes.SeqNum, id byte) cciptypes.Message {
+		return cciptypes.Message{Header: cciptypes.RampMessageHeader{
+			SourceChainSelector: 1,
+			SequenceNumber:      seqNum,
+			MessageID:           cciptypes.Bytes32{id},
+		}}
+	}
+	check := CheckSimulationFailures(exectypes.SimulationFailureObservations{
+		1: {100: cciptypes.Bytes32{0x1}},
+	})
+
+	status, err := check(lggr, msg(100, 0x1), 0, report)
+	require.NoError(t, err)
+	require.Equal(t, SimulationFailed, status)
+
+	status, err = check(lggr, msg(101, 0x2), 1, report)
+	require.NoError(t, err)
+	require.Equal(t, None, status)
+
+	status, err = check(lggr, msg(100, 0x3), 0, report)
+	require.NoError(t, err)
+	require.Equal(t, None, status, "the message ID must match the failed message")
+
+	status, err = check(lggr, msg(100, 0x1), 0, exectypes.CommitData{SourceChain: 2})
+	require.NoError(t, err)
+	require.Equal(t, None, status, "failures are per source chain")
+}
+
 func Test_checkSkippedNonces(t *testing.T) {
 	sourceChain1 := cciptypes.ChainSelector(1)
 	sender1 := cciptypes.UnknownAddress(testhelpersrand.RandomBytes(32))
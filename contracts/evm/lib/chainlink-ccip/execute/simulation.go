@@ -0,0 +1,66 @@
+package execute
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink-ccip/execute/exectypes"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+)
+
+// observeSimulationFailures builds the report this oracle expects for the Filter outcome, from the commit reports of
+// the previous outcome and its own nonce observations, and simulates it on the destination chain. The messages which
+// would fail are returned, the outcome excludes the ones at least F+1 oracles observed so a single reverting receiver
+// does not sink the whole report. Nothing is observed when simulation is disabled or fails.
+//
+// Excluded messages are not marked inflight, they are simulated again in the following rounds and executed once they
+// no longer fail. Until then they can be manually executed after the permissionless execution threshold.
+func (p *Plugin) observeSimulationFailures(
+	ctx context.Context,
+	lggr logger.Logger,
+	previousOutcome exectypes.Outcome,
+	nonces exectypes.NonceObservations,
+) exectypes.SimulationFailureObservations {
+	if p.reportSimulator == nil || !p.offchainCfg.SimulateReports {
+		return nil
+	}
+
+	// the builder attaches metadata to the commit reports, build from a copy.
+	execReport, _, err := p.buildReport(ctx, lggr, slices.Clone(previousOutcome.CommitReports), nonces, nil)
+	if err != nil {
+		lggr.Warnw("unable to build the report to simulate", "err", err)
+		return nil
+	}
+	if len(execReport.ChainReports) == 0 {
+		return nil
+	}
+
+	start := time.Now()
+	failures, err := p.reportSimulator.SimulateExecute(ctx, execReport)
+	p.observer.TrackReportSimulation(failures, time.Since(start), err)
+	if err != nil {
+		lggr.Warnw("unable to simulate report, not observing simulation failures", "err", err)
+		return nil
+	}
+	if len(failures) == 0 {
+		lggr.Debugw("report simulation succeeded")
+		return nil
+	}
+
+	observed := make(exectypes.SimulationFailureObservations)
+	for _, failure := range failures {
+		lggr.Warnw("message would fail on the destination chain, observing it as a simulation failure",
+			"sourceChain", failure.SourceChainSelector,
+			"seqNum", failure.SequenceNumber,
+			"messageID", failure.MessageID,
+			"reason", failure.Reason)
+		if _, ok := observed[failure.SourceChainSelector]; !ok {
+			observed[failure.SourceChainSelector] = make(map[cciptypes.SeqNum]cciptypes.Bytes32)
+		}
+		observed[failure.SourceChainSelector][failure.SequenceNumber] = failure.MessageID
+	}
+	return observed
+}
@@ -0,0 +1,201 @@
+package execute
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink-ccip/execute/exectypes"
+	"github.com/smartcontractkit/chainlink-ccip/execute/internal/cache"
+	"github.com/smartcontractkit/chainlink-ccip/execute/metrics"
+	"github.com/smartcontractkit/chainlink-ccip/execute/report"
+	"github.com/smartcontractkit/chainlink-ccip/internal"
+	"github.com/smartcontractkit/chainlink-ccip/internal/mocks"
+	codec_mocks "github.com/smartcontractkit/chainlink-ccip/mocks/pkg/types/ccipocr3"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+	"github.com/smartcontractkit/chainlink-ccip/pluginconfig"
+)
+
+func makeSimulationCommitReport(
+	t *testing.T, srcChain cciptypes.ChainSelector, numMessages int,
+) exectypes.CommitData {
+	hasher := mocks.NewMessageHasher()
+
+	commitReport := exectypes.CommitData{
+		SourceChain:         srcChain,
+		SequenceNumberRange: cciptypes.NewSeqNumRange(1, cciptypes.SeqNum(numMessages)),
+		MessageTokenData:    make([]exectypes.MessageTokenData, numMessages),
+	}
+	for i := 1; i <= numMessages; i++ {
+		msg := cciptypes.Message{Header: cciptypes.RampMessageHeader{
+			MessageID:           cciptypes.Bytes32{byte(i)},
+			SourceChainSelector: srcChain,
+			SequenceNumber:      cciptypes.SeqNum(i),
+		}}
+		hash, err := hasher.Hash(context.Background(), msg)
+		require.NoError(t, err)
+		commitReport.Messages = append(commitReport.Messages, msg)
+		commitReport.Hashes = append(commitReport.Hashes, hash)
+	}
+	tree, err := report.ConstructMerkleTree(commitReport, logger.Test(t))
+	require.NoError(t, err)
+	commitReport.MerkleRoot = tree.Root()
+	return commitReport
+}
+
+func newSimulationPlugin(t *testing.T, simulator cciptypes.ExecuteReportSimulator, enabled bool) *Plugin {
+	ep := codec_mocks.NewMockEstimateProvider(t)
+	ep.EXPECT().CalculateMessageMaxGas(mock.Anything).Return(uint64(0)).Maybe()
+	ep.EXPECT().CalculateMerkleTreeGas(mock.Anything).Return(uint64(0)).Maybe()
+
+	return &Plugin{
+		offchainCfg: pluginconfig.ExecuteOffchainConfig{
+			SimulateReports: enabled,
+			BatchGasLimit:   1_000_000,
+		},
+		destChain:            100,
+		reportSimulator:      simulator,
+		msgHasher:            mocks.NewMessageHasher(),
+		reportCodec:          mocks.NewExecutePluginJSONReportCodec(),
+		estimateProvider:     ep,
+		addrCodec:            internal.NewMockAddressCodecHex(t),
+		inflightMessageCache: cache.NewInflightMessageCache(10 * time.Minute),
+		observer:             &metrics.Noop{},
+		lggr:                 logger.Test(t),
+	}
+}
+
+func reportSeqNums(execReport cciptypes.ExecutePluginReport) []cciptypes.SeqNum {
+	var seqNums []cciptypes.SeqNum
+	for _, chainReport := range execReport.ChainReports {
+		for _, msg := range chainReport.Messages {
+			seqNums = append(seqNums, msg.Header.SequenceNumber)
+		}
+	}
+	return seqNums
+}
+
+func TestPlugin_observeSimulationFailures(t *testing.T) {
+	const srcChain = cciptypes.ChainSelector(1)
+	failure := func(seqNum cciptypes.SeqNum) cciptypes.MessageSimulationFailure {
+		return cciptypes.MessageSimulationFailure{
+			SourceChainSelector: srcChain,
+			SequenceNumber:      seqNum,
+			MessageID:           cciptypes.Bytes32{byte(seqNum)},
+			Reason:              "WrapperDoesNotExist()",
+		}
+	}
+
+	testCases := []struct {
+		name             string
+		disabled         bool
+		failures         []cciptypes.MessageSimulationFailure
+		err              error
+		expectedFailures exectypes.SimulationFailureObservations
+	}{
+		{
+			name:     "simulation disabled",
+			disabled: true,
+		},
+		{
+			name: "all messages succeed",
+		},
+		{
+			name: "simulation error observes nothing",
+			err:  errors.New("rpc unavailable"),
+		},
+		{
+			name:     "failed messages are observed",
+			failures: []cciptypes.MessageSimulationFailure{failure(1), failure(3)},
+			expectedFailures: exectypes.SimulationFailureObservations{
+				srcChain: {1: cciptypes.Bytes32{1}, 3: cciptypes.Bytes32{3}},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			previousOutcome := exectypes.NewOutcome(
+				exectypes.GetMessages,
+				[]exectypes.CommitData{makeSimulationCommitReport(t, srcChain, 3)},
+				cciptypes.ExecutePluginReport{},
+			)
+
+			simulator := codec_mocks.NewMockExecuteReportSimulator(t)
+			if !tc.disabled {
+				simulator.EXPECT().SimulateExecute(mock.Anything, mock.Anything).
+					RunAndReturn(func(
+						_ context.Context, execReport cciptypes.ExecutePluginReport,
+					) ([]cciptypes.MessageSimulationFailure, error) {
+						// the whole report is simulated, nothing is excluded yet.
+						require.Equal(t, []cciptypes.SeqNum{1, 2, 3}, reportSeqNums(execReport))
+						return tc.failures, tc.err
+					})
+			}
+			p := newSimulationPlugin(t, simulator, !tc.disabled)
+
+			failures := p.observeSimulationFailures(tests.Context(t), p.lggr, previousOutcome, nil)
+			require.Equal(t, tc.expectedFailures, failures)
+			// the previous outcome must not be modified while building the simulated report.
+			require.Empty(t, previousOutcome.CommitReports[0].ExecutedMessages)
+		})
+	}
+}
+
+func TestPlugin_getFilterOutcome_SimulationFailures(t *testing.T) {
+	const srcChain = cciptypes.ChainSelector(1)
+
+	testCases := []struct {
+		name             string
+		failures         exectypes.SimulationFailureObservations
+		expectedMessages []cciptypes.SeqNum
+	}{
+		{
+			name:             "no simulation failures",
+			expectedMessages: []cciptypes.SeqNum{1, 2, 3},
+		},
+		{
+			name:             "failed message is excluded",
+			failures:         exectypes.SimulationFailureObservations{srcChain: {2: cciptypes.Bytes32{2}}},
+			expectedMessages: []cciptypes.SeqNum{1, 3},
+		},
+		{
+			name:             "failure with a different message id is ignored",
+			failures:         exectypes.SimulationFailureObservations{srcChain: {2: cciptypes.Bytes32{9}}},
+			expectedMessages: []cciptypes.SeqNum{1, 2, 3},
+		},
+		{
+			name: "all messages fail",
+			failures: exectypes.SimulationFailureObservations{
+				srcChain: {1: cciptypes.Bytes32{1}, 2: cciptypes.Bytes32{2}, 3: cciptypes.Bytes32{3}},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newSimulationPlugin(t, nil, false)
+			previousOutcome := exectypes.NewOutcome(
+				exectypes.GetMessages,
+				[]exectypes.CommitData{makeSimulationCommitReport(t, srcChain, 3)},
+				cciptypes.ExecutePluginReport{},
+			)
+
+			outcome, err := p.getFilterOutcome(
+				tests.Context(t),
+				p.lggr,
+				exectypes.Observation{SimulationFailures: tc.failures},
+				previousOutcome,
+			)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedMessages, reportSeqNums(outcome.Report))
+		})
+	}
+}
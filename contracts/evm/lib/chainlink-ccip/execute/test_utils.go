@@ -270,10 +270,12 @@ func (it *IntTest) Start() *testhelpers.OCR3Runner[[]byte] {
 		ctx,
 		it.lggr,
 		it.dstSelector,
+		cciptypes.Bytes32{},
 		it.tokenObserverConfig,
 		testhelpers.TokenDataEncoderInstance,
 		it.tokenChainReader,
 		mockAddrCodec,
+		observer.NodeConfig{},
 	)
 	require.NoError(it.t, err)
 
@@ -343,6 +345,8 @@ func (it *IntTest) newNode(
 		homeChain,
 		tokenDataObserver,
 		ep,
+		nil,
+		nil,
 		it.lggr,
 		&metrics.Noop{},
 		mockCodec,
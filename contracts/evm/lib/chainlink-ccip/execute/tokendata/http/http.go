@@ -8,7 +8,9 @@ import (
 	"net/http"
 	"net/url"
 	"path"
+	"sort"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -60,6 +62,18 @@ type httpClient struct {
 	// coolDownUntil defines whether requests are blocked or not.
 	coolDownUntil time.Time
 	coolDownMu    *sync.RWMutex
+	// headers are added to every request sent to the API.
+	headers http.Header
+}
+
+// ClientOption configures optional behavior of the httpClient.
+type ClientOption func(*httpClient)
+
+// WithHeader adds the header to every request sent by the httpClient, e.g. for authenticating to the API.
+func WithHeader(key, value string) ClientOption {
+	return func(h *httpClient) {
+		h.headers.Add(key, value)
+	}
 }
 
 var (
@@ -67,9 +81,11 @@ var (
 	mutex           sync.Mutex
 )
 
-// GetHTTPClient returns a singleton instance of the httpClient for the given API URL.
+// GetHTTPClient returns a singleton instance of the httpClient for the given API URL and headers.
 // It's critical to reuse existing clients because of the self-rate limiting mechanism. Being rate limited by
 // Circle comes with a long cool down period, so we should always self-rate limit before hitting the API rate limit.
+// Clients sending different headers to the same API URL (e.g. authenticating with different API keys) are
+// rate limited separately.
 // IMPORTANT: In the loop world this might require major rework - e.g. making httpClient a loop plugin to
 // enforce the singleton pattern.
 func GetHTTPClient(
@@ -78,49 +94,80 @@ func GetHTTPClient(
 	apiInterval time.Duration,
 	apiTimeout time.Duration,
 	coolDownDuration time.Duration,
+	opts ...ClientOption,
 ) (HTTPClient, error) {
 	mutex.Lock()
 	defer mutex.Unlock()
 
-	if client, exists := clientInstances[api]; exists {
-		return client, nil
-	}
-
-	client, err := newHTTPClient(lggr, api, apiInterval, apiTimeout, coolDownDuration)
+	client, err := newHTTPClient(lggr, api, apiInterval, apiTimeout, coolDownDuration, opts...)
 	if err != nil {
 		return nil, err
 	}
 
-	clientInstances[api] = client
+	key := clientKey(api, client.headers)
+	if existing, exists := clientInstances[key]; exists {
+		return existing, nil
+	}
+
+	clientInstances[key] = client
 	return client, nil
 }
 
+// clientKey identifies the client by the API URL and the headers it sends with every request.
+func clientKey(api string, headers http.Header) string {
+	keys := make([]string, 0, len(headers))
+	for key := range headers {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+	b.WriteString(api)
+	for _, key := range keys {
+		for _, value := range headers[key] {
+			b.WriteString("\n")
+			b.WriteString(key)
+			b.WriteString(": ")
+			b.WriteString(value)
+		}
+	}
+	return b.String()
+}
+
 func newHTTPClient(
 	lggr logger.Logger,
 	api string,
 	apiInterval time.Duration,
 	apiTimeout time.Duration,
 	coolDownDuration time.Duration,
-) (HTTPClient, error) {
+	opts ...ClientOption,
+) (*httpClient, error) {
 	u, err := url.ParseRequestURI(api)
 	if err != nil {
 		return nil, err
 	}
-	return &httpClient{
+	client := &httpClient{
 		lggr:             lggr,
 		apiURL:           u,
 		apiTimeout:       apiTimeout,
 		coolDownDuration: coolDownDuration,
 		rate:             rate.NewLimiter(rate.Every(apiInterval), 1),
 		coolDownMu:       &sync.RWMutex{},
-	}, nil
+		headers:          make(http.Header),
+	}
+	for _, opt := range opts {
+		opt(client)
+	}
+	return client, nil
 }
 
 func (h *httpClient) Get(ctx context.Context, requestPath string) (cciptypes.Bytes, HTTPStatus, error) {
 	lggr := logutil.WithContextValues(ctx, h.lggr)
 
-	requestURL := *h.apiURL
-	requestURL.Path = path.Join(requestURL.Path, requestPath)
+	requestURL, err := h.requestURL(requestPath)
+	if err != nil {
+		return nil, http.StatusBadRequest, err
+	}
 
 	response, httpStatus, err := h.callAPI(ctx, lggr, http.MethodGet, requestURL, nil)
 	lggr.Debugw(
@@ -139,10 +186,12 @@ func (h *httpClient) Post(
 ) (cciptypes.Bytes, HTTPStatus, error) {
 	lggr := logutil.WithContextValues(ctx, h.lggr)
 
-	requestURL := *h.apiURL
-	requestURL.Path = path.Join(requestURL.Path, requestPath)
+	requestURL, err := h.requestURL(requestPath)
+	if err != nil {
+		return nil, http.StatusBadRequest, err
+	}
 
-	response, httpStatus, err := h.callAPI(ctx, lggr, http.MethodPost, *h.apiURL, bytes.NewBuffer(requestData))
+	response, httpStatus, err := h.callAPI(ctx, lggr, http.MethodPost, requestURL, bytes.NewBuffer(requestData))
 	h.lggr.Debugw(
 		"Response from attestation API",
 		"requestURL", requestURL.String(),
@@ -153,6 +202,24 @@ func (h *httpClient) Post(
 	return response, httpStatus, err
 }
 
+// requestURL appends the path of the request to the path of the API URL. The query string of the request,
+// if any, is added to the query string of the API URL.
+func (h *httpClient) requestURL(requestPath string) (url.URL, error) {
+	ref, err := url.Parse(requestPath)
+	if err != nil {
+		return url.URL{}, err
+	}
+	requestURL := *h.apiURL
+	requestURL.Path = path.Join(requestURL.Path, ref.Path)
+	if ref.RawQuery != "" {
+		if requestURL.RawQuery != "" {
+			requestURL.RawQuery += "&"
+		}
+		requestURL.RawQuery += ref.RawQuery
+	}
+	return requestURL, nil
+}
+
 func (h *httpClient) callAPI(
 	ctx context.Context,
 	lggr logger.Logger,
@@ -188,6 +255,11 @@ func (h *httpClient) callAPI(
 		return nil, http.StatusBadRequest, err
 	}
 	req.Header.Add("accept", "application/json")
+	for key, values := range h.headers {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
 	res, err := http.DefaultClient.Do(req)
 	if err != nil {
 		if ctx.Err() != nil && errors.Is(ctx.Err(), context.DeadlineExceeded) {
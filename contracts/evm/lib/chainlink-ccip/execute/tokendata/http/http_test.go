@@ -172,6 +172,56 @@ func Test_HTTPClient_Get(t *testing.T) {
 	}
 }
 
+func Test_HTTPClient_RequestURL(t *testing.T) {
+	tt := []struct {
+		name        string
+		api         string
+		requestPath string
+		expected    string
+	}{
+		{
+			name:        "path",
+			api:         "https://api.example.com/v1",
+			requestPath: "/attestations/0x01",
+			expected:    "https://api.example.com/v1/attestations/0x01",
+		},
+		{
+			name:        "empty path",
+			api:         "https://api.example.com/v1",
+			requestPath: "",
+			expected:    "https://api.example.com/v1",
+		},
+		{
+			name:        "path with query string",
+			api:         "https://api.example.com/v1",
+			requestPath: "/attestations?id=0x01&chain=1",
+			expected:    "https://api.example.com/v1/attestations?id=0x01&chain=1",
+		},
+		{
+			name:        "query string only",
+			api:         "https://api.example.com/v1/attestations",
+			requestPath: "?id=0x01",
+			expected:    "https://api.example.com/v1/attestations?id=0x01",
+		},
+		{
+			name:        "query string added to the API's one",
+			api:         "https://api.example.com/v1?network=mainnet",
+			requestPath: "/attestations?id=0x01",
+			expected:    "https://api.example.com/v1/attestations?network=mainnet&id=0x01",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			client, err := newHTTPClient(logger.Test(t), tc.api, time.Second, time.Second, 0)
+			require.NoError(t, err)
+			requestURL, err := client.requestURL(tc.requestPath)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, requestURL.String())
+		})
+	}
+}
+
 func Test_HTTPClient_Cooldown(t *testing.T) {
 	var requestCount int
 	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -234,6 +284,42 @@ func Test_HTTPClient_GetInstance(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func Test_HTTPClient_GetInstanceWithHeaders(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-API-Key") != "key1" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, err := w.Write(validAttestationResponse)
+		require.NoError(t, err)
+	}))
+	defer ts.Close()
+
+	noHeader, err := GetHTTPClient(logger.Test(t), ts.URL, time.Millisecond, longTimeout, 0)
+	require.NoError(t, err)
+
+	key1, err := GetHTTPClient(logger.Test(t), ts.URL, time.Millisecond, longTimeout, 0, WithHeader("X-API-Key", "key1"))
+	require.NoError(t, err)
+
+	key1Again, err := GetHTTPClient(logger.Test(t), ts.URL, time.Millisecond, longTimeout, 0, WithHeader("X-API-Key", "key1"))
+	require.NoError(t, err)
+
+	key2, err := GetHTTPClient(logger.Test(t), ts.URL, time.Millisecond, longTimeout, 0, WithHeader("X-API-Key", "key2"))
+	require.NoError(t, err)
+
+	assert.True(t, key1 == key1Again)
+	assert.False(t, key1 == noHeader)
+	assert.False(t, key1 == key2)
+
+	// Headers of the cached client are sent, they are not ignored because of the client without headers
+	_, status, err := key1.Get(tests.Context(t), cciptypes.Bytes32{1, 2, 3}.String())
+	require.NoError(t, err)
+	require.Equal(t, HTTPStatus(http.StatusOK), status)
+
+	_, status, _ = key2.Get(tests.Context(t), cciptypes.Bytes32{1, 2, 3}.String())
+	require.Equal(t, HTTPStatus(http.StatusUnauthorized), status)
+}
+
 func Test_HTTPClient_CoolDownWithRetryHeader(t *testing.T) {
 	var requestCount int
 	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
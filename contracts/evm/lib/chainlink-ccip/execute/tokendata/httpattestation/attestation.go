@@ -0,0 +1,198 @@
+package httpattestation
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink-ccip/execute/tokendata"
+	"github.com/smartcontractkit/chainlink-ccip/execute/tokendata/http"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/logutil"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/reader"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+	"github.com/smartcontractkit/chainlink-ccip/pluginconfig"
+)
+
+const (
+	placeholderExtraData           = "{extraData}"
+	placeholderSourceChainSelector = "{sourceChainSelector}"
+	placeholderSeqNum              = "{seqNum}"
+	placeholderTokenIndex          = "{tokenIndex}"
+)
+
+// HTTPAttestationClient is a generic client for fetching attestations from a custom attestation service.
+// Every token is requested separately, either with a GET request to the path (and query string) built from
+// the config's URLTemplate or with a POST request carrying the token's ExtraData under the config's RequestJSONPath.
+// The attestation is read from the response under the config's ResponseJSONPath.
+// Rate limiting and cool down are handled by the underlying http.HTTPClient.
+type HTTPAttestationClient struct {
+	lggr   logger.Logger
+	config pluginconfig.HTTPAttestationObserverConfig
+	client http.HTTPClient
+}
+
+// NewHTTPAttestationClient creates the client for the attestation service. authHeaderValue is the value of
+// the config's AuthHeaderName header, resolved from the node's secrets by the config's AuthCredentialsName.
+func NewHTTPAttestationClient(
+	lggr logger.Logger,
+	config pluginconfig.HTTPAttestationObserverConfig,
+	authHeaderValue string,
+) (tokendata.AttestationClient, error) {
+	var opts []http.ClientOption
+	if config.AuthHeaderName != "" {
+		opts = append(opts, http.WithHeader(config.AuthHeaderName, authHeaderValue))
+	}
+	client, err := http.GetHTTPClient(
+		lggr,
+		config.AttestationAPI,
+		config.AttestationAPIInterval.Duration(),
+		config.AttestationAPITimeout.Duration(),
+		config.AttestationAPICooldown.Duration(),
+		opts...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("create HTTP client: %w", err)
+	}
+	return tokendata.NewObservedAttestationClient(
+		lggr, InitHTTPAttestationClient(lggr, config, client),
+	), nil
+}
+
+// InitHTTPAttestationClient creates the HTTPAttestationClient on top of the already initialized http.HTTPClient.
+func InitHTTPAttestationClient(
+	lggr logger.Logger,
+	config pluginconfig.HTTPAttestationObserverConfig,
+	client http.HTTPClient,
+) *HTTPAttestationClient {
+	return &HTTPAttestationClient{
+		lggr:   lggr,
+		config: config,
+		client: client,
+	}
+}
+
+// Attestations is an AttestationClient method that accepts dict of messages and returns attestations under same keys.
+// As values in input it accepts ExtraData bytes from incoming TokenData
+func (c *HTTPAttestationClient) Attestations(
+	ctx context.Context,
+	messagesByChain map[cciptypes.ChainSelector]map[reader.MessageTokenID]cciptypes.Bytes,
+) (map[cciptypes.ChainSelector]map[reader.MessageTokenID]tokendata.AttestationStatus, error) {
+	lggr := logutil.WithContextValues(ctx, c.lggr)
+	outcome := make(map[cciptypes.ChainSelector]map[reader.MessageTokenID]tokendata.AttestationStatus)
+
+	for chainSelector, messagesByTokenID := range messagesByChain {
+		outcome[chainSelector] = make(map[reader.MessageTokenID]tokendata.AttestationStatus)
+
+		for tokenID, extraData := range messagesByTokenID {
+			lggr.Debugw(
+				"Fetching attestation from the API",
+				"chainSelector", chainSelector,
+				"extraData", extraData,
+				"messageTokenID", tokenID,
+			)
+			outcome[chainSelector][tokenID] = c.fetchSingleToken(ctx, chainSelector, tokenID, extraData)
+		}
+	}
+	return outcome, nil
+}
+
+func (c *HTTPAttestationClient) Type() string {
+	return pluginconfig.HTTPAttestationHandlerType
+}
+
+func (c *HTTPAttestationClient) fetchSingleToken(
+	ctx context.Context,
+	chainSelector cciptypes.ChainSelector,
+	tokenID reader.MessageTokenID,
+	extraData cciptypes.Bytes,
+) tokendata.AttestationStatus {
+	var body cciptypes.Bytes
+	var err error
+	if c.config.RequestJSONPath != "" {
+		var request []byte
+		request, err = buildRequestBody(c.config.RequestJSONPath, extraData.String())
+		if err != nil {
+			return tokendata.ErrorAttestationStatus(err)
+		}
+		body, _, err = c.client.Post(ctx, "", request)
+	} else {
+		body, _, err = c.client.Get(ctx, renderURLTemplate(c.config.URLTemplate, chainSelector, tokenID, extraData))
+	}
+	if err != nil {
+		return tokendata.ErrorAttestationStatus(err)
+	}
+
+	attestation, err := attestationFromResponse(c.config.ResponseJSONPath, body)
+	if err != nil {
+		return tokendata.ErrorAttestationStatus(err)
+	}
+	return tokendata.SuccessAttestationStatus(extraData, extraData, attestation)
+}
+
+func renderURLTemplate(
+	template string,
+	chainSelector cciptypes.ChainSelector,
+	tokenID reader.MessageTokenID,
+	extraData cciptypes.Bytes,
+) string {
+	return strings.NewReplacer(
+		placeholderExtraData, extraData.String(),
+		placeholderSourceChainSelector, strconv.FormatUint(uint64(chainSelector), 10),
+		placeholderSeqNum, strconv.FormatUint(uint64(tokenID.SeqNr), 10),
+		placeholderTokenIndex, strconv.Itoa(tokenID.Index),
+	).Replace(template)
+}
+
+// buildRequestBody creates a JSON object with the value nested under the dot separated path,
+// e.g. "message.hash" results in {"message":{"hash":value}}
+func buildRequestBody(jsonPath string, value string) ([]byte, error) {
+	keys := strings.Split(jsonPath, ".")
+	var body any = value
+	for i := len(keys) - 1; i >= 0; i-- {
+		if keys[i] == "" {
+			return nil, fmt.Errorf("invalid request JSON path %q", jsonPath)
+		}
+		body = map[string]any{keys[i]: body}
+	}
+	return json.Marshal(body)
+}
+
+// attestationFromResponse reads the hex encoded attestation stored under the dot separated path in the JSON response.
+func attestationFromResponse(jsonPath string, body cciptypes.Bytes) (cciptypes.Bytes, error) {
+	var response any
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to decode json: %w", err)
+	}
+
+	value := response
+	for _, key := range strings.Split(jsonPath, ".") {
+		obj, ok := value.(map[string]any)
+		if !ok {
+			return nil, tokendata.ErrNotReady
+		}
+		if value, ok = obj[key]; !ok {
+			return nil, tokendata.ErrNotReady
+		}
+	}
+
+	if value == nil {
+		return nil, tokendata.ErrNotReady
+	}
+	attestation, ok := value.(string)
+	if !ok {
+		return nil, fmt.Errorf("attestation under %q is not a string", jsonPath)
+	}
+	if attestation == "" {
+		return nil, tokendata.ErrNotReady
+	}
+
+	attestationBytes, err := cciptypes.NewBytesFromString(attestation)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode attestation hex: %w", err)
+	}
+	return attestationBytes, nil
+}
@@ -0,0 +1,310 @@
+package httpattestation
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink-ccip/execute/exectypes"
+	"github.com/smartcontractkit/chainlink-ccip/execute/tokendata"
+	"github.com/smartcontractkit/chainlink-ccip/internal"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/reader"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+	"github.com/smartcontractkit/chainlink-ccip/pluginconfig"
+)
+
+const (
+	authHeader = "X-API-Key"
+	authValue  = "secret"
+)
+
+var (
+	readyExtraData   = cciptypes.Bytes{0x0a}
+	pendingExtraData = cciptypes.Bytes{0x0b}
+	attestation      = "0xddeabb261b885a9676022149101626834649faf58012ec5c2d1b016f8225b734"
+)
+
+// newAttestationServer returns a server that serves attestation for readyExtraData and reports
+// pendingExtraData as not yet attested. Requests without the auth header are rejected.
+func newAttestationServer(t *testing.T) *httptest.Server {
+	respond := func(w http.ResponseWriter, extraData string) {
+		response := map[string]any{"data": map[string]any{"attestation": nil}}
+		switch extraData {
+		case readyExtraData.String():
+			response = map[string]any{"data": map[string]any{"attestation": attestation}}
+		case pendingExtraData.String():
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(response))
+	}
+
+	mux := http.NewServeMux()
+	getPattern := "GET /{prefix}/v1/chains/{chain}/attestations/{extraData}"
+	mux.HandleFunc(getPattern, func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get(authHeader) != authValue {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		require.Equal(t, "1", r.PathValue("chain"))
+		respond(w, r.PathValue("extraData"))
+	})
+	mux.HandleFunc("GET /{prefix}/v1/attestations", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "1", r.URL.Query().Get("chain"))
+		respond(w, r.URL.Query().Get("id"))
+	})
+	mux.HandleFunc("POST /", func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		var request struct {
+			Message struct {
+				Hash string `json:"hash"`
+			} `json:"message"`
+		}
+		require.NoError(t, json.Unmarshal(body, &request))
+		respond(w, request.Message.Hash)
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func newConfig(api string) pluginconfig.HTTPAttestationObserverConfig {
+	return pluginconfig.HTTPAttestationObserverConfig{
+		AttestationConfig: pluginconfig.AttestationConfig{
+			AttestationAPI:         api,
+			AttestationAPITimeout:  commonconfig.MustNewDuration(time.Minute),
+			AttestationAPIInterval: commonconfig.MustNewDuration(time.Millisecond),
+		},
+		AttestationAPICooldown: commonconfig.MustNewDuration(time.Minute),
+		URLTemplate:            "/v1/chains/{sourceChainSelector}/attestations/{extraData}",
+		ResponseJSONPath:       "data.attestation",
+		AuthHeaderName:         authHeader,
+		AuthCredentialsName:    "attestation-api",
+		SourcePoolAddressByChain: map[cciptypes.ChainSelector]string{
+			1: "0x01",
+		},
+	}
+}
+
+func Test_HTTPAttestationClient(t *testing.T) {
+	server := newAttestationServer(t)
+
+	getConfig := newConfig(server.URL + "/get")
+
+	postConfig := newConfig(server.URL + "/post")
+	postConfig.URLTemplate = ""
+	postConfig.RequestJSONPath = "message.hash"
+	postConfig.AuthHeaderName = ""
+	postConfig.AuthCredentialsName = ""
+
+	queryConfig := newConfig(server.URL + "/query")
+	queryConfig.URLTemplate = "/v1/attestations?chain={sourceChainSelector}&id={extraData}"
+	queryConfig.AuthHeaderName = ""
+	queryConfig.AuthCredentialsName = ""
+
+	unauthorizedConfig := newConfig(server.URL + "/unauthorized")
+	unauthorizedConfig.AuthHeaderName = ""
+	unauthorizedConfig.AuthCredentialsName = ""
+
+	tt := []struct {
+		name     string
+		config   pluginconfig.HTTPAttestationObserverConfig
+		input    cciptypes.Bytes
+		expected tokendata.AttestationStatus
+	}{
+		{
+			name:   "get with auth header",
+			config: getConfig,
+			input:  readyExtraData,
+			expected: tokendata.SuccessAttestationStatus(
+				readyExtraData, readyExtraData, internal.MustDecode(attestation),
+			),
+		},
+		{
+			name:     "get pending attestation",
+			config:   getConfig,
+			input:    pendingExtraData,
+			expected: tokendata.ErrorAttestationStatus(tokendata.ErrNotReady),
+		},
+		{
+			name:     "get unknown message",
+			config:   getConfig,
+			input:    cciptypes.Bytes{0x0c},
+			expected: tokendata.ErrorAttestationStatus(tokendata.ErrNotReady),
+		},
+		{
+			name:     "get without auth header",
+			config:   unauthorizedConfig,
+			input:    readyExtraData,
+			expected: tokendata.ErrorAttestationStatus(tokendata.ErrUnknownResponse),
+		},
+		{
+			name:   "get with query string",
+			config: queryConfig,
+			input:  readyExtraData,
+			expected: tokendata.SuccessAttestationStatus(
+				readyExtraData, readyExtraData, internal.MustDecode(attestation),
+			),
+		},
+		{
+			name:     "get pending attestation with query string",
+			config:   queryConfig,
+			input:    pendingExtraData,
+			expected: tokendata.ErrorAttestationStatus(tokendata.ErrNotReady),
+		},
+		{
+			name:   "post with request JSON path",
+			config: postConfig,
+			input:  readyExtraData,
+			expected: tokendata.SuccessAttestationStatus(
+				readyExtraData, readyExtraData, internal.MustDecode(attestation),
+			),
+		},
+		{
+			name:     "post pending attestation",
+			config:   postConfig,
+			input:    pendingExtraData,
+			expected: tokendata.ErrorAttestationStatus(tokendata.ErrNotReady),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			require.NoError(t, tc.config.Validate())
+
+			client, err := NewHTTPAttestationClient(logger.Test(t), tc.config, authValue)
+			require.NoError(t, err)
+
+			tokenID := reader.NewMessageTokenID(10, 0)
+			input := map[cciptypes.ChainSelector]map[reader.MessageTokenID]cciptypes.Bytes{
+				1: {tokenID: tc.input},
+			}
+			attestations, err := client.Attestations(tests.Context(t), input)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, attestations[1][tokenID])
+		})
+	}
+}
+
+func Test_HTTPAttestationClient_RateLimit(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := NewHTTPAttestationClient(logger.Test(t), newConfig(server.URL), authValue)
+	require.NoError(t, err)
+
+	input := map[cciptypes.ChainSelector]map[reader.MessageTokenID]cciptypes.Bytes{
+		1: {reader.NewMessageTokenID(10, 0): readyExtraData},
+	}
+	for range 3 {
+		attestations, err := client.Attestations(tests.Context(t), input)
+		require.NoError(t, err)
+		require.ErrorIs(t, attestations[1][reader.NewMessageTokenID(10, 0)].Error, tokendata.ErrRateLimit)
+	}
+	// Only the first request hits the API, the following ones are dropped during the cool down period
+	require.Equal(t, 1, calls)
+}
+
+func Test_HTTPAttestationTokenDataObserver_Observe(t *testing.T) {
+	server := newAttestationServer(t)
+	config := newConfig(server.URL + "/observer")
+
+	observer, err := NewHTTPAttestationTokenDataObserver(logger.Test(t), 2, config, authValue)
+	require.NoError(t, err)
+
+	ready := internal.MessageWithTokens(t, "0x01")
+	ready.TokenAmounts[0].ExtraData = readyExtraData
+	pending := internal.MessageWithTokens(t, "0x01", "0x02")
+	pending.TokenAmounts[0].ExtraData = pendingExtraData
+
+	tokenData, err := observer.Observe(tests.Context(t), exectypes.MessageObservations{
+		1: {
+			10: ready,
+			11: pending,
+		},
+		3: {
+			12: internal.MessageWithTokens(t, "0x01"),
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, exectypes.TokenDataObservations{
+		1: {
+			10: exectypes.NewMessageTokenData(
+				exectypes.NewSuccessTokenData(internal.MustDecode(attestation)),
+			),
+			11: exectypes.NewMessageTokenData(
+				exectypes.NewErrorTokenData(tokendata.ErrNotReady),
+				exectypes.NotSupportedTokenData(),
+			),
+		},
+		3: {
+			12: exectypes.NewMessageTokenData(exectypes.NotSupportedTokenData()),
+		},
+	}, tokenData)
+}
+
+func Test_attestationFromResponse(t *testing.T) {
+	tt := []struct {
+		name     string
+		path     string
+		response string
+		expected cciptypes.Bytes
+		err      error
+	}{
+		{
+			name:     "top level attestation",
+			path:     "attestation",
+			response: `{"attestation": "0x0102"}`,
+			expected: cciptypes.Bytes{0x01, 0x02},
+		},
+		{
+			name:     "nested attestation",
+			path:     "data.proof.attestation",
+			response: `{"data": {"proof": {"attestation": "0x0102"}}}`,
+			expected: cciptypes.Bytes{0x01, 0x02},
+		},
+		{
+			name:     "missing attestation",
+			path:     "data.attestation",
+			response: `{"data": {}}`,
+			err:      tokendata.ErrNotReady,
+		},
+		{
+			name:     "empty attestation",
+			path:     "data.attestation",
+			response: `{"data": {"attestation": ""}}`,
+			err:      tokendata.ErrNotReady,
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			attestation, err := attestationFromResponse(tc.path, cciptypes.Bytes(tc.response))
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, attestation)
+		})
+	}
+
+	_, err := attestationFromResponse("data.attestation", cciptypes.Bytes(`{"data": {"attestation": 1}}`))
+	require.Error(t, err)
+	_, err = attestationFromResponse("data.attestation", cciptypes.Bytes(`not a json`))
+	require.Error(t, err)
+}
@@ -0,0 +1,137 @@
+package httpattestation
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink-ccip/execute/exectypes"
+	"github.com/smartcontractkit/chainlink-ccip/execute/tokendata"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/reader"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+	"github.com/smartcontractkit/chainlink-ccip/pluginconfig"
+)
+
+// HTTPAttestationTokenDataObserver is a TokenDataObserver for tokens whose pools require an offchain proof
+// served by a custom attestation service. Tokens are matched by their source pool address.
+type HTTPAttestationTokenDataObserver struct {
+	lggr                     logger.Logger
+	destChainSelector        cciptypes.ChainSelector
+	supportedPoolsBySelector map[cciptypes.ChainSelector]string
+	client                   tokendata.AttestationClient
+}
+
+func NewHTTPAttestationTokenDataObserver(
+	lggr logger.Logger,
+	destChainSelector cciptypes.ChainSelector,
+	config pluginconfig.HTTPAttestationObserverConfig,
+	authHeaderValue string,
+) (*HTTPAttestationTokenDataObserver, error) {
+	client, err := NewHTTPAttestationClient(lggr, config, authHeaderValue)
+	if err != nil {
+		return nil, fmt.Errorf("create attestation client: %w", err)
+	}
+	return InitHTTPAttestationTokenDataObserver(
+		lggr,
+		destChainSelector,
+		config.SourcePoolAddressByChain,
+		client,
+	), nil
+}
+
+func InitHTTPAttestationTokenDataObserver(
+	lggr logger.Logger,
+	destChainSelector cciptypes.ChainSelector,
+	supportedPoolsBySelector map[cciptypes.ChainSelector]string,
+	client tokendata.AttestationClient,
+) *HTTPAttestationTokenDataObserver {
+	return &HTTPAttestationTokenDataObserver{
+		lggr:                     lggr,
+		destChainSelector:        destChainSelector,
+		supportedPoolsBySelector: supportedPoolsBySelector,
+		client:                   client,
+	}
+}
+
+func (o *HTTPAttestationTokenDataObserver) Observe(
+	ctx context.Context,
+	observations exectypes.MessageObservations,
+) (exectypes.TokenDataObservations, error) {
+	// 1. Pick messages with supported tokens
+	supportedMessages := o.pickOnlySupportedMessages(observations)
+	// 2. Request attestations
+	attestations, err := o.client.Attestations(ctx, supportedMessages)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch attestations: %w", err)
+	}
+	// 3. Map to result
+	return o.createTokenDataObservations(observations, attestations), nil
+}
+
+// IsTokenSupported returns true if the token is supported by the observer.
+func (o *HTTPAttestationTokenDataObserver) IsTokenSupported(
+	sourceChain cciptypes.ChainSelector,
+	msgToken cciptypes.RampTokenAmount,
+) bool {
+	return strings.EqualFold(o.supportedPoolsBySelector[sourceChain], msgToken.SourcePoolAddress.String())
+}
+
+// Close closes the observer and releases any resources.
+func (o *HTTPAttestationTokenDataObserver) Close() error {
+	return nil
+}
+
+func (o *HTTPAttestationTokenDataObserver) pickOnlySupportedMessages(
+	messageObservations exectypes.MessageObservations,
+) map[cciptypes.ChainSelector]map[reader.MessageTokenID]cciptypes.Bytes {
+	supportedMessages := make(map[cciptypes.ChainSelector]map[reader.MessageTokenID]cciptypes.Bytes)
+	for chainSelector, messages := range messageObservations {
+		supportedMessages[chainSelector] = make(map[reader.MessageTokenID]cciptypes.Bytes)
+		for seqNum, message := range messages {
+			for i, tokenAmount := range message.TokenAmounts {
+				if o.IsTokenSupported(chainSelector, tokenAmount) {
+					supportedMessages[chainSelector][reader.NewMessageTokenID(seqNum, i)] = tokenAmount.ExtraData
+				}
+			}
+		}
+	}
+	return supportedMessages
+}
+
+func (o *HTTPAttestationTokenDataObserver) createTokenDataObservations(
+	messages exectypes.MessageObservations,
+	attestations map[cciptypes.ChainSelector]map[reader.MessageTokenID]tokendata.AttestationStatus,
+) exectypes.TokenDataObservations {
+	tokenObservations := make(exectypes.TokenDataObservations)
+	for chainSelector, chainMessages := range messages {
+		tokenObservations[chainSelector] = make(map[cciptypes.SeqNum]exectypes.MessageTokenData)
+		for seqNum, message := range chainMessages {
+			tokenData := make([]exectypes.TokenData, len(message.TokenAmounts))
+			for i, tokenAmount := range message.TokenAmounts {
+				if !o.IsTokenSupported(chainSelector, tokenAmount) {
+					tokenData[i] = exectypes.NotSupportedTokenData()
+					continue
+				}
+				tokenData[i] = attestationToTokenData(reader.NewMessageTokenID(seqNum, i), attestations[chainSelector])
+			}
+			tokenObservations[chainSelector][seqNum] = exectypes.NewMessageTokenData(tokenData...)
+		}
+	}
+	return tokenObservations
+}
+
+func attestationToTokenData(
+	tokenID reader.MessageTokenID,
+	attestations map[reader.MessageTokenID]tokendata.AttestationStatus,
+) exectypes.TokenData {
+	status, ok := attestations[tokenID]
+	if !ok {
+		return exectypes.NewErrorTokenData(tokendata.ErrDataMissing)
+	}
+	if status.Error != nil {
+		return exectypes.NewErrorTokenData(status.Error)
+	}
+	return exectypes.NewSuccessTokenData(status.Attestation)
+}
@@ -2,16 +2,22 @@ package observer
 
 import (
 	"context"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
+	"time"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 
+	"github.com/smartcontractkit/chainlink-ccip/execute/tokendata/httpattestation"
 	"github.com/smartcontractkit/chainlink-ccip/execute/tokendata/lbtc"
 
 	"github.com/smartcontractkit/chainlink-ccip/execute/exectypes"
 	"github.com/smartcontractkit/chainlink-ccip/execute/tokendata/usdc"
 	"github.com/smartcontractkit/chainlink-ccip/pkg/contractreader"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/reader"
 	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
 	"github.com/smartcontractkit/chainlink-ccip/pluginconfig"
 )
@@ -47,18 +53,33 @@ type compositeTokenDataObserver struct {
 	observers []TokenDataObserver
 }
 
+// NodeConfig is the node local configuration of the token data observers. Contrary to the offchain config,
+// it's not shared by the nodes of the DON, therefore it may contain secrets and node specific paths.
+type NodeConfig struct {
+	// Credentials are the node's secrets, keyed by their name, which observers reference from the offchain config
+	// (e.g. the auth header value of the HTTP attestation API), so that secrets are never part of the offchain config.
+	Credentials map[string]string
+	// PersistentCacheDir is the directory of the persistent token data stores. Persistent caches enabled in the
+	// offchain config are not used when it's empty.
+	PersistentCacheDir string
+}
+
 // NewConfigBasedCompositeObservers creates a compositeTokenDataObserver based on the provided configuration.
 // Slice of []pluginconfig.TokenDataObserverConfig must be deduped and validated by the plugin.
 // Therefore, we don't re-run any validation and only match configs to the proper TokenDataObserver implementation.
 // This constructor that should be used by the plugin.
+// configDigest identifies the plugin instance, so that instances running side by side (e.g. blue/green) don't share
+// their persistent token data stores.
 func NewConfigBasedCompositeObservers(
 	ctx context.Context,
 	lggr logger.Logger,
 	destChainSelector cciptypes.ChainSelector,
+	configDigest cciptypes.Bytes32,
 	config []pluginconfig.TokenDataObserverConfig,
 	encoder cciptypes.TokenDataEncoder,
 	readers map[cciptypes.ChainSelector]contractreader.ContractReaderFacade,
 	addrCodec cciptypes.AddressCodec,
+	nodeConfig NodeConfig,
 ) (TokenDataObserver, error) {
 	observers := make([]TokenDataObserver, len(config))
 	for i, c := range config {
@@ -78,6 +99,11 @@ func NewConfigBasedCompositeObservers(
 				observers[i] = observer
 			} else {
 				lggr.Info("Using background observer for USDC/CCTP")
+				opts, err := backgroundObserverOptions(
+					lggr, pluginconfig.USDCCCTPHandlerType, destChainSelector, configDigest, nodeConfig.PersistentCacheDir, c.USDCCCTPObserverConfig.WorkerConfig)
+				if err != nil {
+					return nil, err
+				}
 				observers[i] = NewBackgroundObserver(
 					lggr,
 					observer,
@@ -85,6 +111,7 @@ func NewConfigBasedCompositeObservers(
 					c.USDCCCTPObserverConfig.CacheExpirationInterval.Duration(),
 					c.USDCCCTPObserverConfig.CacheCleanupInterval.Duration(),
 					c.USDCCCTPObserverConfig.ObserveTimeout.Duration(),
+					opts...,
 				)
 			}
 		case c.LBTCObserverConfig != nil:
@@ -98,6 +125,11 @@ func NewConfigBasedCompositeObservers(
 				observers[i] = observer
 			} else {
 				lggr.Info("Using background observer for LBTC")
+				opts, err := backgroundObserverOptions(
+					lggr, pluginconfig.LBTCHandlerType, destChainSelector, configDigest, nodeConfig.PersistentCacheDir, c.LBTCObserverConfig.WorkerConfig)
+				if err != nil {
+					return nil, err
+				}
 				observers[i] = NewBackgroundObserver(
 					lggr,
 					observer,
@@ -105,6 +137,41 @@ func NewConfigBasedCompositeObservers(
 					c.LBTCObserverConfig.CacheExpirationInterval.Duration(),
 					c.LBTCObserverConfig.CacheCleanupInterval.Duration(),
 					c.LBTCObserverConfig.ObserveTimeout.Duration(),
+					opts...,
+				)
+			}
+		case c.HTTPAttestationObserverConfig != nil:
+			var authHeaderValue string
+			if name := c.HTTPAttestationObserverConfig.AuthCredentialsName; name != "" {
+				var ok bool
+				if authHeaderValue, ok = nodeConfig.Credentials[name]; !ok {
+					return nil, fmt.Errorf("HTTP attestation credentials %q not found in the node's secrets", name)
+				}
+			}
+			observer, err := httpattestation.NewHTTPAttestationTokenDataObserver(
+				lggr, destChainSelector, *c.HTTPAttestationObserverConfig, authHeaderValue)
+			if err != nil {
+				return nil, fmt.Errorf("create HTTP attestation token observer: %w", err)
+			}
+
+			if c.HTTPAttestationObserverConfig.IsForeground() {
+				lggr.Info("Using foreground observer for HTTP attestation")
+				observers[i] = observer
+			} else {
+				lggr.Info("Using background observer for HTTP attestation")
+				opts, err := backgroundObserverOptions(
+					lggr, pluginconfig.HTTPAttestationHandlerType, destChainSelector, configDigest, nodeConfig.PersistentCacheDir, c.HTTPAttestationObserverConfig.WorkerConfig)
+				if err != nil {
+					return nil, err
+				}
+				observers[i] = NewBackgroundObserver(
+					lggr,
+					observer,
+					c.HTTPAttestationObserverConfig.NumWorkers,
+					c.HTTPAttestationObserverConfig.CacheExpirationInterval.Duration(),
+					c.HTTPAttestationObserverConfig.CacheCleanupInterval.Duration(),
+					c.HTTPAttestationObserverConfig.ObserveTimeout.Duration(),
+					opts...,
 				)
 			}
 		default:
@@ -114,6 +181,75 @@ func NewConfigBasedCompositeObservers(
 	return NewCompositeObservers(lggr, observers...), nil
 }
 
+// backgroundObserverOptions creates the optional features of the background observer enabled in the config.
+func backgroundObserverOptions(
+	lggr logger.Logger,
+	observerType string,
+	destChainSelector cciptypes.ChainSelector,
+	configDigest cciptypes.Bytes32,
+	persistentCacheDir string,
+	config pluginconfig.WorkerConfig,
+) ([]BackgroundObserverOption, error) {
+	if config.PersistentCache == nil {
+		return nil, nil
+	}
+	if persistentCacheDir == "" {
+		lggr.Warnw("Persistent token data store is enabled, but its directory is not set in the node's config, "+
+			"token data are kept in memory only", "type", observerType)
+		return nil, nil
+	}
+
+	path := filepath.Join(persistentCacheDir, fmt.Sprintf("%s-%d-%s.json",
+		observerType, destChainSelector, hex.EncodeToString(configDigest[:])))
+	lggr.Infow("Using persistent token data store", "type", observerType, "path", path)
+	removeStaleTokenDataStores(lggr, persistentCacheDir, observerType, destChainSelector, path,
+		config.PersistentCache.TTL.Duration())
+	store, err := NewFileTokenDataStore(
+		logger.Named(lggr, "tokenDataStore"),
+		path,
+		config.PersistentCache.TTL.Duration(),
+		config.PersistentCache.MaxEntries,
+		config.PersistentCache.FlushInterval.Duration(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("create %s token data store: %w", observerType, err)
+	}
+	return []BackgroundObserverOption{WithTokenDataStore(store)}, nil
+}
+
+// removeStaleTokenDataStores deletes the store files of the other config digests of the observer type and destination
+// chain which weren't written for longer than the ttl. All their token data are expired, and without it the files of
+// every past config digest would be kept forever. The files of the instances still running with another config
+// digest, e.g. the active instance while a candidate starts, are recent and kept.
+func removeStaleTokenDataStores(
+	lggr logger.Logger,
+	persistentCacheDir string,
+	observerType string,
+	destChainSelector cciptypes.ChainSelector,
+	currentPath string,
+	ttl time.Duration,
+) {
+	paths, err := filepath.Glob(filepath.Join(persistentCacheDir, fmt.Sprintf("%s-%d-*.json", observerType, destChainSelector)))
+	if err != nil {
+		lggr.Warnw("Unable to list the token data stores", "type", observerType, "err", err)
+		return
+	}
+	for _, path := range paths {
+		if path == currentPath {
+			continue
+		}
+		info, err := os.Stat(path)
+		if err != nil || time.Since(info.ModTime()) <= ttl {
+			continue
+		}
+		if err = os.Remove(path); err != nil {
+			lggr.Warnw("Unable to remove stale token data store", "path", path, "err", err)
+			continue
+		}
+		lggr.Infow("Removed stale token data store", "path", path)
+	}
+}
+
 // NewCompositeObservers creates a compositeTokenDataObserver based on the provided observers.
 // Created mostly for tests purposes, it allows the user to specify custom observers and skip the part
 // in which we match the configuration to the proper TokenDataObserver.
@@ -121,6 +257,31 @@ func NewCompositeObservers(lggr logger.Logger, observers ...TokenDataObserver) T
 	return &compositeTokenDataObserver{lggr: lggr, observers: observers}
 }
 
+// AddTokenDataPendingReason observes the token data of the pending message and adds
+// reader.PendingTokenDataNotReady to its pending reasons if any of its tokens is not ready.
+func AddTokenDataPendingReason(
+	ctx context.Context,
+	observer TokenDataObserver,
+	lifecycle *reader.MessageLifecycle,
+) error {
+	if !lifecycle.IsPending() || len(lifecycle.Message.TokenAmounts) == 0 {
+		return nil
+	}
+
+	header := lifecycle.Message.Header
+	tokenData, err := observer.Observe(ctx, exectypes.MessageObservations{
+		header.SourceChainSelector: {header.SequenceNumber: lifecycle.Message},
+	})
+	if err != nil {
+		return fmt.Errorf("observe token data: %w", err)
+	}
+
+	if !tokenData[header.SourceChainSelector][header.SequenceNumber].IsReady() {
+		lifecycle.PendingReasons = append(lifecycle.PendingReasons, reader.PendingTokenDataNotReady)
+	}
+	return nil
+}
+
 // Observe start with stubbing exectypes.TokenDataObservations with empty data based on the supported tokens.
 // Then it iterates over all observers and merges token data returned from them into the final result.
 func (c *compositeTokenDataObserver) Observe(
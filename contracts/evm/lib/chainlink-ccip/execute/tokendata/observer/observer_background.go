@@ -11,6 +11,7 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 
 	"github.com/smartcontractkit/chainlink-ccip/execute/exectypes"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/reader"
 	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
 )
 
@@ -19,6 +20,7 @@ type backgroundObserver struct {
 	observer          TokenDataObserver
 	numWorkers        int
 	cachedTokenData   *inMemTokenDataCache
+	store             TokenDataStore // optional, persists ready token data across restarts
 	msgQueue          *msgQueue
 	wg                sync.WaitGroup
 	done              chan struct{}
@@ -26,6 +28,19 @@ type backgroundObserver struct {
 	reprocessInterval time.Duration // how long to wait before reprocessing a message
 }
 
+// BackgroundObserverOption configures optional features of the background observer.
+type BackgroundObserverOption func(*backgroundObserver)
+
+// WithTokenDataStore makes the background observer persist the ready token data in the given store.
+// Token data missing in memory are looked up in the store before the message is enqueued for processing,
+// so the token data fetched before a restart are not requested from the attestation API again.
+// The store is closed together with the observer.
+func WithTokenDataStore(store TokenDataStore) BackgroundObserverOption {
+	return func(o *backgroundObserver) {
+		o.store = store
+	}
+}
+
 // NewBackgroundObserver initializes an observer that retrieves and caches token data in the background.
 // It uses the provided observer to make the actual Observe calls, storing results in memory for efficient access later.
 // Goroutines are spawned to process messages concurrently, numWorkers defines how many.
@@ -39,6 +54,7 @@ func NewBackgroundObserver(
 	cacheExpirationInterval time.Duration,
 	cacheCleanupInterval time.Duration,
 	observeTimeout time.Duration,
+	opts ...BackgroundObserverOption,
 ) TokenDataObserver {
 	doneChan := make(chan struct{})
 
@@ -58,6 +74,9 @@ func NewBackgroundObserver(
 		observeTimeout:    observeTimeout,
 		reprocessInterval: 5 * time.Second,
 	}
+	for _, opt := range opts {
+		opt(o)
+	}
 
 	o.startWorkers()
 	return o
@@ -112,6 +131,11 @@ func (o *backgroundObserver) Observe(
 				// token data exist so include them in the results
 				lggr.Infow("token data found in cache")
 				tokenDataResults[chainSel][seqNum] = tokenData
+			} else if storedTokenData, stored := o.tokenDataFromStore(chainSel, seqNum, msg); stored {
+				// token data were fetched before, e.g. prior to a restart, keep them in memory from now on
+				lggr.Infow("token data found in persistent store")
+				o.cachedTokenData.set(msg.Header.MessageID, storedTokenData)
+				tokenDataResults[chainSel][seqNum] = storedTokenData
 			} else {
 				// token data not in cache for this message, enqueue the message
 				if ok := o.msgQueue.enqueue(msg, 0); ok {
@@ -136,9 +160,62 @@ func (o *backgroundObserver) IsTokenSupported(
 func (o *backgroundObserver) Close() error {
 	close(o.done)
 	o.wg.Wait()
+	if o.store != nil {
+		return o.store.Close()
+	}
 	return nil
 }
 
+// tokenDataFromStore returns the token data of the message if the persistent store contains token data
+// of all the supported tokens of the message.
+func (o *backgroundObserver) tokenDataFromStore(
+	chainSel cciptypes.ChainSelector,
+	seqNum cciptypes.SeqNum,
+	msg cciptypes.Message,
+) (exectypes.MessageTokenData, bool) {
+	if o.store == nil {
+		return exectypes.MessageTokenData{}, false
+	}
+
+	found := false
+	tokenData := make([]exectypes.TokenData, len(msg.TokenAmounts))
+	for i, tokenAmount := range msg.TokenAmounts {
+		if !o.IsTokenSupported(chainSel, tokenAmount) {
+			tokenData[i] = exectypes.NotSupportedTokenData()
+			continue
+		}
+		td, ok := o.store.Get(chainSel, reader.NewMessageTokenID(seqNum, i), msg.Header.MessageID)
+		if !ok {
+			return exectypes.MessageTokenData{}, false
+		}
+		tokenData[i] = td
+		found = true
+	}
+	return exectypes.NewMessageTokenData(tokenData...), found
+}
+
+// persistTokenData writes the ready token data of the supported tokens of the message to the persistent store.
+func (o *backgroundObserver) persistTokenData(
+	lggr logger.Logger,
+	msg cciptypes.Message,
+	tokenData exectypes.MessageTokenData,
+) {
+	if o.store == nil {
+		return
+	}
+
+	chainSel := msg.Header.SourceChainSelector
+	for i, td := range tokenData.TokenData {
+		if i >= len(msg.TokenAmounts) || !o.IsTokenSupported(chainSel, msg.TokenAmounts[i]) || !td.IsReady() {
+			continue
+		}
+		tokenID := reader.NewMessageTokenID(msg.Header.SequenceNumber, i)
+		if err := o.store.Set(chainSel, tokenID, msg.Header.MessageID, td); err != nil {
+			lggr.Errorw("failed to persist token data", "tokenIndex", i, "err", err)
+		}
+	}
+}
+
 // startWorkers starts the worker goroutines that process messages from the queue.
 func (o *backgroundObserver) startWorkers() {
 	o.lggr.Info("waiting for existing (if any) workers to stop")
@@ -210,10 +287,9 @@ func (o *backgroundObserver) worker(id int) {
 			}
 
 			lggr.Infow("message observation successful, token data cached")
-			o.cachedTokenData.set(
-				msg.Header.MessageID,
-				tokenData[msg.Header.SourceChainSelector][msg.Header.SequenceNumber],
-			)
+			msgTokenData := tokenData[msg.Header.SourceChainSelector][msg.Header.SequenceNumber]
+			o.cachedTokenData.set(msg.Header.MessageID, msgTokenData)
+			o.persistTokenData(lggr, msg, msgTokenData)
 		}
 	}
 }
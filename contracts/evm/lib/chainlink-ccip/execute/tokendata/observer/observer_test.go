@@ -14,20 +14,69 @@ import (
 
 	"github.com/smartcontractkit/chainlink-ccip/execute/exectypes"
 	"github.com/smartcontractkit/chainlink-ccip/internal"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/reader"
 	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
 	"github.com/smartcontractkit/chainlink-ccip/pluginconfig"
 )
 
+func Test_CompositeTokenDataObserver_HTTPAttestationCredentials(t *testing.T) {
+	config := pluginconfig.TokenDataObserverConfig{
+		Type:    pluginconfig.HTTPAttestationHandlerType,
+		Version: "1.0",
+		HTTPAttestationObserverConfig: &pluginconfig.HTTPAttestationObserverConfig{
+			AttestationConfig: pluginconfig.AttestationConfig{
+				AttestationAPI: "http://localhost:8080",
+			},
+			URLTemplate:         "/v1/attestations/{extraData}",
+			ResponseJSONPath:    "data.attestation",
+			AuthHeaderName:      "X-API-Key",
+			AuthCredentialsName: "attestation-api",
+			SourcePoolAddressByChain: map[cciptypes.ChainSelector]string{
+				1: "0xabc",
+			},
+		},
+	}
+	require.NoError(t, config.Validate())
+
+	_, err := observer.NewConfigBasedCompositeObservers(
+		tests.Context(t),
+		logger.Test(t),
+		100,
+		cciptypes.Bytes32{},
+		[]pluginconfig.TokenDataObserverConfig{config},
+		nil,
+		nil,
+		internal.NewMockAddressCodecHex(t),
+		observer.NodeConfig{Credentials: map[string]string{"other-api": "key"}},
+	)
+	require.ErrorContains(t, err, `HTTP attestation credentials "attestation-api" not found in the node's secrets`)
+
+	_, err = observer.NewConfigBasedCompositeObservers(
+		tests.Context(t),
+		logger.Test(t),
+		100,
+		cciptypes.Bytes32{},
+		[]pluginconfig.TokenDataObserverConfig{config},
+		nil,
+		nil,
+		internal.NewMockAddressCodecHex(t),
+		observer.NodeConfig{Credentials: map[string]string{"attestation-api": "key"}},
+	)
+	require.NoError(t, err)
+}
+
 func Test_CompositeTokenDataObserver_EmptyObservers(t *testing.T) {
 	mockAddrCodec := internal.NewMockAddressCodecHex(t)
 	obs, err := observer.NewConfigBasedCompositeObservers(
 		tests.Context(t),
 		logger.Test(t),
 		100,
+		cciptypes.Bytes32{},
 		[]pluginconfig.TokenDataObserverConfig{},
 		nil,
 		nil,
 		mockAddrCodec,
+		observer.NodeConfig{},
 	)
 	require.NoError(t, err)
 
@@ -419,6 +468,43 @@ func Test_CompositeTokenDataObserver_Failures(t *testing.T) {
 	}
 }
 
+func Test_AddTokenDataPendingReason(t *testing.T) {
+	lggr := logger.Test(t)
+	pool := internal.RandBytes().String()
+
+	msg := internal.MessageWithTokens(t, pool)
+	msg.Header = cciptypes.RampMessageHeader{SourceChainSelector: 1, SequenceNumber: 10}
+	supportedTokens := map[cciptypes.ChainSelector]string{1: pool}
+
+	lifecycle := reader.MessageLifecycle{Message: msg}
+	err := observer.AddTokenDataPendingReason(
+		tests.Context(t), observer.NewCompositeObservers(lggr, fake("LINK", supportedTokens)), &lifecycle)
+	require.NoError(t, err)
+	require.Empty(t, lifecycle.PendingReasons)
+
+	lifecycle = reader.MessageLifecycle{
+		Message:        msg,
+		PendingReasons: []reader.MessagePendingReason{reader.PendingNotCommitted},
+	}
+	err = observer.AddTokenDataPendingReason(
+		tests.Context(t), observer.NewCompositeObservers(lggr, faulty("LINK", supportedTokens)), &lifecycle)
+	require.NoError(t, err)
+	require.Equal(t,
+		[]reader.MessagePendingReason{reader.PendingNotCommitted, reader.PendingTokenDataNotReady},
+		lifecycle.PendingReasons,
+	)
+
+	// executed messages are not pending
+	lifecycle = reader.MessageLifecycle{
+		Message:  msg,
+		Executed: &reader.MessageExecuted{State: reader.ExecutionStateSuccess},
+	}
+	err = observer.AddTokenDataPendingReason(
+		tests.Context(t), observer.NewCompositeObservers(lggr, faulty("LINK", supportedTokens)), &lifecycle)
+	require.NoError(t, err)
+	require.Empty(t, lifecycle.PendingReasons)
+}
+
 func faulty(prefix string, supportedTokens map[cciptypes.ChainSelector]string) observer.TokenDataObserver {
 	return fakeObserver{
 		prefix:          prefix,
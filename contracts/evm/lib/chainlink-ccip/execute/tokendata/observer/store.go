@@ -0,0 +1,305 @@
+package observer
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink-ccip/execute/exectypes"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/reader"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+)
+
+// TokenDataStore persists ready token data of individual tokens, so that the background observer
+// doesn't have to fetch them from the attestation API again after a node restart.
+type TokenDataStore interface {
+	// Get returns the token data stored for the token of the message. The message ID must match the stored one,
+	// to make sure that the token data are not served for a different message with the same MessageTokenID.
+	Get(
+		chainSel cciptypes.ChainSelector,
+		tokenID reader.MessageTokenID,
+		msgID cciptypes.Bytes32,
+	) (exectypes.TokenData, bool)
+
+	// Set stores the ready token data of the token of the message.
+	Set(
+		chainSel cciptypes.ChainSelector,
+		tokenID reader.MessageTokenID,
+		msgID cciptypes.Bytes32,
+		tokenData exectypes.TokenData,
+	) error
+
+	// Close persists all pending changes and releases any resources.
+	Close() error
+}
+
+type storeKey struct {
+	chainSel cciptypes.ChainSelector
+	tokenID  reader.MessageTokenID
+}
+
+// storedTokenData is the on-disk representation of a single token data entry.
+type storedTokenData struct {
+	ChainSelector cciptypes.ChainSelector `json:"chainSelector"`
+	SeqNum        cciptypes.SeqNum        `json:"seqNum"`
+	Index         int                     `json:"index"`
+	MessageID     cciptypes.Bytes32       `json:"messageId"`
+	Data          cciptypes.Bytes         `json:"data"`
+	ExpiresAt     time.Time               `json:"expiresAt"`
+}
+
+// fileTokenDataStore is a TokenDataStore that keeps the token data in memory and periodically writes
+// them to a JSON file. The file is loaded when the store is created, so the token data fetched before
+// a restart are available right away.
+type fileTokenDataStore struct {
+	lggr       logger.Logger
+	path       string
+	ttl        time.Duration
+	maxEntries int
+	entries    map[storeKey]storedTokenData
+	dirty      bool
+	mu         *sync.RWMutex
+	flushMu    *sync.Mutex
+	wg         sync.WaitGroup
+	done       chan struct{}
+	closeOnce  sync.Once
+}
+
+// NewFileTokenDataStore initializes a TokenDataStore backed by the file under the given path.
+// Existing token data are loaded from the file, expired ones are dropped.
+// ttl defines for how long the token data are considered valid.
+// maxEntries defines how many token data are stored at most, entries closest to expiration are evicted first.
+// flushInterval defines how often the changes are written to disk.
+func NewFileTokenDataStore(
+	lggr logger.Logger,
+	path string,
+	ttl time.Duration,
+	maxEntries int,
+	flushInterval time.Duration,
+) (TokenDataStore, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return nil, fmt.Errorf("create token data store dir: %w", err)
+	}
+
+	s := &fileTokenDataStore{
+		lggr:       lggr,
+		path:       path,
+		ttl:        ttl,
+		maxEntries: maxEntries,
+		entries:    make(map[storeKey]storedTokenData),
+		mu:         &sync.RWMutex{},
+		flushMu:    &sync.Mutex{},
+		done:       make(chan struct{}),
+	}
+	if err := s.load(); err != nil {
+		return nil, err
+	}
+
+	s.wg.Add(1)
+	go s.runFlushLoop(flushInterval)
+	return s, nil
+}
+
+func (s *fileTokenDataStore) Get(
+	chainSel cciptypes.ChainSelector,
+	tokenID reader.MessageTokenID,
+	msgID cciptypes.Bytes32,
+) (exectypes.TokenData, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	entry, ok := s.entries[storeKey{chainSel: chainSel, tokenID: tokenID}]
+	if !ok || entry.MessageID != msgID || time.Now().UTC().After(entry.ExpiresAt) {
+		return exectypes.TokenData{}, false
+	}
+	return exectypes.NewSuccessTokenData(entry.Data), true
+}
+
+func (s *fileTokenDataStore) Set(
+	chainSel cciptypes.ChainSelector,
+	tokenID reader.MessageTokenID,
+	msgID cciptypes.Bytes32,
+	tokenData exectypes.TokenData,
+) error {
+	if !tokenData.IsReady() {
+		return errors.New("only ready token data can be stored")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := storeKey{chainSel: chainSel, tokenID: tokenID}
+	if _, exists := s.entries[key]; !exists && s.maxEntries > 0 && len(s.entries) >= s.maxEntries {
+		s.evictOldest()
+	}
+	s.entries[key] = storedTokenData{
+		ChainSelector: chainSel,
+		SeqNum:        tokenID.SeqNr,
+		Index:         tokenID.Index,
+		MessageID:     msgID,
+		Data:          tokenData.Data,
+		ExpiresAt:     time.Now().Add(s.ttl).UTC(),
+	}
+	s.dirty = true
+	return nil
+}
+
+// Close stops the flush loop and writes the pending changes to disk.
+func (s *fileTokenDataStore) Close() error {
+	var err error
+	s.closeOnce.Do(func() {
+		close(s.done)
+		s.wg.Wait()
+		err = s.flush()
+	})
+	return err
+}
+
+// size returns the number of stored token data
+func (s *fileTokenDataStore) size() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.entries)
+}
+
+// load reads the token data from disk. Missing file means there is nothing to load. Corrupted file is
+// logged and ignored, it is overwritten on the next flush.
+func (s *fileTokenDataStore) load() error {
+	raw, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("read token data store file: %w", err)
+	}
+
+	var stored []storedTokenData
+	if err := json.Unmarshal(raw, &stored); err != nil {
+		s.lggr.Warnw("token data store file is corrupted, starting with empty store", "path", s.path, "err", err)
+		return nil
+	}
+
+	now := time.Now().UTC()
+	for _, entry := range stored {
+		if now.After(entry.ExpiresAt) {
+			continue
+		}
+		s.entries[storeKey{
+			chainSel: entry.ChainSelector,
+			tokenID:  reader.NewMessageTokenID(entry.SeqNum, entry.Index),
+		}] = entry
+	}
+	for s.maxEntries > 0 && len(s.entries) > s.maxEntries {
+		s.evictOldest()
+	}
+
+	s.lggr.Infow("token data store loaded", "path", s.path, "entries", len(s.entries), "expired",
+		len(stored)-len(s.entries))
+	return nil
+}
+
+// flush removes expired token data and atomically writes the remaining ones to disk if anything changed.
+func (s *fileTokenDataStore) flush() error {
+	s.flushMu.Lock()
+	defer s.flushMu.Unlock()
+
+	s.mu.Lock()
+	now := time.Now().UTC()
+	for key, entry := range s.entries {
+		if now.After(entry.ExpiresAt) {
+			delete(s.entries, key)
+			s.dirty = true
+		}
+	}
+	if !s.dirty {
+		s.mu.Unlock()
+		return nil
+	}
+	stored := make([]storedTokenData, 0, len(s.entries))
+	for _, entry := range s.entries {
+		stored = append(stored, entry)
+	}
+	s.dirty = false
+	s.mu.Unlock()
+
+	raw, err := json.Marshal(stored)
+	if err != nil {
+		return s.markDirty(fmt.Errorf("marshal token data: %w", err))
+	}
+
+	if err := s.writeFile(raw); err != nil {
+		return s.markDirty(err)
+	}
+
+	s.lggr.Debugw("token data store flushed", "path", s.path, "entries", len(stored))
+	return nil
+}
+
+// writeFile atomically replaces the store file with the given content. The content is written to a temporary file
+// unique to this write first, so that concurrent writers never interleave their writes.
+func (s *fileTokenDataStore) writeFile(raw []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("create token data store temp file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err = tmp.Write(raw); err != nil {
+		tmp.Close()
+		return fmt.Errorf("write token data store file: %w", err)
+	}
+	if err = tmp.Close(); err != nil {
+		return fmt.Errorf("close token data store file: %w", err)
+	}
+	if err = os.Rename(tmp.Name(), s.path); err != nil {
+		return fmt.Errorf("rename token data store file: %w", err)
+	}
+	return nil
+}
+
+// markDirty makes sure the next flush retries writing the token data that failed to be written.
+func (s *fileTokenDataStore) markDirty(err error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.dirty = true
+	return err
+}
+
+// evictOldest removes the entry closest to expiration, the caller must hold the lock.
+func (s *fileTokenDataStore) evictOldest() {
+	var oldestKey storeKey
+	var oldestExpiresAt time.Time
+	for key, entry := range s.entries {
+		if oldestExpiresAt.IsZero() || entry.ExpiresAt.Before(oldestExpiresAt) {
+			oldestKey = key
+			oldestExpiresAt = entry.ExpiresAt
+		}
+	}
+	delete(s.entries, oldestKey)
+}
+
+// runFlushLoop periodically writes the token data to disk until the store is closed.
+func (s *fileTokenDataStore) runFlushLoop(flushInterval time.Duration) {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(flushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.done:
+			s.lggr.Debug("flush loop gracefully stopped")
+			return
+		case <-ticker.C:
+			if err := s.flush(); err != nil {
+				s.lggr.Errorw("failed to flush token data store", "path", s.path, "err", err)
+			}
+		}
+	}
+}
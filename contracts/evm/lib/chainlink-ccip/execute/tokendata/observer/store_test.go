@@ -0,0 +1,275 @@
+package observer
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink-ccip/execute/exectypes"
+	"github.com/smartcontractkit/chainlink-ccip/internal/mocks"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/reader"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+	"github.com/smartcontractkit/chainlink-ccip/pluginconfig"
+)
+
+func Test_fileTokenDataStore(t *testing.T) {
+	lggr := mocks.NullLogger
+	path := filepath.Join(t.TempDir(), "store", "usdc-cctp-1.json")
+
+	msgID := cciptypes.Bytes32{0x1}
+	tokenID := reader.NewMessageTokenID(10, 0)
+	tokenData := exectypes.NewSuccessTokenData([]byte{0xa, 0xb})
+
+	store, err := NewFileTokenDataStore(lggr, path, time.Hour, 10, time.Hour)
+	require.NoError(t, err)
+
+	require.Error(t, store.Set(1, tokenID, msgID, exectypes.NewErrorTokenData(errors.New("not ready"))))
+	require.NoError(t, store.Set(1, tokenID, msgID, tokenData))
+
+	stored, ok := store.Get(1, tokenID, msgID)
+	require.True(t, ok)
+	require.Equal(t, tokenData, stored)
+
+	_, ok = store.Get(1, tokenID, cciptypes.Bytes32{0x2})
+	require.False(t, ok, "different message with the same token id must not be served")
+	_, ok = store.Get(2, tokenID, msgID)
+	require.False(t, ok)
+	_, ok = store.Get(1, reader.NewMessageTokenID(10, 1), msgID)
+	require.False(t, ok)
+
+	require.NoError(t, store.Close())
+	require.NoError(t, store.Close(), "close must be idempotent")
+
+	// token data are loaded from disk after restart
+	store, err = NewFileTokenDataStore(lggr, path, time.Hour, 10, time.Hour)
+	require.NoError(t, err)
+	stored, ok = store.Get(1, tokenID, msgID)
+	require.True(t, ok)
+	require.Equal(t, tokenData, stored)
+	require.NoError(t, store.Close())
+}
+
+func Test_fileTokenDataStore_Limits(t *testing.T) {
+	lggr := mocks.NullLogger
+	path := filepath.Join(t.TempDir(), "lbtc-1.json")
+
+	store, err := NewFileTokenDataStore(lggr, path, time.Hour, 2, time.Hour)
+	require.NoError(t, err)
+
+	for i := range 3 {
+		require.NoError(t, store.Set(
+			1,
+			reader.NewMessageTokenID(cciptypes.SeqNum(i), 0),
+			cciptypes.Bytes32{byte(i)},
+			exectypes.NewSuccessTokenData([]byte{byte(i)}),
+		))
+		time.Sleep(time.Millisecond)
+	}
+	require.Equal(t, 2, store.(*fileTokenDataStore).size())
+
+	// the oldest entry is evicted first
+	_, ok := store.Get(1, reader.NewMessageTokenID(0, 0), cciptypes.Bytes32{0})
+	require.False(t, ok)
+	_, ok = store.Get(1, reader.NewMessageTokenID(2, 0), cciptypes.Bytes32{2})
+	require.True(t, ok)
+	require.NoError(t, store.Close())
+
+	// expired entries are neither served nor written to disk
+	store, err = NewFileTokenDataStore(lggr, path, time.Millisecond, 2, time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, 2, store.(*fileTokenDataStore).size())
+	require.NoError(t, store.Set(1, reader.NewMessageTokenID(3, 0), cciptypes.Bytes32{3},
+		exectypes.NewSuccessTokenData([]byte{0x3})))
+	time.Sleep(2 * time.Millisecond)
+	_, ok = store.Get(1, reader.NewMessageTokenID(3, 0), cciptypes.Bytes32{3})
+	require.False(t, ok, "expired token data must not be served")
+	require.NoError(t, store.Close())
+
+	store, err = NewFileTokenDataStore(lggr, path, time.Hour, 2, time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, 1, store.(*fileTokenDataStore).size())
+	require.NoError(t, store.Close())
+
+	// expired entries are dropped when loading from disk
+	expired := `[{"chainSelector":1,"seqNum":4,"index":0,"messageId":"0x` +
+		`0400000000000000000000000000000000000000000000000000000000000000",` +
+		`"data":"0x04","expiresAt":"2020-01-01T00:00:00Z"}]`
+	require.NoError(t, os.WriteFile(path, []byte(expired), 0o600))
+	store, err = NewFileTokenDataStore(lggr, path, time.Hour, 2, time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, 0, store.(*fileTokenDataStore).size())
+	require.NoError(t, store.Close())
+}
+
+func Test_fileTokenDataStore_CorruptedFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "usdc-cctp-1.json")
+	require.NoError(t, os.WriteFile(path, []byte("not a json"), 0o600))
+
+	store, err := NewFileTokenDataStore(mocks.NullLogger, path, time.Hour, 10, time.Millisecond)
+	require.NoError(t, err)
+	require.Equal(t, 0, store.(*fileTokenDataStore).size())
+
+	require.NoError(t, store.Set(1, reader.NewMessageTokenID(1, 0), cciptypes.Bytes32{1},
+		exectypes.NewSuccessTokenData([]byte{0x1})))
+
+	// the flush loop overwrites the corrupted file
+	require.Eventually(t, func() bool {
+		raw, err := os.ReadFile(path)
+		return err == nil && string(raw) != "not a json"
+	}, tests.WaitTimeout(t), 10*time.Millisecond)
+	require.NoError(t, store.Close())
+}
+
+func Test_fileTokenDataStore_ConcurrentWriters(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "usdc-cctp-1.json")
+
+	stores := make([]*fileTokenDataStore, 2)
+	for i := range stores {
+		store, err := NewFileTokenDataStore(mocks.NullLogger, path, time.Hour, 10, time.Hour)
+		require.NoError(t, err)
+		stores[i] = store.(*fileTokenDataStore)
+	}
+
+	var wg sync.WaitGroup
+	for i, store := range stores {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for seqNum := range 20 {
+				assert.NoError(t, store.Set(1, reader.NewMessageTokenID(cciptypes.SeqNum(seqNum), i),
+					cciptypes.Bytes32{1}, exectypes.NewSuccessTokenData([]byte{0x1})))
+				assert.NoError(t, store.flush())
+			}
+		}()
+	}
+	wg.Wait()
+
+	// the file is written by either of the writers and no temporary files are left behind
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, "usdc-cctp-1.json", entries[0].Name())
+
+	loaded, err := NewFileTokenDataStore(mocks.NullLogger, path, time.Hour, 20, time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, 10, loaded.(*fileTokenDataStore).size())
+	for _, store := range append(stores, loaded.(*fileTokenDataStore)) {
+		require.NoError(t, store.Close())
+	}
+}
+
+func Test_backgroundObserverOptions(t *testing.T) {
+	config := pluginconfig.WorkerConfig{PersistentCache: &pluginconfig.PersistentCacheConfig{}}
+	require.NoError(t, config.PersistentCache.Validate())
+
+	// the store is not used when the node doesn't configure its directory
+	opts, err := backgroundObserverOptions(mocks.NullLogger, "usdc-cctp", 1, cciptypes.Bytes32{1}, "", config)
+	require.NoError(t, err)
+	require.Empty(t, opts)
+
+	dir := t.TempDir()
+	for _, digest := range []cciptypes.Bytes32{{1}, {2}} {
+		opts, err = backgroundObserverOptions(mocks.NullLogger, "usdc-cctp", 1, digest, dir, config)
+		require.NoError(t, err)
+		require.Len(t, opts, 1)
+
+		observer := NewBackgroundObserver(mocks.NullLogger, &NoopTokenDataObserver{}, 1, time.Hour, time.Hour,
+			time.Second, opts...)
+		require.NoError(t, observer.(*backgroundObserver).store.Set(1, reader.NewMessageTokenID(1, 0),
+			cciptypes.Bytes32{1}, exectypes.NewSuccessTokenData([]byte{0x1})))
+		require.NoError(t, observer.Close())
+	}
+
+	// instances with different config digests (e.g. blue/green) use their own files
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	require.ElementsMatch(t, []string{
+		"usdc-cctp-1-0100000000000000000000000000000000000000000000000000000000000000.json",
+		"usdc-cctp-1-0200000000000000000000000000000000000000000000000000000000000000.json",
+	}, names)
+
+	// the file of a digest which wasn't written for longer than the ttl is removed when another digest opens its store,
+	// the files of other destination chains are kept
+	otherDest := filepath.Join(dir, "usdc-cctp-10-0100000000000000000000000000000000000000000000000000000000000000.json")
+	require.NoError(t, os.WriteFile(otherDest, []byte("{}"), 0o600))
+	expired := time.Now().Add(-config.PersistentCache.TTL.Duration() - time.Minute)
+	for _, path := range []string{filepath.Join(dir, names[0]), otherDest} {
+		require.NoError(t, os.Chtimes(path, expired, expired))
+	}
+	opts, err = backgroundObserverOptions(mocks.NullLogger, "usdc-cctp", 1, cciptypes.Bytes32{3}, dir, config)
+	require.NoError(t, err)
+	require.NoError(t, NewBackgroundObserver(mocks.NullLogger, &NoopTokenDataObserver{}, 1, time.Hour, time.Hour,
+		time.Second, opts...).Close())
+	entries, err = os.ReadDir(dir)
+	require.NoError(t, err)
+	names = names[:0]
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	require.ElementsMatch(t, []string{
+		"usdc-cctp-1-0200000000000000000000000000000000000000000000000000000000000000.json",
+		"usdc-cctp-10-0100000000000000000000000000000000000000000000000000000000000000.json",
+	}, names)
+}
+
+func Test_backgroundObserver_TokenDataStore(t *testing.T) {
+	ctx := tests.Context(t)
+	lggr := mocks.NullLogger
+	path := filepath.Join(t.TempDir(), "usdc-cctp-1.json")
+
+	msgObservations, _ := generateMsgObservations(map[cciptypes.ChainSelector]int{1000: 5, 2000: 5})
+
+	newObserver := func(base TokenDataObserver) TokenDataObserver {
+		store, err := NewFileTokenDataStore(lggr, path, time.Hour, 100, time.Hour)
+		require.NoError(t, err)
+		return NewBackgroundObserver(lggr, base, 2, time.Hour, time.Hour, time.Second, WithTokenDataStore(store))
+	}
+
+	observer := newObserver(&NoopTokenDataObserver{tokenSupported: true})
+	require.Eventually(t, func() bool {
+		tokenDataObservations, err := observer.Observe(ctx, msgObservations)
+		require.NoError(t, err)
+		return allTokenDataReady(tokenDataObservations)
+	}, tests.WaitTimeout(t), 50*time.Millisecond)
+	require.NoError(t, observer.Close())
+
+	// after restart, the token data are served from the store even though the underlying observer fails
+	errorTokenData := map[cciptypes.ChainSelector]map[cciptypes.SeqNum][]int{}
+	for chain, msgs := range msgObservations {
+		errorTokenData[chain] = map[cciptypes.SeqNum][]int{}
+		for seqNum := range msgs {
+			errorTokenData[chain][seqNum] = []int{0, 1}
+		}
+	}
+	observer = newObserver(&NoopTokenDataObserver{tokenSupported: true, errorTokenData: errorTokenData})
+	tokenDataObservations, err := observer.Observe(ctx, msgObservations)
+	require.NoError(t, err)
+	require.True(t, allTokenDataReady(tokenDataObservations))
+	require.Equal(t, 10, observer.(*backgroundObserver).cachedTokenData.size())
+	require.Equal(t, 0, observer.(*backgroundObserver).msgQueue.size())
+	require.NoError(t, observer.Close())
+}
+
+func allTokenDataReady(tokenDataObservations exectypes.TokenDataObservations) bool {
+	for _, seqNums := range tokenDataObservations {
+		for _, tokenData := range seqNums {
+			if !tokenData.IsReady() {
+				return false
+			}
+		}
+	}
+	return true
+}
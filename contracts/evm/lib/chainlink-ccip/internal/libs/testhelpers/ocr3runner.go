@@ -35,6 +35,19 @@ type OCR3Runner[RI any] struct {
 	nodeIDs         []commontypes.OracleID
 	round           int
 	previousOutcome ocr3types.Outcome
+	faults          Faults
+	roundRobin      bool
+}
+
+// Faults are injected in every round of an OCR3Runner until they are replaced.
+type Faults struct {
+	// DroppedObservations are the oracles whose observation never reaches the leader.
+	DroppedObservations map[commontypes.OracleID]bool
+
+	// ByzantineObservations replace the observation of the given oracles.
+	// A byzantine observation failing ValidateObservation is discarded, like libocr does,
+	// while an honest observation failing it still fails the round.
+	ByzantineObservations map[commontypes.OracleID]func(types.Observation) types.Observation
 }
 
 func NewOCR3Runner[RI any](
@@ -48,6 +61,19 @@ func NewOCR3Runner[RI any](
 	}
 }
 
+// WithFaults sets the faults injected in the following rounds, an empty Faults removes them.
+func (r *OCR3Runner[RI]) WithFaults(faults Faults) *OCR3Runner[RI] {
+	r.faults = faults
+	return r
+}
+
+// WithRoundRobinLeader makes the leader of each round the next node, instead of a random one,
+// so that the rounds are deterministic.
+func (r *OCR3Runner[RI]) WithRoundRobinLeader() *OCR3Runner[RI] {
+	r.roundRobin = true
+	return r
+}
+
 // RunRound will run some basic steps of an OCR3 flow.
 // This is not a full OCR3 round but only the bare minimum.
 //
@@ -65,20 +91,35 @@ func (r *OCR3Runner[RI]) RunRound(ctx context.Context) (result RoundResult[RI],
 		return RoundResult[RI]{}, fmt.Errorf("%w: %w", err, ErrQuery)
 	}
 
-	attributedObservations := make([]types.AttributedObservation, len(r.nodes))
+	attributedObservations := make([]types.AttributedObservation, 0, len(r.nodes))
+	var discarded []commontypes.OracleID
 	for i, n := range r.nodes {
 		obs, err2 := n.Observation(ctx, outcomeCtx, q)
 		if err2 != nil {
 			return RoundResult[RI]{}, fmt.Errorf("%w: %w", err2, ErrObservation)
 		}
 
-		attrObs := types.AttributedObservation{Observation: obs, Observer: r.nodeIDs[i]}
+		oracleID := r.nodeIDs[i]
+		if r.faults.DroppedObservations[oracleID] {
+			discarded = append(discarded, oracleID)
+			continue
+		}
+		byzantine, isByzantine := r.faults.ByzantineObservations[oracleID]
+		if isByzantine {
+			obs = byzantine(obs)
+		}
+
+		attrObs := types.AttributedObservation{Observation: obs, Observer: oracleID}
 		err = leaderNode.ValidateObservation(ctx, outcomeCtx, q, attrObs)
+		if err != nil && isByzantine {
+			discarded = append(discarded, oracleID)
+			continue
+		}
 		if err != nil {
 			return RoundResult[RI]{}, fmt.Errorf("%w: %w", err, ErrValidateObservation)
 		}
 
-		attributedObservations[i] = attrObs
+		attributedObservations = append(attributedObservations, attrObs)
 	}
 
 	outcomes := make([]ocr3types.Outcome, len(r.nodes))
@@ -165,6 +206,7 @@ func (r *OCR3Runner[RI]) RunRound(ctx context.Context) (result RoundResult[RI],
 		NotAccepted:    notAccepted,
 		NotTransmitted: notTransmitted,
 		Outcome:        outcomes[0],
+		Discarded:      discarded,
 	}, nil
 }
 
@@ -173,6 +215,9 @@ func (r *OCR3Runner[RI]) selectLeader() ocr3types.ReportingPlugin[RI] {
 	if numNodes == 0 {
 		return nil
 	}
+	if r.roundRobin {
+		return r.nodes[r.round%numNodes]
+	}
 
 	idx, err := rand.Int(rand.Reader, big.NewInt(int64(numNodes)))
 	if err != nil {
@@ -189,6 +234,8 @@ type RoundResult[RI any] struct {
 	NotAccepted    []ocr3types.ReportWithInfo[RI]
 	NotTransmitted []ocr3types.ReportWithInfo[RI]
 	Outcome        []byte
+	// Discarded are the oracles whose observation was dropped or failed validation.
+	Discarded []commontypes.OracleID
 }
 
 func countUniqueOutcomes(outcomes []ocr3types.Outcome) int {
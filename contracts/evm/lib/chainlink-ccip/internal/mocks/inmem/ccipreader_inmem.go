@@ -2,6 +2,7 @@ package inmem
 
 import (
 	"context"
+	"fmt"
 	"math/big"
 	"sort"
 	"time"
@@ -21,6 +22,8 @@ type MessagesWithMetadata struct {
 	cciptypes.Message
 	Executed    bool
 	Destination cciptypes.ChainSelector
+	// SentAt is the timestamp of the message on the source chain, returned by MessageStatus.
+	SentAt time.Time
 }
 
 type InMemoryCCIPReader struct {
@@ -35,17 +38,43 @@ type InMemoryCCIPReader struct {
 	Dest cciptypes.ChainSelector
 
 	ConfigDigest [32]byte
+
+	// OffRampNextSeqNums are the next sequence numbers expected by the offRamp, by source chain.
+	OffRampNextSeqNums map[cciptypes.ChainSelector]cciptypes.SeqNum
+
+	// SenderNonces are the inbound nonces of the offRamp, by source chain and sender address.
+	SenderNonces map[cciptypes.ChainSelector]map[string]uint64
+
+	// CurseInfo is returned by GetRmnCurseInfo, nothing is cursed when nil.
+	CurseInfo *reader.CurseInfo
+
+	// SourceChainsConfig is the static offRamp config of the source chains.
+	SourceChainsConfig map[cciptypes.ChainSelector]reader.StaticSourceChainConfig
+
+	// ContractAddresses are returned by GetContractAddress and DiscoverContracts.
+	ContractAddresses reader.ContractAddresses
+
+	// FeeComponents are returned by GetChainsFeeComponents.
+	FeeComponents map[cciptypes.ChainSelector]types.ChainFeeComponents
 }
 
 func (r InMemoryCCIPReader) GetContractAddress(contractName string, chain cciptypes.ChainSelector) ([]byte, error) {
-	panic("not implemented")
+	addr, ok := r.ContractAddresses[contractName][chain]
+	if !ok {
+		return nil, fmt.Errorf("no %s address for chain %d", contractName, chain)
+	}
+	return addr, nil
 }
 
 // GetExpectedNextSequenceNumber implements reader.CCIP.
 func (r InMemoryCCIPReader) GetExpectedNextSequenceNumber(
 	ctx context.Context,
 	sourceChainSelector cciptypes.ChainSelector) (cciptypes.SeqNum, error) {
-	panic("unimplemented")
+	latest, err := r.LatestMsgSeqNum(ctx, sourceChainSelector)
+	if err != nil {
+		return 0, err
+	}
+	return latest + 1, nil
 }
 
 func (r InMemoryCCIPReader) CommitReportsGTETimestamp(ctx context.Context,
@@ -146,29 +175,106 @@ func (r InMemoryCCIPReader) MsgsBetweenSeqNums(
 	}), nil
 }
 
+// MessageStatus returns the lifecycle of an in-memory message, committed by any of the finalized reports.
+func (r InMemoryCCIPReader) MessageStatus(
+	_ context.Context, sourceChainSelector cciptypes.ChainSelector, msgID cciptypes.Bytes32,
+) (reader.MessageLifecycle, error) {
+	for _, msg := range r.Messages[sourceChainSelector] {
+		if msg.Header.MessageID != msgID || msg.Destination != r.Dest {
+			continue
+		}
+
+		lifecycle := reader.MessageLifecycle{
+			Message: msg.Message,
+			Sent:    reader.MessageSent{SeqNum: msg.Header.SequenceNumber, Timestamp: msg.SentAt, Finalized: true},
+		}
+		for _, report := range r.FinalizedReports {
+			for _, root := range report.Report.BlessedMerkleRoots {
+				if root.ChainSel == sourceChainSelector && root.SeqNumsRange.Contains(msg.Header.SequenceNumber) {
+					lifecycle.Committed = &reader.MessageCommitted{
+						MerkleRoot:      root.MerkleRoot,
+						SeqNumsRange:    root.SeqNumsRange,
+						Blessed:         true,
+						ReportTimestamp: report.Timestamp,
+						BlockNum:        report.BlockNum,
+					}
+				}
+			}
+		}
+		if msg.Executed {
+			lifecycle.Executed = &reader.MessageExecuted{State: reader.ExecutionStateSuccess}
+		} else if lifecycle.Committed == nil {
+			lifecycle.PendingReasons = []reader.MessagePendingReason{reader.PendingNotCommitted}
+		}
+		return lifecycle, nil
+	}
+	return reader.MessageLifecycle{}, reader.ErrMessageNotFound
+}
+
+// MessagesExecuted returns a successful execution of the in-memory messages executed within the range.
+func (r InMemoryCCIPReader) MessagesExecuted(
+	_ context.Context, sourceChainSelector cciptypes.ChainSelector, seqNumRange cciptypes.SeqNumRange,
+) (map[cciptypes.SeqNum]reader.MessageExecuted, error) {
+	executed := make(map[cciptypes.SeqNum]reader.MessageExecuted)
+	for _, msg := range r.Messages[sourceChainSelector] {
+		if msg.Executed && msg.Destination == r.Dest && seqNumRange.Contains(msg.Header.SequenceNumber) {
+			executed[msg.Header.SequenceNumber] = reader.MessageExecuted{State: reader.ExecutionStateSuccess}
+		}
+	}
+	return executed, nil
+}
+
+// LatestMsgSeqNum returns the highest sequence number of the messages sent from chain to Dest, 0 if none.
 func (r InMemoryCCIPReader) LatestMsgSeqNum(
 	ctx context.Context, chain cciptypes.ChainSelector) (cciptypes.SeqNum, error) {
-	return 0, nil
+	var latest cciptypes.SeqNum
+	for _, msg := range r.Messages[chain] {
+		if msg.Destination == r.Dest && msg.Header.SequenceNumber > latest {
+			latest = msg.Header.SequenceNumber
+		}
+	}
+	return latest, nil
 }
 
+// NextSeqNum returns the OffRampNextSeqNums of the given chains, chains without one are omitted.
 func (r InMemoryCCIPReader) NextSeqNum(
 	ctx context.Context, chains []cciptypes.ChainSelector,
 ) (seqNum map[cciptypes.ChainSelector]cciptypes.SeqNum, err error) {
-	panic("implement me")
+	seqNum = make(map[cciptypes.ChainSelector]cciptypes.SeqNum, len(chains))
+	for _, chain := range chains {
+		if next, ok := r.OffRampNextSeqNums[chain]; ok {
+			seqNum[chain] = next
+		}
+	}
+	return seqNum, nil
 }
 
+// Nonces returns the SenderNonces of the given senders, senders without one have a 0 nonce.
 func (r InMemoryCCIPReader) Nonces(
 	ctx context.Context,
 	addressesByChain map[cciptypes.ChainSelector][]string,
 ) (map[cciptypes.ChainSelector]map[string]uint64, error) {
-	return nil, nil
+	nonces := make(map[cciptypes.ChainSelector]map[string]uint64, len(addressesByChain))
+	for chain, addresses := range addressesByChain {
+		nonces[chain] = make(map[string]uint64, len(addresses))
+		for _, addr := range addresses {
+			nonces[chain][addr] = r.SenderNonces[chain][addr]
+		}
+	}
+	return nonces, nil
 }
 
 func (r InMemoryCCIPReader) GetChainsFeeComponents(
 	ctx context.Context,
 	chains []cciptypes.ChainSelector,
 ) map[cciptypes.ChainSelector]types.ChainFeeComponents {
-	panic("implement me")
+	feeComponents := make(map[cciptypes.ChainSelector]types.ChainFeeComponents, len(chains))
+	for _, chain := range chains {
+		if fc, ok := r.FeeComponents[chain]; ok {
+			feeComponents[chain] = fc
+		}
+	}
+	return feeComponents
 }
 
 func (r InMemoryCCIPReader) GetDestChainFeeComponents(_ context.Context) (types.ChainFeeComponents, error) {
@@ -196,7 +302,7 @@ func (r InMemoryCCIPReader) GetChainFeePriceUpdate(
 func (r InMemoryCCIPReader) DiscoverContracts(
 	ctx context.Context,
 	allChains []cciptypes.ChainSelector) (reader.ContractAddresses, error) {
-	return nil, nil
+	return r.ContractAddresses, nil
 }
 
 func (r InMemoryCCIPReader) GetRMNRemoteConfig(ctx context.Context) (cciptypes.RemoteConfig, error) {
@@ -204,6 +310,9 @@ func (r InMemoryCCIPReader) GetRMNRemoteConfig(ctx context.Context) (cciptypes.R
 }
 
 func (r InMemoryCCIPReader) GetRmnCurseInfo(ctx context.Context) (reader.CurseInfo, error) {
+	if r.CurseInfo != nil {
+		return *r.CurseInfo, nil
+	}
 	return reader.CurseInfo{
 		CursedSourceChains: map[cciptypes.ChainSelector]bool{},
 		CursedDestination:  false,
@@ -231,7 +340,16 @@ func (r InMemoryCCIPReader) GetOffRampConfigDigest(ctx context.Context, pluginTy
 
 func (r InMemoryCCIPReader) GetOffRampSourceChainsConfig(ctx context.Context, chains []cciptypes.ChainSelector,
 ) (map[cciptypes.ChainSelector]reader.StaticSourceChainConfig, error) {
-	return nil, nil
+	if r.SourceChainsConfig == nil {
+		return nil, nil
+	}
+	configs := make(map[cciptypes.ChainSelector]reader.StaticSourceChainConfig, len(chains))
+	for _, chain := range chains {
+		if cfg, ok := r.SourceChainsConfig[chain]; ok {
+			configs[chain] = cfg
+		}
+	}
+	return configs, nil
 }
 
 // Close implements the reader.CCIPReader interface
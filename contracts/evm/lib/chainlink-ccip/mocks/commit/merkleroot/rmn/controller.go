@@ -187,6 +187,53 @@ func (_c *MockController_InitConnection_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// NodeScores provides a mock function with no fields
+func (_m *MockController) NodeScores() []rmn.NodeScore {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for NodeScores")
+	}
+
+	var r0 []rmn.NodeScore
+	if rf, ok := ret.Get(0).(func() []rmn.NodeScore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]rmn.NodeScore)
+		}
+	}
+
+	return r0
+}
+
+// MockController_NodeScores_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NodeScores'
+type MockController_NodeScores_Call struct {
+	*mock.Call
+}
+
+// NodeScores is a helper method to define mock.On call
+func (_e *MockController_Expecter) NodeScores() *MockController_NodeScores_Call {
+	return &MockController_NodeScores_Call{Call: _e.mock.On("NodeScores")}
+}
+
+func (_c *MockController_NodeScores_Call) Run(run func()) *MockController_NodeScores_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockController_NodeScores_Call) Return(_a0 []rmn.NodeScore) *MockController_NodeScores_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockController_NodeScores_Call) RunAndReturn(run func() []rmn.NodeScore) *MockController_NodeScores_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockController creates a new instance of MockController. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockController(t interface {
@@ -974,6 +974,124 @@ func (_c *MockCCIPReader_LinkPriceUSD_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// MessageStatus provides a mock function with given fields: ctx, sourceChainSelector, msgID
+func (_m *MockCCIPReader) MessageStatus(ctx context.Context, sourceChainSelector ccipocr3.ChainSelector, msgID ccipocr3.Bytes32) (reader.MessageLifecycle, error) {
+	ret := _m.Called(ctx, sourceChainSelector, msgID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MessageStatus")
+	}
+
+	var r0 reader.MessageLifecycle
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, ccipocr3.ChainSelector, ccipocr3.Bytes32) (reader.MessageLifecycle, error)); ok {
+		return rf(ctx, sourceChainSelector, msgID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, ccipocr3.ChainSelector, ccipocr3.Bytes32) reader.MessageLifecycle); ok {
+		r0 = rf(ctx, sourceChainSelector, msgID)
+	} else {
+		r0 = ret.Get(0).(reader.MessageLifecycle)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, ccipocr3.ChainSelector, ccipocr3.Bytes32) error); ok {
+		r1 = rf(ctx, sourceChainSelector, msgID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCCIPReader_MessageStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MessageStatus'
+type MockCCIPReader_MessageStatus_Call struct {
+	*mock.Call
+}
+
+// MessageStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sourceChainSelector ccipocr3.ChainSelector
+//   - msgID ccipocr3.Bytes32
+func (_e *MockCCIPReader_Expecter) MessageStatus(ctx interface{}, sourceChainSelector interface{}, msgID interface{}) *MockCCIPReader_MessageStatus_Call {
+	return &MockCCIPReader_MessageStatus_Call{Call: _e.mock.On("MessageStatus", ctx, sourceChainSelector, msgID)}
+}
+
+func (_c *MockCCIPReader_MessageStatus_Call) Run(run func(ctx context.Context, sourceChainSelector ccipocr3.ChainSelector, msgID ccipocr3.Bytes32)) *MockCCIPReader_MessageStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(ccipocr3.ChainSelector), args[2].(ccipocr3.Bytes32))
+	})
+	return _c
+}
+
+func (_c *MockCCIPReader_MessageStatus_Call) Return(_a0 reader.MessageLifecycle, _a1 error) *MockCCIPReader_MessageStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCCIPReader_MessageStatus_Call) RunAndReturn(run func(context.Context, ccipocr3.ChainSelector, ccipocr3.Bytes32) (reader.MessageLifecycle, error)) *MockCCIPReader_MessageStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MessagesExecuted provides a mock function with given fields: ctx, sourceChainSelector, seqNumRange
+func (_m *MockCCIPReader) MessagesExecuted(ctx context.Context, sourceChainSelector ccipocr3.ChainSelector, seqNumRange ccipocr3.SeqNumRange) (map[ccipocr3.SeqNum]reader.MessageExecuted, error) {
+	ret := _m.Called(ctx, sourceChainSelector, seqNumRange)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MessagesExecuted")
+	}
+
+	var r0 map[ccipocr3.SeqNum]reader.MessageExecuted
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, ccipocr3.ChainSelector, ccipocr3.SeqNumRange) (map[ccipocr3.SeqNum]reader.MessageExecuted, error)); ok {
+		return rf(ctx, sourceChainSelector, seqNumRange)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, ccipocr3.ChainSelector, ccipocr3.SeqNumRange) map[ccipocr3.SeqNum]reader.MessageExecuted); ok {
+		r0 = rf(ctx, sourceChainSelector, seqNumRange)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[ccipocr3.SeqNum]reader.MessageExecuted)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, ccipocr3.ChainSelector, ccipocr3.SeqNumRange) error); ok {
+		r1 = rf(ctx, sourceChainSelector, seqNumRange)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCCIPReader_MessagesExecuted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MessagesExecuted'
+type MockCCIPReader_MessagesExecuted_Call struct {
+	*mock.Call
+}
+
+// MessagesExecuted is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sourceChainSelector ccipocr3.ChainSelector
+//   - seqNumRange ccipocr3.SeqNumRange
+func (_e *MockCCIPReader_Expecter) MessagesExecuted(ctx interface{}, sourceChainSelector interface{}, seqNumRange interface{}) *MockCCIPReader_MessagesExecuted_Call {
+	return &MockCCIPReader_MessagesExecuted_Call{Call: _e.mock.On("MessagesExecuted", ctx, sourceChainSelector, seqNumRange)}
+}
+
+func (_c *MockCCIPReader_MessagesExecuted_Call) Run(run func(ctx context.Context, sourceChainSelector ccipocr3.ChainSelector, seqNumRange ccipocr3.SeqNumRange)) *MockCCIPReader_MessagesExecuted_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(ccipocr3.ChainSelector), args[2].(ccipocr3.SeqNumRange))
+	})
+	return _c
+}
+
+func (_c *MockCCIPReader_MessagesExecuted_Call) Return(_a0 map[ccipocr3.SeqNum]reader.MessageExecuted, _a1 error) *MockCCIPReader_MessagesExecuted_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCCIPReader_MessagesExecuted_Call) RunAndReturn(run func(context.Context, ccipocr3.ChainSelector, ccipocr3.SeqNumRange) (map[ccipocr3.SeqNum]reader.MessageExecuted, error)) *MockCCIPReader_MessagesExecuted_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // MsgsBetweenSeqNums provides a mock function with given fields: ctx, chain, seqNumRange
 func (_m *MockCCIPReader) MsgsBetweenSeqNums(ctx context.Context, chain ccipocr3.ChainSelector, seqNumRange ccipocr3.SeqNumRange) ([]ccipocr3.Message, error) {
 	ret := _m.Called(ctx, chain, seqNumRange)
@@ -0,0 +1,322 @@
+package feequoter
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+var (
+	evmExtraArgsV1Tag     = [4]byte{0x97, 0xa6, 0x57, 0xc9}
+	genericExtraArgsV2Tag = [4]byte{0x18, 0x1d, 0xcf, 0x10}
+	svmExtraArgsV1Tag     = [4]byte{0x1f, 0x3b, 0x3a, 0xba}
+)
+
+const (
+	evmPrecompileSpace   = 1024
+	aptosPrecompileSpace = 0x0b
+
+	// svmExtraArgsMaxAccounts is the maximum number of accounts of SVMExtraArgsV1.
+	svmExtraArgsMaxAccounts = 64
+	// svmMessagingAccountsOverhead is the number of accounts needed to execute a message on SVM: the receiver and
+	// the offRamp signer PDA.
+	svmMessagingAccountsOverhead = 2
+	svmAccountByteSize           = 32
+	// svmTokenTransferDataOverhead is the static size of a token transfer executed on SVM,
+	// see Client.SVM_TOKEN_TRANSFER_DATA_OVERHEAD.
+	svmTokenTransferDataOverhead = (4 + 32) + 32 + 4 + 4 + 32 + 32 + 32 + 32 + 32 + 32 + 32
+)
+
+var (
+	abiUint256, _ = abi.NewType("uint256", "", nil)
+	abiBool, _    = abi.NewType("bool", "", nil)
+
+	abiSVMExtraArgsV1, _ = abi.NewType("tuple", "", []abi.ArgumentMarshaling{
+		{Name: "computeUnits", Type: "uint32"},
+		{Name: "accountIsWritableBitmap", Type: "uint64"},
+		{Name: "allowOutOfOrderExecution", Type: "bool"},
+		{Name: "tokenReceiver", Type: "bytes32"},
+		{Name: "accounts", Type: "bytes32[]"},
+	})
+
+	evmExtraArgsV1Args     = abi.Arguments{{Type: abiUint256}}
+	genericExtraArgsV2Args = abi.Arguments{{Type: abiUint256}, {Type: abiBool}}
+	svmExtraArgsV1Args     = abi.Arguments{{Type: abiSVMExtraArgsV1}}
+
+	uint160Max = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 160), big.NewInt(1))
+)
+
+// genericExtraArgs are the extra args of messages sent to EVM, Aptos and Sui chains.
+type genericExtraArgs struct {
+	GasLimit                 *big.Int
+	AllowOutOfOrderExecution bool
+}
+
+// svmExtraArgs are the extra args of messages sent to SVM chains.
+type svmExtraArgs struct {
+	ComputeUnits             uint32
+	AccountIsWritableBitmap  uint64
+	AllowOutOfOrderExecution bool
+	TokenReceiver            [32]byte
+	Accounts                 [][32]byte
+}
+
+// QuoteEVM quotes the fee of sending msg from an EVM source chain, reproducing FeeQuoter.getValidatedFee.
+func QuoteEVM(snapshot Snapshot, msg Message) (Quote, error) {
+	cfg := snapshot.DestChainConfig
+	if !cfg.IsEnabled {
+		return Quote{}, fmt.Errorf("%w: %d", ErrDestChainNotEnabled, snapshot.DestChainSelector)
+	}
+
+	// the Router charges fees in the wrapped native token when no fee token is set
+	feeToken := msg.FeeToken
+	if feeToken == "" {
+		feeToken = snapshot.WrappedNative
+	}
+	feeTokenConfig, ok := snapshot.FeeTokens[feeToken]
+	if !ok {
+		return Quote{}, fmt.Errorf("%w: %s", ErrFeeTokenNotSupported, feeToken)
+	}
+
+	gasLimit, err := validateEVMMessage(snapshot, msg)
+	if err != nil {
+		return Quote{}, err
+	}
+
+	feeTokenPrice, ok := snapshot.validatedTokenPrice(feeToken)
+	if !ok {
+		return Quote{}, fmt.Errorf("%w: %s", ErrTokenNotSupported, feeToken)
+	}
+	// a staleness threshold of 0 means the gas price is always valid
+	if threshold := int64(cfg.GasPriceStalenessThreshold); threshold != 0 && snapshot.gasPriceAge() > threshold {
+		return Quote{}, fmt.Errorf("%w: %d seconds old, threshold %d", ErrStaleGasPrice, snapshot.gasPriceAge(),
+			threshold)
+	}
+	executionGasPrice, dataAvailGasPrice := unpackGasPrice(snapshot.GasPrice.Value.Int)
+
+	c := feeComponents{
+		gasLimit:            gasLimit,
+		premiumFeeUSD:       usdCents(cfg.NetworkFeeUSDCents),
+		feeTokenPrice:       feeTokenPrice,
+		premiumMultiplier:   feeTokenConfig.PremiumMultiplierWeiPerEth,
+		executionGasPrice:   executionGasPrice,
+		dataAvailGasPrice:   dataAvailGasPrice,
+		applyDataAvailCosts: cfg.DestDataAvailabilityMultiplierBps > 0,
+	}
+	// with token transfers the premium is the token transfer fee instead of the network fee
+	if len(msg.TokenAmounts) > 0 {
+		c.premiumFeeUSD, c.transferGas, c.transferBytes, err = evmTokenTransferCost(snapshot, msg.TokenAmounts)
+		if err != nil {
+			return Quote{}, err
+		}
+	}
+
+	return quote(cfg, msg, feeToken, c), nil
+}
+
+// evmTokenTransferCost returns the premium fee in USD with 18 decimals, the gas and the bytes overhead of the token
+// transfers of a message, see FeeQuoter._getTokenTransferCost.
+func evmTokenTransferCost(snapshot Snapshot, tokenAmounts []TokenAmount) (*big.Int, uint64, uint64, error) {
+	cfg := snapshot.DestChainConfig
+	fee := big.NewInt(0)
+	var gas, bytesOverhead uint64
+
+	for _, tokenAmount := range tokenAmounts {
+		transferFeeConfig := snapshot.TokenTransferFeeConfigs[tokenAmount.Token]
+		// tokens without specific overrides use the defaults of the destination chain
+		if !transferFeeConfig.IsEnabled {
+			fee.Add(fee, usdCents(uint32(cfg.DefaultTokenFeeUSDCents)))
+			gas += uint64(cfg.DefaultTokenDestGasOverhead)
+			bytesOverhead += lockOrBurnRetBytes
+			continue
+		}
+
+		var price *big.Int
+		// a ratio of 0 means no bps fee, the token does not need a price
+		if transferFeeConfig.DeciBps > 0 {
+			var ok bool
+			if price, ok = snapshot.validatedTokenPrice(tokenAmount.Token); !ok {
+				return nil, 0, 0, fmt.Errorf("%w: %s", ErrTokenNotSupported, tokenAmount.Token)
+			}
+		}
+
+		fee.Add(fee, tokenTransferFee(transferFeeConfig, amountOf(tokenAmount), price))
+		gas += uint64(transferFeeConfig.DestGasOverhead)
+		bytesOverhead += uint64(transferFeeConfig.DestBytesOverhead)
+	}
+
+	return fee, gas, bytesOverhead, nil
+}
+
+// validateEVMMessage validates msg against the destination chain config and returns its gas limit,
+// see FeeQuoter._validateMessageAndResolveGasLimitForDestination.
+func validateEVMMessage(snapshot Snapshot, msg Message) (uint64, error) {
+	cfg := snapshot.DestChainConfig
+	if len(msg.Data) > int(cfg.MaxDataBytes) {
+		return 0, fmt.Errorf("%w: %d bytes, max %d", ErrMessageTooLarge, len(msg.Data), cfg.MaxDataBytes)
+	}
+	if len(msg.TokenAmounts) > int(cfg.MaxNumberOfTokensPerMsg) {
+		return 0, fmt.Errorf("%w: %d tokens, max %d", ErrUnsupportedNumberOfTokens, len(msg.TokenAmounts),
+			cfg.MaxNumberOfTokensPerMsg)
+	}
+
+	switch cfg.ChainFamilySelector {
+	case ChainFamilySelectorEVM, ChainFamilySelectorAptos, ChainFamilySelectorSui:
+		args, err := decodeEVMGenericExtraArgs(msg.ExtraArgs, cfg.DefaultTxGasLimit)
+		if err != nil {
+			return 0, err
+		}
+		if args.GasLimit.Cmp(big.NewInt(int64(cfg.MaxPerMsgGasLimit))) > 0 {
+			return 0, fmt.Errorf("%w: %s, max %d", ErrMessageGasLimitTooHigh, args.GasLimit, cfg.MaxPerMsgGasLimit)
+		}
+		if cfg.EnforceOutOfOrder && !args.AllowOutOfOrderExecution {
+			return 0, ErrOutOfOrderExecutionRequired
+		}
+		gasLimit := args.GasLimit.Uint64()
+		return gasLimit, validateEVMDestAddress(cfg.ChainFamilySelector, msg.Receiver, gasLimit)
+
+	case ChainFamilySelectorSVM:
+		args, err := decodeEVMSVMExtraArgs(msg.ExtraArgs)
+		if err != nil {
+			return 0, err
+		}
+		if cfg.EnforceOutOfOrder && !args.AllowOutOfOrderExecution {
+			return 0, ErrOutOfOrderExecutionRequired
+		}
+		if args.ComputeUnits > cfg.MaxPerMsgGasLimit {
+			return 0, fmt.Errorf("%w: %d, max %d", ErrMessageGasLimitTooHigh, args.ComputeUnits, cfg.MaxPerMsgGasLimit)
+		}
+		gasLimit := uint64(args.ComputeUnits)
+		if err := validateEVMDestAddress(cfg.ChainFamilySelector, msg.Receiver, gasLimit); err != nil {
+			return 0, err
+		}
+		return gasLimit, validateEVMToSVMMessage(snapshot, msg, args)
+
+	default:
+		return 0, fmt.Errorf("%w: %x", ErrInvalidChainFamilySelector, cfg.ChainFamilySelector)
+	}
+}
+
+// validateEVMToSVMMessage validates the size of a message sent to SVM, which depends on the accounts of the extra
+// args and on the token transfers.
+func validateEVMToSVMMessage(snapshot Snapshot, msg Message, args svmExtraArgs) error {
+	cfg := snapshot.DestChainConfig
+	accounts := len(args.Accounts)
+	expandedDataLength := len(msg.Data)
+
+	// the receiver is not invoked when it is zero, no accounts can be specified for it
+	if msg.Receiver.IsZeroOrEmpty() {
+		if accounts > 0 {
+			return fmt.Errorf("%w: %d accounts for a zero receiver", ErrInvalidExtraArgs, accounts)
+		}
+	} else {
+		expandedDataLength += (accounts + svmMessagingAccountsOverhead) * svmAccountByteSize
+	}
+
+	if len(msg.TokenAmounts) > 0 && args.TokenReceiver == [32]byte{} {
+		return ErrInvalidTokenReceiver
+	}
+	if accounts > svmExtraArgsMaxAccounts {
+		return fmt.Errorf("%w: %d accounts, max %d", ErrInvalidExtraArgs, accounts, svmExtraArgsMaxAccounts)
+	}
+	if args.AccountIsWritableBitmap>>accounts != 0 {
+		return fmt.Errorf("%w: writable bitmap %b for %d accounts", ErrInvalidExtraArgs,
+			args.AccountIsWritableBitmap, accounts)
+	}
+
+	expandedDataLength += len(msg.TokenAmounts) * svmTokenTransferDataOverhead
+	for _, tokenAmount := range msg.TokenAmounts {
+		// pools return lockOrBurnRetBytes by default, the configured overhead is used instead when set
+		if bytesOverhead := snapshot.TokenTransferFeeConfigs[tokenAmount.Token].DestBytesOverhead; bytesOverhead > 0 {
+			expandedDataLength += int(bytesOverhead)
+		} else {
+			expandedDataLength += lockOrBurnRetBytes
+		}
+	}
+
+	if expandedDataLength > int(cfg.MaxDataBytes) {
+		return fmt.Errorf("%w: %d bytes, max %d", ErrMessageTooLarge, expandedDataLength, cfg.MaxDataBytes)
+	}
+	return nil
+}
+
+// validateEVMDestAddress validates the receiver of a message for the destination chain family,
+// see FeeQuoter._validateDestFamilyAddress.
+func validateEVMDestAddress(family [4]byte, receiver []byte, gasLimit uint64) error {
+	if len(receiver) != 32 {
+		return fmt.Errorf("%w: %x", ErrInvalidReceiver, receiver)
+	}
+	address := new(big.Int).SetBytes(receiver)
+
+	var minValue int64
+	switch family {
+	case ChainFamilySelectorEVM:
+		if address.Cmp(uint160Max) > 0 || address.Cmp(big.NewInt(evmPrecompileSpace)) < 0 {
+			return fmt.Errorf("%w: %x", ErrInvalidReceiver, receiver)
+		}
+		return nil
+	case ChainFamilySelectorSVM:
+		// SVM has no precompile space, the receiver must only be non zero if it is invoked
+		if gasLimit > 0 {
+			minValue = 1
+		}
+	default:
+		minValue = aptosPrecompileSpace
+	}
+
+	if address.Cmp(big.NewInt(minValue)) < 0 {
+		return fmt.Errorf("%w: %x", ErrInvalidReceiver, receiver)
+	}
+	return nil
+}
+
+// decodeEVMGenericExtraArgs decodes abi encoded GenericExtraArgsV2 or EVMExtraArgsV1,
+// the default gas limit is used when there are no extra args.
+func decodeEVMGenericExtraArgs(extraArgs []byte, defaultGasLimit uint32) (genericExtraArgs, error) {
+	if len(extraArgs) == 0 {
+		return genericExtraArgs{GasLimit: big.NewInt(int64(defaultGasLimit))}, nil
+	}
+	if len(extraArgs) < 4 {
+		return genericExtraArgs{}, fmt.Errorf("%w: %x", ErrInvalidExtraArgs, extraArgs)
+	}
+
+	tag, data := extraArgs[:4], extraArgs[4:]
+	switch {
+	case bytes.Equal(tag, genericExtraArgsV2Tag[:]):
+		values, err := genericExtraArgsV2Args.Unpack(data)
+		if err != nil {
+			return genericExtraArgs{}, fmt.Errorf("%w: decode GenericExtraArgsV2: %w", ErrInvalidExtraArgs, err)
+		}
+		return genericExtraArgs{
+			GasLimit:                 values[0].(*big.Int),
+			AllowOutOfOrderExecution: values[1].(bool),
+		}, nil
+	case bytes.Equal(tag, evmExtraArgsV1Tag[:]):
+		// EVMExtraArgsV1 only carries a gas limit, the deprecated strict field is ignored
+		values, err := evmExtraArgsV1Args.Unpack(data)
+		if err != nil {
+			return genericExtraArgs{}, fmt.Errorf("%w: decode EVMExtraArgsV1: %w", ErrInvalidExtraArgs, err)
+		}
+		return genericExtraArgs{GasLimit: values[0].(*big.Int)}, nil
+	default:
+		return genericExtraArgs{}, fmt.Errorf("%w: unknown tag %x", ErrInvalidExtraArgs, tag)
+	}
+}
+
+// decodeEVMSVMExtraArgs decodes abi encoded SVMExtraArgsV1, they are mandatory for SVM destinations.
+func decodeEVMSVMExtraArgs(extraArgs []byte) (svmExtraArgs, error) {
+	if len(extraArgs) < 4 {
+		return svmExtraArgs{}, fmt.Errorf("%w: SVMExtraArgsV1 are required", ErrInvalidExtraArgs)
+	}
+	if tag := extraArgs[:4]; !bytes.Equal(tag, svmExtraArgsV1Tag[:]) {
+		return svmExtraArgs{}, fmt.Errorf("%w: unknown tag %x", ErrInvalidExtraArgs, tag)
+	}
+
+	values, err := svmExtraArgsV1Args.Unpack(extraArgs[4:])
+	if err != nil {
+		return svmExtraArgs{}, fmt.Errorf("%w: decode SVMExtraArgsV1: %w", ErrInvalidExtraArgs, err)
+	}
+	return *abi.ConvertType(values[0], new(svmExtraArgs)).(*svmExtraArgs), nil
+}
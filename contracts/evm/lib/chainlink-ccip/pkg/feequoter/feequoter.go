@@ -0,0 +1,283 @@
+// Package feequoter reproduces the fee quoting of the CCIP FeeQuoter contracts offline. Fees are computed from a
+// Snapshot of the FeeQuoter state of the source chain, which allows quoting any number of messages without a
+// getFee round trip to the source chain for each of them.
+//
+// Both the EVM FeeQuoter (FeeQuoter.getValidatedFee) and the SVM fee-quoter program (get_fee) are supported,
+// the implementation is picked from the family of the source chain of the snapshot.
+package feequoter
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	chainsel "github.com/smartcontractkit/chain-selectors"
+
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+)
+
+var (
+	ErrDestChainNotEnabled         = errors.New("destination chain not enabled")
+	ErrFeeTokenNotSupported        = errors.New("fee token not supported")
+	ErrTokenNotSupported           = errors.New("token price not available")
+	ErrStaleGasPrice               = errors.New("stale gas price")
+	ErrMessageTooLarge             = errors.New("message too large")
+	ErrUnsupportedNumberOfTokens   = errors.New("unsupported number of tokens")
+	ErrMessageGasLimitTooHigh      = errors.New("message gas limit too high")
+	ErrOutOfOrderExecutionRequired = errors.New("extra args out of order execution must be true")
+	ErrInvalidExtraArgs            = errors.New("invalid extra args")
+	ErrInvalidReceiver             = errors.New("invalid receiver address")
+	ErrInvalidTokenReceiver        = errors.New("invalid token receiver")
+	ErrInvalidChainFamilySelector  = errors.New("invalid chain family selector")
+	ErrInvalidTokenPrice           = errors.New("invalid token price")
+)
+
+var (
+	ChainFamilySelectorEVM   = [4]byte{0x28, 0x12, 0xd5, 0x2c}
+	ChainFamilySelectorSVM   = [4]byte{0x1e, 0x10, 0xbd, 0xc4}
+	ChainFamilySelectorAptos = [4]byte{0xac, 0x77, 0xff, 0xec}
+	ChainFamilySelectorSui   = [4]byte{0xc4, 0xe0, 0x59, 0x53}
+)
+
+const (
+	// gasPriceBits is the number of bits of the packed gas price holding the execution gas price, the data
+	// availability gas price is stored in the bits above.
+	gasPriceBits = 112
+
+	// messageFixedBytes is the size of the fixed fields of an abi encoded message, see Internal.MESSAGE_FIXED_BYTES.
+	messageFixedBytes = 32 * 15
+	// messageFixedBytesPerToken is the size of the fixed fields of an abi encoded token transfer,
+	// see Internal.MESSAGE_FIXED_BYTES_PER_TOKEN.
+	messageFixedBytesPerToken = 32 * ((2 * 3) + 3)
+	// lockOrBurnRetBytes is the default size of the data returned by a source pool,
+	// see Pool.CCIP_LOCK_OR_BURN_V1_RET_BYTES.
+	lockOrBurnRetBytes = 32
+)
+
+var (
+	e5  = big.NewInt(1e5)
+	e14 = big.NewInt(1e14)
+	e16 = big.NewInt(1e16)
+	e18 = big.NewInt(1e18)
+)
+
+// Snapshot is the state of the FeeQuoter of a source chain needed to quote the fees of messages sent to a single
+// destination chain. All the prices are the values stored in the FeeQuoter, in USD with 18 decimals.
+type Snapshot struct {
+	SourceChainSelector cciptypes.ChainSelector `json:"sourceChainSelector"`
+	DestChainSelector   cciptypes.ChainSelector `json:"destChainSelector"`
+	// Timestamp is the block time of the source chain at which the snapshot was taken,
+	// gas prices are checked for staleness against it.
+	Timestamp       time.Time                          `json:"timestamp"`
+	DestChainConfig cciptypes.FeeQuoterDestChainConfig `json:"destChainConfig"`
+	// GasPrice is the price of a unit of gas of the destination chain. The lower 112 bits hold the execution gas
+	// price and the upper 112 bits the data availability gas price.
+	GasPrice cciptypes.TimestampedBig `json:"gasPrice"`
+	// TokenPrices are the prices of 1e18 of the smallest denomination of the fee tokens and the transferred tokens,
+	// as returned by FeeQuoter.getTokenPrice.
+	TokenPrices map[cciptypes.UnknownEncodedAddress]cciptypes.TimestampedBig `json:"tokenPrices"`
+	// FeeTokens are the tokens fees can be paid with.
+	FeeTokens map[cciptypes.UnknownEncodedAddress]FeeTokenConfig `json:"feeTokens"`
+	// TokenTransferFeeConfigs are the transfer fee configs of the destination chain, keyed by source token.
+	TokenTransferFeeConfigs map[cciptypes.UnknownEncodedAddress]TokenTransferFeeConfig `json:"tokenTransferFeeConfigs"`
+	// WrappedNative is the fee token of messages without one, it is the wrapped native token of the Router on EVM
+	// and the wrapped SOL mint on SVM if not set.
+	WrappedNative cciptypes.UnknownEncodedAddress `json:"wrappedNative"`
+}
+
+// FeeTokenConfig is the configuration of a fee token.
+type FeeTokenConfig struct {
+	// PremiumMultiplierWeiPerEth is the multiplier applied to the premium fee, 1e18 based.
+	PremiumMultiplierWeiPerEth uint64 `json:"premiumMultiplierWeiPerEth"`
+}
+
+// TokenTransferFeeConfig is the transfer fee configuration of a token for the destination chain.
+type TokenTransferFeeConfig struct {
+	MinFeeUSDCents    uint32 `json:"minFeeUSDCents"`    // Minimum fee to charge per token transfer
+	MaxFeeUSDCents    uint32 `json:"maxFeeUSDCents"`    // Maximum fee to charge per token transfer
+	DeciBps           uint16 `json:"deciBps"`           // Basis points charged on token transfers, multiples of 0.1bps
+	DestGasOverhead   uint32 `json:"destGasOverhead"`   // Gas charged to execute the token transfer on destination
+	DestBytesOverhead uint32 `json:"destBytesOverhead"` // Bytes returned by the source pool to the destination pool
+	IsEnabled         bool   `json:"isEnabled"`         // Whether the token overrides the default transfer fees
+}
+
+// Message is the message to quote, it mirrors Client.EVM2AnyMessage and SVM2AnyMessage.
+type Message struct {
+	Receiver     cciptypes.UnknownAddress        `json:"receiver"`
+	Data         cciptypes.Bytes                 `json:"data"`
+	TokenAmounts []TokenAmount                   `json:"tokenAmounts"`
+	FeeToken     cciptypes.UnknownEncodedAddress `json:"feeToken"`
+	ExtraArgs    cciptypes.Bytes                 `json:"extraArgs"`
+}
+
+// TokenAmount is a token transferred by a message.
+type TokenAmount struct {
+	Token  cciptypes.UnknownEncodedAddress `json:"token"`
+	Amount cciptypes.BigInt                `json:"amount"`
+}
+
+// Quote is the fee of a message along with its components.
+type Quote struct {
+	FeeToken cciptypes.UnknownEncodedAddress `json:"feeToken"`
+	// Fee is the fee in the smallest denomination of the fee token, as returned by getFee.
+	Fee cciptypes.BigInt `json:"fee"`
+	// GasLimit is the gas limit, or compute units, of the message resolved from its extra args.
+	GasLimit uint64 `json:"gasLimit"`
+	// DestGas is the total gas charged on the destination chain.
+	DestGas uint64 `json:"destGas"`
+	// PremiumFeeUSD, ExecutionCostUSD and DataAvailabilityCostUSD are the components of the fee in USD with
+	// 36 decimals, their sum divided by the fee token price is the fee.
+	PremiumFeeUSD           cciptypes.BigInt `json:"premiumFeeUSD"`
+	ExecutionCostUSD        cciptypes.BigInt `json:"executionCostUSD"`
+	DataAvailabilityCostUSD cciptypes.BigInt `json:"dataAvailabilityCostUSD"`
+}
+
+// QuoteFee quotes the fee of sending msg to the destination chain of the snapshot, using the fee quoting of the
+// source chain family.
+func QuoteFee(snapshot Snapshot, msg Message) (Quote, error) {
+	family, err := chainsel.GetSelectorFamily(uint64(snapshot.SourceChainSelector))
+	if err != nil {
+		return Quote{}, fmt.Errorf("get family of source chain %d: %w", snapshot.SourceChainSelector, err)
+	}
+
+	switch family {
+	case chainsel.FamilyEVM:
+		return QuoteEVM(snapshot, msg)
+	case chainsel.FamilySolana:
+		return QuoteSVM(snapshot, msg)
+	default:
+		return Quote{}, fmt.Errorf("fee quoting not supported for chain family %s", family)
+	}
+}
+
+// feeComponents are the values the fee is computed from, once the message is validated.
+type feeComponents struct {
+	gasLimit            uint64
+	premiumFeeUSD       *big.Int // USD with 18 decimals
+	transferGas         uint64
+	transferBytes       uint64
+	feeTokenPrice       *big.Int
+	premiumMultiplier   uint64
+	executionGasPrice   *big.Int
+	dataAvailGasPrice   *big.Int
+	applyDataAvailCosts bool
+}
+
+// quote computes the fee of a message from its components, it is shared by all the chain families.
+func quote(cfg cciptypes.FeeQuoterDestChainConfig, msg Message, feeToken cciptypes.UnknownEncodedAddress,
+	c feeComponents) Quote {
+	premium := new(big.Int).Mul(c.premiumFeeUSD, new(big.Int).SetUint64(c.premiumMultiplier))
+
+	dataAvailCost := big.NewInt(0)
+	if c.applyDataAvailCosts {
+		dataAvailCost = dataAvailabilityCost(cfg, c.dataAvailGasPrice, len(msg.Data), len(msg.TokenAmounts),
+			c.transferBytes)
+	}
+
+	destGas := uint64(cfg.DestGasOverhead) + c.transferGas +
+		calldataGas(cfg, uint64(len(msg.Data))+c.transferBytes) + c.gasLimit
+	executionCost := new(big.Int).Mul(new(big.Int).SetUint64(destGas), c.executionGasPrice)
+	executionCost.Mul(executionCost, new(big.Int).SetUint64(cfg.GasMultiplierWeiPerEth))
+
+	fee := new(big.Int).Add(premium, executionCost)
+	fee.Add(fee, dataAvailCost)
+	fee.Div(fee, c.feeTokenPrice)
+
+	return Quote{
+		FeeToken:                feeToken,
+		Fee:                     cciptypes.NewBigInt(fee),
+		GasLimit:                c.gasLimit,
+		DestGas:                 destGas,
+		PremiumFeeUSD:           cciptypes.NewBigInt(premium),
+		ExecutionCostUSD:        cciptypes.NewBigInt(executionCost),
+		DataAvailabilityCostUSD: cciptypes.NewBigInt(dataAvailCost),
+	}
+}
+
+// calldataGas returns the gas charged for the calldata of a message, accounting for EIP-7623: the base rate is
+// charged up to the threshold and the high rate above it.
+func calldataGas(cfg cciptypes.FeeQuoterDestChainConfig, calldataLength uint64) uint64 {
+	threshold := uint64(cfg.DestGasPerPayloadByteThreshold)
+	if calldataLength <= threshold {
+		return calldataLength * uint64(cfg.DestGasPerPayloadByteBase)
+	}
+	return threshold*uint64(cfg.DestGasPerPayloadByteBase) +
+		(calldataLength-threshold)*uint64(cfg.DestGasPerPayloadByteHigh)
+}
+
+// dataAvailabilityCost returns the data availability cost of a message in USD with 36 decimals.
+func dataAvailabilityCost(cfg cciptypes.FeeQuoterDestChainConfig, gasPrice *big.Int, dataLength, numberOfTokens int,
+	transferBytes uint64) *big.Int {
+	lengthBytes := uint64(messageFixedBytes+dataLength+numberOfTokens*messageFixedBytesPerToken) + transferBytes
+	gas := lengthBytes*uint64(cfg.DestGasPerDataAvailabilityByte) + uint64(cfg.DestDataAvailabilityOverheadGas)
+
+	// the gas price has 18 decimals and the multiplier 4, 14 decimals are padded to reach 36 decimals
+	cost := new(big.Int).Mul(new(big.Int).SetUint64(gas), gasPrice)
+	cost.Mul(cost, big.NewInt(int64(cfg.DestDataAvailabilityMultiplierBps)))
+	return cost.Mul(cost, e14)
+}
+
+// tokenTransferFee returns the premium fee of a token transfer with an enabled transfer fee config in USD with
+// 18 decimals, the bps fee of the transferred value is kept within the min and max fees.
+func tokenTransferFee(cfg TokenTransferFeeConfig, amount, price *big.Int) *big.Int {
+	bpsFee := big.NewInt(0)
+	if price != nil {
+		// the ratio represents multiples of 0.1bps, or 1e-5
+		bpsFee.Mul(usdValue(price, amount), big.NewInt(int64(cfg.DeciBps)))
+		bpsFee.Div(bpsFee, e5)
+	}
+
+	if minFee := usdCents(cfg.MinFeeUSDCents); bpsFee.Cmp(minFee) < 0 {
+		return minFee
+	}
+	if maxFee := usdCents(cfg.MaxFeeUSDCents); bpsFee.Cmp(maxFee) > 0 {
+		return maxFee
+	}
+	return bpsFee
+}
+
+// usdValue returns the value in USD with 18 decimals of an amount of tokens with the given price.
+func usdValue(price, amount *big.Int) *big.Int {
+	value := new(big.Int).Mul(price, amount)
+	return value.Div(value, e18)
+}
+
+// usdCents converts an amount of USD cents to USD with 18 decimals.
+func usdCents(cents uint32) *big.Int {
+	return new(big.Int).Mul(big.NewInt(int64(cents)), e16)
+}
+
+// unpackGasPrice splits a packed gas price into its execution and data availability gas prices.
+func unpackGasPrice(packed *big.Int) (executionGasPrice, dataAvailGasPrice *big.Int) {
+	if packed == nil {
+		return big.NewInt(0), big.NewInt(0)
+	}
+	mask := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), gasPriceBits), big.NewInt(1))
+	executionGasPrice = new(big.Int).And(packed, mask)
+	dataAvailGasPrice = new(big.Int).Rsh(packed, gasPriceBits)
+	return executionGasPrice, dataAvailGasPrice.And(dataAvailGasPrice, mask)
+}
+
+// validatedTokenPrice returns the price of a token, a token price must have been set at least once.
+func (s Snapshot) validatedTokenPrice(token cciptypes.UnknownEncodedAddress) (*big.Int, bool) {
+	price, ok := s.TokenPrices[token]
+	if !ok || price.Value.Int == nil || price.Value.Sign() == 0 || price.Timestamp.IsZero() ||
+		price.Timestamp.Unix() == 0 {
+		return nil, false
+	}
+	return price.Value.Int, true
+}
+
+// gasPriceAge returns the number of seconds since the gas price was last updated.
+func (s Snapshot) gasPriceAge() int64 {
+	return s.Timestamp.Unix() - s.GasPrice.Timestamp.Unix()
+}
+
+// amountOf returns the amount of a token transfer, treating an unset amount as zero.
+func amountOf(tokenAmount TokenAmount) *big.Int {
+	if tokenAmount.Amount.Int == nil {
+		return big.NewInt(0)
+	}
+	return tokenAmount.Amount.Int
+}
@@ -0,0 +1,258 @@
+package feequoter
+
+import (
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+)
+
+// conformanceCase is a fee quoted for a message and a snapshot of the FeeQuoter state. The evm cases are Router.getFee
+// eth_calls captured by core/scripts/ccip/fee-quoter-capture of chainlink, with the block, Router and call data of the
+// eth_call, and the fee or the revert it returned. The svm cases are the fees and errors asserted by the unit tests
+// of the fee-quoter program.
+type conformanceCase struct {
+	Name        string          `json:"name"`
+	Source      string          `json:"source"`
+	BlockNumber uint64          `json:"blockNumber"`
+	Router      string          `json:"router"`
+	CallData    cciptypes.Bytes `json:"callData"`
+	Snapshot    Snapshot        `json:"snapshot"`
+	Message     Message         `json:"message"`
+	Fee         string          `json:"fee"`
+	Revert      string          `json:"revert"`
+	Error       string          `json:"error"`
+}
+
+// conformanceFixture is a file of conformance cases. The header of the evm cases records the directory, the command
+// and the flags of the core/scripts/ccip/fee-quoter-capture run that captured them.
+type conformanceFixture struct {
+	Capture *struct {
+		Directory  string            `json:"directory"`
+		Command    string            `json:"command"`
+		Parameters map[string]string `json:"parameters"`
+	} `json:"capture"`
+	Cases []conformanceCase `json:"cases"`
+}
+
+// revertErrors are the errors of the FeeQuoter custom errors getFee reverts with.
+var revertErrors = map[string]error{
+	"DestinationChainNotEnabled":            ErrDestChainNotEnabled,
+	"FeeTokenNotSupported":                  ErrFeeTokenNotSupported,
+	"TokenNotSupported":                     ErrTokenNotSupported,
+	"StaleGasPrice":                         ErrStaleGasPrice,
+	"MessageTooLarge":                       ErrMessageTooLarge,
+	"UnsupportedNumberOfTokens":             ErrUnsupportedNumberOfTokens,
+	"MessageGasLimitTooHigh":                ErrMessageGasLimitTooHigh,
+	"ExtraArgOutOfOrderExecutionMustBeTrue": ErrOutOfOrderExecutionRequired,
+	"InvalidExtraArgsTag":                   ErrInvalidExtraArgs,
+	"InvalidExtraArgsData":                  ErrInvalidExtraArgs,
+	"TooManySVMExtraArgsAccounts":           ErrInvalidExtraArgs,
+	"InvalidSVMExtraArgsWritableBitmap":     ErrInvalidExtraArgs,
+	"InvalidEVMAddress":                     ErrInvalidReceiver,
+	"Invalid32ByteAddress":                  ErrInvalidReceiver,
+	"InvalidTokenReceiver":                  ErrInvalidTokenReceiver,
+}
+
+func TestQuoteFee_Conformance(t *testing.T) {
+	for _, family := range []string{"evm", "svm"} {
+		t.Run(family, func(t *testing.T) {
+			b, err := os.ReadFile(filepath.Join("testdata", family+".json"))
+			require.NoError(t, err)
+			var fixture conformanceFixture
+			require.NoError(t, json.Unmarshal(b, &fixture))
+			require.NotEmpty(t, fixture.Cases)
+			if family == "evm" {
+				require.NotNil(t, fixture.Capture, "the evm cases must record how they were captured")
+				require.NotEmpty(t, fixture.Capture.Command)
+			}
+
+			for _, tc := range fixture.Cases {
+				t.Run(tc.Name, func(t *testing.T) {
+					if family == "evm" {
+						// the message and the destination chain of the case are the ones of the eth_call
+						assert.Equal(t, tc.CallData, getFeeCallData(t, tc.Snapshot.DestChainSelector, tc.Message))
+					}
+
+					q, err := QuoteFee(tc.Snapshot, tc.Message)
+					switch {
+					case tc.Revert != "":
+						name, _, _ := strings.Cut(tc.Revert, "(")
+						require.Contains(t, revertErrors, name, tc.Revert)
+						require.ErrorIs(t, err, revertErrors[name], tc.Revert)
+					case tc.Error != "":
+						require.ErrorContains(t, err, tc.Error, tc.Source)
+					default:
+						require.NoError(t, err, tc.Source)
+						assert.Equal(t, tc.Fee, q.Fee.String(), tc.Source)
+					}
+				})
+			}
+		})
+	}
+}
+
+// getFeeCallData returns the call data of Router.getFee(uint64,(bytes,bytes,(address,uint256)[],address,bytes)).
+func getFeeCallData(t *testing.T, dest cciptypes.ChainSelector, msg Message) cciptypes.Bytes {
+	uint64Type, err := abi.NewType("uint64", "", nil)
+	require.NoError(t, err)
+	messageType, err := abi.NewType("tuple", "", []abi.ArgumentMarshaling{
+		{Name: "receiver", Type: "bytes"},
+		{Name: "data", Type: "bytes"},
+		{Name: "tokenAmounts", Type: "tuple[]", Components: []abi.ArgumentMarshaling{
+			{Name: "token", Type: "address"},
+			{Name: "amount", Type: "uint256"},
+		}},
+		{Name: "feeToken", Type: "address"},
+		{Name: "extraArgs", Type: "bytes"},
+	})
+	require.NoError(t, err)
+
+	type tokenAmount struct {
+		Token  common.Address
+		Amount *big.Int
+	}
+	message := struct {
+		Receiver     []byte
+		Data         []byte
+		TokenAmounts []tokenAmount
+		FeeToken     common.Address
+		ExtraArgs    []byte
+	}{
+		Receiver:     msg.Receiver,
+		Data:         msg.Data,
+		TokenAmounts: []tokenAmount{},
+		ExtraArgs:    msg.ExtraArgs,
+	}
+	if msg.FeeToken != "" {
+		message.FeeToken = common.HexToAddress(string(msg.FeeToken))
+	}
+	for _, ta := range msg.TokenAmounts {
+		message.TokenAmounts = append(message.TokenAmounts, tokenAmount{
+			Token:  common.HexToAddress(string(ta.Token)),
+			Amount: ta.Amount.Int,
+		})
+	}
+
+	args, err := abi.Arguments{{Type: uint64Type}, {Type: messageType}}.Pack(uint64(dest), message)
+	require.NoError(t, err)
+	return append(crypto.Keccak256(
+		[]byte("getFee(uint64,(bytes,bytes,(address,uint256)[],address,bytes))"))[:4], args...)
+}
+
+func TestQuoteFee_Components(t *testing.T) {
+	snapshot := Snapshot{
+		SourceChainSelector: 5009297550715157269, // ethereum mainnet
+		DestChainSelector:   1,
+		Timestamp:           time.Unix(1000, 0),
+		DestChainConfig: cciptypes.FeeQuoterDestChainConfig{
+			IsEnabled:                         true,
+			MaxNumberOfTokensPerMsg:           1,
+			MaxDataBytes:                      1000,
+			MaxPerMsgGasLimit:                 1_000_000,
+			DestGasOverhead:                   100,
+			DestGasPerPayloadByteBase:         2,
+			DestGasPerPayloadByteHigh:         5,
+			DestGasPerPayloadByteThreshold:    10,
+			DestDataAvailabilityOverheadGas:   10,
+			DestGasPerDataAvailabilityByte:    1,
+			DestDataAvailabilityMultiplierBps: 10_000,
+			DefaultTxGasLimit:                 1000,
+			GasMultiplierWeiPerEth:            1e18,
+			NetworkFeeUSDCents:                1,
+			ChainFamilySelector:               ChainFamilySelectorEVM,
+		},
+		// execution gas price of 1 and data availability gas price of 2
+		GasPrice: cciptypes.TimestampedBig{
+			Timestamp: time.Unix(1000, 0),
+			Value:     cciptypes.NewBigInt(new(big.Int).Or(new(big.Int).Lsh(big.NewInt(2), 112), big.NewInt(1))),
+		},
+		TokenPrices: map[cciptypes.UnknownEncodedAddress]cciptypes.TimestampedBig{
+			"link": {Timestamp: time.Unix(1000, 0), Value: cciptypes.NewBigIntFromInt64(1e18)},
+		},
+		FeeTokens: map[cciptypes.UnknownEncodedAddress]FeeTokenConfig{
+			"link": {PremiumMultiplierWeiPerEth: 2e18},
+		},
+	}
+	receiver := make([]byte, 32)
+	receiver[12] = 1
+
+	q, err := QuoteFee(snapshot, Message{Receiver: receiver, Data: make([]byte, 20), FeeToken: "link"})
+	require.NoError(t, err)
+
+	// 100 overhead + 10 * 2 + 10 * 5 calldata + 1000 gas limit
+	assert.Equal(t, uint64(1000), q.GasLimit)
+	assert.Equal(t, uint64(1170), q.DestGas)
+	assert.Equal(t, "1170000000000000000000", q.ExecutionCostUSD.String())
+	// 1 cent with the premium multiplier of 2
+	assert.Equal(t, "20000000000000000000000000000000000", q.PremiumFeeUSD.String())
+	// ((480 fixed + 20 data) bytes * 1 gas + 10 overhead gas) * 2 gas price * 1e4 bps * 1e14
+	assert.Equal(t, "1020000000000000000000", q.DataAvailabilityCostUSD.String())
+	assert.Equal(t, "20000000000002190", q.Fee.String())
+	assert.Equal(t, cciptypes.UnknownEncodedAddress("link"), q.FeeToken)
+
+	_, err = QuoteFee(Snapshot{SourceChainSelector: 1}, Message{})
+	require.Error(t, err)
+}
+
+func TestDecodeEVMSVMExtraArgs(t *testing.T) {
+	args := svmExtraArgs{
+		ComputeUnits:             5000,
+		AccountIsWritableBitmap:  2,
+		AllowOutOfOrderExecution: true,
+		TokenReceiver:            [32]byte{1},
+		Accounts:                 [][32]byte{{2}, {3}},
+	}
+	encoded, err := svmExtraArgsV1Args.Pack(args)
+	require.NoError(t, err)
+
+	decoded, err := decodeEVMSVMExtraArgs(append(svmExtraArgsV1Tag[:], encoded...))
+	require.NoError(t, err)
+	assert.Equal(t, args, decoded)
+
+	_, err = decodeEVMSVMExtraArgs(append(svmExtraArgsV1Tag[:], encoded[:64]...))
+	require.ErrorIs(t, err, ErrInvalidExtraArgs)
+}
+
+func TestDecodeSVMExtraArgs(t *testing.T) {
+	cfg := cciptypes.FeeQuoterDestChainConfig{DefaultTxGasLimit: 200, ChainFamilySelector: ChainFamilySelectorEVM}
+
+	args, err := decodeSVMExtraArgs(cfg, nil, false)
+	require.NoError(t, err)
+	assert.Equal(t, genericExtraArgs{GasLimit: big.NewInt(200)}, args)
+
+	// gas limit of 2^64 encoded as a little endian u128, followed by allow out of order execution
+	generic := append(genericExtraArgsV2Tag[:], 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 1)
+	args, err = decodeSVMExtraArgs(cfg, generic, false)
+	require.NoError(t, err)
+	assert.Equal(t, new(big.Int).Lsh(big.NewInt(1), 64), args.GasLimit)
+	assert.True(t, args.AllowOutOfOrderExecution)
+
+	_, err = decodeSVMExtraArgs(cfg, generic[:12], false)
+	require.ErrorIs(t, err, ErrInvalidExtraArgs)
+
+	cfg.ChainFamilySelector = ChainFamilySelectorSVM
+	svm := append(svmExtraArgsV1Tag[:], 10, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1)
+	svm = append(svm, make([]byte, 32)...)
+	// two accounts with the data of a single one
+	svm = append(svm, 2, 0, 0, 0)
+	svm = append(svm, make([]byte, 32)...)
+	_, err = decodeSVMExtraArgs(cfg, svm, false)
+	require.ErrorContains(t, err, "exceed the data left")
+
+	svm = append(svm, make([]byte, 32)...)
+	args, err = decodeSVMExtraArgs(cfg, svm, false)
+	require.NoError(t, err)
+	assert.Equal(t, genericExtraArgs{GasLimit: big.NewInt(10), AllowOutOfOrderExecution: true}, args)
+}
@@ -0,0 +1,286 @@
+package feequoter
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+)
+
+// wrappedSOLMint is the fee token of SVM messages without one.
+const wrappedSOLMint = cciptypes.UnknownEncodedAddress("So11111111111111111111111111111111111111112")
+
+// QuoteSVM quotes the fee of sending msg from an SVM source chain, reproducing get_fee of the fee-quoter program.
+// Token amounts and fees are bounded to u64 on SVM.
+func QuoteSVM(snapshot Snapshot, msg Message) (Quote, error) {
+	cfg := snapshot.DestChainConfig
+
+	feeToken := msg.FeeToken
+	if feeToken == "" {
+		feeToken = snapshot.WrappedNative
+	}
+	if feeToken == "" {
+		feeToken = wrappedSOLMint
+	}
+
+	if !cfg.IsEnabled {
+		return Quote{}, fmt.Errorf("%w: %d", ErrDestChainNotEnabled, snapshot.DestChainSelector)
+	}
+	feeTokenConfig, ok := snapshot.FeeTokens[feeToken]
+	if !ok {
+		return Quote{}, fmt.Errorf("%w: %s", ErrFeeTokenNotSupported, feeToken)
+	}
+
+	gasLimit, err := validateSVMMessage(cfg, msg)
+	if err != nil {
+		return Quote{}, err
+	}
+
+	feeTokenPrice, ok := snapshot.validatedTokenPrice(feeToken)
+	if !ok {
+		return Quote{}, fmt.Errorf("%w: %s", ErrTokenNotSupported, feeToken)
+	}
+	// unlike on EVM, a gas price as old as the staleness threshold is already stale
+	if threshold := int64(cfg.GasPriceStalenessThreshold); threshold != 0 && snapshot.gasPriceAge() >= threshold {
+		return Quote{}, fmt.Errorf("%w: %d seconds old, threshold %d", ErrStaleGasPrice, snapshot.gasPriceAge(),
+			threshold)
+	}
+	executionGasPrice, dataAvailGasPrice := unpackGasPrice(snapshot.GasPrice.Value.Int)
+
+	c := feeComponents{
+		gasLimit:            gasLimit,
+		premiumFeeUSD:       usdCents(cfg.NetworkFeeUSDCents),
+		feeTokenPrice:       feeTokenPrice,
+		premiumMultiplier:   feeTokenConfig.PremiumMultiplierWeiPerEth,
+		executionGasPrice:   executionGasPrice,
+		dataAvailGasPrice:   dataAvailGasPrice,
+		applyDataAvailCosts: true,
+	}
+	if len(msg.TokenAmounts) > 0 {
+		c.premiumFeeUSD, c.transferGas, c.transferBytes, err = svmTokenTransferCost(snapshot, msg.TokenAmounts)
+		if err != nil {
+			return Quote{}, err
+		}
+	}
+
+	q := quote(cfg, msg, feeToken, c)
+	if !q.Fee.IsUint64() {
+		return Quote{}, fmt.Errorf("%w: fee %s overflows u64", ErrInvalidTokenPrice, q.Fee)
+	}
+	return q, nil
+}
+
+// svmTokenTransferCost returns the premium fee in USD with 18 decimals, the gas and the bytes overhead of the token
+// transfers of a message, see network_fee of the fee-quoter program.
+func svmTokenTransferCost(snapshot Snapshot, tokenAmounts []TokenAmount) (*big.Int, uint64, uint64, error) {
+	cfg := snapshot.DestChainConfig
+	fee := big.NewInt(0)
+	var gas, bytesOverhead uint64
+
+	for _, tokenAmount := range tokenAmounts {
+		transferFeeConfig := snapshot.TokenTransferFeeConfigs[tokenAmount.Token]
+		if !transferFeeConfig.IsEnabled {
+			fee.Add(fee, usdCents(uint32(cfg.DefaultTokenFeeUSDCents)))
+			gas += uint64(cfg.DefaultTokenDestGasOverhead)
+			bytesOverhead += lockOrBurnRetBytes
+			continue
+		}
+		if transferFeeConfig.MinFeeUSDCents > transferFeeConfig.MaxFeeUSDCents {
+			return nil, 0, 0, fmt.Errorf("invalid transfer fee config of token %s: min fee above max fee",
+				tokenAmount.Token)
+		}
+
+		// tokens without a billing config are charged without bps fee as their price is unknown
+		var price *big.Int
+		if _, hasBillingConfig := snapshot.TokenPrices[tokenAmount.Token]; hasBillingConfig &&
+			transferFeeConfig.DeciBps > 0 {
+			var ok bool
+			if price, ok = snapshot.validatedTokenPrice(tokenAmount.Token); !ok {
+				return nil, 0, 0, fmt.Errorf("%w: %s", ErrTokenNotSupported, tokenAmount.Token)
+			}
+		}
+
+		fee.Add(fee, tokenTransferFee(transferFeeConfig, amountOf(tokenAmount), price))
+		gas += uint64(transferFeeConfig.DestGasOverhead)
+		bytesOverhead += uint64(transferFeeConfig.DestBytesOverhead)
+	}
+
+	return fee, gas, bytesOverhead, nil
+}
+
+// validateSVMMessage validates msg against the destination chain config and returns its gas limit,
+// see validate_svm2any of the fee-quoter program.
+func validateSVMMessage(cfg cciptypes.FeeQuoterDestChainConfig, msg Message) (uint64, error) {
+	if len(msg.Data) > int(cfg.MaxDataBytes) {
+		return 0, fmt.Errorf("%w: %d bytes, max %d", ErrMessageTooLarge, len(msg.Data), cfg.MaxDataBytes)
+	}
+	if len(msg.TokenAmounts) > int(cfg.MaxNumberOfTokensPerMsg) {
+		return 0, fmt.Errorf("%w: %d tokens, max %d", ErrUnsupportedNumberOfTokens, len(msg.TokenAmounts),
+			cfg.MaxNumberOfTokensPerMsg)
+	}
+	for _, tokenAmount := range msg.TokenAmounts {
+		if !amountOf(tokenAmount).IsUint64() {
+			return 0, fmt.Errorf("amount %s of token %s overflows u64", amountOf(tokenAmount), tokenAmount.Token)
+		}
+	}
+
+	args, err := decodeSVMExtraArgs(cfg, msg.ExtraArgs, len(msg.TokenAmounts) > 0)
+	if err != nil {
+		return 0, err
+	}
+	if args.GasLimit.Cmp(big.NewInt(int64(cfg.MaxPerMsgGasLimit))) > 0 {
+		return 0, fmt.Errorf("%w: %s, max %d", ErrMessageGasLimitTooHigh, args.GasLimit, cfg.MaxPerMsgGasLimit)
+	}
+	if cfg.EnforceOutOfOrder && !args.AllowOutOfOrderExecution {
+		return 0, ErrOutOfOrderExecutionRequired
+	}
+
+	gasLimit := args.GasLimit.Uint64()
+	if len(msg.Receiver) != 32 {
+		return 0, fmt.Errorf("%w: %x", ErrInvalidReceiver, msg.Receiver)
+	}
+	switch cfg.ChainFamilySelector {
+	case ChainFamilySelectorEVM:
+		receiver := new(big.Int).SetBytes(msg.Receiver)
+		if receiver.Cmp(uint160Max) > 0 || receiver.Cmp(big.NewInt(evmPrecompileSpace)) < 0 {
+			return 0, fmt.Errorf("%w: %x", ErrInvalidReceiver, msg.Receiver)
+		}
+	case ChainFamilySelectorSVM:
+		if gasLimit > 0 && msg.Receiver.IsZeroOrEmpty() {
+			return 0, fmt.Errorf("%w: %x", ErrInvalidReceiver, msg.Receiver)
+		}
+	default:
+		return 0, fmt.Errorf("%w: %x", ErrInvalidChainFamilySelector, cfg.ChainFamilySelector)
+	}
+	return gasLimit, nil
+}
+
+// decodeSVMExtraArgs decodes borsh encoded extra args, SVMExtraArgsV1 for SVM destinations and GenericExtraArgsV2
+// for the other destinations. See process_extra_args of the fee-quoter program.
+func decodeSVMExtraArgs(cfg cciptypes.FeeQuoterDestChainConfig, extraArgs []byte,
+	hasTokens bool) (genericExtraArgs, error) {
+	if cfg.ChainFamilySelector != ChainFamilySelectorSVM {
+		// extra args are optional for non SVM destinations
+		if len(extraArgs) < 4 {
+			return genericExtraArgs{GasLimit: big.NewInt(int64(cfg.DefaultTxGasLimit))}, nil
+		}
+		if tag := extraArgs[:4]; !bytes.Equal(tag, genericExtraArgsV2Tag[:]) {
+			return genericExtraArgs{}, fmt.Errorf("%w: unknown tag %x", ErrInvalidExtraArgs, tag)
+		}
+
+		d := borshDecoder{data: extraArgs[4:]}
+		if len(d.data) == 0 {
+			return genericExtraArgs{}, fmt.Errorf("%w: missing data after tag", ErrInvalidExtraArgs)
+		}
+		args := genericExtraArgs{GasLimit: d.u128(), AllowOutOfOrderExecution: d.bool()}
+		if d.err != nil {
+			return genericExtraArgs{}, fmt.Errorf("%w: decode GenericExtraArgsV2: %w", ErrInvalidExtraArgs, d.err)
+		}
+		return args, nil
+	}
+
+	// extra args are mandatory for SVM destinations
+	if len(extraArgs) < 4 {
+		return genericExtraArgs{}, fmt.Errorf("%w: SVMExtraArgsV1 are required", ErrInvalidExtraArgs)
+	}
+	if tag := extraArgs[:4]; !bytes.Equal(tag, svmExtraArgsV1Tag[:]) {
+		return genericExtraArgs{}, fmt.Errorf("%w: unknown tag %x", ErrInvalidExtraArgs, tag)
+	}
+
+	args := svmExtraArgs{ComputeUnits: cfg.DefaultTxGasLimit}
+	if d := (borshDecoder{data: extraArgs[4:]}); len(d.data) > 0 {
+		args = d.svmExtraArgs()
+		if d.err != nil {
+			return genericExtraArgs{}, fmt.Errorf("%w: decode SVMExtraArgsV1: %w", ErrInvalidExtraArgs, d.err)
+		}
+		if len(args.Accounts) > svmExtraArgsMaxAccounts {
+			return genericExtraArgs{}, fmt.Errorf("%w: %d accounts, max %d", ErrInvalidExtraArgs,
+				len(args.Accounts), svmExtraArgsMaxAccounts)
+		}
+		if args.AccountIsWritableBitmap>>len(args.Accounts) != 0 {
+			return genericExtraArgs{}, fmt.Errorf("%w: writable bitmap %b for %d accounts", ErrInvalidExtraArgs,
+				args.AccountIsWritableBitmap, len(args.Accounts))
+		}
+	}
+
+	// the token receiver must be set if and only if tokens are transferred
+	if hasTokens == (args.TokenReceiver == [32]byte{}) {
+		return genericExtraArgs{}, ErrInvalidTokenReceiver
+	}
+	return genericExtraArgs{
+		GasLimit:                 big.NewInt(int64(args.ComputeUnits)),
+		AllowOutOfOrderExecution: args.AllowOutOfOrderExecution,
+	}, nil
+}
+
+// borshDecoder decodes the borsh encoded fields of extra args, the first error is kept and stops the decoding.
+type borshDecoder struct {
+	data []byte
+	err  error
+}
+
+func (d *borshDecoder) next(n int) []byte {
+	if d.err != nil {
+		return make([]byte, n)
+	}
+	if len(d.data) < n {
+		d.err = fmt.Errorf("unexpected end of data, %d bytes left, %d needed", len(d.data), n)
+		return make([]byte, n)
+	}
+	b := d.data[:n]
+	d.data = d.data[n:]
+	return b
+}
+
+func (d *borshDecoder) u32() uint32 {
+	return binary.LittleEndian.Uint32(d.next(4))
+}
+
+func (d *borshDecoder) u64() uint64 {
+	return binary.LittleEndian.Uint64(d.next(8))
+}
+
+func (d *borshDecoder) u128() *big.Int {
+	le := d.next(16)
+	be := make([]byte, len(le))
+	for i := range le {
+		be[len(le)-1-i] = le[i]
+	}
+	return new(big.Int).SetBytes(be)
+}
+
+func (d *borshDecoder) bool() bool {
+	b := d.next(1)[0]
+	if b > 1 && d.err == nil {
+		d.err = fmt.Errorf("invalid bool %d", b)
+	}
+	return b == 1
+}
+
+func (d *borshDecoder) bytes32() [32]byte {
+	return [32]byte(d.next(32))
+}
+
+func (d *borshDecoder) svmExtraArgs() svmExtraArgs {
+	args := svmExtraArgs{
+		ComputeUnits:             d.u32(),
+		AccountIsWritableBitmap:  d.u64(),
+		AllowOutOfOrderExecution: d.bool(),
+		TokenReceiver:            d.bytes32(),
+	}
+	n := d.u32()
+	// every account takes 32 bytes, a length beyond the data left is invalid
+	if d.err == nil && uint64(n)*32 > uint64(len(d.data)) {
+		d.err = fmt.Errorf("%d accounts exceed the data left", n)
+	}
+	if d.err != nil {
+		return args
+	}
+	args.Accounts = make([][32]byte, n)
+	for i := range args.Accounts {
+		args.Accounts[i] = d.bytes32()
+	}
+	return args
+}
@@ -0,0 +1,66 @@
+package lanemonitor
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink-ccip/internal/plugincommon"
+	"github.com/smartcontractkit/chainlink-ccip/internal/plugincommon/discovery"
+	dt "github.com/smartcontractkit/chainlink-ccip/internal/plugincommon/discovery/discoverytypes"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/consts"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/reader"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+)
+
+// DiscoverSources discovers the contracts of the lanes of the destination chain with the contract discovery
+// processor of the plugins, binds them to the reader and returns the source chains with an onRamp on the offRamp,
+// sorted. The reader is the only observer, its observation is trusted without consensus.
+func DiscoverSources(
+	ctx context.Context,
+	lggr logger.Logger,
+	homeChain reader.HomeChain,
+	ccipReader reader.CCIPReader,
+	dest cciptypes.ChainSelector,
+) ([]cciptypes.ChainSelector, error) {
+	processor := discovery.NewContractDiscoveryProcessor(
+		lggr, &ccipReader, homeChain, dest, 0, nil, plugincommon.NoopReporter{})
+
+	obs, err := processor.Observation(ctx, dt.Outcome{}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("observe contracts: %w", err)
+	}
+	// A single observation reaches consensus when every chain is assumed to have no faulty node.
+	fChain := make(map[cciptypes.ChainSelector]int, len(obs.FChain))
+	for chain := range obs.FChain {
+		fChain[chain] = 0
+	}
+	obs.FChain = fChain
+
+	// The outcome binds the contracts to the reader, it logs sync errors instead of returning them.
+	_, err = processor.Outcome(ctx, dt.Outcome{}, nil,
+		[]plugincommon.AttributedObservation[dt.Observation]{{Observation: obs}})
+	if err != nil {
+		return nil, fmt.Errorf("sync contracts: %w", err)
+	}
+
+	sources := make([]cciptypes.ChainSelector, 0, len(obs.Addresses[consts.ContractNameOnRamp]))
+	for source, onRamp := range obs.Addresses[consts.ContractNameOnRamp] {
+		if source != dest && !isZero(onRamp) {
+			sources = append(sources, source)
+		}
+	}
+	slices.Sort(sources)
+	return sources, nil
+}
+
+func isZero(addr cciptypes.UnknownAddress) bool {
+	for _, b := range addr {
+		if b != 0 {
+			return false
+		}
+	}
+	return true
+}
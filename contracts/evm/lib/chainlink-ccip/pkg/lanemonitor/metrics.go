@@ -0,0 +1,106 @@
+package lanemonitor
+
+import (
+	"strconv"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+)
+
+var (
+	laneLabels = []string{"sourceChainSelector", "destChainSelector"}
+
+	promCommitLag = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "ccip_lane_monitor_commit_lag",
+			Help: "This metric tracks the number of finalized messages of a lane not committed yet",
+		},
+		laneLabels,
+	)
+	promExecLag = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "ccip_lane_monitor_exec_lag",
+			Help: "This metric tracks the number of committed messages of a lane not executed yet",
+		},
+		laneLabels,
+	)
+	promOldestPendingAge = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "ccip_lane_monitor_oldest_pending_age_seconds",
+			Help: "This metric tracks the age of the oldest committed message of a lane not executed yet",
+		},
+		laneLabels,
+	)
+	promCursed = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "ccip_lane_monitor_cursed",
+			Help: "This metric is 1 when the lane is cursed by RMN, 0 otherwise",
+		},
+		laneLabels,
+	)
+	promAlerts = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "ccip_lane_monitor_alert",
+			Help: "This metric is 1 when the threshold of the alert is exceeded on the lane, 0 otherwise",
+		},
+		append(laneLabels, "alert"),
+	)
+	promErrors = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "ccip_lane_monitor_errors",
+			Help: "This metric tracks the number of failed checks of the lanes of a destination chain",
+		},
+		[]string{"destChainSelector"},
+	)
+)
+
+type metrics struct {
+	commitLag        *prometheus.GaugeVec
+	execLag          *prometheus.GaugeVec
+	oldestPendingAge *prometheus.GaugeVec
+	cursed           *prometheus.GaugeVec
+	alerts           *prometheus.GaugeVec
+	errors           *prometheus.CounterVec
+}
+
+func newMetrics() *metrics {
+	return &metrics{
+		commitLag:        promCommitLag,
+		execLag:          promExecLag,
+		oldestPendingAge: promOldestPendingAge,
+		cursed:           promCursed,
+		alerts:           promAlerts,
+		errors:           promErrors,
+	}
+}
+
+func (m *metrics) trackLane(status LaneStatus) {
+	source := strconv.FormatUint(uint64(status.SourceChainSelector), 10)
+	dest := strconv.FormatUint(uint64(status.DestChainSelector), 10)
+
+	m.commitLag.WithLabelValues(source, dest).Set(float64(status.CommitLag))
+	m.execLag.WithLabelValues(source, dest).Set(float64(status.ExecLag))
+	m.oldestPendingAge.WithLabelValues(source, dest).Set(status.OldestPendingAge().Seconds())
+	m.cursed.WithLabelValues(source, dest).Set(boolToFloat(status.Cursed))
+
+	raised := make(map[Alert]bool, len(status.Alerts))
+	for _, alert := range status.Alerts {
+		raised[alert] = true
+	}
+	for _, alert := range []Alert{AlertCommitLag, AlertExecLag, AlertOldestPendingAge, AlertCursed} {
+		m.alerts.WithLabelValues(source, dest, string(alert)).Set(boolToFloat(raised[alert]))
+	}
+}
+
+func (m *metrics) trackError(dest cciptypes.ChainSelector) {
+	m.errors.WithLabelValues(strconv.FormatUint(uint64(dest), 10)).Inc()
+}
+
+func boolToFloat(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,512 @@
+// Package lanemonitor monitors the health of the CCIP lanes of one or more destination chains, outside the OCR
+// plugins. It compares the messages sent on the source chains with the messages committed and executed on the
+// destination chains and alerts when a lane lags behind or is cursed.
+package lanemonitor
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"golang.org/x/exp/maps"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	"github.com/smartcontractkit/chainlink-common/pkg/types/query/primitives"
+
+	"github.com/smartcontractkit/chainlink-ccip/pkg/reader"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+)
+
+const (
+	defaultInterval          = time.Minute
+	defaultDiscoveryInterval = 10 * time.Minute
+	// defaultExecScanLimit is the number of committed messages scanned for execution when the monitor starts,
+	// older messages are assumed to be executed.
+	defaultExecScanLimit = 1000
+)
+
+// Alert is raised on a lane when one of the thresholds of the monitor is exceeded.
+type Alert string
+
+const (
+	AlertCommitLag        Alert = "commit_lag"
+	AlertExecLag          Alert = "exec_lag"
+	AlertOldestPendingAge Alert = "oldest_pending_age"
+	AlertCursed           Alert = "cursed"
+)
+
+// Thresholds above which alerts are raised, a zero threshold disables its alert.
+type Thresholds struct {
+	// CommitLag is the number of finalized messages not committed yet.
+	CommitLag uint64
+	// ExecLag is the number of committed messages not executed yet.
+	ExecLag uint64
+	// OldestPendingAge is the age of the oldest committed message not executed yet.
+	OldestPendingAge time.Duration
+	// Cursed raises an alert when the lane is cursed.
+	Cursed bool
+}
+
+type Config struct {
+	// Interval between two checks of the lanes, defaults to 1 minute.
+	Interval time.Duration
+	// DiscoveryInterval between two discoveries of the lanes of a destination chain, defaults to 10 minutes.
+	DiscoveryInterval time.Duration
+	// ExecScanLimit is the number of committed messages scanned for execution when a lane is first checked,
+	// defaults to 1000.
+	ExecScanLimit uint64
+	Thresholds    Thresholds
+}
+
+// LaneStatus is the health of a lane at UpdatedAt.
+type LaneStatus struct {
+	SourceChainSelector cciptypes.ChainSelector `json:"sourceChainSelector"`
+	DestChainSelector   cciptypes.ChainSelector `json:"destChainSelector"`
+	// LatestSeqNum is the latest finalized message sent on the source chain.
+	LatestSeqNum cciptypes.SeqNum `json:"latestSeqNum"`
+	// NextCommitSeqNum is the next message expected to be committed by the offRamp.
+	NextCommitSeqNum cciptypes.SeqNum `json:"nextCommitSeqNum"`
+	// NextExecSeqNum is the oldest committed message not executed yet, or NextCommitSeqNum when all the
+	// committed messages are executed.
+	NextExecSeqNum cciptypes.SeqNum `json:"nextExecSeqNum"`
+	CommitLag      uint64           `json:"commitLag"`
+	ExecLag        uint64           `json:"execLag"`
+	// OldestPendingSentAt is when the oldest committed message not executed yet was sent.
+	OldestPendingSentAt *time.Time `json:"oldestPendingSentAt,omitempty"`
+	// OldestPendingReasons explain why the oldest committed message is not executed yet.
+	OldestPendingReasons []reader.MessagePendingReason `json:"oldestPendingReasons,omitempty"`
+	Cursed               bool                          `json:"cursed"`
+	Alerts               []Alert                       `json:"alerts,omitempty"`
+	UpdatedAt            time.Time                     `json:"updatedAt"`
+	// Error is set when the lane could not be checked, the other fields are the ones of the last successful check.
+	Error string `json:"error,omitempty"`
+}
+
+// OldestPendingAge returns the age of the oldest committed message not executed yet at UpdatedAt.
+func (s LaneStatus) OldestPendingAge() time.Duration {
+	if s.OldestPendingSentAt == nil {
+		return 0
+	}
+	return s.UpdatedAt.Sub(*s.OldestPendingSentAt)
+}
+
+type lane struct {
+	source cciptypes.ChainSelector
+	dest   cciptypes.ChainSelector
+}
+
+// destination is a destination chain monitored through its CCIPReader, the home chain is used to discover its lanes.
+type destination struct {
+	homeChain     reader.HomeChain
+	reader        reader.CCIPReader
+	sources       []cciptypes.ChainSelector
+	lastDiscovery time.Time
+}
+
+// Monitor periodically checks the lanes of the destination chains. It is a http.Handler serving the status of
+// the lanes as JSON.
+type Monitor struct {
+	services.StateMachine
+
+	lggr    logger.Logger
+	cfg     Config
+	metrics *metrics
+	now     func() time.Time
+
+	// checkMu serializes the checks, which update the destinations.
+	checkMu sync.Mutex
+
+	// destinations are the readers added for each destination chain, the last one added is used.
+	destMu       sync.Mutex
+	destinations map[cciptypes.ChainSelector][]*destination
+
+	mu       sync.RWMutex
+	statuses map[lane]LaneStatus
+
+	stopChan services.StopChan
+	wg       sync.WaitGroup
+}
+
+var _ services.Service = (*Monitor)(nil)
+var _ http.Handler = (*Monitor)(nil)
+
+// NewMonitor creates a monitor without destination chains, they are added with AddDestination.
+func NewMonitor(lggr logger.Logger, cfg Config) *Monitor {
+	if cfg.Interval == 0 {
+		cfg.Interval = defaultInterval
+	}
+	if cfg.DiscoveryInterval == 0 {
+		cfg.DiscoveryInterval = defaultDiscoveryInterval
+	}
+	if cfg.ExecScanLimit == 0 {
+		cfg.ExecScanLimit = defaultExecScanLimit
+	}
+
+	return &Monitor{
+		lggr:         lggr,
+		cfg:          cfg,
+		metrics:      newMetrics(),
+		now:          time.Now,
+		destinations: make(map[cciptypes.ChainSelector][]*destination),
+		statuses:     make(map[lane]LaneStatus),
+		stopChan:     make(services.StopChan),
+	}
+}
+
+// AddDestination monitors the lanes of the destination chain through the reader, which must be able to read the
+// destination chain and its source chains. The lanes of a destination chain added several times, e.g. by the
+// active and candidate plugin instances, are checked through the reader added last. The returned function removes
+// the reader, the destination chain isn't monitored anymore once all its readers are removed.
+func (m *Monitor) AddDestination(
+	dest cciptypes.ChainSelector,
+	homeChain reader.HomeChain,
+	ccipReader reader.CCIPReader,
+) (remove func()) {
+	d := &destination{homeChain: homeChain, reader: ccipReader}
+	m.destMu.Lock()
+	m.destinations[dest] = append(m.destinations[dest], d)
+	m.destMu.Unlock()
+	m.lggr.Infow("monitoring the lanes of a destination chain", "dest", dest)
+
+	var once sync.Once
+	return func() { once.Do(func() { m.removeDestination(dest, d) }) }
+}
+
+func (m *Monitor) removeDestination(dest cciptypes.ChainSelector, d *destination) {
+	m.destMu.Lock()
+	defer m.destMu.Unlock()
+	m.destinations[dest] = slices.DeleteFunc(m.destinations[dest], func(added *destination) bool { return added == d })
+	if len(m.destinations[dest]) > 0 {
+		return
+	}
+	delete(m.destinations, dest)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for l := range m.statuses {
+		if l.dest == dest {
+			delete(m.statuses, l)
+		}
+	}
+}
+
+func (m *Monitor) Start(context.Context) error {
+	return m.StartOnce("LaneMonitor", func() error {
+		m.wg.Add(1)
+		go m.run()
+		m.lggr.Infow("Lane monitor started", "interval", m.cfg.Interval, "thresholds", m.cfg.Thresholds)
+		return nil
+	})
+}
+
+func (m *Monitor) Close() error {
+	return m.StopOnce("LaneMonitor", func() error {
+		close(m.stopChan)
+		m.wg.Wait()
+		return nil
+	})
+}
+
+func (m *Monitor) Name() string {
+	return m.lggr.Name()
+}
+
+func (m *Monitor) HealthReport() map[string]error {
+	return map[string]error{m.Name(): m.Healthy()}
+}
+
+func (m *Monitor) run() {
+	defer m.wg.Done()
+	ctx, cancel := m.stopChan.NewCtx()
+	defer cancel()
+
+	ticker := time.NewTicker(m.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		m.CheckLanes(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// CheckLanes checks all the lanes of all the destination chains once, the destinations are checked in parallel.
+func (m *Monitor) CheckLanes(ctx context.Context) {
+	m.checkMu.Lock()
+	defer m.checkMu.Unlock()
+
+	m.destMu.Lock()
+	destinations := make(map[cciptypes.ChainSelector]*destination, len(m.destinations))
+	for destSel, added := range m.destinations {
+		destinations[destSel] = added[len(added)-1]
+	}
+	m.destMu.Unlock()
+
+	var wg sync.WaitGroup
+	for destSel, dest := range destinations {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := m.checkDestination(ctx, destSel, dest); err != nil {
+				m.metrics.trackError(destSel)
+				m.lggr.Errorw("failed to check the lanes of a destination chain", "dest", destSel, "err", err)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// Statuses returns the status of all the lanes, sorted by destination and source chain.
+func (m *Monitor) Statuses() []LaneStatus {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	statuses := maps.Values(m.statuses)
+	slices.SortFunc(statuses, func(a, b LaneStatus) int {
+		if a.DestChainSelector != b.DestChainSelector {
+			return cmp.Compare(a.DestChainSelector, b.DestChainSelector)
+		}
+		return cmp.Compare(a.SourceChainSelector, b.SourceChainSelector)
+	})
+	return statuses
+}
+
+// ServeHTTP serves the status of the lanes as JSON, optionally filtered with the source and dest query parameters.
+func (m *Monitor) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	statuses := m.Statuses()
+	for _, param := range []string{"source", "dest"} {
+		if value := r.URL.Query().Get(param); value != "" {
+			selector, err := strconv.ParseUint(value, 10, 64)
+			if err != nil {
+				http.Error(w, fmt.Sprintf("invalid %s chain selector %q", param, value), http.StatusBadRequest)
+				return
+			}
+			statuses = slices.DeleteFunc(statuses, func(s LaneStatus) bool {
+				if param == "source" {
+					return uint64(s.SourceChainSelector) != selector
+				}
+				return uint64(s.DestChainSelector) != selector
+			})
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(statuses); err != nil {
+		m.lggr.Errorw("failed to write lane statuses", "err", err)
+	}
+}
+
+// checkDestination checks the lanes of a destination chain, the lanes are rediscovered every DiscoveryInterval.
+func (m *Monitor) checkDestination(
+	ctx context.Context,
+	destSel cciptypes.ChainSelector,
+	dest *destination,
+) error {
+	if dest.sources == nil || m.now().Sub(dest.lastDiscovery) >= m.cfg.DiscoveryInterval {
+		if err := m.discover(ctx, destSel, dest); err != nil {
+			return fmt.Errorf("discover lanes: %w", err)
+		}
+	}
+
+	nextSeqNums, err := dest.reader.NextSeqNum(ctx, dest.sources)
+	if err != nil {
+		return fmt.Errorf("get offRamp next sequence numbers: %w", err)
+	}
+	curseInfo, err := dest.reader.GetRmnCurseInfo(ctx)
+	if err != nil {
+		return fmt.Errorf("get curse info: %w", err)
+	}
+
+	for _, source := range dest.sources {
+		l := lane{source: source, dest: destSel}
+		status, err := m.checkLane(ctx, dest.reader, l, nextSeqNums, curseInfo)
+		if err != nil {
+			m.metrics.trackError(destSel)
+			m.lggr.Warnw("failed to check lane", "source", source, "dest", destSel, "err", err)
+			status = m.status(l)
+			status.SourceChainSelector, status.DestChainSelector = source, destSel
+			status.Error = err.Error()
+		}
+		m.setStatus(dest, l, status)
+	}
+	return nil
+}
+
+func (m *Monitor) discover(ctx context.Context, destSel cciptypes.ChainSelector, dest *destination) error {
+	sources, err := DiscoverSources(ctx, m.lggr, dest.homeChain, dest.reader, destSel)
+	if err != nil {
+		return err
+	}
+
+	m.lggr.Infow("discovered lanes", "dest", destSel, "sources", sources)
+	dest.sources = sources
+	dest.lastDiscovery = m.now()
+	return nil
+}
+
+func (m *Monitor) checkLane(
+	ctx context.Context,
+	ccipReader reader.CCIPReader,
+	l lane,
+	nextSeqNums map[cciptypes.ChainSelector]cciptypes.SeqNum,
+	curseInfo reader.CurseInfo,
+) (LaneStatus, error) {
+	nextCommit, ok := nextSeqNums[l.source]
+	if !ok {
+		return LaneStatus{}, errors.New("source chain not configured on the offRamp")
+	}
+	latest, err := ccipReader.LatestMsgSeqNum(ctx, l.source)
+	if err != nil {
+		return LaneStatus{}, fmt.Errorf("get latest sequence number: %w", err)
+	}
+
+	status := LaneStatus{
+		SourceChainSelector: l.source,
+		DestChainSelector:   l.dest,
+		LatestSeqNum:        latest,
+		NextCommitSeqNum:    nextCommit,
+		Cursed:              curseInfo.GlobalCurse || curseInfo.CursedDestination || curseInfo.CursedSourceChains[l.source],
+		UpdatedAt:           m.now(),
+	}
+	if latest >= nextCommit {
+		status.CommitLag = uint64(latest - nextCommit + 1)
+	}
+
+	if err := m.checkExecution(ctx, ccipReader, l, &status); err != nil {
+		return LaneStatus{}, err
+	}
+
+	status.Alerts = m.alerts(status)
+	m.metrics.trackLane(status)
+	if len(status.Alerts) > 0 {
+		m.lggr.Warnw("lane is unhealthy", "status", status)
+	}
+	return status, nil
+}
+
+// checkExecution scans the committed messages from the oldest one not executed at the previous check, and sets
+// the execution lag and the oldest message not executed yet.
+func (m *Monitor) checkExecution(
+	ctx context.Context,
+	ccipReader reader.CCIPReader,
+	l lane,
+	status *LaneStatus,
+) error {
+	previous := m.status(l)
+	nextExec := previous.NextExecSeqNum
+	if nextExec == 0 {
+		nextExec = 1
+		if uint64(status.NextCommitSeqNum) > m.cfg.ExecScanLimit {
+			nextExec = status.NextCommitSeqNum - cciptypes.SeqNum(m.cfg.ExecScanLimit)
+		}
+	}
+	status.NextExecSeqNum = status.NextCommitSeqNum
+	if nextExec >= status.NextCommitSeqNum {
+		return nil
+	}
+
+	committed := cciptypes.NewSeqNumRange(nextExec, status.NextCommitSeqNum-1)
+	executed, err := ccipReader.ExecutedMessages(ctx,
+		map[cciptypes.ChainSelector][]cciptypes.SeqNumRange{l.source: {committed}}, primitives.Unconfirmed)
+	if err != nil {
+		return fmt.Errorf("get executed messages: %w", err)
+	}
+	executedSet := make(map[cciptypes.SeqNum]struct{}, len(executed[l.source]))
+	for _, seqNum := range executed[l.source] {
+		executedSet[seqNum] = struct{}{}
+	}
+	for seqNum := committed.Start(); seqNum <= committed.End(); seqNum++ {
+		if _, ok := executedSet[seqNum]; ok {
+			continue
+		}
+		if status.ExecLag == 0 {
+			status.NextExecSeqNum = seqNum
+		}
+		status.ExecLag++
+	}
+	if status.ExecLag == 0 {
+		return nil
+	}
+
+	// The sent timestamp of the oldest message is reused until it is executed.
+	if previous.NextExecSeqNum == status.NextExecSeqNum && previous.OldestPendingSentAt != nil {
+		status.OldestPendingSentAt = previous.OldestPendingSentAt
+		status.OldestPendingReasons = previous.OldestPendingReasons
+		return nil
+	}
+	return m.checkOldestPending(ctx, ccipReader, l, status)
+}
+
+func (m *Monitor) checkOldestPending(
+	ctx context.Context,
+	ccipReader reader.CCIPReader,
+	l lane,
+	status *LaneStatus,
+) error {
+	msgs, err := ccipReader.MsgsBetweenSeqNums(ctx, l.source,
+		cciptypes.NewSeqNumRange(status.NextExecSeqNum, status.NextExecSeqNum))
+	if err != nil {
+		return fmt.Errorf("get message %d: %w", status.NextExecSeqNum, err)
+	}
+	if len(msgs) == 0 {
+		return fmt.Errorf("message %d not found on the source chain", status.NextExecSeqNum)
+	}
+	lifecycle, err := ccipReader.MessageStatus(ctx, l.source, msgs[0].Header.MessageID)
+	if err != nil {
+		return fmt.Errorf("get status of message %d: %w", status.NextExecSeqNum, err)
+	}
+	if !lifecycle.Sent.Timestamp.IsZero() {
+		sentAt := lifecycle.Sent.Timestamp
+		status.OldestPendingSentAt = &sentAt
+	}
+	status.OldestPendingReasons = lifecycle.PendingReasons
+	return nil
+}
+
+func (m *Monitor) alerts(status LaneStatus) []Alert {
+	thresholds := m.cfg.Thresholds
+	var alerts []Alert
+	if thresholds.CommitLag > 0 && status.CommitLag > thresholds.CommitLag {
+		alerts = append(alerts, AlertCommitLag)
+	}
+	if thresholds.ExecLag > 0 && status.ExecLag > thresholds.ExecLag {
+		alerts = append(alerts, AlertExecLag)
+	}
+	if thresholds.OldestPendingAge > 0 && status.OldestPendingAge() > thresholds.OldestPendingAge {
+		alerts = append(alerts, AlertOldestPendingAge)
+	}
+	if thresholds.Cursed && status.Cursed {
+		alerts = append(alerts, AlertCursed)
+	}
+	return alerts
+}
+
+func (m *Monitor) status(l lane) LaneStatus {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.statuses[l]
+}
+
+// setStatus sets the status of a lane of the destination, unless its reader was removed during the check.
+func (m *Monitor) setStatus(dest *destination, l lane, status LaneStatus) {
+	m.destMu.Lock()
+	defer m.destMu.Unlock()
+	if !slices.Contains(m.destinations[l.dest], dest) {
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.statuses[l] = status
+}
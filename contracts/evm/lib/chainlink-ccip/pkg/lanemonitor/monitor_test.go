@@ -0,0 +1,215 @@
+package lanemonitor
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink-ccip/internal/mocks/inmem"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/consts"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/reader"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+
+	readermock "github.com/smartcontractkit/chainlink-ccip/mocks/internal_/reader"
+)
+
+const (
+	dest    = cciptypes.ChainSelector(1)
+	source1 = cciptypes.ChainSelector(2)
+	source2 = cciptypes.ChainSelector(3)
+	// source3 has an onRamp but is not configured on the offRamp
+	source3 = cciptypes.ChainSelector(4)
+)
+
+var now = time.Date(2025, 5, 1, 12, 0, 0, 0, time.UTC)
+
+func newMessage(seqNum cciptypes.SeqNum, executed bool) inmem.MessagesWithMetadata {
+	return inmem.MessagesWithMetadata{
+		Message: cciptypes.Message{Header: cciptypes.RampMessageHeader{
+			MessageID:           cciptypes.Bytes32{byte(seqNum)},
+			SourceChainSelector: source1,
+			DestChainSelector:   dest,
+			SequenceNumber:      seqNum,
+		}},
+		Executed:    executed,
+		Destination: dest,
+		SentAt:      now.Add(-time.Duration(10-seqNum) * time.Hour),
+	}
+}
+
+func setupMonitor(t *testing.T, cfg Config) (*Monitor, *inmem.InMemoryCCIPReader) {
+	ccipReader := &inmem.InMemoryCCIPReader{
+		Dest: dest,
+		Messages: map[cciptypes.ChainSelector][]inmem.MessagesWithMetadata{
+			source1: {
+				newMessage(1, true), newMessage(2, true), newMessage(3, false), newMessage(4, true), newMessage(5, false),
+			},
+		},
+		FinalizedReports: []cciptypes.CommitPluginReportWithMeta{{
+			Report: cciptypes.CommitPluginReport{BlessedMerkleRoots: []cciptypes.MerkleRootChain{
+				{ChainSel: source1, SeqNumsRange: cciptypes.NewSeqNumRange(1, 4)},
+			}},
+		}},
+		OffRampNextSeqNums: map[cciptypes.ChainSelector]cciptypes.SeqNum{source1: 5, source2: 1},
+		CurseInfo: &reader.CurseInfo{
+			CursedSourceChains: map[cciptypes.ChainSelector]bool{source2: true},
+		},
+		ContractAddresses: reader.ContractAddresses{
+			consts.ContractNameOnRamp: {
+				source1: cciptypes.UnknownAddress("onramp1"),
+				source2: cciptypes.UnknownAddress("onramp2"),
+				source3: cciptypes.UnknownAddress("onramp3"),
+				// the offRamp returns a zero onRamp for the chains without a source config
+				cciptypes.ChainSelector(5): make(cciptypes.UnknownAddress, 20),
+			},
+		},
+	}
+
+	homeChain := readermock.NewMockHomeChain(t)
+	homeChain.EXPECT().GetAllChainConfigs().Return(nil, nil).Maybe()
+	homeChain.EXPECT().GetFChain().Return(map[cciptypes.ChainSelector]int{dest: 1, source1: 1}, nil).Maybe()
+
+	monitor := NewMonitor(logger.Test(t), cfg)
+	_ = monitor.AddDestination(dest, homeChain, ccipReader)
+	monitor.now = func() time.Time { return now }
+	return monitor, ccipReader
+}
+
+func TestMonitor_CheckLanes(t *testing.T) {
+	ctx := t.Context()
+	monitor, ccipReader := setupMonitor(t, Config{Thresholds: Thresholds{
+		CommitLag:        1,
+		OldestPendingAge: 5 * time.Hour,
+		Cursed:           true,
+	}})
+
+	monitor.CheckLanes(ctx)
+	statuses := monitor.Statuses()
+	require.Len(t, statuses, 3)
+
+	sentAt := now.Add(-7 * time.Hour)
+	assert.Equal(t, LaneStatus{
+		SourceChainSelector: source1,
+		DestChainSelector:   dest,
+		LatestSeqNum:        5,
+		NextCommitSeqNum:    5,
+		NextExecSeqNum:      3,
+		CommitLag:           1,
+		ExecLag:             1,
+		OldestPendingSentAt: &sentAt,
+		Alerts:              []Alert{AlertOldestPendingAge},
+		UpdatedAt:           now,
+	}, statuses[0])
+	assert.Equal(t, 7*time.Hour, statuses[0].OldestPendingAge())
+
+	assert.Equal(t, LaneStatus{
+		SourceChainSelector: source2,
+		DestChainSelector:   dest,
+		NextCommitSeqNum:    1,
+		NextExecSeqNum:      1,
+		Cursed:              true,
+		Alerts:              []Alert{AlertCursed},
+		UpdatedAt:           now,
+	}, statuses[1])
+
+	assert.Equal(t, source3, statuses[2].SourceChainSelector)
+	assert.Equal(t, "source chain not configured on the offRamp", statuses[2].Error)
+
+	// message 3 is executed and message 5 committed, message 5 is now the oldest pending message
+	ccipReader.Messages[source1][2].Executed = true
+	ccipReader.OffRampNextSeqNums[source1] = 6
+	ccipReader.FinalizedReports[0].Report.BlessedMerkleRoots[0].SeqNumsRange = cciptypes.NewSeqNumRange(1, 5)
+	monitor.CheckLanes(ctx)
+
+	status := monitor.Statuses()[0]
+	assert.Equal(t, uint64(0), status.CommitLag)
+	assert.Equal(t, uint64(1), status.ExecLag)
+	assert.Equal(t, cciptypes.SeqNum(5), status.NextExecSeqNum)
+	assert.Equal(t, 5*time.Hour, status.OldestPendingAge())
+	assert.Empty(t, status.Alerts)
+
+	ccipReader.Messages[source1][4].Executed = true
+	monitor.CheckLanes(ctx)
+
+	status = monitor.Statuses()[0]
+	assert.Equal(t, uint64(0), status.ExecLag)
+	assert.Equal(t, cciptypes.SeqNum(6), status.NextExecSeqNum)
+	assert.Nil(t, status.OldestPendingSentAt)
+}
+
+func TestMonitor_ExecScanLimit(t *testing.T) {
+	monitor, _ := setupMonitor(t, Config{ExecScanLimit: 1})
+
+	// only message 4 is scanned when the lane is first checked
+	monitor.CheckLanes(t.Context())
+	status := monitor.Statuses()[0]
+	assert.Equal(t, uint64(0), status.ExecLag)
+	assert.Equal(t, cciptypes.SeqNum(5), status.NextExecSeqNum)
+}
+
+func TestMonitor_AddDestination(t *testing.T) {
+	monitor, ccipReader := setupMonitor(t, Config{})
+	homeChain := readermock.NewMockHomeChain(t)
+
+	// the destination is checked through the reader added last
+	remove := monitor.AddDestination(dest, homeChain, ccipReader)
+	homeChain.EXPECT().GetAllChainConfigs().Return(nil, nil).Once()
+	homeChain.EXPECT().GetFChain().Return(map[cciptypes.ChainSelector]int{dest: 1}, nil).Once()
+	monitor.CheckLanes(t.Context())
+	require.Len(t, monitor.Statuses(), 3)
+
+	// the destination is monitored until all its readers are removed
+	remove()
+	remove()
+	monitor.CheckLanes(t.Context())
+	require.Len(t, monitor.Statuses(), 3)
+
+	monitor.removeDestination(dest, monitor.destinations[dest][0])
+	assert.Empty(t, monitor.Statuses())
+	monitor.CheckLanes(t.Context())
+	assert.Empty(t, monitor.Statuses())
+}
+
+func TestMonitor_ServeHTTP(t *testing.T) {
+	monitor, _ := setupMonitor(t, Config{})
+	monitor.CheckLanes(t.Context())
+
+	get := func(url string) (int, []LaneStatus) {
+		rec := httptest.NewRecorder()
+		monitor.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, url, nil))
+		var statuses []LaneStatus
+		if rec.Code == http.StatusOK {
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &statuses))
+		}
+		return rec.Code, statuses
+	}
+
+	code, statuses := get("/lanes")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Len(t, statuses, 3)
+
+	code, statuses = get("/lanes?source=3&dest=1")
+	assert.Equal(t, http.StatusOK, code)
+	require.Len(t, statuses, 1)
+	assert.True(t, statuses[0].Cursed)
+
+	code, _ = get("/lanes?dest=eth")
+	assert.Equal(t, http.StatusBadRequest, code)
+}
+
+func TestMonitor_StartClose(t *testing.T) {
+	monitor, _ := setupMonitor(t, Config{Interval: time.Hour})
+
+	require.NoError(t, monitor.Start(t.Context()))
+	require.Eventually(t, func() bool {
+		return len(monitor.Statuses()) == 3
+	}, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, monitor.Close())
+}
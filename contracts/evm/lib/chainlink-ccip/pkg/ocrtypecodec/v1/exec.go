@@ -48,7 +48,8 @@ func (e *ExecCodecProto) EncodeObservation(observation exectypes.Observation) ([
 				Addresses: e.tr.discoveryAddressesToProto(observation.Contracts.Addresses),
 			},
 		},
-		FChain: e.tr.fChainToProto(observation.FChain),
+		FChain:             e.tr.fChainToProto(observation.FChain),
+		SimulationFailures: e.tr.messageHashesToProto(exectypes.MessageHashes(observation.SimulationFailures)),
 	}
 
 	return proto.Marshal(pbObs)
@@ -75,6 +76,8 @@ func (e *ExecCodecProto) DecodeObservation(data []byte) (exectypes.Observation,
 			Addresses: e.tr.discoveryAddressesFromProto(pbObs.Contracts.ContractNames.Addresses),
 		},
 		FChain: e.tr.fChainFromProto(pbObs.FChain),
+		SimulationFailures: exectypes.SimulationFailureObservations(
+			e.tr.messageHashesFromProto(pbObs.SimulationFailures)),
 	}, nil
 }
 
@@ -465,11 +465,15 @@ func (d *dataGenerator) execObservation() exectypes.Observation {
 	msgHashObservations := make(map[cciptypes.ChainSelector]map[cciptypes.SeqNum]cciptypes.Bytes32)
 	nonces := make(map[cciptypes.ChainSelector]map[string]uint64)
 	msgObservations := make(map[cciptypes.ChainSelector]map[cciptypes.SeqNum]cciptypes.Message)
+	simulationFailures := make(exectypes.SimulationFailureObservations)
 	for i := 0; i < d.numSourceChains; i++ {
 		chainSel := cciptypes.ChainSelector(rand.Uint64())
 		nonces[chainSel] = map[string]uint64{
 			genRandomString(5): rand.Uint64(),
 		}
+		simulationFailures[chainSel] = map[cciptypes.SeqNum]cciptypes.Bytes32{
+			cciptypes.SeqNum(rand.Uint64()): randomBytes32(),
+		}
 
 		tokenDataObservations[chainSel] = make(map[cciptypes.SeqNum]exectypes.MessageTokenData, d.numMessagesPerChain)
 		msgHashObservations[chainSel] = make(map[cciptypes.SeqNum]cciptypes.Bytes32, d.numMessagesPerChain)
@@ -526,13 +530,14 @@ func (d *dataGenerator) execObservation() exectypes.Observation {
 	}
 
 	return exectypes.Observation{
-		CommitReports: commitReports,
-		Messages:      msgObservations,
-		Hashes:        msgHashObservations,
-		TokenData:     tokenDataObservations,
-		Nonces:        nonces,
-		Contracts:     discoveryObs,
-		FChain:        discoveryObs.FChain,
+		CommitReports:      commitReports,
+		Messages:           msgObservations,
+		Hashes:             msgHashObservations,
+		TokenData:          tokenDataObservations,
+		Nonces:             nonces,
+		SimulationFailures: simulationFailures,
+		Contracts:          discoveryObs,
+		FChain:             discoveryObs.FChain,
 	}
 }
 
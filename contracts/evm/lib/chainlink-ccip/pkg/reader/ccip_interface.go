@@ -184,6 +184,24 @@ type CCIPReader interface {
 		seqNumRange cciptypes.SeqNumRange,
 	) ([]cciptypes.Message, error)
 
+	// MessageStatus traces the message with the provided ID sent from the source chain to the destination chain.
+	// It returns the lifecycle of the message: sent, committed and executed, and if the message was not
+	// successfully executed yet, the onchain reasons why it is pending. Returns ErrMessageNotFound if the
+	// message is not found on the source chain.
+	MessageStatus(
+		ctx context.Context,
+		sourceChainSelector cciptypes.ChainSelector,
+		msgID cciptypes.Bytes32,
+	) (MessageLifecycle, error)
+
+	// MessagesExecuted returns the latest ExecutionStateChanged event of each message sent from the source chain
+	// whose sequence number is within the range, in a single query. Messages without events are omitted.
+	MessagesExecuted(
+		ctx context.Context,
+		sourceChainSelector cciptypes.ChainSelector,
+		seqNumRange cciptypes.SeqNumRange,
+	) (map[cciptypes.SeqNum]MessageExecuted, error)
+
 	// LatestMsgSeqNum reads the source chain and returns the latest finalized message sequence number.
 	LatestMsgSeqNum(ctx context.Context, chain cciptypes.ChainSelector) (cciptypes.SeqNum, error)
 
@@ -0,0 +1,514 @@
+package reader
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+	"github.com/smartcontractkit/chainlink-common/pkg/types/query"
+	"github.com/smartcontractkit/chainlink-common/pkg/types/query/primitives"
+
+	"github.com/smartcontractkit/chainlink-ccip/pkg/consts"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/logutil"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+)
+
+const (
+	// msgStatusSearchBatch is the number of sequence numbers queried at once while searching for a message.
+	msgStatusSearchBatch = 256
+	// msgStatusMaxSearchDepth is how many sequence numbers below the latest one are searched for a message.
+	msgStatusMaxSearchDepth = 50_000
+	// msgStatusCommitBatch is the number of commit reports queried at once while searching for a message's root.
+	msgStatusCommitBatch = 100
+	// msgStatusMaxCommitPages limits the number of commit report queries while searching for a message's root.
+	msgStatusMaxCommitPages = 100
+	// msgStatusClockSkew is subtracted from the source chain send timestamp before searching for commit reports
+	// on the destination chain, to tolerate block timestamp differences between the chains.
+	msgStatusClockSkew = 10 * time.Minute
+)
+
+// ErrMessageNotFound is returned by MessageStatus when the message was not sent from the source chain to the
+// destination chain of the reader within the searched sequence numbers.
+var ErrMessageNotFound = errors.New("message not found")
+
+// MessageExecutionState is the execution state of a message as reported by the offRamp.
+type MessageExecutionState uint8
+
+const (
+	ExecutionStateUntouched MessageExecutionState = iota
+	ExecutionStateInProgress
+	ExecutionStateSuccess
+	ExecutionStateFailure
+)
+
+func (s MessageExecutionState) String() string {
+	switch s {
+	case ExecutionStateUntouched:
+		return "untouched"
+	case ExecutionStateInProgress:
+		return "in progress"
+	case ExecutionStateSuccess:
+		return "success"
+	case ExecutionStateFailure:
+		return "failure"
+	default:
+		return fmt.Sprintf("unknown(%d)", uint8(s))
+	}
+}
+
+func (s MessageExecutionState) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// MessagePendingReason explains why a message was not successfully executed yet.
+type MessagePendingReason string
+
+const (
+	// PendingSourceNotFinalized the message is not finalized on the source chain, only finalized messages are
+	// committed.
+	PendingSourceNotFinalized MessagePendingReason = "source not finalized"
+	// PendingNotCommitted the message is finalized but no commit report covering it was accepted yet.
+	PendingNotCommitted MessagePendingReason = "not committed"
+	// PendingCursed the source chain, the destination chain or all chains are cursed by RMN.
+	PendingCursed MessagePendingReason = "cursed"
+	// PendingRootSnoozed the exec plugin snoozes the commit roots of cursed chains, the root of the message is
+	// not picked up for execution until the curse is lifted and the snooze expires.
+	PendingRootSnoozed MessagePendingReason = "root snoozed"
+	// PendingNonceGap the message is ordered and a previous message of the same sender is not executed yet.
+	PendingNonceGap MessagePendingReason = "nonce gap"
+	// PendingTokenDataNotReady offchain token data (e.g. USDC or LBTC attestation) of the message are not ready.
+	// The reader has no access to token data, the reason is added by the token data observers.
+	PendingTokenDataNotReady MessagePendingReason = "token data not ready"
+	// PendingExecutionFailed the latest execution attempt failed, the message requires manual execution.
+	PendingExecutionFailed MessagePendingReason = "execution failed"
+)
+
+// MessageSent describes the CCIPMessageSent event of a message on the source chain.
+type MessageSent struct {
+	SeqNum    cciptypes.SeqNum `json:"seqNum"`
+	BlockNum  uint64           `json:"blockNum"`
+	Timestamp time.Time        `json:"timestamp"`
+	Finalized bool             `json:"finalized"`
+	// EventCursor is the chain specific locator of the event, e.g. blockNumber-logIndex-txHash on EVM.
+	EventCursor string `json:"eventCursor"`
+}
+
+// MessageCommitted describes the accepted commit report whose merkle root covers the message.
+type MessageCommitted struct {
+	MerkleRoot      cciptypes.Bytes32     `json:"merkleRoot"`
+	SeqNumsRange    cciptypes.SeqNumRange `json:"seqNumsRange"`
+	Blessed         bool                  `json:"blessed"`
+	ReportTimestamp time.Time             `json:"reportTimestamp"`
+	BlockNum        uint64                `json:"blockNum"`
+}
+
+// MessageExecuted describes the latest ExecutionStateChanged event of the message on the destination chain.
+type MessageExecuted struct {
+	State      MessageExecutionState `json:"state"`
+	ReturnData cciptypes.Bytes       `json:"returnData"`
+	BlockNum   uint64                `json:"blockNum"`
+	Timestamp  time.Time             `json:"timestamp"`
+	// EventCursor is the chain specific locator of the event, e.g. blockNumber-logIndex-txHash on EVM.
+	EventCursor string `json:"eventCursor"`
+}
+
+// MessageLifecycle is the status of a message across the source chain, commit and execution.
+type MessageLifecycle struct {
+	Message   cciptypes.Message `json:"message"`
+	Sent      MessageSent       `json:"sent"`
+	Committed *MessageCommitted `json:"committed,omitempty"`
+	Executed  *MessageExecuted  `json:"executed,omitempty"`
+	// PendingReasons are set when the message is not successfully executed yet.
+	PendingReasons []MessagePendingReason `json:"pendingReasons,omitempty"`
+}
+
+// IsPending returns true if the message was not successfully executed yet.
+func (l MessageLifecycle) IsPending() bool {
+	return l.Executed == nil || l.Executed.State != ExecutionStateSuccess
+}
+
+// Stage returns the latest stage reached by the message: sent, committed or executed.
+func (l MessageLifecycle) Stage() string {
+	switch {
+	case l.Executed != nil:
+		return "executed"
+	case l.Committed != nil:
+		return "committed"
+	default:
+		return "sent"
+	}
+}
+
+// MessageStatus implements CCIPReader.
+func (r *ccipChainReader) MessageStatus(
+	ctx context.Context,
+	sourceChainSelector cciptypes.ChainSelector,
+	msgID cciptypes.Bytes32,
+) (MessageLifecycle, error) {
+	lggr := logutil.WithContextValues(ctx, r.lggr)
+	if err := validateExtendedReaderExistence(r.contractReaders, sourceChainSelector, r.destChain); err != nil {
+		return MessageLifecycle{}, err
+	}
+
+	msg, sent, err := r.findSentMessage(ctx, sourceChainSelector, msgID)
+	if err != nil {
+		return MessageLifecycle{}, fmt.Errorf("find sent message: %w", err)
+	}
+	lifecycle := MessageLifecycle{Message: msg, Sent: sent}
+
+	lifecycle.Executed, err = r.findMessageExecution(ctx, sourceChainSelector, msg.Header)
+	if err != nil {
+		return MessageLifecycle{}, fmt.Errorf("find message execution: %w", err)
+	}
+
+	// only finalized messages are committed
+	if sent.Finalized {
+		lifecycle.Committed, err = r.findMessageCommit(ctx, sourceChainSelector, sent)
+		if err != nil {
+			return MessageLifecycle{}, fmt.Errorf("find message commit: %w", err)
+		}
+	}
+
+	if lifecycle.IsPending() {
+		lifecycle.PendingReasons, err = r.messagePendingReasons(ctx, lifecycle)
+		if err != nil {
+			return MessageLifecycle{}, fmt.Errorf("message pending reasons: %w", err)
+		}
+	}
+
+	lggr.Debugw("message status", "msgID", msgID, "sourceChainSelector", sourceChainSelector,
+		"stage", lifecycle.Stage(), "pendingReasons", lifecycle.PendingReasons)
+	return lifecycle, nil
+}
+
+// findSentMessage searches the CCIPMessageSent events of the source chain backwards from the latest
+// sequence number, including the events which are not finalized yet.
+func (r *ccipChainReader) findSentMessage(
+	ctx context.Context,
+	sourceChainSelector cciptypes.ChainSelector,
+	msgID cciptypes.Bytes32,
+) (cciptypes.Message, MessageSent, error) {
+	latest, err := r.querySentMessages(ctx, sourceChainSelector, nil, query.Desc, 1)
+	if err != nil {
+		return cciptypes.Message{}, MessageSent{}, err
+	}
+	if len(latest) == 0 {
+		return cciptypes.Message{}, MessageSent{}, ErrMessageNotFound
+	}
+	latestEv, ok := latest[0].Data.(*SendRequestedEvent)
+	if !ok {
+		return cciptypes.Message{}, MessageSent{}, fmt.Errorf("failed to cast %T to SendRequestedEvent", latest[0].Data)
+	}
+
+	latestFinalized, err := r.LatestMsgSeqNum(ctx, sourceChainSelector)
+	if err != nil {
+		return cciptypes.Message{}, MessageSent{}, fmt.Errorf("latest finalized seq num: %w", err)
+	}
+
+	for end := latestEv.SequenceNumber; end > 0 && latestEv.SequenceNumber-end < msgStatusMaxSearchDepth; {
+		start := cciptypes.SeqNum(1)
+		if end > msgStatusSearchBatch {
+			start = end - msgStatusSearchBatch + 1
+		}
+		seqNumRange := cciptypes.NewSeqNumRange(start, end)
+
+		seqs, err := r.querySentMessages(ctx, sourceChainSelector, &seqNumRange, query.Asc, uint64(seqNumRange.Length()))
+		if err != nil {
+			return cciptypes.Message{}, MessageSent{}, err
+		}
+		for _, item := range seqs {
+			ev, ok := item.Data.(*SendRequestedEvent)
+			if !ok {
+				return cciptypes.Message{}, MessageSent{}, fmt.Errorf("failed to cast %T to SendRequestedEvent", item.Data)
+			}
+			if err := validateSendRequestedEvent(ev, sourceChainSelector, r.destChain, seqNumRange); err != nil {
+				r.lggr.Errorw("validate send requested event", "err", err, "message", ev)
+				continue
+			}
+			if ev.Message.Header.MessageID != msgID {
+				continue
+			}
+
+			blockNum, err := strconv.ParseUint(item.Head.Height, 10, 64)
+			if err != nil {
+				return cciptypes.Message{}, MessageSent{}, fmt.Errorf("parse block number %s: %w", item.Head.Height, err)
+			}
+			return ev.Message, MessageSent{
+				SeqNum:      ev.SequenceNumber,
+				BlockNum:    blockNum,
+				Timestamp:   time.Unix(int64(item.Timestamp), 0).UTC(),
+				Finalized:   ev.SequenceNumber <= latestFinalized,
+				EventCursor: item.Cursor,
+			}, nil
+		}
+		end = start - 1
+	}
+
+	return cciptypes.Message{}, MessageSent{}, ErrMessageNotFound
+}
+
+// querySentMessages queries the CCIPMessageSent events of the source chain to the destination chain
+// regardless of their finality. If seqNumRange is nil, all sequence numbers are considered.
+func (r *ccipChainReader) querySentMessages(
+	ctx context.Context,
+	sourceChainSelector cciptypes.ChainSelector,
+	seqNumRange *cciptypes.SeqNumRange,
+	sortDirection query.SortDirection,
+	limit uint64,
+) ([]types.Sequence, error) {
+	expressions := []query.Expression{
+		query.Comparator(consts.EventAttributeSourceChain, primitives.ValueComparator{
+			Value:    sourceChainSelector,
+			Operator: primitives.Eq,
+		}),
+		query.Comparator(consts.EventAttributeDestChain, primitives.ValueComparator{
+			Value:    r.destChain,
+			Operator: primitives.Eq,
+		}),
+		query.Confidence(primitives.Unconfirmed),
+	}
+	if seqNumRange != nil {
+		expressions = append(expressions, query.Comparator(consts.EventAttributeSequenceNumber,
+			primitives.ValueComparator{
+				Value:    seqNumRange.Start(),
+				Operator: primitives.Gte,
+			}, primitives.ValueComparator{
+				Value:    seqNumRange.End(),
+				Operator: primitives.Lte,
+			}))
+	}
+
+	seqs, err := r.contractReaders[sourceChainSelector].ExtendedQueryKey(
+		ctx,
+		consts.ContractNameOnRamp,
+		query.KeyFilter{
+			Key:         consts.EventNameCCIPMessageSent,
+			Expressions: expressions,
+		},
+		query.LimitAndSort{
+			SortBy: []query.SortBy{query.NewSortBySequence(sortDirection)},
+			Limit:  query.Limit{Count: limit},
+		},
+		&SendRequestedEvent{},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query onRamp: %w", err)
+	}
+	return seqs, nil
+}
+
+// findMessageExecution returns the latest ExecutionStateChanged event of the message, nil if there is none.
+// A message can have multiple events, e.g. a failed execution followed by a successful manual execution.
+func (r *ccipChainReader) findMessageExecution(
+	ctx context.Context,
+	sourceChainSelector cciptypes.ChainSelector,
+	header cciptypes.RampMessageHeader,
+) (*MessageExecuted, error) {
+	rangesPerChain := map[cciptypes.ChainSelector][]cciptypes.SeqNumRange{
+		sourceChainSelector: {cciptypes.NewSeqNumRange(header.SequenceNumber, header.SequenceNumber)},
+	}
+	keyFilter, _ := createExecutedMessagesKeyFilter(rangesPerChain, primitives.Unconfirmed)
+
+	seqs, err := r.contractReaders[r.destChain].ExtendedQueryKey(
+		ctx,
+		consts.ContractNameOffRamp,
+		keyFilter,
+		query.LimitAndSort{
+			SortBy: []query.SortBy{query.NewSortBySequence(query.Desc)},
+			Limit:  query.Limit{Count: 1},
+		},
+		&ExecutionStateChangedEvent{},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query offRamp: %w", err)
+	}
+
+	for _, item := range seqs {
+		ev, executed, err := r.parseMessageExecuted(item, rangesPerChain)
+		if err != nil {
+			return nil, err
+		}
+		if ev == nil || ev.MessageID != header.MessageID {
+			continue
+		}
+		return &executed, nil
+	}
+	return nil, nil
+}
+
+// MessagesExecuted implements CCIPReader.
+func (r *ccipChainReader) MessagesExecuted(
+	ctx context.Context,
+	sourceChainSelector cciptypes.ChainSelector,
+	seqNumRange cciptypes.SeqNumRange,
+) (map[cciptypes.SeqNum]MessageExecuted, error) {
+	if err := validateExtendedReaderExistence(r.contractReaders, r.destChain); err != nil {
+		return nil, err
+	}
+
+	rangesPerChain := map[cciptypes.ChainSelector][]cciptypes.SeqNumRange{sourceChainSelector: {seqNumRange}}
+	keyFilter, _ := createExecutedMessagesKeyFilter(rangesPerChain, primitives.Unconfirmed)
+
+	// events are sorted ascending, the latest event of a message overrides the previous ones.
+	seqs, err := r.contractReaders[r.destChain].ExtendedQueryKey(
+		ctx,
+		consts.ContractNameOffRamp,
+		keyFilter,
+		query.LimitAndSort{
+			SortBy: []query.SortBy{query.NewSortBySequence(query.Asc)},
+		},
+		&ExecutionStateChangedEvent{},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query offRamp: %w", err)
+	}
+
+	executed := make(map[cciptypes.SeqNum]MessageExecuted)
+	for _, item := range seqs {
+		ev, e, err := r.parseMessageExecuted(item, rangesPerChain)
+		if err != nil {
+			return nil, err
+		}
+		if ev == nil {
+			continue
+		}
+		executed[ev.SequenceNumber] = e
+	}
+	return executed, nil
+}
+
+// parseMessageExecuted parses an ExecutionStateChanged event, the event is nil if it is not valid.
+func (r *ccipChainReader) parseMessageExecuted(
+	item types.Sequence,
+	rangesPerChain map[cciptypes.ChainSelector][]cciptypes.SeqNumRange,
+) (*ExecutionStateChangedEvent, MessageExecuted, error) {
+	ev, ok := item.Data.(*ExecutionStateChangedEvent)
+	if !ok {
+		return nil, MessageExecuted{}, fmt.Errorf("failed to cast %T to ExecutionStateChangedEvent", item.Data)
+	}
+	if err := validateExecutionStateChangedEvent(ev, rangesPerChain); err != nil {
+		r.lggr.Errorw("validate execution state changed event", "err", err, "stateChange", ev)
+		return nil, MessageExecuted{}, nil
+	}
+
+	blockNum, err := strconv.ParseUint(item.Head.Height, 10, 64)
+	if err != nil {
+		return nil, MessageExecuted{}, fmt.Errorf("parse block number %s: %w", item.Head.Height, err)
+	}
+	return ev, MessageExecuted{
+		State:       MessageExecutionState(ev.State),
+		ReturnData:  ev.ReturnData,
+		BlockNum:    blockNum,
+		Timestamp:   time.Unix(int64(item.Timestamp), 0).UTC(),
+		EventCursor: item.Cursor,
+	}, nil
+}
+
+// findMessageCommit pages through the commit reports accepted after the message was sent and returns
+// the merkle root covering the message, nil if there is none.
+func (r *ccipChainReader) findMessageCommit(
+	ctx context.Context,
+	sourceChainSelector cciptypes.ChainSelector,
+	sent MessageSent,
+) (*MessageCommitted, error) {
+	since := sent.Timestamp.Add(-msgStatusClockSkew)
+	for page := 0; page < msgStatusMaxCommitPages; page++ {
+		reports, err := r.CommitReportsGTETimestamp(ctx, since, primitives.Unconfirmed, msgStatusCommitBatch)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, report := range reports {
+			if committed := findMerkleRoot(report, sourceChainSelector, sent.SeqNum); committed != nil {
+				return committed, nil
+			}
+		}
+
+		if len(reports) < msgStatusCommitBatch {
+			return nil, nil
+		}
+		// reports sharing the last timestamp are queried again, make sure the search advances
+		next := reports[len(reports)-1].Timestamp
+		if !next.After(since) {
+			next = since.Add(time.Second)
+		}
+		since = next
+	}
+	return nil, nil
+}
+
+func findMerkleRoot(
+	report cciptypes.CommitPluginReportWithMeta,
+	sourceChainSelector cciptypes.ChainSelector,
+	seqNum cciptypes.SeqNum,
+) *MessageCommitted {
+	rootsByBlessing := []struct {
+		blessed bool
+		roots   []cciptypes.MerkleRootChain
+	}{
+		{blessed: true, roots: report.Report.BlessedMerkleRoots},
+		{blessed: false, roots: report.Report.UnblessedMerkleRoots},
+	}
+	for _, r := range rootsByBlessing {
+		for _, root := range r.roots {
+			if root.ChainSel == sourceChainSelector && root.SeqNumsRange.Contains(seqNum) {
+				return &MessageCommitted{
+					MerkleRoot:      root.MerkleRoot,
+					SeqNumsRange:    root.SeqNumsRange,
+					Blessed:         r.blessed,
+					ReportTimestamp: report.Timestamp,
+					BlockNum:        report.BlockNum,
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// messagePendingReasons returns the onchain reasons why a message is not successfully executed yet.
+func (r *ccipChainReader) messagePendingReasons(
+	ctx context.Context,
+	lifecycle MessageLifecycle,
+) ([]MessagePendingReason, error) {
+	reasons := make([]MessagePendingReason, 0)
+	switch {
+	case lifecycle.Executed != nil && lifecycle.Executed.State == ExecutionStateFailure:
+		reasons = append(reasons, PendingExecutionFailed)
+	case !lifecycle.Sent.Finalized:
+		reasons = append(reasons, PendingSourceNotFinalized)
+	case lifecycle.Committed == nil:
+		reasons = append(reasons, PendingNotCommitted)
+	}
+
+	sourceChainSelector := lifecycle.Message.Header.SourceChainSelector
+	curseInfo, err := r.GetRmnCurseInfo(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get curse info: %w", err)
+	}
+	if curseInfo.GlobalCurse || curseInfo.CursedDestination || curseInfo.CursedSourceChains[sourceChainSelector] {
+		reasons = append(reasons, PendingCursed)
+		if lifecycle.Committed != nil {
+			reasons = append(reasons, PendingRootSnoozed)
+		}
+	}
+
+	// nonce 0 means out of order execution, the message doesn't wait for previous messages of the sender
+	if lifecycle.Executed == nil && lifecycle.Message.Header.Nonce > 0 {
+		sender, err := r.addrCodec.AddressBytesToString(lifecycle.Message.Sender, sourceChainSelector)
+		if err != nil {
+			return nil, fmt.Errorf("convert sender address to string: %w", err)
+		}
+		nonces, err := r.Nonces(ctx, map[cciptypes.ChainSelector][]string{sourceChainSelector: {sender}})
+		if err != nil {
+			return nil, fmt.Errorf("get sender nonce: %w", err)
+		}
+		if lifecycle.Message.Header.Nonce > nonces[sourceChainSelector][sender]+1 {
+			reasons = append(reasons, PendingNonceGap)
+		}
+	}
+
+	return reasons, nil
+}
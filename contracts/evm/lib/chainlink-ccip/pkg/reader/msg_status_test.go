@@ -0,0 +1,335 @@
+package reader
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+	"github.com/smartcontractkit/chainlink-common/pkg/types/query"
+	"github.com/smartcontractkit/chainlink-common/pkg/types/query/primitives"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink-ccip/internal"
+	reader_mocks "github.com/smartcontractkit/chainlink-ccip/mocks/pkg/contractreader"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/consts"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/contractreader"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+)
+
+func TestCCIPChainReader_MessageStatus(t *testing.T) {
+	sentAt := time.Unix(1_700_000_000, 0).UTC()
+	msgID := cciptypes.Bytes32{0x2}
+	root := cciptypes.Bytes32{0xaa}
+
+	sentEvent := func(seqNum cciptypes.SeqNum, nonce uint64) types.Sequence {
+		return types.Sequence{
+			Cursor: strconv.Itoa(int(seqNum)) + "-0-0xabc",
+			Head:   types.Head{Height: strconv.Itoa(100 + int(seqNum)), Timestamp: uint64(sentAt.Unix())},
+			Data: &SendRequestedEvent{
+				DestChainSelector: chainB,
+				SequenceNumber:    seqNum,
+				Message: cciptypes.Message{
+					Header: cciptypes.RampMessageHeader{
+						MessageID:           cciptypes.Bytes32{byte(seqNum)},
+						SourceChainSelector: chainA,
+						DestChainSelector:   chainB,
+						SequenceNumber:      seqNum,
+						Nonce:               nonce,
+					},
+					Sender:         cciptypes.UnknownAddress{0x1},
+					Receiver:       cciptypes.UnknownAddress{0x2},
+					FeeToken:       cciptypes.UnknownAddress{0x3},
+					FeeTokenAmount: cciptypes.NewBigIntFromInt64(1),
+				},
+			},
+		}
+	}
+	executedEvent := func(state MessageExecutionState) types.Sequence {
+		return types.Sequence{
+			Cursor: "500-1-0xdef",
+			Head:   types.Head{Height: "500", Timestamp: uint64(sentAt.Add(time.Hour).Unix())},
+			Data: &ExecutionStateChangedEvent{
+				SourceChainSelector: chainA,
+				SequenceNumber:      2,
+				MessageID:           msgID,
+				MessageHash:         cciptypes.Bytes32{0x1},
+				State:               uint8(state),
+			},
+		}
+	}
+	commitEvent := types.Sequence{
+		Head: types.Head{Height: "400", Timestamp: uint64(sentAt.Add(time.Minute).Unix())},
+		Data: &CommitReportAcceptedEvent{
+			BlessedMerkleRoots: []MerkleRoot{{
+				SourceChainSelector: uint64(chainA),
+				OnRampAddress:       cciptypes.UnknownAddress{0x4},
+				MinSeqNr:            1,
+				MaxSeqNr:            2,
+				MerkleRoot:          root,
+			}},
+		},
+	}
+
+	testCases := []struct {
+		name           string
+		msgID          cciptypes.Bytes32
+		nonce          uint64
+		finalized      cciptypes.SeqNum
+		commits        []types.Sequence
+		executions     []types.Sequence
+		curseInfo      CurseInfo
+		senderNonce    uint64
+		expStage       string
+		expReasons     []MessagePendingReason
+		expCommitted   bool
+		expExecState   MessageExecutionState
+		expErr         error
+		expectsNonce   bool
+		expectsCurse   bool
+		expectsCommits bool
+	}{
+		{
+			name:           "executed",
+			msgID:          msgID,
+			finalized:      3,
+			commits:        []types.Sequence{commitEvent},
+			executions:     []types.Sequence{executedEvent(ExecutionStateSuccess)},
+			expStage:       "executed",
+			expCommitted:   true,
+			expExecState:   ExecutionStateSuccess,
+			expectsCommits: true,
+		},
+		{
+			name:           "execution failed",
+			msgID:          msgID,
+			finalized:      3,
+			commits:        []types.Sequence{commitEvent},
+			executions:     []types.Sequence{executedEvent(ExecutionStateFailure)},
+			expStage:       "executed",
+			expReasons:     []MessagePendingReason{PendingExecutionFailed},
+			expCommitted:   true,
+			expExecState:   ExecutionStateFailure,
+			expectsCurse:   true,
+			expectsCommits: true,
+		},
+		{
+			name:         "source not finalized",
+			msgID:        msgID,
+			finalized:    1,
+			expStage:     "sent",
+			expReasons:   []MessagePendingReason{PendingSourceNotFinalized},
+			expectsCurse: true,
+		},
+		{
+			name:           "committed but cursed",
+			msgID:          msgID,
+			finalized:      3,
+			commits:        []types.Sequence{commitEvent},
+			curseInfo:      CurseInfo{CursedSourceChains: map[cciptypes.ChainSelector]bool{chainA: true}},
+			expStage:       "committed",
+			expReasons:     []MessagePendingReason{PendingCursed, PendingRootSnoozed},
+			expCommitted:   true,
+			expectsCurse:   true,
+			expectsCommits: true,
+		},
+		{
+			name:           "not committed with nonce gap",
+			msgID:          msgID,
+			nonce:          5,
+			finalized:      3,
+			senderNonce:    3,
+			expStage:       "sent",
+			expReasons:     []MessagePendingReason{PendingNotCommitted, PendingNonceGap},
+			expectsCurse:   true,
+			expectsCommits: true,
+			expectsNonce:   true,
+		},
+		{
+			name:      "message not found",
+			msgID:     cciptypes.Bytes32{0xff},
+			finalized: 3,
+			expErr:    ErrMessageNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sourceReader := reader_mocks.NewMockExtended(t)
+			destReader := reader_mocks.NewMockExtended(t)
+			mockCache := new(mockConfigCache)
+
+			sent := []types.Sequence{sentEvent(1, 0), sentEvent(2, tc.nonce), sentEvent(3, 0)}
+			sourceReader.EXPECT().ExtendedQueryKey(
+				mock.Anything, consts.ContractNameOnRamp, mock.Anything, mock.Anything, mock.Anything,
+			).RunAndReturn(func(
+				_ context.Context, _ string, filter query.KeyFilter, limitAndSort query.LimitAndSort, _ any,
+			) ([]types.Sequence, error) {
+				if confidenceOf(filter) == primitives.Finalized {
+					return sent[tc.finalized-1 : tc.finalized], nil
+				}
+				if limitAndSort.Limit.Count == 1 {
+					return sent[len(sent)-1:], nil
+				}
+				return sent, nil
+			})
+
+			destReader.EXPECT().ExtendedQueryKey(
+				mock.Anything, consts.ContractNameOffRamp, mock.Anything, mock.Anything, mock.Anything,
+			).RunAndReturn(func(
+				_ context.Context, _ string, filter query.KeyFilter, _ query.LimitAndSort, _ any,
+			) ([]types.Sequence, error) {
+				if filter.Key == consts.EventNameCommitReportAccepted {
+					return tc.commits, nil
+				}
+				return tc.executions, nil
+			}).Maybe()
+
+			if tc.expectsCurse {
+				mockCache.On("GetChainConfig", mock.Anything, chainB).
+					Return(ChainConfigSnapshot{CurseInfo: tc.curseInfo}, nil)
+			}
+			if tc.expectsNonce {
+				result := &types.BatchReadResult{ReadName: consts.MethodNameGetInboundNonce}
+				result.SetResult(&tc.senderNonce, nil)
+				destReader.EXPECT().ExtendedBatchGetLatestValues(mock.Anything, mock.Anything, false).Return(
+					types.BatchGetLatestValuesResult{
+						types.BoundContract{Name: consts.ContractNameNonceManager}: {*result},
+					}, []string{}, nil)
+			}
+
+			ccipReader := &ccipChainReader{
+				lggr: logger.Test(t),
+				contractReaders: map[cciptypes.ChainSelector]contractreader.Extended{
+					chainA: sourceReader,
+					chainB: destReader,
+				},
+				destChain:    chainB,
+				configPoller: mockCache,
+				addrCodec:    internal.NewMockAddressCodecHex(t),
+			}
+
+			lifecycle, err := ccipReader.MessageStatus(tests.Context(t), chainA, tc.msgID)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			mockCache.AssertExpectations(t)
+
+			require.Equal(t, tc.msgID, lifecycle.Message.Header.MessageID)
+			require.Equal(t, cciptypes.SeqNum(2), lifecycle.Sent.SeqNum)
+			require.Equal(t, uint64(102), lifecycle.Sent.BlockNum)
+			require.Equal(t, sentAt, lifecycle.Sent.Timestamp)
+			require.Equal(t, "2-0-0xabc", lifecycle.Sent.EventCursor)
+			require.Equal(t, tc.expStage, lifecycle.Stage())
+			require.Equal(t, tc.expReasons, nilIfEmpty(lifecycle.PendingReasons))
+
+			if tc.expCommitted {
+				require.NotNil(t, lifecycle.Committed)
+				require.Equal(t, root, lifecycle.Committed.MerkleRoot)
+				require.True(t, lifecycle.Committed.Blessed)
+				require.Equal(t, uint64(400), lifecycle.Committed.BlockNum)
+			} else {
+				require.Nil(t, lifecycle.Committed)
+			}
+
+			if tc.executions != nil {
+				require.NotNil(t, lifecycle.Executed)
+				require.Equal(t, tc.expExecState, lifecycle.Executed.State)
+				require.Equal(t, "500-1-0xdef", lifecycle.Executed.EventCursor)
+			} else {
+				require.Nil(t, lifecycle.Executed)
+			}
+		})
+	}
+}
+
+func TestCCIPChainReader_MessagesExecuted(t *testing.T) {
+	executedEvent := func(seqNum cciptypes.SeqNum, state MessageExecutionState, cursor string) types.Sequence {
+		return types.Sequence{
+			Cursor: cursor,
+			Head:   types.Head{Height: "500", Timestamp: 1_700_000_000},
+			Data: &ExecutionStateChangedEvent{
+				SourceChainSelector: chainA,
+				SequenceNumber:      seqNum,
+				MessageID:           cciptypes.Bytes32{byte(seqNum)},
+				MessageHash:         cciptypes.Bytes32{0x1},
+				State:               uint8(state),
+			},
+		}
+	}
+
+	destReader := reader_mocks.NewMockExtended(t)
+	destReader.EXPECT().ExtendedQueryKey(
+		mock.Anything, consts.ContractNameOffRamp, mock.Anything, mock.Anything, mock.Anything,
+	).Return([]types.Sequence{
+		executedEvent(1, ExecutionStateFailure, "a"),
+		executedEvent(2, ExecutionStateSuccess, "b"),
+		executedEvent(1, ExecutionStateSuccess, "c"),
+		// out of the queried range
+		executedEvent(9, ExecutionStateSuccess, "d"),
+	}, nil)
+
+	ccipReader := &ccipChainReader{
+		lggr:            logger.Test(t),
+		contractReaders: map[cciptypes.ChainSelector]contractreader.Extended{chainB: destReader},
+		destChain:       chainB,
+	}
+
+	executed, err := ccipReader.MessagesExecuted(tests.Context(t), chainA, cciptypes.NewSeqNumRange(1, 3))
+	require.NoError(t, err)
+	require.Len(t, executed, 2)
+	// the latest event of a message is returned
+	require.Equal(t, ExecutionStateSuccess, executed[1].State)
+	require.Equal(t, "c", executed[1].EventCursor)
+	require.Equal(t, uint64(500), executed[2].BlockNum)
+}
+
+func Test_findMerkleRoot(t *testing.T) {
+	report := cciptypes.CommitPluginReportWithMeta{
+		Report: cciptypes.CommitPluginReport{
+			BlessedMerkleRoots: []cciptypes.MerkleRootChain{
+				{ChainSel: chainA, SeqNumsRange: cciptypes.NewSeqNumRange(1, 10), MerkleRoot: cciptypes.Bytes32{0x1}},
+			},
+			UnblessedMerkleRoots: []cciptypes.MerkleRootChain{
+				{ChainSel: chainC, SeqNumsRange: cciptypes.NewSeqNumRange(5, 7), MerkleRoot: cciptypes.Bytes32{0x2}},
+			},
+		},
+		BlockNum: 10,
+	}
+
+	committed := findMerkleRoot(report, chainA, 10)
+	require.NotNil(t, committed)
+	require.True(t, committed.Blessed)
+	require.Equal(t, cciptypes.Bytes32{0x1}, committed.MerkleRoot)
+
+	committed = findMerkleRoot(report, chainC, 6)
+	require.NotNil(t, committed)
+	require.False(t, committed.Blessed)
+	require.Equal(t, uint64(10), committed.BlockNum)
+
+	require.Nil(t, findMerkleRoot(report, chainA, 11))
+	require.Nil(t, findMerkleRoot(report, chainB, 1))
+}
+
+func confidenceOf(filter query.KeyFilter) primitives.ConfidenceLevel {
+	for _, expr := range filter.Expressions {
+		if confidence, ok := expr.Primitive.(*primitives.Confidence); ok {
+			return confidence.ConfidenceLevel
+		}
+	}
+	return ""
+}
+
+func nilIfEmpty(reasons []MessagePendingReason) []MessagePendingReason {
+	if len(reasons) == 0 {
+		return nil
+	}
+	return reasons
+}
@@ -155,25 +155,121 @@ func (pr *priceReader) GetFeeQuoterTokenUpdates(
 	return updateMap, nil
 }
 
-// GetFeedPricesUSD gets USD prices for multiple tokens using batch requests
+// GetFeedPricesUSD gets USD prices for multiple tokens using batch requests.
+// Aggregator prices are read from the feed chain of each token, derived prices are calculated from the price of
+// their underlying token and fixed prices are taken from the token info. Prices copied from the FeeQuoter are
+// not feed prices and are skipped.
 func (pr *priceReader) GetFeedPricesUSD(
 	ctx context.Context,
 	tokens []ccipocr3.UnknownEncodedAddress,
 ) (ccipocr3.TokenPriceMap, error) {
 	lggr := logutil.WithContextValues(ctx, pr.lggr)
+
+	// prices of the aggregator and fixed price tokens, including the underlying tokens of derived prices.
+	basePrices := make(ccipocr3.TokenPriceMap)
+	aggregatorTokens := make(map[ccipocr3.ChainSelector][]ccipocr3.UnknownEncodedAddress)
+	for _, token := range pr.baseTokens(lggr, tokens) {
+		tokenInfo := pr.tokenInfo[token]
+		switch tokenInfo.PriceSource() {
+		case pluginconfig.TokenPriceSourceAggregator:
+			chain := tokenInfo.AggregatorChain(pr.feedChain)
+			aggregatorTokens[chain] = append(aggregatorTokens[chain], token)
+		case pluginconfig.TokenPriceSourceFixed:
+			basePrices[token] = ccipocr3.NewBigInt(FixedTokenPriceUSD(tokenInfo))
+		}
+	}
+
+	for chain, chainTokens := range aggregatorTokens {
+		if err := pr.getAggregatorPricesUSD(ctx, lggr, chain, chainTokens, basePrices); err != nil {
+			return nil, err
+		}
+	}
+
 	prices := make(ccipocr3.TokenPriceMap)
-	if pr.feedChainReader() == nil {
-		lggr.Debug("node does not support feed chain")
-		return prices, nil
+	for _, token := range tokens {
+		tokenInfo, ok := pr.tokenInfo[token]
+		if !ok {
+			continue
+		}
+		if tokenInfo.PriceSource() != pluginconfig.TokenPriceSourceDerived {
+			if price, ok := basePrices[token]; ok {
+				prices[token] = price
+			}
+			continue
+		}
+
+		underlyingPrice, ok := basePrices[tokenInfo.UnderlyingToken]
+		if !ok {
+			lggr.Warnw("price of underlying token not available, derived price skipped",
+				"token", token, "underlyingToken", tokenInfo.UnderlyingToken)
+			continue
+		}
+		price := DeriveTokenPriceUSD(underlyingPrice.Int, pr.tokenInfo[tokenInfo.UnderlyingToken], tokenInfo)
+		if price.Sign() <= 0 {
+			lggr.Errorw("derived price is not positive", "token", token)
+			continue
+		}
+		prices[token] = ccipocr3.NewBigInt(price)
+	}
+
+	return prices, nil
+}
+
+// baseTokens returns the tokens whose prices are read or configured directly, replacing derived tokens with their
+// underlying token.
+func (pr *priceReader) baseTokens(
+	lggr logger.Logger,
+	tokens []ccipocr3.UnknownEncodedAddress,
+) []ccipocr3.UnknownEncodedAddress {
+	seen := make(map[ccipocr3.UnknownEncodedAddress]struct{}, len(tokens))
+	baseTokens := make([]ccipocr3.UnknownEncodedAddress, 0, len(tokens))
+	for _, token := range tokens {
+		tokenInfo, ok := pr.tokenInfo[token]
+		if !ok {
+			lggr.Errorw("missing token info, token skipped", "token", token)
+			continue
+		}
+		switch tokenInfo.PriceSource() {
+		case pluginconfig.TokenPriceSourceFeeQuoter:
+			lggr.Debugw("token price is copied from the fee quoter, token skipped", "token", token)
+			continue
+		case pluginconfig.TokenPriceSourceDerived:
+			token = tokenInfo.UnderlyingToken
+			if _, ok := pr.tokenInfo[token]; !ok {
+				lggr.Errorw("missing token info of underlying token, token skipped", "token", token)
+				continue
+			}
+		}
+		if _, ok := seen[token]; ok {
+			continue
+		}
+		seen[token] = struct{}{}
+		baseTokens = append(baseTokens, token)
+	}
+	return baseTokens
+}
+
+// getAggregatorPricesUSD reads the aggregators of the tokens on the feed chain and adds their prices to prices.
+func (pr *priceReader) getAggregatorPricesUSD(
+	ctx context.Context,
+	lggr logger.Logger,
+	chain ccipocr3.ChainSelector,
+	tokens []ccipocr3.UnknownEncodedAddress,
+	prices ccipocr3.TokenPriceMap,
+) error {
+	feedChainReader, ok := pr.chainReaders[chain]
+	if !ok {
+		lggr.Debugw("node does not support feed chain", "chain", chain)
+		return nil
 	}
 
 	// Create batch request grouped by contract
 	batchRequest, contractTokenMap := pr.prepareBatchRequest(tokens)
 
 	// Execute batch request
-	results, err := pr.feedChainReader().BatchGetLatestValues(ctx, batchRequest)
+	results, err := feedChainReader.BatchGetLatestValues(ctx, batchRequest)
 	if err != nil {
-		return nil, fmt.Errorf("batch request failed: %w", err)
+		return fmt.Errorf("batch request failed on feed chain %d: %w", chain, err)
 	}
 
 	// Process results by contract
@@ -218,7 +314,7 @@ func (pr *priceReader) GetFeedPricesUSD(
 		}
 	}
 
-	return prices, nil
+	return nil
 }
 
 func (pr *priceReader) getPriceData(
@@ -309,10 +405,6 @@ func (pr *priceReader) normalizePrice(price *big.Int, decimals uint8) *big.Int {
 	return answer
 }
 
-func (pr *priceReader) feedChainReader() contractreader.ContractReaderFacade {
-	return pr.chainReaders[pr.feedChain]
-}
-
 // Input price is USD per full token, with 18 decimal precision
 // Result price is USD per 1e18 of smallest token denomination, with 18 decimal precision
 // Examples:
@@ -325,5 +417,46 @@ func calculateUsdPer1e18TokenAmount(price *big.Int, decimals uint8) *big.Int {
 	return tmp.Div(tmp, big.NewInt(0).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil))
 }
 
+// FixedTokenPriceUSD returns the fixed price of the token in USD per 1e18 of the smallest token denomination.
+func FixedTokenPriceUSD(tokenInfo pluginconfig.TokenInfo) *big.Int {
+	if tokenInfo.FixedPrice == nil || tokenInfo.FixedPrice.Int == nil {
+		return big.NewInt(0)
+	}
+	return calculateUsdPer1e18TokenAmount(tokenInfo.FixedPrice.Int, tokenInfo.Decimals)
+}
+
+// DeriveTokenPriceUSD returns the price of a derived token from the price of its underlying token, both in USD
+// per 1e18 of the smallest token denomination.
+//
+//	price = underlyingPrice * 10^underlyingDecimals * ratio / (1e18 * 10^decimals)
+func DeriveTokenPriceUSD(
+	underlyingPrice *big.Int,
+	underlying pluginconfig.TokenInfo,
+	tokenInfo pluginconfig.TokenInfo,
+) *big.Int {
+	if underlyingPrice == nil || tokenInfo.Ratio == nil || tokenInfo.Ratio.Int == nil {
+		return big.NewInt(0)
+	}
+	price := new(big.Int).Mul(underlyingPrice, tokenInfo.Ratio.Int)
+	price.Mul(price, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(underlying.Decimals)), nil))
+	divisor := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(tokenInfo.Decimals)), nil)
+	divisor.Mul(divisor, big.NewInt(1e18))
+	return price.Div(price, divisor)
+}
+
+// CopyTokenPriceUSD returns the price of a token copied from the FeeQuoter price of its underlying token, both in USD
+// per 1e18 of the smallest token denomination. The price is rescaled from the underlying token decimals to the token
+// decimals, the full tokens having the same USD price.
+//
+//	price = underlyingPrice * 10^underlyingDecimals / 10^decimals
+func CopyTokenPriceUSD(underlyingPrice *big.Int, tokenInfo pluginconfig.TokenInfo) *big.Int {
+	if underlyingPrice == nil || tokenInfo.UnderlyingDecimals == nil {
+		return big.NewInt(0)
+	}
+	price := new(big.Int).Mul(
+		underlyingPrice, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(*tokenInfo.UnderlyingDecimals)), nil))
+	return price.Div(price, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(tokenInfo.Decimals)), nil))
+}
+
 // Ensure priceReader implements PriceReader
 var _ PriceReader = (*priceReader)(nil)
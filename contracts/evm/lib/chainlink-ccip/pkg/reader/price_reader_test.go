@@ -161,6 +161,75 @@ func TestOnchainTokenPricesReader_GetTokenPricesUSD(t *testing.T) {
 	}
 }
 
+func TestPriceReader_GetFeedPricesUSD_Sources(t *testing.T) {
+	const (
+		bEthAddr   = cciptypes.UnknownEncodedAddress("0xbe00000000000000000000000000000000000000")
+		bUsdcAddr  = cciptypes.UnknownEncodedAddress("0xbc00000000000000000000000000000000000000")
+		usdcAddr   = cciptypes.UnknownEncodedAddress("0xc100000000000000000000000000000000000000")
+		bArbAddr   = cciptypes.UnknownEncodedAddress("0xba00000000000000000000000000000000000000")
+		feedChain  = cciptypes.ChainSelector(1)
+		otherChain = cciptypes.ChainSelector(2)
+	)
+	ratio := func(v int64) *cciptypes.BigInt {
+		r := cciptypes.NewBigInt(new(big.Int).Mul(big.NewInt(v), big.NewInt(1e17)))
+		return &r
+	}
+	fixedPrice := cciptypes.NewBigInt(big.NewInt(1e18))
+
+	ethInfo := EthInfo
+	ethInfo.FeedChainSelector = otherChain
+	tokenInfo := map[cciptypes.UnknownEncodedAddress]pluginconfig.TokenInfo{
+		ArbAddr: ArbInfo,
+		EthAddr: ethInfo,
+		// 1 bETH is worth 1.5 ETH
+		bEthAddr: {
+			Source:          pluginconfig.TokenPriceSourceDerived,
+			UnderlyingToken: EthAddr,
+			Ratio:           ratio(15),
+			Decimals:        Decimals18,
+		},
+		usdcAddr: {
+			Source:     pluginconfig.TokenPriceSourceFixed,
+			FixedPrice: &fixedPrice,
+			Decimals:   6,
+		},
+		// 1 bUSDC is worth 1 USDC, but has 18 decimals
+		bUsdcAddr: {
+			Source:          pluginconfig.TokenPriceSourceDerived,
+			UnderlyingToken: usdcAddr,
+			Ratio:           ratio(10),
+			Decimals:        Decimals18,
+		},
+		bArbAddr: {
+			Source:          pluginconfig.TokenPriceSourceFeeQuoter,
+			UnderlyingToken: ArbAddr,
+			Decimals:        Decimals18,
+		},
+	}
+
+	tokenPricesReader := priceReader{
+		lggr: logger.Test(t),
+		chainReaders: map[cciptypes.ChainSelector]contractreader.ContractReaderFacade{
+			feedChain: createMockReader(t,
+				map[cciptypes.UnknownEncodedAddress]*big.Int{ArbAddr: ArbPrice}, nil, tokenInfo),
+			otherChain: createMockReader(t,
+				map[cciptypes.UnknownEncodedAddress]*big.Int{EthAddr: EthPrice}, nil, tokenInfo),
+		},
+		tokenInfo: tokenInfo,
+		feedChain: feedChain,
+	}
+
+	prices, err := tokenPricesReader.GetFeedPricesUSD(
+		context.Background(), []cciptypes.UnknownEncodedAddress{ArbAddr, bEthAddr, usdcAddr, bUsdcAddr, bArbAddr})
+	require.NoError(t, err)
+	require.Equal(t, cciptypes.TokenPriceMap{
+		ArbAddr:   cciptypes.NewBigInt(ArbPrice),
+		bEthAddr:  cciptypes.NewBigInt(new(big.Int).Mul(big.NewInt(105), big.NewInt(1e17))),
+		usdcAddr:  cciptypes.NewBigInt(new(big.Int).Mul(big.NewInt(1e18), big.NewInt(1e12))),
+		bUsdcAddr: cciptypes.NewBigInt(big.NewInt(1e18)),
+	}, prices)
+}
+
 func TestPriceService_calculateUsdPer1e18TokenAmount(t *testing.T) {
 	testCases := []struct {
 		name       string
@@ -298,7 +367,7 @@ func createMockReader(
 			}
 			return true
 		}),
-	).Return(expectedResults, nil).Once()
+	).Return(expectedResults, nil).Maybe()
 
 	return reader
 }
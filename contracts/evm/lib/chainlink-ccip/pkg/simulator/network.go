@@ -0,0 +1,472 @@
+// Package simulator provides a deterministic in-memory CCIP network, with N source chains sending messages to a
+// single destination chain, and drives the commit and exec plugins against it through full OCR3 rounds.
+// It's meant for regression testing lane behavior without any chain, NewCommitPlugins and NewExecPlugins
+// return the plugins of a DON reading the network.
+package simulator
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/hashutil"
+	"github.com/smartcontractkit/chainlink-common/pkg/merklemulti"
+
+	"github.com/smartcontractkit/chainlink-ccip/internal/mocks/inmem"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/consts"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/reader"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+)
+
+var (
+	// ErrCursed is returned when a report is transmitted for a cursed chain.
+	ErrCursed = errors.New("cursed")
+	// ErrStaleCommitReport is returned when a merkle root doesn't start at the next sequence number of the offRamp.
+	ErrStaleCommitReport = errors.New("stale commit report")
+	// ErrInvalidMerkleRoot is returned when a merkle root doesn't match the messages sent on the source chain.
+	ErrInvalidMerkleRoot = errors.New("invalid merkle root")
+	// ErrNotCommitted is returned when an executed message isn't part of a committed merkle root.
+	ErrNotCommitted = errors.New("message not committed")
+)
+
+// Config of a simulated network.
+type Config struct {
+	Dest    cciptypes.ChainSelector
+	Sources []SourceConfig
+
+	// DestFinalityDepth is the number of blocks after which the commit reports are finalized.
+	DestFinalityDepth uint64
+
+	// BlockTime is the time between two blocks, 1s by default.
+	BlockTime time.Duration
+
+	// StartTime is the time of the first block. It's one hour ago by default, the plugins compare the
+	// commit reports timestamps with the wall clock.
+	StartTime time.Time
+
+	// MsgHasher verifies the committed merkle roots, it must be the hasher given to the plugins.
+	MsgHasher cciptypes.MessageHasher
+
+	// ConfigDigest is the OCR config digest of the offRamp, reports of another config aren't transmitted.
+	ConfigDigest [32]byte
+}
+
+// SourceConfig is the config of a simulated source chain.
+type SourceConfig struct {
+	Selector cciptypes.ChainSelector
+	OnRamp   cciptypes.UnknownAddress
+
+	// FinalityDepth is the number of blocks after which the sent messages are finalized,
+	// messages are only visible to the plugins once finalized.
+	FinalityDepth uint64
+}
+
+// SendRequest is a message sent on a source chain.
+type SendRequest struct {
+	Sender       cciptypes.UnknownAddress
+	Receiver     cciptypes.UnknownAddress
+	Data         []byte
+	TokenAmounts []cciptypes.RampTokenAmount
+
+	// Ordered messages get the next nonce of their sender, and are executed in nonce order.
+	Ordered bool
+
+	// TokenDataDelay is the number of blocks after which the offchain token data of the message is available.
+	TokenDataDelay uint64
+}
+
+// Reader is the CCIPReader of the destination chain plugins, it reflects the network state between rounds.
+type Reader struct {
+	reader.CCIPReader
+}
+
+var _ reader.CCIPReader = (*Reader)(nil)
+
+// Network is a simulated CCIP network. The blocks of every chain are mined together.
+// A Network must not be modified while a round runs, the plugins read it through Reader.
+type Network struct {
+	cfg   Config
+	block uint64
+
+	sources map[cciptypes.ChainSelector]*sourceChain
+
+	offRampNextSeqNums map[cciptypes.ChainSelector]cciptypes.SeqNum
+	inboundNonces      map[cciptypes.ChainSelector]map[string]uint64
+	executionStates    map[cciptypes.ChainSelector]map[cciptypes.SeqNum]reader.MessageExecutionState
+	commitReports      []cciptypes.CommitPluginReportWithMeta
+	curseInfo          reader.CurseInfo
+
+	inmem  *inmem.InMemoryCCIPReader
+	reader *Reader
+}
+
+type sourceChain struct {
+	cfg            SourceConfig
+	msgs           []sentMessage
+	outboundNonces map[string]uint64
+}
+
+type sentMessage struct {
+	msg                 cciptypes.Message
+	block               uint64
+	tokenDataReadyBlock uint64
+}
+
+// NewNetwork returns a network at block 1, without any message.
+func NewNetwork(cfg Config) (*Network, error) {
+	if cfg.MsgHasher == nil {
+		return nil, errors.New("message hasher is required")
+	}
+	if cfg.BlockTime == 0 {
+		cfg.BlockTime = time.Second
+	}
+	if cfg.StartTime.IsZero() {
+		cfg.StartTime = time.Now().Add(-time.Hour).Truncate(time.Second)
+	}
+
+	n := &Network{
+		cfg:                cfg,
+		block:              1,
+		sources:            make(map[cciptypes.ChainSelector]*sourceChain, len(cfg.Sources)),
+		offRampNextSeqNums: make(map[cciptypes.ChainSelector]cciptypes.SeqNum, len(cfg.Sources)),
+		inboundNonces:      make(map[cciptypes.ChainSelector]map[string]uint64, len(cfg.Sources)),
+		executionStates:    make(map[cciptypes.ChainSelector]map[cciptypes.SeqNum]reader.MessageExecutionState),
+		curseInfo:          reader.CurseInfo{CursedSourceChains: map[cciptypes.ChainSelector]bool{}},
+	}
+
+	sourceChainsConfig := make(map[cciptypes.ChainSelector]reader.StaticSourceChainConfig, len(cfg.Sources))
+	addresses := reader.ContractAddresses{
+		consts.ContractNameOnRamp:  {},
+		consts.ContractNameOffRamp: {cfg.Dest: cciptypes.UnknownAddress("offramp")},
+	}
+	for _, src := range cfg.Sources {
+		if src.Selector == cfg.Dest {
+			return nil, fmt.Errorf("source chain %d is the destination chain", src.Selector)
+		}
+		if _, ok := n.sources[src.Selector]; ok {
+			return nil, fmt.Errorf("duplicate source chain %d", src.Selector)
+		}
+		n.sources[src.Selector] = &sourceChain{cfg: src, outboundNonces: map[string]uint64{}}
+		n.offRampNextSeqNums[src.Selector] = 1
+		n.inboundNonces[src.Selector] = map[string]uint64{}
+		n.executionStates[src.Selector] = map[cciptypes.SeqNum]reader.MessageExecutionState{}
+		// RMN isn't simulated, the roots are committed unblessed.
+		sourceChainsConfig[src.Selector] = reader.StaticSourceChainConfig{
+			IsEnabled:                 true,
+			IsRMNVerificationDisabled: true,
+			OnRamp:                    src.OnRamp,
+		}
+		addresses[consts.ContractNameOnRamp][src.Selector] = src.OnRamp
+	}
+
+	n.inmem = &inmem.InMemoryCCIPReader{
+		Dest:               cfg.Dest,
+		ConfigDigest:       cfg.ConfigDigest,
+		SourceChainsConfig: sourceChainsConfig,
+		ContractAddresses:  addresses,
+	}
+	n.reader = &Reader{CCIPReader: n.inmem}
+	n.sync()
+	return n, nil
+}
+
+// Reader returns the reader of the destination chain plugins.
+func (n *Network) Reader() *Reader {
+	return n.reader
+}
+
+// Block returns the current block number.
+func (n *Network) Block() uint64 {
+	return n.block
+}
+
+// Now returns the timestamp of the current block.
+func (n *Network) Now() time.Time {
+	return n.cfg.StartTime.Add(time.Duration(n.block-1) * n.cfg.BlockTime)
+}
+
+// Mine mines the given number of blocks on every chain.
+func (n *Network) Mine(blocks uint64) {
+	n.block += blocks
+	n.sync()
+}
+
+// Send sends a message from the source chain to the destination chain in the current block.
+func (n *Network) Send(src cciptypes.ChainSelector, req SendRequest) (cciptypes.Message, error) {
+	source, ok := n.sources[src]
+	if !ok {
+		return cciptypes.Message{}, fmt.Errorf("unknown source chain %d", src)
+	}
+
+	seqNum := cciptypes.SeqNum(len(source.msgs) + 1)
+	var nonce uint64
+	if req.Ordered {
+		source.outboundNonces[req.Sender.String()]++
+		nonce = source.outboundNonces[req.Sender.String()]
+	}
+
+	msg := cciptypes.Message{
+		Header: cciptypes.RampMessageHeader{
+			MessageID:           sha256.Sum256([]byte(fmt.Sprintf("%d-%d-%d", src, seqNum, n.block))),
+			SourceChainSelector: src,
+			DestChainSelector:   n.cfg.Dest,
+			SequenceNumber:      seqNum,
+			Nonce:               nonce,
+			OnRamp:              source.cfg.OnRamp,
+		},
+		Sender:         req.Sender,
+		Receiver:       req.Receiver,
+		Data:           req.Data,
+		TokenAmounts:   req.TokenAmounts,
+		FeeTokenAmount: cciptypes.NewBigIntFromInt64(0),
+		FeeValueJuels:  cciptypes.NewBigIntFromInt64(100),
+	}
+	source.msgs = append(source.msgs, sentMessage{
+		msg:                 msg,
+		block:               n.block,
+		tokenDataReadyBlock: n.block + req.TokenDataDelay,
+	})
+	n.sync()
+	return msg, nil
+}
+
+// Reorg drops the last depth blocks of the source chain, along with the messages sent in them.
+// Only unfinalized blocks can be reorged.
+func (n *Network) Reorg(src cciptypes.ChainSelector, depth uint64) ([]cciptypes.Message, error) {
+	source, ok := n.sources[src]
+	if !ok {
+		return nil, fmt.Errorf("unknown source chain %d", src)
+	}
+	if depth > source.cfg.FinalityDepth {
+		return nil, fmt.Errorf("cannot reorg %d blocks, finality depth is %d", depth, source.cfg.FinalityDepth)
+	}
+
+	var dropped []cciptypes.Message
+	for len(source.msgs) > 0 && source.msgs[len(source.msgs)-1].block+depth > n.block {
+		msg := source.msgs[len(source.msgs)-1].msg
+		if msg.Header.Nonce != 0 {
+			source.outboundNonces[msg.Sender.String()]--
+		}
+		dropped = append([]cciptypes.Message{msg}, dropped...)
+		source.msgs = source.msgs[:len(source.msgs)-1]
+	}
+	n.sync()
+	return dropped, nil
+}
+
+// Curse curses the given source chain, reports of cursed chains are rejected.
+func (n *Network) Curse(src cciptypes.ChainSelector) {
+	n.curseInfo.CursedSourceChains[src] = true
+	n.sync()
+}
+
+// Uncurse lifts the curse of the given source chain.
+func (n *Network) Uncurse(src cciptypes.ChainSelector) {
+	delete(n.curseInfo.CursedSourceChains, src)
+	n.sync()
+}
+
+// CurseGlobal curses or uncurses every chain.
+func (n *Network) CurseGlobal(cursed bool) {
+	n.curseInfo.GlobalCurse = cursed
+	n.sync()
+}
+
+// ExecutionState returns the execution state of a message on the destination chain.
+func (n *Network) ExecutionState(src cciptypes.ChainSelector, seqNum cciptypes.SeqNum) reader.MessageExecutionState {
+	return n.executionStates[src][seqNum]
+}
+
+// CommitReports returns the commit reports accepted by the destination chain.
+func (n *Network) CommitReports() []cciptypes.CommitPluginReportWithMeta {
+	return n.commitReports
+}
+
+// AcceptCommitReport applies a transmitted commit report, an error means the transmission reverted.
+func (n *Network) AcceptCommitReport(ctx context.Context, report cciptypes.CommitPluginReport) error {
+	roots := append(append([]cciptypes.MerkleRootChain{}, report.BlessedMerkleRoots...),
+		report.UnblessedMerkleRoots...)
+	if len(roots) > 0 && (n.curseInfo.GlobalCurse || n.curseInfo.CursedDestination) {
+		return ErrCursed
+	}
+	for _, root := range roots {
+		if err := n.verifyMerkleRoot(ctx, root); err != nil {
+			return fmt.Errorf("chain %d range %s: %w", root.ChainSel, root.SeqNumsRange, err)
+		}
+	}
+
+	for _, root := range roots {
+		n.offRampNextSeqNums[root.ChainSel] = root.SeqNumsRange.End() + 1
+	}
+	n.commitReports = append(n.commitReports, cciptypes.CommitPluginReportWithMeta{
+		Report:    report,
+		Timestamp: n.Now(),
+		BlockNum:  n.block,
+	})
+	n.sync()
+	return nil
+}
+
+func (n *Network) verifyMerkleRoot(ctx context.Context, root cciptypes.MerkleRootChain) error {
+	source, ok := n.sources[root.ChainSel]
+	if !ok {
+		return fmt.Errorf("unknown source chain %d", root.ChainSel)
+	}
+	if n.curseInfo.CursedSourceChains[root.ChainSel] {
+		return ErrCursed
+	}
+	if root.SeqNumsRange.Start() != n.offRampNextSeqNums[root.ChainSel] {
+		return fmt.Errorf("%w: offRamp expects %d", ErrStaleCommitReport, n.offRampNextSeqNums[root.ChainSel])
+	}
+	if root.SeqNumsRange.End() < root.SeqNumsRange.Start() || int(root.SeqNumsRange.End()) > len(source.msgs) {
+		return fmt.Errorf("%w: range includes messages that were not sent", ErrInvalidMerkleRoot)
+	}
+
+	hashes := make([][32]byte, 0, root.SeqNumsRange.End()-root.SeqNumsRange.Start()+1)
+	for _, seqNum := range root.SeqNumsRange.ToSlice() {
+		hash, err := n.cfg.MsgHasher.Hash(ctx, source.msgs[seqNum-1].msg)
+		if err != nil {
+			return fmt.Errorf("hash message %d: %w", seqNum, err)
+		}
+		hashes = append(hashes, hash)
+	}
+	tree, err := merklemulti.NewTree(hashutil.NewKeccak(), hashes)
+	if err != nil {
+		return fmt.Errorf("build merkle tree: %w", err)
+	}
+	if tree.Root() != root.MerkleRoot {
+		return ErrInvalidMerkleRoot
+	}
+	return nil
+}
+
+// Execute applies a transmitted execute report, an error means the transmission reverted.
+// Like the offRamp, the reports of cursed chains and the messages that were already executed or whose sender
+// has a previous message inflight are skipped, and messages without their offchain token data fail.
+func (n *Network) Execute(ctx context.Context, report cciptypes.ExecutePluginReport) error {
+	if n.curseInfo.GlobalCurse || n.curseInfo.CursedDestination {
+		return ErrCursed
+	}
+	for _, chainReport := range report.ChainReports {
+		if err := n.verifyCommitted(ctx, chainReport); err != nil {
+			return fmt.Errorf("chain %d: %w", chainReport.SourceChainSelector, err)
+		}
+	}
+
+	for _, chainReport := range report.ChainReports {
+		if n.curseInfo.CursedSourceChains[chainReport.SourceChainSelector] {
+			continue
+		}
+		for i, msg := range chainReport.Messages {
+			var tokenData [][]byte
+			if i < len(chainReport.OffchainTokenData) {
+				tokenData = chainReport.OffchainTokenData[i]
+			}
+			n.executeMessage(msg, tokenData)
+		}
+	}
+	n.sync()
+	return nil
+}
+
+func (n *Network) verifyCommitted(ctx context.Context, chainReport cciptypes.ExecutePluginReportSingleChain) error {
+	source, ok := n.sources[chainReport.SourceChainSelector]
+	if !ok {
+		return fmt.Errorf("unknown source chain %d", chainReport.SourceChainSelector)
+	}
+	for _, msg := range chainReport.Messages {
+		seqNum := msg.Header.SequenceNumber
+		if seqNum < 1 || seqNum >= n.offRampNextSeqNums[chainReport.SourceChainSelector] {
+			return fmt.Errorf("%w: sequence number %d", ErrNotCommitted, seqNum)
+		}
+		sentHash, err := n.cfg.MsgHasher.Hash(ctx, source.msgs[seqNum-1].msg)
+		if err != nil {
+			return fmt.Errorf("hash sent message %d: %w", seqNum, err)
+		}
+		hash, err := n.cfg.MsgHasher.Hash(ctx, msg)
+		if err != nil {
+			return fmt.Errorf("hash executed message %d: %w", seqNum, err)
+		}
+		if hash != sentHash {
+			return fmt.Errorf("%w: message %d doesn't match the sent one", ErrInvalidMerkleRoot, seqNum)
+		}
+	}
+	return nil
+}
+
+func (n *Network) executeMessage(msg cciptypes.Message, tokenData [][]byte) {
+	src, seqNum := msg.Header.SourceChainSelector, msg.Header.SequenceNumber
+	if n.executionStates[src][seqNum] == reader.ExecutionStateSuccess {
+		return
+	}
+
+	sender := msg.Sender.String()
+	if msg.Header.Nonce != 0 && n.executionStates[src][seqNum] == reader.ExecutionStateUntouched {
+		if msg.Header.Nonce != n.inboundNonces[src][sender]+1 {
+			// a previous message of the sender is inflight.
+			return
+		}
+		n.inboundNonces[src][sender]++
+	}
+
+	sent := n.sources[src].msgs[seqNum-1]
+	if len(msg.TokenAmounts) > 0 && (n.block < sent.tokenDataReadyBlock || len(tokenData) != len(msg.TokenAmounts)) {
+		n.executionStates[src][seqNum] = reader.ExecutionStateFailure
+		return
+	}
+	n.executionStates[src][seqNum] = reader.ExecutionStateSuccess
+}
+
+// sync updates the reader of the plugins with the network state.
+func (n *Network) sync() {
+	messages := make(map[cciptypes.ChainSelector][]inmem.MessagesWithMetadata, len(n.sources))
+	for sel, source := range n.sources {
+		messages[sel] = []inmem.MessagesWithMetadata{}
+		for _, sent := range source.msgs {
+			if sent.block+source.cfg.FinalityDepth > n.block {
+				break
+			}
+			messages[sel] = append(messages[sel], inmem.MessagesWithMetadata{
+				Message:     sent.msg,
+				Executed:    n.executionStates[sel][sent.msg.Header.SequenceNumber] == reader.ExecutionStateSuccess,
+				Destination: n.cfg.Dest,
+			})
+		}
+	}
+
+	var finalized []cciptypes.CommitPluginReportWithMeta
+	for _, report := range n.commitReports {
+		if report.BlockNum+n.cfg.DestFinalityDepth <= n.block {
+			finalized = append(finalized, report)
+		}
+	}
+
+	nextSeqNums := make(map[cciptypes.ChainSelector]cciptypes.SeqNum, len(n.offRampNextSeqNums))
+	for sel, seqNum := range n.offRampNextSeqNums {
+		nextSeqNums[sel] = seqNum
+	}
+	nonces := make(map[cciptypes.ChainSelector]map[string]uint64, len(n.inboundNonces))
+	for sel, senderNonces := range n.inboundNonces {
+		nonces[sel] = make(map[string]uint64, len(senderNonces))
+		for sender, nonce := range senderNonces {
+			nonces[sel][sender] = nonce
+		}
+	}
+	cursed := make(map[cciptypes.ChainSelector]bool, len(n.curseInfo.CursedSourceChains))
+	for sel, isCursed := range n.curseInfo.CursedSourceChains {
+		cursed[sel] = isCursed
+	}
+
+	n.inmem.Messages = messages
+	n.inmem.UnfinalizedReports = append([]cciptypes.CommitPluginReportWithMeta{}, n.commitReports...)
+	n.inmem.FinalizedReports = finalized
+	n.inmem.OffRampNextSeqNums = nextSeqNums
+	n.inmem.SenderNonces = nonces
+	n.inmem.CurseInfo = &reader.CurseInfo{
+		CursedSourceChains: cursed,
+		CursedDestination:  n.curseInfo.CursedDestination,
+		GlobalCurse:        n.curseInfo.GlobalCurse,
+	}
+}
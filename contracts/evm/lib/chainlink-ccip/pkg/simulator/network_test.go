@@ -0,0 +1,208 @@
+package simulator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/hashutil"
+	"github.com/smartcontractkit/chainlink-common/pkg/merklemulti"
+	"github.com/smartcontractkit/chainlink-common/pkg/types/query/primitives"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink-ccip/execute/exectypes"
+	"github.com/smartcontractkit/chainlink-ccip/internal/mocks"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/reader"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+)
+
+const (
+	dest    = cciptypes.ChainSelector(1)
+	source1 = cciptypes.ChainSelector(2)
+	source2 = cciptypes.ChainSelector(3)
+)
+
+var (
+	sender   = cciptypes.UnknownAddress("sender")
+	receiver = cciptypes.UnknownAddress("receiver")
+)
+
+func newTestNetwork(t *testing.T) *Network {
+	n, err := NewNetwork(Config{
+		Dest: dest,
+		Sources: []SourceConfig{
+			{Selector: source1, OnRamp: cciptypes.UnknownAddress("onramp1"), FinalityDepth: 2},
+			{Selector: source2, OnRamp: cciptypes.UnknownAddress("onramp2")},
+		},
+		MsgHasher: mocks.NewMessageHasher(),
+	})
+	require.NoError(t, err)
+	return n
+}
+
+func merkleRoot(t *testing.T, msgs ...cciptypes.Message) cciptypes.MerkleRootChain {
+	hashes := make([][32]byte, 0, len(msgs))
+	for _, msg := range msgs {
+		hashes = append(hashes, msg.Header.MessageID)
+	}
+	tree, err := merklemulti.NewTree(hashutil.NewKeccak(), hashes)
+	require.NoError(t, err)
+	return cciptypes.MerkleRootChain{
+		ChainSel:     msgs[0].Header.SourceChainSelector,
+		SeqNumsRange: cciptypes.NewSeqNumRange(msgs[0].Header.SequenceNumber, msgs[len(msgs)-1].Header.SequenceNumber),
+		MerkleRoot:   tree.Root(),
+	}
+}
+
+func TestNetwork_Finality(t *testing.T) {
+	ctx := tests.Context(t)
+	n := newTestNetwork(t)
+
+	msg1, err := n.Send(source1, SendRequest{Sender: sender, Receiver: receiver})
+	require.NoError(t, err)
+	_, err = n.Send(source2, SendRequest{Sender: sender, Receiver: receiver})
+	require.NoError(t, err)
+
+	latest, err := n.Reader().LatestMsgSeqNum(ctx, source1)
+	require.NoError(t, err)
+	require.Equal(t, cciptypes.SeqNum(0), latest, "unfinalized messages are not visible")
+	latest, err = n.Reader().LatestMsgSeqNum(ctx, source2)
+	require.NoError(t, err)
+	require.Equal(t, cciptypes.SeqNum(1), latest)
+
+	n.Mine(2)
+	msgs, err := n.Reader().MsgsBetweenSeqNums(ctx, source1, cciptypes.NewSeqNumRange(1, 1))
+	require.NoError(t, err)
+	require.Equal(t, []cciptypes.Message{msg1}, msgs)
+
+	_, err = n.Send(source1, SendRequest{})
+	require.NoError(t, err)
+	_, err = n.Reorg(source1, 3)
+	require.Error(t, err, "finalized blocks cannot be reorged")
+}
+
+func TestNetwork_Reorg(t *testing.T) {
+	n := newTestNetwork(t)
+
+	first, err := n.Send(source1, SendRequest{Sender: sender, Ordered: true})
+	require.NoError(t, err)
+	n.Mine(1)
+	second, err := n.Send(source1, SendRequest{Sender: sender, Ordered: true})
+	require.NoError(t, err)
+
+	dropped, err := n.Reorg(source1, 1)
+	require.NoError(t, err)
+	require.Equal(t, []cciptypes.Message{second}, dropped)
+
+	resent, err := n.Send(source1, SendRequest{Sender: sender, Ordered: true})
+	require.NoError(t, err)
+	require.Equal(t, cciptypes.SeqNum(2), resent.Header.SequenceNumber)
+	require.Equal(t, uint64(2), resent.Header.Nonce)
+	require.NotEqual(t, first.Header.MessageID, resent.Header.MessageID)
+}
+
+func TestNetwork_AcceptCommitReport(t *testing.T) {
+	ctx := tests.Context(t)
+	n := newTestNetwork(t)
+
+	msg1, err := n.Send(source2, SendRequest{})
+	require.NoError(t, err)
+	msg2, err := n.Send(source2, SendRequest{})
+	require.NoError(t, err)
+
+	invalid := merkleRoot(t, msg1, msg2)
+	invalid.MerkleRoot[0]++
+	err = n.AcceptCommitReport(ctx, cciptypes.CommitPluginReport{
+		UnblessedMerkleRoots: []cciptypes.MerkleRootChain{invalid},
+	})
+	require.ErrorIs(t, err, ErrInvalidMerkleRoot)
+
+	err = n.AcceptCommitReport(ctx, cciptypes.CommitPluginReport{
+		UnblessedMerkleRoots: []cciptypes.MerkleRootChain{merkleRoot(t, msg2)},
+	})
+	require.ErrorIs(t, err, ErrStaleCommitReport)
+
+	n.Curse(source2)
+	err = n.AcceptCommitReport(ctx, cciptypes.CommitPluginReport{
+		UnblessedMerkleRoots: []cciptypes.MerkleRootChain{merkleRoot(t, msg1)},
+	})
+	require.ErrorIs(t, err, ErrCursed)
+	n.Uncurse(source2)
+
+	report := cciptypes.CommitPluginReport{UnblessedMerkleRoots: []cciptypes.MerkleRootChain{merkleRoot(t, msg1, msg2)}}
+	require.NoError(t, n.AcceptCommitReport(ctx, report))
+	nextSeqNums, err := n.Reader().NextSeqNum(ctx, []cciptypes.ChainSelector{source1, source2})
+	require.NoError(t, err)
+	require.Equal(t, map[cciptypes.ChainSelector]cciptypes.SeqNum{source1: 1, source2: 3}, nextSeqNums)
+	require.Len(t, n.CommitReports(), 1)
+}
+
+func TestNetwork_Execute(t *testing.T) {
+	ctx := tests.Context(t)
+	n := newTestNetwork(t)
+
+	tokens := []cciptypes.RampTokenAmount{{Amount: cciptypes.NewBigIntFromInt64(1)}}
+	withToken, err := n.Send(source2, SendRequest{Sender: sender, Ordered: true, TokenAmounts: tokens, TokenDataDelay: 2})
+	require.NoError(t, err)
+	ordered, err := n.Send(source2, SendRequest{Sender: sender, Ordered: true})
+	require.NoError(t, err)
+
+	executeReport := func(msgs ...cciptypes.Message) cciptypes.ExecutePluginReport {
+		tokenData := make([][][]byte, len(msgs))
+		for i, msg := range msgs {
+			for range msg.TokenAmounts {
+				tokenData[i] = append(tokenData[i], []byte("attestation"))
+			}
+		}
+		return cciptypes.ExecutePluginReport{ChainReports: []cciptypes.ExecutePluginReportSingleChain{
+			{SourceChainSelector: source2, Messages: msgs, OffchainTokenData: tokenData},
+		}}
+	}
+
+	require.ErrorIs(t, n.Execute(ctx, executeReport(withToken)), ErrNotCommitted)
+
+	require.NoError(t, n.AcceptCommitReport(ctx, cciptypes.CommitPluginReport{
+		UnblessedMerkleRoots: []cciptypes.MerkleRootChain{merkleRoot(t, withToken, ordered)},
+	}))
+
+	// the sender has a previous message inflight.
+	require.NoError(t, n.Execute(ctx, executeReport(ordered)))
+	require.Equal(t, reader.ExecutionStateUntouched, n.ExecutionState(source2, 2))
+
+	// the token data isn't ready, the first message fails but still consumes its nonce.
+	require.NoError(t, n.Execute(ctx, executeReport(withToken, ordered)))
+	require.Equal(t, reader.ExecutionStateFailure, n.ExecutionState(source2, 1))
+	require.Equal(t, reader.ExecutionStateSuccess, n.ExecutionState(source2, 2))
+
+	n.Mine(2)
+	require.NoError(t, n.Execute(ctx, executeReport(withToken)))
+	require.Equal(t, reader.ExecutionStateSuccess, n.ExecutionState(source2, 1))
+
+	nonces, err := n.Reader().Nonces(ctx, map[cciptypes.ChainSelector][]string{source2: {sender.String()}})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), nonces[source2][sender.String()])
+
+	executed, err := n.Reader().ExecutedMessages(ctx,
+		map[cciptypes.ChainSelector][]cciptypes.SeqNumRange{source2: {cciptypes.NewSeqNumRange(1, 2)}}, primitives.Finalized)
+	require.NoError(t, err)
+	require.Equal(t, []cciptypes.SeqNum{1, 2}, executed[source2])
+}
+
+func TestNetwork_TokenDataObserver(t *testing.T) {
+	ctx := tests.Context(t)
+	n := newTestNetwork(t)
+
+	tokens := []cciptypes.RampTokenAmount{{Amount: cciptypes.NewBigIntFromInt64(1)}}
+	msg, err := n.Send(source2, SendRequest{TokenAmounts: tokens, TokenDataDelay: 1})
+	require.NoError(t, err)
+	observations := exectypes.MessageObservations{source2: {1: msg}}
+
+	tokenData, err := n.TokenDataObserver().Observe(ctx, observations)
+	require.NoError(t, err)
+	require.False(t, tokenData[source2][1].IsReady())
+
+	n.Mine(1)
+	tokenData, err = n.TokenDataObserver().Observe(ctx, observations)
+	require.NoError(t, err)
+	require.True(t, tokenData[source2][1].IsReady())
+}
@@ -0,0 +1,269 @@
+package simulator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+
+	mapset "github.com/deckarep/golang-set/v2"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/libocr/commontypes"
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/ocr3types"
+	libocrtypes "github.com/smartcontractkit/libocr/ragep2p/types"
+
+	"github.com/smartcontractkit/chainlink-ccip/chainconfig"
+	"github.com/smartcontractkit/chainlink-ccip/commit"
+	commitmetrics "github.com/smartcontractkit/chainlink-ccip/commit/metrics"
+	"github.com/smartcontractkit/chainlink-ccip/execute"
+	execmetrics "github.com/smartcontractkit/chainlink-ccip/execute/metrics"
+	"github.com/smartcontractkit/chainlink-ccip/internal/mocks"
+	"github.com/smartcontractkit/chainlink-ccip/internal/plugintypes"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/reader"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+	"github.com/smartcontractkit/chainlink-ccip/pluginconfig"
+)
+
+// DONConfig is the destination chain DON running the plugins, every oracle reads every chain of the network.
+type DONConfig struct {
+	DonID           plugintypes.DonID
+	OracleIDToP2PID map[commontypes.OracleID]libocrtypes.PeerID
+
+	// F is the max number of faulty oracles of the DON, and FChain the one of every chain.
+	F      int
+	FChain int
+
+	// ChainConfig is the home chain config of every chain.
+	ChainConfig chainconfig.ChainConfig
+
+	AddressCodec cciptypes.AddressCodec
+	Lggr         logger.Logger
+}
+
+func (c DONConfig) validate() error {
+	if len(c.OracleIDToP2PID) == 0 {
+		return errors.New("at least one oracle is required")
+	}
+	if c.AddressCodec == nil || c.Lggr == nil {
+		return errors.New("address codec and logger are required")
+	}
+	return nil
+}
+
+// oracleIDs returns the oracle IDs of the DON in ascending order.
+func (c DONConfig) oracleIDs() []commontypes.OracleID {
+	return slices.Sorted(maps.Keys(c.OracleIDToP2PID))
+}
+
+func (n *Network) reportingConfig(don DONConfig, oracleID commontypes.OracleID) ocr3types.ReportingPluginConfig {
+	return ocr3types.ReportingPluginConfig{
+		ConfigDigest: n.cfg.ConfigDigest,
+		OracleID:     oracleID,
+		N:            len(don.OracleIDToP2PID),
+		F:            don.F,
+	}
+}
+
+// NewCommitPlugins returns the commit plugins of the DON reading the network, the offchain config gets the defaults
+// of the plugin factory. Token prices aren't simulated, the offchain config must not have any token info.
+func (n *Network) NewCommitPlugins(
+	don DONConfig, offchainCfg pluginconfig.CommitOffchainConfig,
+) (*CommitPlugins, error) {
+	if err := don.validate(); err != nil {
+		return nil, err
+	}
+	if len(offchainCfg.TokenInfo) > 0 {
+		return nil, errors.New("token prices are not simulated")
+	}
+	if err := offchainCfg.ApplyDefaultsAndValidate(); err != nil {
+		return nil, fmt.Errorf("invalid commit offchain config: %w", err)
+	}
+	reportBuilder, err := commit.NewReportBuilder(offchainCfg)
+	if err != nil {
+		return nil, fmt.Errorf("create report builder: %w", err)
+	}
+
+	plugins := &CommitPlugins{Codec: mocks.NewCommitPluginJSONReportCodec()}
+	homeChain := n.homeChain(don)
+	for _, oracleID := range don.oracleIDs() {
+		plugins.Nodes = append(plugins.Nodes, commit.NewPlugin(
+			don.DonID,
+			don.OracleIDToP2PID,
+			offchainCfg,
+			n.cfg.Dest,
+			n.reader,
+			noPrices{},
+			plugins.Codec,
+			n.cfg.MsgHasher,
+			don.Lggr,
+			homeChain,
+			noRMNHome{},
+			nil,
+			nil,
+			n.reportingConfig(don, oracleID),
+			&commitmetrics.Noop{},
+			don.AddressCodec,
+			reportBuilder,
+		))
+		plugins.OracleIDs = append(plugins.OracleIDs, oracleID)
+	}
+	return plugins, nil
+}
+
+// NewExecPlugins returns the exec plugins of the DON reading the network, the offchain config gets the defaults
+// of the plugin factory. The offchain token data comes from the network TokenDataObserver.
+func (n *Network) NewExecPlugins(
+	don DONConfig, offchainCfg pluginconfig.ExecuteOffchainConfig, estimateProvider cciptypes.EstimateProvider,
+) (*ExecPlugins, error) {
+	if err := don.validate(); err != nil {
+		return nil, err
+	}
+	if estimateProvider == nil {
+		return nil, errors.New("estimate provider is required")
+	}
+	if err := offchainCfg.ApplyDefaultsAndValidate(); err != nil {
+		return nil, fmt.Errorf("invalid exec offchain config: %w", err)
+	}
+
+	plugins := &ExecPlugins{Codec: mocks.NewExecutePluginJSONReportCodec()}
+	homeChain := n.homeChain(don)
+	for _, oracleID := range don.oracleIDs() {
+		plugins.Nodes = append(plugins.Nodes, execute.NewPlugin(
+			don.DonID,
+			n.reportingConfig(don, oracleID),
+			offchainCfg,
+			n.cfg.Dest,
+			don.OracleIDToP2PID,
+			n.reader,
+			plugins.Codec,
+			n.cfg.MsgHasher,
+			homeChain,
+			n.TokenDataObserver(),
+			estimateProvider,
+			nil,
+			nil,
+			don.Lggr,
+			&execmetrics.Noop{},
+			don.AddressCodec,
+		))
+		plugins.OracleIDs = append(plugins.OracleIDs, oracleID)
+	}
+	return plugins, nil
+}
+
+// homeChain returns the home chain of the DON, with the destination and source chains of the network.
+func (n *Network) homeChain(don DONConfig) *homeChain {
+	peers := mapset.NewSet[libocrtypes.PeerID]()
+	for _, peerID := range don.OracleIDToP2PID {
+		peers.Add(peerID)
+	}
+	chainConfig := reader.ChainConfig{FChain: don.FChain, SupportedNodes: peers, Config: don.ChainConfig}
+
+	h := &homeChain{chainConfigs: map[cciptypes.ChainSelector]reader.ChainConfig{n.cfg.Dest: chainConfig}}
+	for sel := range n.sources {
+		h.chainConfigs[sel] = chainConfig
+	}
+	return h
+}
+
+// homeChain is a static home chain, every oracle supports every chain.
+type homeChain struct {
+	chainConfigs map[cciptypes.ChainSelector]reader.ChainConfig
+}
+
+func (h *homeChain) GetChainConfig(chainSelector cciptypes.ChainSelector) (reader.ChainConfig, error) {
+	cfg, ok := h.chainConfigs[chainSelector]
+	if !ok {
+		return reader.ChainConfig{}, fmt.Errorf("chain config not found for chain %d", chainSelector)
+	}
+	return cfg, nil
+}
+
+func (h *homeChain) GetAllChainConfigs() (map[cciptypes.ChainSelector]reader.ChainConfig, error) {
+	return h.chainConfigs, nil
+}
+
+func (h *homeChain) GetSupportedChainsForPeer(id libocrtypes.PeerID) (mapset.Set[cciptypes.ChainSelector], error) {
+	chains := mapset.NewSet[cciptypes.ChainSelector]()
+	for sel, cfg := range h.chainConfigs {
+		if cfg.SupportedNodes.Contains(id) {
+			chains.Add(sel)
+		}
+	}
+	return chains, nil
+}
+
+func (h *homeChain) GetKnownCCIPChains() (mapset.Set[cciptypes.ChainSelector], error) {
+	chains := mapset.NewSet[cciptypes.ChainSelector]()
+	for sel := range h.chainConfigs {
+		chains.Add(sel)
+	}
+	return chains, nil
+}
+
+func (h *homeChain) GetFChain() (map[cciptypes.ChainSelector]int, error) {
+	fChain := make(map[cciptypes.ChainSelector]int, len(h.chainConfigs))
+	for sel, cfg := range h.chainConfigs {
+		fChain[sel] = cfg.FChain
+	}
+	return fChain, nil
+}
+
+func (h *homeChain) GetOCRConfigs(context.Context, uint32, uint8) (reader.ActiveAndCandidate, error) {
+	return reader.ActiveAndCandidate{}, nil
+}
+
+func (h *homeChain) Start(context.Context) error    { return nil }
+func (h *homeChain) Close() error                   { return nil }
+func (h *homeChain) Ready() error                   { return nil }
+func (h *homeChain) HealthReport() map[string]error { return map[string]error{h.Name(): nil} }
+func (h *homeChain) Name() string                   { return "SimulatedHomeChain" }
+
+// noRMNHome is the RMNHome of a network without RMN, the roots are committed unblessed.
+type noRMNHome struct{}
+
+func (noRMNHome) GetRMNNodesInfo(cciptypes.Bytes32) ([]reader.HomeNodeInfo, error) { return nil, nil }
+func (noRMNHome) IsRMNHomeConfigDigestSet(cciptypes.Bytes32) bool                  { return false }
+
+func (noRMNHome) GetRMNEnabledSourceChains(cciptypes.Bytes32) (map[cciptypes.ChainSelector]bool, error) {
+	return map[cciptypes.ChainSelector]bool{}, nil
+}
+
+func (noRMNHome) GetFObserve(cciptypes.Bytes32) (map[cciptypes.ChainSelector]int, error) {
+	return map[cciptypes.ChainSelector]int{}, nil
+}
+
+func (noRMNHome) GetOffChainConfig(cciptypes.Bytes32) (cciptypes.Bytes, error) { return nil, nil }
+
+func (noRMNHome) GetAllConfigDigests() (cciptypes.Bytes32, cciptypes.Bytes32) {
+	return cciptypes.Bytes32{}, cciptypes.Bytes32{}
+}
+
+func (noRMNHome) Start(context.Context) error    { return nil }
+func (noRMNHome) Close() error                   { return nil }
+func (noRMNHome) Ready() error                   { return nil }
+func (noRMNHome) HealthReport() map[string]error { return map[string]error{"SimulatedRMNHome": nil} }
+func (noRMNHome) Name() string                   { return "SimulatedRMNHome" }
+
+// noPrices is the price reader of the commit plugins, token prices aren't simulated.
+type noPrices struct{}
+
+func (noPrices) GetFeedPricesUSD(
+	context.Context, []cciptypes.UnknownEncodedAddress,
+) (cciptypes.TokenPriceMap, error) {
+	return cciptypes.TokenPriceMap{}, nil
+}
+
+func (noPrices) GetFeeQuoterTokenUpdates(
+	context.Context, []cciptypes.UnknownEncodedAddress, cciptypes.ChainSelector,
+) (map[cciptypes.UnknownEncodedAddress]cciptypes.TimestampedBig, error) {
+	return map[cciptypes.UnknownEncodedAddress]cciptypes.TimestampedBig{}, nil
+}
+
+var (
+	_ reader.HomeChain   = (*homeChain)(nil)
+	_ reader.RMNHome     = noRMNHome{}
+	_ reader.PriceReader = noPrices{}
+)
@@ -0,0 +1,158 @@
+package simulator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/smartcontractkit/libocr/commontypes"
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/ocr3types"
+
+	"github.com/smartcontractkit/chainlink-ccip/internal/libs/testhelpers"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+)
+
+// Faults are injected in the rounds of a plugin, see testhelpers.Faults.
+type Faults = testhelpers.Faults
+
+// CommitPlugins are the commit plugin instances of the oracles of the destination chain DON.
+type CommitPlugins struct {
+	Nodes     []ocr3types.ReportingPlugin[[]byte]
+	OracleIDs []commontypes.OracleID
+	Codec     cciptypes.CommitPluginCodec
+}
+
+// ExecPlugins are the exec plugin instances of the oracles of the destination chain DON.
+type ExecPlugins struct {
+	Nodes     []ocr3types.ReportingPlugin[[]byte]
+	OracleIDs []commontypes.OracleID
+	Codec     cciptypes.ExecutePluginCodec
+}
+
+// Simulator drives the commit and exec plugins against a Network.
+// Each round runs a commit round, an exec round, transmits their reports and mines a block,
+// the leader of the rounds rotates so that a run is deterministic.
+type Simulator struct {
+	network *Network
+
+	commit      *testhelpers.OCR3Runner[[]byte]
+	commitCodec cciptypes.CommitPluginCodec
+	exec        *testhelpers.OCR3Runner[[]byte]
+	execCodec   cciptypes.ExecutePluginCodec
+}
+
+// RoundResult is the result of a simulator round.
+type RoundResult struct {
+	Block uint64
+
+	Commit testhelpers.RoundResult[[]byte]
+	Exec   testhelpers.RoundResult[[]byte]
+
+	// CommitReports and ExecReports are the transmitted reports accepted by the destination chain.
+	CommitReports []cciptypes.CommitPluginReport
+	ExecReports   []cciptypes.ExecutePluginReport
+
+	// Reverted are the errors of the transmitted reports rejected by the destination chain.
+	Reverted []error
+}
+
+// New returns a simulator of the network, either plugin set is optional.
+func New(network *Network, commit *CommitPlugins, exec *ExecPlugins) (*Simulator, error) {
+	s := &Simulator{network: network}
+	if commit != nil {
+		if len(commit.Nodes) != len(commit.OracleIDs) || commit.Codec == nil {
+			return nil, errors.New("commit plugins need an oracle ID per node and a codec")
+		}
+		s.commit = testhelpers.NewOCR3Runner(commit.Nodes, commit.OracleIDs, nil).WithRoundRobinLeader()
+		s.commitCodec = commit.Codec
+	}
+	if exec != nil {
+		if len(exec.Nodes) != len(exec.OracleIDs) || exec.Codec == nil {
+			return nil, errors.New("exec plugins need an oracle ID per node and a codec")
+		}
+		s.exec = testhelpers.NewOCR3Runner(exec.Nodes, exec.OracleIDs, nil).WithRoundRobinLeader()
+		s.execCodec = exec.Codec
+	}
+	return s, nil
+}
+
+// Network returns the simulated network.
+func (s *Simulator) Network() *Network {
+	return s.network
+}
+
+// WithCommitFaults sets the faults injected in the following commit rounds.
+func (s *Simulator) WithCommitFaults(faults Faults) *Simulator {
+	if s.commit != nil {
+		s.commit.WithFaults(faults)
+	}
+	return s
+}
+
+// WithExecFaults sets the faults injected in the following exec rounds.
+func (s *Simulator) WithExecFaults(faults Faults) *Simulator {
+	if s.exec != nil {
+		s.exec.WithFaults(faults)
+	}
+	return s
+}
+
+// Run runs the given number of rounds and returns their results.
+func (s *Simulator) Run(ctx context.Context, rounds int) ([]RoundResult, error) {
+	results := make([]RoundResult, 0, rounds)
+	for i := 0; i < rounds; i++ {
+		result, err := s.RunRound(ctx)
+		if err != nil {
+			return results, fmt.Errorf("round %d: %w", i+1, err)
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// RunRound runs a commit round and an exec round, transmits their reports and mines a block.
+// A report rejected by the network is recorded in Reverted, while a plugin failure is returned.
+func (s *Simulator) RunRound(ctx context.Context) (RoundResult, error) {
+	result := RoundResult{Block: s.network.Block()}
+
+	if s.commit != nil {
+		commitResult, err := s.commit.RunRound(ctx)
+		if err != nil {
+			return result, fmt.Errorf("commit: %w", err)
+		}
+		result.Commit = commitResult
+		for _, transmitted := range commitResult.Transmitted {
+			report, err := s.commitCodec.Decode(ctx, transmitted.Report)
+			if err != nil {
+				return result, fmt.Errorf("decode commit report: %w", err)
+			}
+			if err := s.network.AcceptCommitReport(ctx, report); err != nil {
+				result.Reverted = append(result.Reverted, fmt.Errorf("commit report: %w", err))
+				continue
+			}
+			result.CommitReports = append(result.CommitReports, report)
+		}
+	}
+
+	if s.exec != nil {
+		execResult, err := s.exec.RunRound(ctx)
+		if err != nil {
+			return result, fmt.Errorf("exec: %w", err)
+		}
+		result.Exec = execResult
+		for _, transmitted := range execResult.Transmitted {
+			report, err := s.execCodec.Decode(ctx, transmitted.Report)
+			if err != nil {
+				return result, fmt.Errorf("decode exec report: %w", err)
+			}
+			if err := s.network.Execute(ctx, report); err != nil {
+				result.Reverted = append(result.Reverted, fmt.Errorf("exec report: %w", err))
+				continue
+			}
+			result.ExecReports = append(result.ExecReports, report)
+		}
+	}
+
+	s.network.Mine(1)
+	return result, nil
+}
@@ -0,0 +1,273 @@
+package simulator_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	sel "github.com/smartcontractkit/chain-selectors"
+
+	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+	"github.com/smartcontractkit/libocr/commontypes"
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink-ccip/chainconfig"
+	"github.com/smartcontractkit/chainlink-ccip/internal"
+	"github.com/smartcontractkit/chainlink-ccip/internal/libs/testhelpers"
+	"github.com/smartcontractkit/chainlink-ccip/internal/mocks"
+	cciptypesmocks "github.com/smartcontractkit/chainlink-ccip/mocks/pkg/types/ccipocr3"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/reader"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/simulator"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+	"github.com/smartcontractkit/chainlink-ccip/pluginconfig"
+)
+
+var (
+	destChain = cciptypes.ChainSelector(1)
+	evmChain  = cciptypes.ChainSelector(sel.ETHEREUM_TESTNET_SEPOLIA.Selector)
+	solChain  = cciptypes.ChainSelector(sel.SOLANA_DEVNET.Selector)
+
+	oracleIDs = []commontypes.OracleID{1, 2, 3}
+)
+
+// inflightCacheExpiry is how long the exec plugins wait for a transmitted message before reporting it again.
+const inflightCacheExpiry = 100 * time.Millisecond
+
+func newTestNetwork(t *testing.T) *simulator.Network {
+	network, err := simulator.NewNetwork(simulator.Config{
+		Dest: destChain,
+		Sources: []simulator.SourceConfig{
+			{Selector: evmChain, OnRamp: cciptypes.UnknownAddress{1}, FinalityDepth: 2},
+			{Selector: solChain, OnRamp: cciptypes.UnknownAddress{2}},
+		},
+		MsgHasher:    mocks.NewMessageHasher(),
+		ConfigDigest: [32]byte{0xde, 0xad},
+	})
+	require.NoError(t, err)
+	return network
+}
+
+func newTestDON(t *testing.T) simulator.DONConfig {
+	return simulator.DONConfig{
+		DonID:           1,
+		OracleIDToP2PID: testhelpers.CreateOracleIDToP2pID(1, 2, 3),
+		F:               1,
+		FChain:          1,
+		ChainConfig: chainconfig.ChainConfig{
+			GasPriceDeviationPPB:   cciptypes.NewBigInt(big.NewInt(1e9)),
+			DAGasPriceDeviationPPB: cciptypes.NewBigInt(big.NewInt(5e8)),
+		},
+		AddressCodec: internal.NewMockAddressCodecHex(t),
+		Lggr:         logger.Test(t),
+	}
+}
+
+func newTestCommitPlugins(
+	t *testing.T, network *simulator.Network, don simulator.DONConfig,
+) *simulator.CommitPlugins {
+	plugins, err := network.NewCommitPlugins(don, pluginconfig.CommitOffchainConfig{
+		NewMsgScanBatchSize:                100,
+		MaxReportTransmissionCheckAttempts: 2,
+		TokenPriceBatchWriteFrequency:      *commonconfig.MustNewDuration(time.Minute),
+		InflightPriceCheckRetries:          10,
+		MerkleRootAsyncObserverDisabled:    true,
+		ChainFeeAsyncObserverDisabled:      true,
+		TokenPriceAsyncObserverDisabled:    true,
+	})
+	require.NoError(t, err)
+	return plugins
+}
+
+func send(t *testing.T, network *simulator.Network, chain cciptypes.ChainSelector, n int, req simulator.SendRequest) {
+	for i := 0; i < n; i++ {
+		_, err := network.Send(chain, req)
+		require.NoError(t, err)
+	}
+}
+
+func requireNoRevert(t *testing.T, results []simulator.RoundResult) {
+	for _, result := range results {
+		require.Empty(t, result.Reverted)
+	}
+}
+
+func TestSimulator_Commit(t *testing.T) {
+	ctx := tests.Context(t)
+	network := newTestNetwork(t)
+	sim, err := simulator.New(network, newTestCommitPlugins(t, network, newTestDON(t)), nil)
+	require.NoError(t, err)
+
+	msg := simulator.SendRequest{
+		Sender:   cciptypes.UnknownAddress("sender"),
+		Receiver: cciptypes.UnknownAddress("receiver"),
+	}
+	nextSeqNums := func() map[cciptypes.ChainSelector]cciptypes.SeqNum {
+		seqNums, err := network.Reader().NextSeqNum(ctx, []cciptypes.ChainSelector{evmChain, solChain})
+		require.NoError(t, err)
+		return seqNums
+	}
+
+	t.Run("messages are committed once finalized", func(t *testing.T) {
+		send(t, network, evmChain, 3, msg)
+		send(t, network, solChain, 2, msg)
+		// the last evm message is reorged before being finalized.
+		network.Mine(1)
+		send(t, network, evmChain, 1, msg)
+		dropped, err := network.Reorg(evmChain, 1)
+		require.NoError(t, err)
+		require.Len(t, dropped, 1)
+
+		results, err := sim.Run(ctx, 10)
+		require.NoError(t, err)
+		requireNoRevert(t, results)
+		require.Equal(t, map[cciptypes.ChainSelector]cciptypes.SeqNum{evmChain: 4, solChain: 3}, nextSeqNums())
+	})
+
+	t.Run("cursed chains are not committed", func(t *testing.T) {
+		network.Curse(solChain)
+		send(t, network, evmChain, 1, msg)
+		send(t, network, solChain, 1, msg)
+
+		_, err := sim.Run(ctx, 10)
+		require.NoError(t, err)
+		require.Equal(t, map[cciptypes.ChainSelector]cciptypes.SeqNum{evmChain: 5, solChain: 3}, nextSeqNums())
+
+		network.Uncurse(solChain)
+		_, err = sim.Run(ctx, 10)
+		require.NoError(t, err)
+		require.Equal(t, map[cciptypes.ChainSelector]cciptypes.SeqNum{evmChain: 5, solChain: 4}, nextSeqNums())
+	})
+
+	t.Run("faulty oracles stall the DON until they recover", func(t *testing.T) {
+		send(t, network, evmChain, 2, msg)
+
+		// fChain consensus needs 2F+1 observations, a single faulty oracle out of 3 is enough to stall the commits.
+		sim.WithCommitFaults(simulator.Faults{
+			ByzantineObservations: map[commontypes.OracleID]func(ocrtypes.Observation) ocrtypes.Observation{
+				oracleIDs[1]: func(ocrtypes.Observation) ocrtypes.Observation { return []byte("garbage") },
+			},
+		})
+		results, err := sim.Run(ctx, 5)
+		require.NoError(t, err)
+		require.Equal(t, []commontypes.OracleID{oracleIDs[1]}, results[0].Commit.Discarded)
+		require.Equal(t, map[cciptypes.ChainSelector]cciptypes.SeqNum{evmChain: 5, solChain: 4}, nextSeqNums())
+
+		sim.WithCommitFaults(simulator.Faults{
+			DroppedObservations: map[commontypes.OracleID]bool{oracleIDs[0]: true},
+		})
+		results, err = sim.Run(ctx, 5)
+		require.NoError(t, err)
+		require.Equal(t, []commontypes.OracleID{oracleIDs[0]}, results[0].Commit.Discarded)
+		require.Equal(t, map[cciptypes.ChainSelector]cciptypes.SeqNum{evmChain: 5, solChain: 4}, nextSeqNums())
+
+		sim.WithCommitFaults(simulator.Faults{})
+		_, err = sim.Run(ctx, 10)
+		require.NoError(t, err)
+		require.Equal(t, map[cciptypes.ChainSelector]cciptypes.SeqNum{evmChain: 7, solChain: 4}, nextSeqNums())
+	})
+}
+
+func TestSimulator_Exec(t *testing.T) {
+	ctx := tests.Context(t)
+	network := newTestNetwork(t)
+	don := newTestDON(t)
+
+	estimateProvider := cciptypesmocks.NewMockEstimateProvider(t)
+	estimateProvider.EXPECT().CalculateMessageMaxGas(mock.Anything).Return(uint64(0)).Maybe()
+	estimateProvider.EXPECT().CalculateMerkleTreeGas(mock.Anything).Return(uint64(0)).Maybe()
+	execPlugins, err := network.NewExecPlugins(don, pluginconfig.ExecuteOffchainConfig{
+		MessageVisibilityInterval: *commonconfig.MustNewDuration(8 * time.Hour),
+		InflightCacheExpiry:       *commonconfig.MustNewDuration(inflightCacheExpiry),
+		RootSnoozeTime:            *commonconfig.MustNewDuration(inflightCacheExpiry),
+		BatchGasLimit:             100000000,
+	}, estimateProvider)
+	require.NoError(t, err)
+
+	sim, err := simulator.New(network, newTestCommitPlugins(t, network, don), execPlugins)
+	require.NoError(t, err)
+
+	executionStates := func(chain cciptypes.ChainSelector, msgs []cciptypes.Message) []reader.MessageExecutionState {
+		states := make([]reader.MessageExecutionState, 0, len(msgs))
+		for _, msg := range msgs {
+			states = append(states, network.ExecutionState(chain, msg.Header.SequenceNumber))
+		}
+		return states
+	}
+	repeat := func(state reader.MessageExecutionState, n int) []reader.MessageExecutionState {
+		states := make([]reader.MessageExecutionState, n)
+		for i := range states {
+			states[i] = state
+		}
+		return states
+	}
+
+	t.Run("committed messages are executed", func(t *testing.T) {
+		var sent []cciptypes.Message
+		for i := 0; i < 3; i++ {
+			msg, err := network.Send(evmChain, simulator.SendRequest{
+				Sender:   cciptypes.UnknownAddress("sender"),
+				Receiver: cciptypes.UnknownAddress("receiver"),
+				Ordered:  true,
+			})
+			require.NoError(t, err)
+			sent = append(sent, msg)
+		}
+
+		results, err := sim.Run(ctx, 10)
+		require.NoError(t, err)
+		requireNoRevert(t, results)
+		require.Equal(t, repeat(reader.ExecutionStateSuccess, 3), executionStates(evmChain, sent))
+	})
+
+	t.Run("token transfers wait for their token data", func(t *testing.T) {
+		msg, err := network.Send(solChain, simulator.SendRequest{
+			Sender:   cciptypes.UnknownAddress("sender"),
+			Receiver: cciptypes.UnknownAddress("receiver"),
+			TokenAmounts: []cciptypes.RampTokenAmount{{
+				SourcePoolAddress: cciptypes.UnknownAddress("pool"),
+				DestTokenAddress:  cciptypes.UnknownAddress("token"),
+				Amount:            cciptypes.NewBigIntFromInt64(1),
+			}},
+			TokenDataDelay: 5,
+		})
+		require.NoError(t, err)
+
+		results, err := sim.Run(ctx, 3)
+		require.NoError(t, err)
+		requireNoRevert(t, results)
+		require.Equal(t, reader.ExecutionStateUntouched, network.ExecutionState(solChain, msg.Header.SequenceNumber))
+
+		results, err = sim.Run(ctx, 10)
+		require.NoError(t, err)
+		requireNoRevert(t, results)
+		require.Equal(t, reader.ExecutionStateSuccess, network.ExecutionState(solChain, msg.Header.SequenceNumber))
+	})
+
+	t.Run("cursed chains are not executed", func(t *testing.T) {
+		msg, err := network.Send(evmChain, simulator.SendRequest{
+			Sender:   cciptypes.UnknownAddress("sender"),
+			Receiver: cciptypes.UnknownAddress("receiver"),
+		})
+		require.NoError(t, err)
+
+		// the message is committed before the curse.
+		_, err = sim.Run(ctx, 4)
+		require.NoError(t, err)
+		network.Curse(evmChain)
+		_, err = sim.Run(ctx, 10)
+		require.NoError(t, err)
+		require.Equal(t, reader.ExecutionStateUntouched, network.ExecutionState(evmChain, msg.Header.SequenceNumber))
+
+		// the reports built during the curse keep the message inflight until the cache expires.
+		network.Uncurse(evmChain)
+		time.Sleep(inflightCacheExpiry)
+		_, err = sim.Run(ctx, 10)
+		require.NoError(t, err)
+		require.Equal(t, reader.ExecutionStateSuccess, network.ExecutionState(evmChain, msg.Header.SequenceNumber))
+	})
+}
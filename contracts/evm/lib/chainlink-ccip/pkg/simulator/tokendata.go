@@ -0,0 +1,59 @@
+package simulator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/smartcontractkit/chainlink-ccip/execute/exectypes"
+	"github.com/smartcontractkit/chainlink-ccip/execute/tokendata/observer"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+)
+
+// TokenDataObserver returns the token data observer of the exec plugins. The token data of a message is
+// available SendRequest.TokenDataDelay blocks after it was sent, like an attestation.
+func (n *Network) TokenDataObserver() observer.TokenDataObserver {
+	return &tokenDataObserver{network: n}
+}
+
+type tokenDataObserver struct {
+	network *Network
+}
+
+func (o *tokenDataObserver) Observe(
+	_ context.Context,
+	observations exectypes.MessageObservations,
+) (exectypes.TokenDataObservations, error) {
+	tokenObservations := make(exectypes.TokenDataObservations, len(observations))
+	for chain, msgs := range observations {
+		tokenObservations[chain] = make(map[cciptypes.SeqNum]exectypes.MessageTokenData, len(msgs))
+		for seqNum, msg := range msgs {
+			tokenData := make([]exectypes.TokenData, len(msg.TokenAmounts))
+			for i := range msg.TokenAmounts {
+				tokenData[i] = o.tokenData(chain, seqNum, i)
+			}
+			tokenObservations[chain][seqNum] = exectypes.NewMessageTokenData(tokenData...)
+		}
+	}
+	return tokenObservations, nil
+}
+
+func (o *tokenDataObserver) tokenData(
+	chain cciptypes.ChainSelector, seqNum cciptypes.SeqNum, idx int,
+) exectypes.TokenData {
+	source, ok := o.network.sources[chain]
+	if !ok || seqNum < 1 || int(seqNum) > len(source.msgs) {
+		return exectypes.NewErrorTokenData(fmt.Errorf("unknown message %d on chain %d", seqNum, chain))
+	}
+	if o.network.block < source.msgs[seqNum-1].tokenDataReadyBlock {
+		return exectypes.NewErrorTokenData(fmt.Errorf("token data of message %d not ready", seqNum))
+	}
+	return exectypes.NewSuccessTokenData([]byte(fmt.Sprintf("%d-%d-%d", chain, seqNum, idx)))
+}
+
+func (o *tokenDataObserver) IsTokenSupported(cciptypes.ChainSelector, cciptypes.RampTokenAmount) bool {
+	return true
+}
+
+func (o *tokenDataObserver) Close() error {
+	return nil
+}
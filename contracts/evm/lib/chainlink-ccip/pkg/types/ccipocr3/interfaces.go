@@ -16,6 +16,16 @@ type ExecutePluginCodec interface {
 	Decode(context.Context, []byte) (ExecutePluginReport, error)
 }
 
+// ExecuteReportSimulator simulates the execution of an execute plugin report on the destination chain without
+// transmitting it, for example with an eth_call of the offramp executeSingleMessage function on EVM or
+// simulateTransaction on Solana.
+type ExecuteReportSimulator interface {
+	// SimulateExecute returns the messages of the report which would fail to execute. An error is returned when
+	// the simulation could not be performed, in which case no simulation failures are observed. Implementations
+	// may stop before the deadline of ctx and return the failures of the messages simulated so far.
+	SimulateExecute(ctx context.Context, report ExecutePluginReport) ([]MessageSimulationFailure, error)
+}
+
 type MessageHasher interface {
 	Hash(context.Context, Message) (Bytes32, error)
 }
@@ -46,6 +56,12 @@ type TokenDataEncoder interface {
 	EncodeUSDC(ctx context.Context, message Bytes, attestation Bytes) (Bytes, error)
 }
 
+// ExtraArgsDecoder decodes the extra args of a message into a chain agnostic map keyed by the field names of the
+// extra args, e.g. gasLimit for EVM destinations or computeUnits for Solana destinations.
+type ExtraArgsDecoder interface {
+	DecodeExtraArgs(extraArgs Bytes, sourceChainSelector ChainSelector) (map[string]any, error)
+}
+
 // EstimateProvider is used to estimate the gas cost of a message or a merkle tree.
 type EstimateProvider interface {
 	CalculateMerkleTreeGas(numRequests int) uint64
@@ -18,6 +18,16 @@ type ExecutePluginReportSingleChain struct {
 	ProofFlagBits       BigInt        `json:"proofFlagBits"`
 }
 
+// MessageSimulationFailure is a message of an execute report which failed when the report was simulated
+// on the destination chain, for example because the receiver reverted.
+type MessageSimulationFailure struct {
+	SourceChainSelector ChainSelector `json:"sourceChainSelector"`
+	SequenceNumber      SeqNum        `json:"seqNum"`
+	MessageID           Bytes32       `json:"messageId"`
+	// Reason is the decoded revert reason when available, otherwise the raw error.
+	Reason string `json:"reason"`
+}
+
 // ExecuteReportInfo contains metadata needed by transmitter and contract
 // writer.
 type ExecuteReportInfo struct {
@@ -9,6 +9,8 @@ import (
 	"strings"
 	"time"
 
+	chainsel "github.com/smartcontractkit/chain-selectors"
+
 	"github.com/smartcontractkit/chainlink-common/pkg/merklemulti"
 
 	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
@@ -30,10 +32,47 @@ const (
 	defaultAsyncObserverSyncTimeout           = 10 * time.Second
 )
 
+// TokenPriceSource is the kind of source the USD price of a token is read from.
+type TokenPriceSource string
+
+const (
+	// TokenPriceSourceAggregator reads the price from a TOKEN/USD AggregatorV3Interface feed. This is the default
+	// source when none is configured.
+	TokenPriceSourceAggregator TokenPriceSource = "aggregator"
+	// TokenPriceSourceDerived multiplies the feed price of the underlying token by a ratio, e.g. for wrapped tokens.
+	TokenPriceSourceDerived TokenPriceSource = "derived"
+	// TokenPriceSourceFixed uses a fixed USD price, e.g. for pegged tokens.
+	TokenPriceSourceFixed TokenPriceSource = "fixed"
+	// TokenPriceSourceFeeQuoter copies the price of the underlying token from the FeeQuoter on the dest chain.
+	TokenPriceSourceFeeQuoter TokenPriceSource = "feeQuoter"
+)
+
 type TokenInfo struct {
+	// Source is the kind of source the price of the token is read from, defaults to TokenPriceSourceAggregator.
+	Source TokenPriceSource `json:"source,omitempty"`
+
 	// AggregatorAddress is the address of the price feed TOKEN/USD aggregator on the feed chain.
 	AggregatorAddress cciptypes.UnknownEncodedAddress `json:"aggregatorAddress"`
 
+	// FeedChainSelector is the chain the aggregator is deployed on, it must be an EVM chain.
+	// If not set the PriceFeedChainSelector of the commit offchain config is used.
+	FeedChainSelector cciptypes.ChainSelector `json:"feedChainSelector,omitempty"`
+
+	// UnderlyingToken is the token the price is derived or copied from.
+	// For derived prices the underlying token must be configured with an aggregator or a fixed price.
+	UnderlyingToken cciptypes.UnknownEncodedAddress `json:"underlyingToken,omitempty"`
+
+	// Ratio is the amount of underlying full tokens per full token with 18 decimal precision, used for
+	// derived prices, i.e. 1e18 means the token has the same USD price as the underlying token.
+	Ratio *cciptypes.BigInt `json:"ratio,omitempty"`
+
+	// UnderlyingDecimals is the number of decimals of the underlying token, used for prices copied from the
+	// FeeQuoter to rescale the price of the underlying token to the decimals of the token.
+	UnderlyingDecimals *uint8 `json:"underlyingDecimals,omitempty"`
+
+	// FixedPrice is the USD price per full token with 18 decimal precision, used for fixed prices.
+	FixedPrice *cciptypes.BigInt `json:"fixedPrice,omitempty"`
+
 	// DeviationPPB is the deviation in parts per billion that the price feed is allowed to deviate
 	// from the last written price on-chain before we write a new price.
 	DeviationPPB cciptypes.BigInt `json:"deviationPPB"`
@@ -42,11 +81,72 @@ type TokenInfo struct {
 	Decimals uint8 `json:"decimals"`
 }
 
+// PriceSource returns the kind of source the price of the token is read from.
+func (a TokenInfo) PriceSource() TokenPriceSource {
+	if a.Source == "" {
+		return TokenPriceSourceAggregator
+	}
+	return a.Source
+}
+
+// AggregatorChain returns the chain the aggregator of the token is deployed on.
+func (a TokenInfo) AggregatorChain(defaultFeedChain cciptypes.ChainSelector) cciptypes.ChainSelector {
+	if a.FeedChainSelector == 0 {
+		return defaultFeedChain
+	}
+	return a.FeedChainSelector
+}
+
 func (a TokenInfo) Validate() error {
+	var err error
+	switch a.PriceSource() {
+	case TokenPriceSourceAggregator:
+		err = a.validateAggregatorSource()
+	case TokenPriceSourceDerived:
+		err = a.validateDerivedSource()
+	case TokenPriceSourceFixed:
+		err = a.validateFixedSource()
+	case TokenPriceSourceFeeQuoter:
+		err = a.validateFeeQuoterSource()
+	default:
+		err = fmt.Errorf("unknown price source %q", a.Source)
+	}
+	if err != nil {
+		return err
+	}
+
+	if a.PriceSource() != TokenPriceSourceAggregator && (a.AggregatorAddress != "" || a.FeedChainSelector != 0) {
+		return fmt.Errorf("aggregatorAddress and feedChainSelector can't be set for %s price source", a.Source)
+	}
+
+	if a.DeviationPPB.Int.Cmp(big.NewInt(0)) <= 0 {
+		return errors.New("deviationPPB not set or negative, must be positive")
+	}
+
+	if a.Decimals == 0 {
+		return fmt.Errorf("tokenDecimals can't be zero")
+	}
+
+	return nil
+}
+
+func (a TokenInfo) validateAggregatorSource() error {
 	if a.AggregatorAddress == "" {
 		return errors.New("aggregatorAddress not set")
 	}
 
+	// only EVM chains provide a contract reader config for the aggregators.
+	if a.FeedChainSelector != 0 {
+		family, err := chainsel.GetSelectorFamily(uint64(a.FeedChainSelector))
+		if err != nil {
+			return fmt.Errorf("unknown feedChainSelector %d: %w", a.FeedChainSelector, err)
+		}
+		if family != chainsel.FamilyEVM {
+			return fmt.Errorf("feedChainSelector %d is a %s chain, aggregators are only supported on %s chains",
+				a.FeedChainSelector, family, chainsel.FamilyEVM)
+		}
+	}
+
 	// aggregator must be an ethereum address
 	decoded, err := hex.DecodeString(strings.ToLower(strings.TrimPrefix(string(a.AggregatorAddress), "0x")))
 	if err != nil {
@@ -55,15 +155,33 @@ func (a TokenInfo) Validate() error {
 	if len(decoded) != 20 {
 		return fmt.Errorf("aggregatorAddress must be a valid ethereum address, got %d bytes expected 20", len(decoded))
 	}
+	return nil
+}
 
-	if a.DeviationPPB.Int.Cmp(big.NewInt(0)) <= 0 {
-		return errors.New("deviationPPB not set or negative, must be positive")
+func (a TokenInfo) validateDerivedSource() error {
+	if a.UnderlyingToken == "" {
+		return errors.New("underlyingToken not set")
 	}
+	if a.Ratio == nil || !a.Ratio.IsPositive() {
+		return errors.New("ratio not set or not positive, must be positive")
+	}
+	return nil
+}
 
-	if a.Decimals == 0 {
-		return fmt.Errorf("tokenDecimals can't be zero")
+func (a TokenInfo) validateFixedSource() error {
+	if a.FixedPrice == nil || !a.FixedPrice.IsPositive() {
+		return errors.New("fixedPrice not set or not positive, must be positive")
 	}
+	return nil
+}
 
+func (a TokenInfo) validateFeeQuoterSource() error {
+	if a.UnderlyingToken == "" {
+		return errors.New("underlyingToken not set")
+	}
+	if a.UnderlyingDecimals == nil {
+		return errors.New("underlyingDecimals not set")
+	}
 	return nil
 }
 
@@ -88,7 +206,7 @@ type CommitOffchainConfig struct {
 	TokenInfo map[cciptypes.UnknownEncodedAddress]TokenInfo `json:"tokenInfo"`
 
 	// PriceFeedChainSelector is the chain selector for the chain on which
-	// the token prices are read from.
+	// the token prices are read from, unless the token info sets its own feed chain.
 	// This will typically be an arbitrum testnet/mainnet chain depending on
 	// the deployment.
 	PriceFeedChainSelector cciptypes.ChainSelector `json:"tokenPriceChainSelector"`
@@ -249,7 +367,7 @@ func (c *CommitOffchainConfig) Validate() error {
 	// If price sources are provided the batch write frequency and token price chain selector
 	// config fields MUST be provided.
 	if len(c.TokenInfo) > 0 &&
-		(c.TokenPriceBatchWriteFrequency.Duration() == 0 || c.PriceFeedChainSelector == 0) {
+		(c.TokenPriceBatchWriteFrequency.Duration() == 0 || c.requiresPriceFeedChain() && c.PriceFeedChainSelector == 0) {
 		return fmt.Errorf("tokenPriceBatchWriteFrequency (%s) or tokenPriceChainSelector (%d) not set",
 			c.TokenPriceBatchWriteFrequency, c.PriceFeedChainSelector)
 	}
@@ -258,6 +376,9 @@ func (c *CommitOffchainConfig) Validate() error {
 		if err := tokenInfo.Validate(); err != nil {
 			return fmt.Errorf("invalid token info for token %s: %w", token, err)
 		}
+		if err := c.validateUnderlyingToken(token, tokenInfo); err != nil {
+			return fmt.Errorf("invalid token info for token %s: %w", token, err)
+		}
 	}
 
 	if c.NewMsgScanBatchSize == 0 {
@@ -318,6 +439,57 @@ func (c *CommitOffchainConfig) Validate() error {
 	return nil
 }
 
+// requiresPriceFeedChain returns true if an aggregator relies on the default PriceFeedChainSelector.
+func (c *CommitOffchainConfig) requiresPriceFeedChain() bool {
+	for _, tokenInfo := range c.TokenInfo {
+		if tokenInfo.PriceSource() == TokenPriceSourceAggregator && tokenInfo.FeedChainSelector == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *CommitOffchainConfig) validateUnderlyingToken(
+	token cciptypes.UnknownEncodedAddress, tokenInfo TokenInfo) error {
+	switch tokenInfo.PriceSource() {
+	case TokenPriceSourceDerived:
+		underlying, ok := c.TokenInfo[tokenInfo.UnderlyingToken]
+		if !ok {
+			return fmt.Errorf("underlying token %s has no token info", tokenInfo.UnderlyingToken)
+		}
+		if s := underlying.PriceSource(); s != TokenPriceSourceAggregator && s != TokenPriceSourceFixed {
+			return fmt.Errorf("underlying token %s must have an aggregator or fixed price source, got %s",
+				tokenInfo.UnderlyingToken, s)
+		}
+	case TokenPriceSourceFeeQuoter:
+		if tokenInfo.UnderlyingToken == token {
+			return errors.New("underlying token can't be the token itself")
+		}
+	}
+	return nil
+}
+
+// TokenPriceFeedChain returns the chain the price of the token is read from, derived prices are read from the
+// chain of their underlying token. It returns false if the price is not read from a feed chain, i.e. for fixed
+// prices and prices copied from the FeeQuoter.
+func (c *CommitOffchainConfig) TokenPriceFeedChain(
+	token cciptypes.UnknownEncodedAddress) (cciptypes.ChainSelector, bool) {
+	tokenInfo, ok := c.TokenInfo[token]
+	if !ok {
+		return 0, false
+	}
+	if tokenInfo.PriceSource() == TokenPriceSourceDerived {
+		tokenInfo, ok = c.TokenInfo[tokenInfo.UnderlyingToken]
+		if !ok {
+			return 0, false
+		}
+	}
+	if tokenInfo.PriceSource() != TokenPriceSourceAggregator {
+		return 0, false
+	}
+	return tokenInfo.AggregatorChain(c.PriceFeedChainSelector), true
+}
+
 func (c *CommitOffchainConfig) ApplyDefaultsAndValidate() error {
 	c.applyDefaults()
 	return c.Validate()
@@ -10,6 +10,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	chainsel "github.com/smartcontractkit/chain-selectors"
+
 	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
 
 	"github.com/smartcontractkit/chainlink-ccip/internal/libs/testhelpers/rand"
@@ -114,6 +116,230 @@ func TestArbitrumPriceSource_Validate(t *testing.T) {
 	}
 }
 
+func TestTokenInfo_Validate_Sources(t *testing.T) {
+	positive := cciptypes.NewBigInt(big.NewInt(1e18))
+	deviation := cciptypes.NewBigInt(big.NewInt(1))
+	decimals18 := uint8(18)
+	solanaMainnet := cciptypes.ChainSelector(chainsel.SOLANA_MAINNET.Selector)
+
+	tests := []struct {
+		name    string
+		info    TokenInfo
+		wantErr string
+	}{
+		{
+			name: "aggregator on a non-evm feed chain",
+			info: TokenInfo{
+				AggregatorAddress: "7UVimffxr9ow1uXYxsr4LHAcV58mLzhmwaeKvJ1pjLiE",
+				FeedChainSelector: solanaMainnet,
+				DeviationPPB:      deviation,
+				Decimals:          9,
+			},
+			wantErr: "aggregators are only supported on evm chains",
+		},
+		{
+			name: "aggregator on an unknown feed chain",
+			info: TokenInfo{
+				AggregatorAddress: "0x2e03388D351BF87CF2409EFf18C45Df59775Fbb2",
+				FeedChainSelector: 20,
+				DeviationPPB:      deviation,
+				Decimals:          18,
+			},
+			wantErr: "unknown feedChainSelector",
+		},
+		{
+			name: "aggregator on an evm feed chain",
+			info: TokenInfo{
+				AggregatorAddress: "0x2e03388D351BF87CF2409EFf18C45Df59775Fbb2",
+				FeedChainSelector: cciptypes.ChainSelector(chainsel.ETHEREUM_MAINNET.Selector),
+				DeviationPPB:      deviation,
+				Decimals:          18,
+			},
+		},
+		{
+			name: "invalid aggregator on an evm feed chain",
+			info: TokenInfo{
+				AggregatorAddress: "7UVimffxr9ow1uXYxsr4LHAcV58mLzhmwaeKvJ1pjLiE",
+				FeedChainSelector: cciptypes.ChainSelector(chainsel.ETHEREUM_MAINNET.Selector),
+				DeviationPPB:      deviation,
+				Decimals:          18,
+			},
+			wantErr: "valid ethereum address",
+		},
+		{
+			name: "derived",
+			info: TokenInfo{
+				Source:          TokenPriceSourceDerived,
+				UnderlyingToken: "0x1",
+				Ratio:           &positive,
+				DeviationPPB:    deviation,
+				Decimals:        18,
+			},
+		},
+		{
+			name: "derived without ratio",
+			info: TokenInfo{
+				Source:          TokenPriceSourceDerived,
+				UnderlyingToken: "0x1",
+				DeviationPPB:    deviation,
+				Decimals:        18,
+			},
+			wantErr: "ratio not set",
+		},
+		{
+			name: "derived with aggregator",
+			info: TokenInfo{
+				Source:            TokenPriceSourceDerived,
+				AggregatorAddress: "0x2e03388D351BF87CF2409EFf18C45Df59775Fbb2",
+				UnderlyingToken:   "0x1",
+				Ratio:             &positive,
+				DeviationPPB:      deviation,
+				Decimals:          18,
+			},
+			wantErr: "can't be set for derived price source",
+		},
+		{
+			name: "fixed",
+			info: TokenInfo{
+				Source:       TokenPriceSourceFixed,
+				FixedPrice:   &positive,
+				DeviationPPB: deviation,
+				Decimals:     6,
+			},
+		},
+		{
+			name: "fixed without price",
+			info: TokenInfo{
+				Source:       TokenPriceSourceFixed,
+				DeviationPPB: deviation,
+				Decimals:     6,
+			},
+			wantErr: "fixedPrice not set",
+		},
+		{
+			name: "fee quoter",
+			info: TokenInfo{
+				Source:             TokenPriceSourceFeeQuoter,
+				UnderlyingToken:    "0x1",
+				UnderlyingDecimals: &decimals18,
+				DeviationPPB:       deviation,
+				Decimals:           18,
+			},
+		},
+		{
+			name: "fee quoter without underlying token",
+			info: TokenInfo{
+				Source:             TokenPriceSourceFeeQuoter,
+				UnderlyingDecimals: &decimals18,
+				DeviationPPB:       deviation,
+				Decimals:           18,
+			},
+			wantErr: "underlyingToken not set",
+		},
+		{
+			name: "fee quoter without underlying decimals",
+			info: TokenInfo{
+				Source:          TokenPriceSourceFeeQuoter,
+				UnderlyingToken: "0x1",
+				DeviationPPB:    deviation,
+				Decimals:        6,
+			},
+			wantErr: "underlyingDecimals not set",
+		},
+		{
+			name:    "unknown source",
+			info:    TokenInfo{Source: "oracle", DeviationPPB: deviation, Decimals: 18},
+			wantErr: "unknown price source",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.info.Validate()
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestCommitOffchainConfig_TokenPriceSources(t *testing.T) {
+	positive := cciptypes.NewBigInt(big.NewInt(1e18))
+	deviation := cciptypes.NewBigInt(big.NewInt(1))
+	decimals18 := uint8(18)
+	feedChain := cciptypes.ChainSelector(chainsel.ETHEREUM_MAINNET.Selector)
+	aggregator := TokenInfo{
+		AggregatorAddress: "0x2e03388D351BF87CF2409EFf18C45Df59775Fbb2",
+		FeedChainSelector: feedChain,
+		DeviationPPB:      deviation,
+		Decimals:          18,
+	}
+	derived := func(underlying cciptypes.UnknownEncodedAddress) TokenInfo {
+		return TokenInfo{
+			Source:          TokenPriceSourceDerived,
+			UnderlyingToken: underlying,
+			Ratio:           &positive,
+			DeviationPPB:    deviation,
+			Decimals:        18,
+		}
+	}
+	cfg := func(tokenInfo map[cciptypes.UnknownEncodedAddress]TokenInfo) CommitOffchainConfig {
+		c := CommitOffchainConfig{
+			TokenPriceBatchWriteFrequency: *commonconfig.MustNewDuration(time.Minute),
+			TokenInfo:                     tokenInfo,
+		}
+		c.applyDefaults()
+		return c
+	}
+
+	t.Run("aggregators with their own feed chain don't require the default feed chain", func(t *testing.T) {
+		c := cfg(map[cciptypes.UnknownEncodedAddress]TokenInfo{"0x1": aggregator, "0x2": derived("0x1")})
+		require.NoError(t, c.Validate())
+
+		chain, ok := c.TokenPriceFeedChain("0x2")
+		require.True(t, ok)
+		require.Equal(t, feedChain, chain)
+	})
+
+	t.Run("aggregator without feed chain requires the default feed chain", func(t *testing.T) {
+		defaultChain := aggregator
+		defaultChain.FeedChainSelector = 0
+		c := cfg(map[cciptypes.UnknownEncodedAddress]TokenInfo{"0x1": defaultChain})
+		require.ErrorContains(t, c.Validate(), "tokenPriceChainSelector")
+
+		c.PriceFeedChainSelector = 10
+		require.NoError(t, c.Validate())
+		chain, ok := c.TokenPriceFeedChain("0x1")
+		require.True(t, ok)
+		require.Equal(t, cciptypes.ChainSelector(10), chain)
+	})
+
+	t.Run("derived from unknown token", func(t *testing.T) {
+		c := cfg(map[cciptypes.UnknownEncodedAddress]TokenInfo{"0x2": derived("0x1")})
+		require.ErrorContains(t, c.Validate(), "underlying token 0x1 has no token info")
+	})
+
+	t.Run("derived from derived token", func(t *testing.T) {
+		c := cfg(map[cciptypes.UnknownEncodedAddress]TokenInfo{
+			"0x1": aggregator, "0x2": derived("0x1"), "0x3": derived("0x2")})
+		require.ErrorContains(t, c.Validate(), "must have an aggregator or fixed price source")
+	})
+
+	t.Run("fee quoter copy of itself", func(t *testing.T) {
+		c := cfg(map[cciptypes.UnknownEncodedAddress]TokenInfo{"0x1": {
+			Source:             TokenPriceSourceFeeQuoter,
+			UnderlyingToken:    "0x1",
+			UnderlyingDecimals: &decimals18,
+			DeviationPPB:       deviation,
+			Decimals:           18,
+		}})
+		require.ErrorContains(t, c.Validate(), "can't be the token itself")
+		_, ok := c.TokenPriceFeedChain("0x1")
+		require.False(t, ok)
+	})
+}
+
 func TestCommitOffchainConfig_Validate(t *testing.T) {
 	type fields struct {
 		RemoteGasPriceBatchWriteFrequency  commonconfig.Duration
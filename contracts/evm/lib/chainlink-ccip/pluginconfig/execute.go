@@ -3,9 +3,12 @@ package pluginconfig
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"time"
 
 	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
 )
 
 // ExecuteOffchainConfig is the OCR offchainConfig for the exec plugin.
@@ -45,6 +48,20 @@ type ExecuteOffchainConfig struct {
 	// MaxSingleChainReports is the maximum number of single chain reports that can be included in a report.
 	// When set to 0, this setting is ignored.
 	MaxSingleChainReports uint64 `json:"maxSingleChainReports"`
+
+	// MessagePriority configures the order in which ready messages are selected for execution reports.
+	// When unset, messages are selected in the order they were committed.
+	MessagePriority MessagePriorityConfig `json:"messagePriority"`
+
+	// SimulateReports enables the simulation of execute reports on the destination chain during the Filter
+	// observation. Messages which F+1 oracles observe to fail are skipped by the outcome and retried in later rounds.
+	// Oracles which are not configured with a report simulator don't observe simulation failures.
+	SimulateReports bool `json:"simulateReports"`
+
+	// MessageChecks are additional checks, registered by name in the exec report builder, which every message has
+	// to pass to be executed. Messages failing a check are skipped, so a misbehaving receiver can be quarantined
+	// without a code release.
+	MessageChecks []MessageCheckConfig `json:"messageChecks,omitempty"`
 }
 
 func (e *ExecuteOffchainConfig) ApplyDefaultsAndValidate() error {
@@ -90,6 +107,16 @@ func (e *ExecuteOffchainConfig) Validate() error {
 		}
 		set[key] = struct{}{}
 	}
+
+	if err := e.MessagePriority.Validate(); err != nil {
+		return fmt.Errorf("invalid message priority: %w", err)
+	}
+
+	for i, check := range e.MessageChecks {
+		if err := check.Validate(); err != nil {
+			return fmt.Errorf("invalid message check %d: %w", i, err)
+		}
+	}
 	return nil
 }
 
@@ -113,6 +140,63 @@ func (e *ExecuteOffchainConfig) IsLBTCEnabled() bool {
 	return false
 }
 
+// MessagePriorityConfig configures the priority policy of the exec report builder. The policies are
+// applied in order: age boosting, prioritized senders, then fee paid per gas. Messages with the same
+// priority keep their commit order, and sequenced messages of a sender are never reordered so the nonce
+// ordering required onchain is preserved.
+type MessagePriorityConfig struct {
+	// AgeBoostAfter boosts messages committed at least this long before the newest pending commit report
+	// above all other messages, so low priority messages are not starved. When set to 0, this setting is ignored.
+	AgeBoostAfter commonconfig.Duration `json:"ageBoostAfter"`
+
+	// PrioritySenders are the senders, per source chain, whose messages are selected first.
+	PrioritySenders map[cciptypes.ChainSelector][]string `json:"prioritySenders,omitempty"`
+
+	// OrderByFeePerGas selects messages which paid a higher fee per unit of gas first.
+	OrderByFeePerGas bool `json:"orderByFeePerGas"`
+}
+
+// IsEnabled returns true if any priority policy is configured.
+func (m MessagePriorityConfig) IsEnabled() bool {
+	return m.AgeBoostAfter.Duration() > 0 || len(m.PrioritySenders) > 0 || m.OrderByFeePerGas
+}
+
+func (m MessagePriorityConfig) Validate() error {
+	for chain, senders := range m.PrioritySenders {
+		if chain == 0 {
+			return errors.New("priority senders configured for chain selector 0")
+		}
+		for _, sender := range senders {
+			if sender == "" {
+				return fmt.Errorf("empty priority sender for chain %d", chain)
+			}
+		}
+	}
+	return nil
+}
+
+// MessageCheckConfig enables a message check of the exec report builder, for example:
+//
+//	{
+//	  "name": "blockedReceivers",
+//	  "params": {"receivers": ["0x1234..."]}
+//	}
+//
+// The params are specific to the check and are validated when the check is created.
+type MessageCheckConfig struct {
+	// Name is the name the check is registered with.
+	Name string `json:"name"`
+	// Params are the JSON encoded parameters of the check.
+	Params json.RawMessage `json:"params,omitempty"`
+}
+
+func (m MessageCheckConfig) Validate() error {
+	if m.Name == "" {
+		return errors.New("name not set")
+	}
+	return nil
+}
+
 // EncodeExecuteOffchainConfig encodes a ExecuteOffchainConfig into bytes using JSON.
 func EncodeExecuteOffchainConfig(e ExecuteOffchainConfig) ([]byte, error) {
 	return json.Marshal(e)
@@ -1,11 +1,15 @@
 package pluginconfig
 
 import (
+	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
 	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
 )
 
 func TestExecuteOffchainConfig_Validate(t *testing.T) {
@@ -15,6 +19,8 @@ func TestExecuteOffchainConfig_Validate(t *testing.T) {
 		RootSnoozeTime            commonconfig.Duration
 		MessageVisibilityInterval commonconfig.Duration
 		BatchingStrategyID        uint32
+		MessagePriority           MessagePriorityConfig
+		MessageChecks             []MessageCheckConfig
 	}
 	tests := []struct {
 		name    string
@@ -76,6 +82,57 @@ func TestExecuteOffchainConfig_Validate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"valid, message priority",
+			fields{
+				BatchGasLimit:             1,
+				InflightCacheExpiry:       *commonconfig.MustNewDuration(1),
+				RootSnoozeTime:            *commonconfig.MustNewDuration(1),
+				MessageVisibilityInterval: *commonconfig.MustNewDuration(1),
+				MessagePriority: MessagePriorityConfig{
+					PrioritySenders:  map[cciptypes.ChainSelector][]string{1: {"0x01"}},
+					OrderByFeePerGas: true,
+				},
+			},
+			false,
+		},
+		{
+			"invalid, empty priority sender",
+			fields{
+				BatchGasLimit:             1,
+				InflightCacheExpiry:       *commonconfig.MustNewDuration(1),
+				RootSnoozeTime:            *commonconfig.MustNewDuration(1),
+				MessageVisibilityInterval: *commonconfig.MustNewDuration(1),
+				MessagePriority: MessagePriorityConfig{
+					PrioritySenders: map[cciptypes.ChainSelector][]string{1: {""}},
+				},
+			},
+			true,
+		},
+		{
+			"valid, message checks",
+			fields{
+				BatchGasLimit:             1,
+				InflightCacheExpiry:       *commonconfig.MustNewDuration(1),
+				RootSnoozeTime:            *commonconfig.MustNewDuration(1),
+				MessageVisibilityInterval: *commonconfig.MustNewDuration(1),
+				MessageChecks: []MessageCheckConfig{
+					{Name: "blockedReceivers", Params: json.RawMessage(`{"receivers":["0x01"]}`)},
+				},
+			},
+			false,
+		},
+		{
+			"invalid, message check without name",
+			fields{
+				BatchGasLimit:             1,
+				InflightCacheExpiry:       *commonconfig.MustNewDuration(1),
+				RootSnoozeTime:            *commonconfig.MustNewDuration(1),
+				MessageVisibilityInterval: *commonconfig.MustNewDuration(1),
+				MessageChecks:             []MessageCheckConfig{{Params: json.RawMessage(`{}`)}},
+			},
+			true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -85,6 +142,8 @@ func TestExecuteOffchainConfig_Validate(t *testing.T) {
 				RootSnoozeTime:            tt.fields.RootSnoozeTime,
 				MessageVisibilityInterval: tt.fields.MessageVisibilityInterval,
 				BatchingStrategyID:        tt.fields.BatchingStrategyID,
+				MessagePriority:           tt.fields.MessagePriority,
+				MessageChecks:             tt.fields.MessageChecks,
 			}
 			if err := e.Validate(); (err != nil) != tt.wantErr {
 				t.Errorf("ExecuteOffchainConfig.Validate() error = %v, wantErr %v", err, tt.wantErr)
@@ -101,6 +160,8 @@ func TestExecuteOffchainConfig_EncodeDecode(t *testing.T) {
 		MessageVisibilityInterval commonconfig.Duration
 		BatchingStrategyID        uint32
 		TokenDataObserver         []TokenDataObserverConfig
+		MessagePriority           MessagePriorityConfig
+		MessageChecks             []MessageCheckConfig
 	}
 	tests := []struct {
 		name   string
@@ -126,6 +187,33 @@ func TestExecuteOffchainConfig_EncodeDecode(t *testing.T) {
 				BatchingStrategyID:        0,
 			},
 		},
+		{
+			"valid, message priority",
+			fields{
+				BatchGasLimit:             1,
+				InflightCacheExpiry:       *commonconfig.MustNewDuration(1),
+				RootSnoozeTime:            *commonconfig.MustNewDuration(1),
+				MessageVisibilityInterval: *commonconfig.MustNewDuration(1),
+				MessagePriority: MessagePriorityConfig{
+					AgeBoostAfter:    *commonconfig.MustNewDuration(time.Hour),
+					PrioritySenders:  map[cciptypes.ChainSelector][]string{1: {"0x01", "0x02"}},
+					OrderByFeePerGas: true,
+				},
+			},
+		},
+		{
+			"valid, message checks",
+			fields{
+				BatchGasLimit:             1,
+				InflightCacheExpiry:       *commonconfig.MustNewDuration(1),
+				RootSnoozeTime:            *commonconfig.MustNewDuration(1),
+				MessageVisibilityInterval: *commonconfig.MustNewDuration(1),
+				MessageChecks: []MessageCheckConfig{
+					{Name: "blockedReceivers", Params: json.RawMessage(`{"receivers":["0x01"]}`)},
+					{Name: "custom"},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -136,6 +224,8 @@ func TestExecuteOffchainConfig_EncodeDecode(t *testing.T) {
 				MessageVisibilityInterval: tt.fields.MessageVisibilityInterval,
 				BatchingStrategyID:        tt.fields.BatchingStrategyID,
 				TokenDataObservers:        tt.fields.TokenDataObserver,
+				MessagePriority:           tt.fields.MessagePriority,
+				MessageChecks:             tt.fields.MessageChecks,
 			}
 			encoded, err := EncodeExecuteOffchainConfig(e)
 			require.NoError(t, err)
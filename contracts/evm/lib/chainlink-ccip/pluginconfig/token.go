@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net/url"
 	"time"
 
 	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
@@ -12,8 +13,9 @@ import (
 )
 
 const (
-	USDCCCTPHandlerType = "usdc-cctp"
-	LBTCHandlerType     = "lbtc"
+	USDCCCTPHandlerType        = "usdc-cctp"
+	LBTCHandlerType            = "lbtc"
+	HTTPAttestationHandlerType = "http-attestation"
 )
 
 // TokenDataObserverConfig is the base struct for token data observers. Every token data observer
@@ -55,6 +57,7 @@ type TokenDataObserverConfig struct {
 
 	*USDCCCTPObserverConfig
 	*LBTCObserverConfig
+	*HTTPAttestationObserverConfig
 }
 
 // WellFormed checks that the observer's config is syntactically correct - proper struct is initialized based on type
@@ -71,6 +74,12 @@ func (t *TokenDataObserverConfig) WellFormed() error {
 		}
 		return nil
 	}
+	if t.IsHTTPAttestation() {
+		if t.HTTPAttestationObserverConfig == nil {
+			return errors.New("HTTPAttestationObserverConfig is empty")
+		}
+		return nil
+	}
 	return errors.New("unknown token data observer type")
 }
 
@@ -84,14 +93,29 @@ func (t *TokenDataObserverConfig) Validate() error {
 		if t.LBTCObserverConfig != nil {
 			return errors.New("LBTCObserverConfig must be null with USDC plugin type")
 		}
+		if t.HTTPAttestationObserverConfig != nil {
+			return errors.New("HTTPAttestationObserverConfig must be null with USDC plugin type")
+		}
 		return t.USDCCCTPObserverConfig.Validate()
 	}
 	if t.IsLBTC() {
 		if t.USDCCCTPObserverConfig != nil {
 			return errors.New("USDCCCTPObserverConfig must be null with LBTC plugin type")
 		}
+		if t.HTTPAttestationObserverConfig != nil {
+			return errors.New("HTTPAttestationObserverConfig must be null with LBTC plugin type")
+		}
 		return t.LBTCObserverConfig.Validate()
 	}
+	if t.IsHTTPAttestation() {
+		if t.USDCCCTPObserverConfig != nil {
+			return errors.New("USDCCCTPObserverConfig must be null with HTTP attestation plugin type")
+		}
+		if t.LBTCObserverConfig != nil {
+			return errors.New("LBTCObserverConfig must be null with HTTP attestation plugin type")
+		}
+		return t.HTTPAttestationObserverConfig.Validate()
+	}
 	return errors.New("unknown token data observer type " + t.Type)
 }
 
@@ -103,6 +127,10 @@ func (t *TokenDataObserverConfig) IsLBTC() bool {
 	return t.Type == LBTCHandlerType
 }
 
+func (t *TokenDataObserverConfig) IsHTTPAttestation() bool {
+	return t.Type == HTTPAttestationHandlerType
+}
+
 // MarshalJSON is a custom JSON marshaller for TokenDataObserverConfig.
 // It constructs raw map based on provided type. Custom marshaller is needed because default golang marshaller
 // doesn't marshal clashing fields of pointer embeddings even if only one pointer is present and rest are set to nil
@@ -128,6 +156,16 @@ func (t *TokenDataObserverConfig) MarshalJSON() ([]byte, error) {
 			Version:            t.Version,
 			LBTCObserverConfig: t.LBTCObserverConfig,
 		})
+	case HTTPAttestationHandlerType:
+		return json.Marshal(&struct {
+			Type    string `json:"type"`
+			Version string `json:"version"`
+			*HTTPAttestationObserverConfig
+		}{
+			Type:                          t.Type,
+			Version:                       t.Version,
+			HTTPAttestationObserverConfig: t.HTTPAttestationObserverConfig,
+		})
 	default:
 		return nil, fmt.Errorf("unknown token data observer type: %q", t.Type)
 	}
@@ -135,7 +173,8 @@ func (t *TokenDataObserverConfig) MarshalJSON() ([]byte, error) {
 
 // UnmarshalJSON is a custom JSON unmarshaller for TokenDataObserverConfig.
 // It first reads top-level fields, then allocates the correct embedded config pointer
-// (USDCCCTPObserverConfig or LBTCObserverConfig) before finally unmarshalling into that pointer.
+// (USDCCCTPObserverConfig, LBTCObserverConfig or HTTPAttestationObserverConfig) before finally unmarshalling
+// into that pointer.
 // Custom unmarshaller is needed because default golang marshaller doesn't unmarshal clashing fields of
 // pointer embeddings
 func (t *TokenDataObserverConfig) UnmarshalJSON(data []byte) error {
@@ -161,6 +200,11 @@ func (t *TokenDataObserverConfig) UnmarshalJSON(data []byte) error {
 		if err := json.Unmarshal(data, t.LBTCObserverConfig); err != nil {
 			return fmt.Errorf("failed to unmarshal LBTCObserverConfig: %w", err)
 		}
+	case HTTPAttestationHandlerType:
+		t.HTTPAttestationObserverConfig = &HTTPAttestationObserverConfig{}
+		if err := json.Unmarshal(data, t.HTTPAttestationObserverConfig); err != nil {
+			return fmt.Errorf("failed to unmarshal HTTPAttestationObserverConfig: %w", err)
+		}
 	default:
 		return fmt.Errorf("unknown token data observer type: %q", t.Type)
 	}
@@ -213,6 +257,9 @@ type WorkerConfig struct {
 	CacheCleanupInterval *commonconfig.Duration `json:"cacheCleanupInterval"`
 	// ObserveTimeout is the timeout for the actual synchronous Observe calls.
 	ObserveTimeout *commonconfig.Duration `json:"observeTimeout"`
+	// PersistentCache enables the on-disk store of the token data fetched by the workers. Optional, when set
+	// the token data survive node restarts and don't have to be fetched from the attestation API again.
+	PersistentCache *PersistentCacheConfig `json:"persistentCache,omitempty"`
 }
 
 func (c *WorkerConfig) IsForeground() bool {
@@ -250,6 +297,54 @@ func (c *WorkerConfig) Validate() error {
 	if c.ObserveTimeout == nil || c.ObserveTimeout.Duration() == 0 {
 		return errors.New("ObserveTimeout not set")
 	}
+	if c.PersistentCache != nil {
+		if err := c.PersistentCache.Validate(); err != nil {
+			return fmt.Errorf("PersistentCache: %w", err)
+		}
+	}
+	return nil
+}
+
+// PersistentCacheConfig configures the on-disk token data store used by the background workers.
+// The directory of the store is node local, therefore it's not a part of the offchain config, but of the node's
+// config. Nodes that don't configure the directory keep the token data in memory only.
+type PersistentCacheConfig struct {
+	// TTL defines for how long the stored token data are considered valid.
+	TTL *commonconfig.Duration `json:"ttl"`
+	// MaxEntries is the maximum number of stored token data, entries closest to expiration are evicted first.
+	MaxEntries int `json:"maxEntries"`
+	// FlushInterval defines how often the token data are written to disk.
+	FlushInterval *commonconfig.Duration `json:"flushInterval"`
+}
+
+func (c *PersistentCacheConfig) setDefaults() {
+	// Default to 24 hours if TTL is not set
+	if c.TTL == nil {
+		c.TTL = commonconfig.MustNewDuration(24 * time.Hour)
+	}
+
+	// Default to 10k entries if MaxEntries is not set
+	if c.MaxEntries == 0 {
+		c.MaxEntries = 10_000
+	}
+
+	// Default to 30 seconds if FlushInterval is not set
+	if c.FlushInterval == nil {
+		c.FlushInterval = commonconfig.MustNewDuration(30 * time.Second)
+	}
+}
+
+func (c *PersistentCacheConfig) Validate() error {
+	c.setDefaults()
+	if c.TTL.Duration() <= 0 {
+		return errors.New("TTL must be positive")
+	}
+	if c.MaxEntries < 0 {
+		return errors.New("MaxEntries must not be negative")
+	}
+	if c.FlushInterval.Duration() <= 0 {
+		return errors.New("FlushInterval must be positive")
+	}
 	return nil
 }
 
@@ -345,3 +440,98 @@ func (c *LBTCObserverConfig) Validate() error {
 
 	return nil
 }
+
+// HTTPAttestationObserverConfig configures a generic token data observer that fetches offchain proofs from
+// a custom attestation service over HTTP. It's meant for tokens whose pools require an attestation in the
+// offchainTokenData, but which don't need a dedicated observer implementation (like USDC/CCTP or LBTC do).
+//
+// The token's ExtraData emitted by the source pool is passed to the service, either as a part of the request URL
+// or as a part of the JSON request body. The attestation is read from the JSON response and must be hex encoded.
+// Example:
+//
+//	{
+//	  "type": "http-attestation",
+//	  "version": "1.0",
+//	  "attestationAPI": "https://attestation.example.com",
+//	  "urlTemplate": "/v1/chains/{sourceChainSelector}/attestations/{extraData}",
+//	  "responseJSONPath": "data.attestation",
+//	  "sourcePoolAddressByChain": {
+//	    "5009297550715157269": "0x1234"
+//	  }
+//	}
+type HTTPAttestationObserverConfig struct {
+	AttestationConfig
+	WorkerConfig
+	// AttestationAPICooldown defines in what time it is allowed to make next call to API.
+	// Activates when plugin hits API's rate limits
+	AttestationAPICooldown *commonconfig.Duration `json:"attestationAPICooldown"`
+	// URLTemplate is the path appended to the AttestationAPI for GET requests, optionally followed by a query
+	// string, e.g. "/v1/attestations?id={extraData}". It supports the following placeholders:
+	// {extraData} - hex encoded token's ExtraData, {sourceChainSelector} - source chain selector,
+	// {seqNum} - message sequence number and {tokenIndex} - index of the token within the message.
+	URLTemplate string `json:"urlTemplate,omitempty"`
+	// RequestJSONPath is a dot separated path under which hex encoded token's ExtraData is placed in the JSON
+	// request body, e.g. "message.hash". If set, the request body is POSTed to the AttestationAPI and
+	// URLTemplate must not be set.
+	RequestJSONPath string `json:"requestJSONPath,omitempty"`
+	// ResponseJSONPath is a dot separated path to the hex encoded attestation in the JSON response,
+	// e.g. "data.attestation". Missing or empty attestation is considered as not ready yet.
+	ResponseJSONPath string `json:"responseJSONPath"`
+	// AuthHeaderName is the name of the header used for authenticating to the attestation service, e.g. "X-API-Key".
+	AuthHeaderName string `json:"authHeaderName,omitempty"`
+	// AuthCredentialsName is the name of the node's secret holding the value of the auth header. Offchain config
+	// is publicly available onchain, therefore the value itself is read from the node's secrets
+	// ([CCIP.AttestationCredentials.<name>]) and only its name is shared here.
+	AuthCredentialsName string `json:"authCredentialsName,omitempty"`
+	// SourcePoolAddressByChain contains the token pool addresses, per source chain, that are covered by the observer.
+	SourcePoolAddressByChain map[cciptypes.ChainSelector]string `json:"sourcePoolAddressByChain"`
+}
+
+func (c *HTTPAttestationObserverConfig) setDefaults() {
+	if c.AttestationAPICooldown == nil || c.AttestationAPICooldown.Duration() == 0 {
+		c.AttestationAPICooldown = commonconfig.MustNewDuration(5 * time.Minute)
+	}
+}
+
+func (c *HTTPAttestationObserverConfig) Validate() error {
+	c.setDefaults()
+	if c.URLTemplate == "" && c.RequestJSONPath == "" {
+		return errors.New("either URLTemplate or RequestJSONPath must be set")
+	}
+	if c.URLTemplate != "" && c.RequestJSONPath != "" {
+		return errors.New("URLTemplate and RequestJSONPath can't be set together")
+	}
+	if c.URLTemplate != "" {
+		u, err := url.Parse(c.URLTemplate)
+		if err != nil {
+			return fmt.Errorf("invalid URLTemplate: %w", err)
+		}
+		if u.Scheme != "" || u.Host != "" || u.Fragment != "" {
+			return errors.New("URLTemplate must be a path with an optional query string")
+		}
+	}
+	if c.ResponseJSONPath == "" {
+		return errors.New("ResponseJSONPath not set")
+	}
+	if (c.AuthHeaderName == "") != (c.AuthCredentialsName == "") {
+		return errors.New("AuthHeaderName and AuthCredentialsName must be set together")
+	}
+	if len(c.SourcePoolAddressByChain) == 0 {
+		return errors.New("SourcePoolAddressByChain is not set")
+	}
+	for _, sourcePoolAddress := range c.SourcePoolAddressByChain {
+		if sourcePoolAddress == "" {
+			return errors.New("SourcePoolAddressByChain is empty")
+		}
+	}
+	err := c.AttestationConfig.Validate()
+	if err != nil {
+		return err
+	}
+	err = c.WorkerConfig.Validate()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -162,6 +162,41 @@ func Test_TokenDataObserver_Unmarshal(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "valid config with HTTPAttestationObserverConfig",
+			json: `"tokenDataObservers": [
+							{
+							  "type": "http-attestation",
+							  "version": "1.0",
+							  "attestationAPI": "http://localhost:8080",
+							  "urlTemplate": "/v1/attestations/{extraData}",
+							  "responseJSONPath": "data.attestation",
+							  "authHeaderName": "X-API-Key",
+							  "authCredentialsName": "attestation-api",
+							  "sourcePoolAddressByChain": {
+								"1": "0xabc"
+							  }
+							}
+				  	],`,
+			want: []TokenDataObserverConfig{
+				{
+					Type:    "http-attestation",
+					Version: "1.0",
+					HTTPAttestationObserverConfig: &HTTPAttestationObserverConfig{
+						AttestationConfig: AttestationConfig{
+							AttestationAPI: "http://localhost:8080",
+						},
+						URLTemplate:         "/v1/attestations/{extraData}",
+						ResponseJSONPath:    "data.attestation",
+						AuthHeaderName:      "X-API-Key",
+						AuthCredentialsName: "attestation-api",
+						SourcePoolAddressByChain: map[cciptypes.ChainSelector]string{
+							1: "0xabc",
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -490,6 +525,47 @@ func Test_TokenDataObserver_Marshal(t *testing.T) {
 							}
 				  		]`,
 		},
+		{
+			name: "valid config with HTTPAttestationObserverConfig",
+			config: []TokenDataObserverConfig{
+				{
+					Type:    "http-attestation",
+					Version: "1.0",
+					HTTPAttestationObserverConfig: &HTTPAttestationObserverConfig{
+						AttestationConfig: AttestationConfig{
+							AttestationAPI:         "http://localhost:8080",
+							AttestationAPITimeout:  commonconfig.MustNewDuration(time.Second),
+							AttestationAPIInterval: commonconfig.MustNewDuration(500 * time.Millisecond),
+						},
+						AttestationAPICooldown: commonconfig.MustNewDuration(10 * time.Minute),
+						RequestJSONPath:        "message.hash",
+						ResponseJSONPath:       "data.attestation",
+						SourcePoolAddressByChain: map[cciptypes.ChainSelector]string{
+							1: "0xabc",
+						},
+					},
+				},
+			},
+			wantJSON: `[
+							{
+							  "type": "http-attestation",
+							  "version": "1.0",
+							  "sourcePoolAddressByChain": {
+								"1": "0xabc"
+							  },
+							  "attestationAPI": "http://localhost:8080",
+							  "attestationAPITimeout": "1s",
+							  "attestationAPIInterval": "500ms",
+							  "attestationAPICooldown": "10m0s",
+							  "requestJSONPath": "message.hash",
+							  "responseJSONPath": "data.attestation",
+							  "numWorkers": 0,
+							  "cacheExpirationInterval": null,
+							  "cacheCleanupInterval": null,
+							  "observeTimeout": null
+							}
+				  		]`,
+		},
 	}
 
 	for _, tt := range tests {
@@ -567,6 +643,19 @@ func Test_TokenDataObserver_Validation(t *testing.T) {
 		}
 	}
 
+	withHTTPAttestationConfig := func() *HTTPAttestationObserverConfig {
+		return &HTTPAttestationObserverConfig{
+			AttestationConfig: AttestationConfig{
+				AttestationAPI: "http://localhost:8080",
+			},
+			URLTemplate:      "/v1/attestations/{extraData}",
+			ResponseJSONPath: "data.attestation",
+			SourcePoolAddressByChain: map[cciptypes.ChainSelector]string{
+				1: "0xabc",
+			},
+		}
+	}
+
 	tests := []struct {
 		name        string
 		config      ExecuteOffchainConfig
@@ -745,6 +834,168 @@ func Test_TokenDataObserver_Validation(t *testing.T) {
 			usdcEnabled: false,
 			lbtcEnabled: true,
 		},
+		{
+			name: "valid config with single http attestation observer",
+			config: withBaseConfig(
+				TokenDataObserverConfig{
+					Type:                          "http-attestation",
+					Version:                       "1.0",
+					HTTPAttestationObserverConfig: withHTTPAttestationConfig(),
+				}),
+		},
+		{
+			name: "http attestation type but two simultaneous configs",
+			config: withBaseConfig(
+				TokenDataObserverConfig{
+					Type:                          "http-attestation",
+					Version:                       "1.0",
+					LBTCObserverConfig:            withLBTCConfig(),
+					HTTPAttestationObserverConfig: withHTTPAttestationConfig(),
+				}),
+			wantErr: true,
+			errMsg:  "LBTCObserverConfig must be null with HTTP attestation plugin type",
+		},
+		{
+			name: "http attestation type is set but struct is empty",
+			config: withBaseConfig(
+				TokenDataObserverConfig{
+					Type:    "http-attestation",
+					Version: "1.0",
+				}),
+			wantErr: true,
+			errMsg:  "HTTPAttestationObserverConfig is empty",
+		},
+		{
+			name: "http attestation without url template and request path",
+			config: withBaseConfig(
+				TokenDataObserverConfig{
+					Type:    "http-attestation",
+					Version: "1.0",
+					HTTPAttestationObserverConfig: &HTTPAttestationObserverConfig{
+						AttestationConfig: AttestationConfig{
+							AttestationAPI: "http://localhost:8080",
+						},
+						ResponseJSONPath: "data.attestation",
+						SourcePoolAddressByChain: map[cciptypes.ChainSelector]string{
+							1: "0xabc",
+						},
+					},
+				}),
+			wantErr: true,
+			errMsg:  "either URLTemplate or RequestJSONPath must be set",
+		},
+		{
+			name: "http attestation with url template and request path",
+			config: withBaseConfig(
+				TokenDataObserverConfig{
+					Type:    "http-attestation",
+					Version: "1.0",
+					HTTPAttestationObserverConfig: &HTTPAttestationObserverConfig{
+						AttestationConfig: AttestationConfig{
+							AttestationAPI: "http://localhost:8080",
+						},
+						URLTemplate:      "/v1/attestations/{extraData}",
+						RequestJSONPath:  "message.hash",
+						ResponseJSONPath: "data.attestation",
+						SourcePoolAddressByChain: map[cciptypes.ChainSelector]string{
+							1: "0xabc",
+						},
+					},
+				}),
+			wantErr: true,
+			errMsg:  "URLTemplate and RequestJSONPath can't be set together",
+		},
+		{
+			name: "http attestation with absolute url template",
+			config: withBaseConfig(
+				TokenDataObserverConfig{
+					Type:    "http-attestation",
+					Version: "1.0",
+					HTTPAttestationObserverConfig: &HTTPAttestationObserverConfig{
+						AttestationConfig: AttestationConfig{
+							AttestationAPI: "http://localhost:8080",
+						},
+						URLTemplate:      "https://attestation.example.com/v1/attestations/{extraData}",
+						ResponseJSONPath: "data.attestation",
+						SourcePoolAddressByChain: map[cciptypes.ChainSelector]string{
+							1: "0xabc",
+						},
+					},
+				}),
+			wantErr: true,
+			errMsg:  "URLTemplate must be a path with an optional query string",
+		},
+		{
+			name: "http attestation with url template fragment",
+			config: withBaseConfig(
+				TokenDataObserverConfig{
+					Type:    "http-attestation",
+					Version: "1.0",
+					HTTPAttestationObserverConfig: &HTTPAttestationObserverConfig{
+						AttestationConfig: AttestationConfig{
+							AttestationAPI: "http://localhost:8080",
+						},
+						URLTemplate:      "/v1/attestations#{extraData}",
+						ResponseJSONPath: "data.attestation",
+						SourcePoolAddressByChain: map[cciptypes.ChainSelector]string{
+							1: "0xabc",
+						},
+					},
+				}),
+			wantErr: true,
+			errMsg:  "URLTemplate must be a path with an optional query string",
+		},
+		{
+			name: "http attestation with auth header name but without value",
+			config: withBaseConfig(
+				TokenDataObserverConfig{
+					Type:    "http-attestation",
+					Version: "1.0",
+					HTTPAttestationObserverConfig: &HTTPAttestationObserverConfig{
+						AttestationConfig: AttestationConfig{
+							AttestationAPI: "http://localhost:8080",
+						},
+						URLTemplate:      "/v1/attestations/{extraData}",
+						ResponseJSONPath: "data.attestation",
+						AuthHeaderName:   "X-API-Key",
+						SourcePoolAddressByChain: map[cciptypes.ChainSelector]string{
+							1: "0xabc",
+						},
+					},
+				}),
+			wantErr: true,
+			errMsg:  "AuthHeaderName and AuthCredentialsName must be set together",
+		},
+		{
+			name: "valid config with usdc persistent cache",
+			config: withBaseConfig(
+				TokenDataObserverConfig{
+					Type:    "usdc-cctp",
+					Version: "1.0",
+					USDCCCTPObserverConfig: func() *USDCCCTPObserverConfig {
+						cfg := withUSDCConfig()
+						cfg.PersistentCache = &PersistentCacheConfig{}
+						return cfg
+					}(),
+				}),
+			usdcEnabled: true,
+		},
+		{
+			name: "lbtc persistent cache with negative max entries",
+			config: withBaseConfig(
+				TokenDataObserverConfig{
+					Type:    "lbtc",
+					Version: "1.0",
+					LBTCObserverConfig: func() *LBTCObserverConfig {
+						cfg := withLBTCConfig()
+						cfg.PersistentCache = &PersistentCacheConfig{MaxEntries: -1}
+						return cfg
+					}(),
+				}),
+			lbtcEnabled: true,
+			wantErr:     true,
+			errMsg:      "PersistentCache: MaxEntries must not be negative",
+		},
 	}
 
 	for _, tt := range tests {
@@ -6,6 +6,8 @@ import (
 	"fmt"
 
 	"github.com/ethereum/go-ethereum/common"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+	"github.com/smartcontractkit/chainlink-ccip/pluginconfig"
 	"github.com/smartcontractkit/chainlink-common/pkg/types"
 	ccipcommon "github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/common"
 	evmconfig "github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/configs/evm"
@@ -24,7 +26,7 @@ func (g ChainCWProvider) GetChainReader(ctx context.Context, params ccipcommon.C
 		chainReaderConfig = evmconfig.SourceReaderConfig
 	}
 
-	if !params.Ofc.CommitEmpty() && params.Ofc.Commit.PriceFeedChainSelector == params.ChainSelector {
+	if isFeedChain(params.Ofc, params.ChainSelector) {
 		params.Lggr.Debugw("Adding feed reader config", "chainID", params.ChainID)
 		chainReaderConfig = evmconfig.MergeReaderConfigs(chainReaderConfig, evmconfig.FeedReaderConfig)
 	}
@@ -81,6 +83,24 @@ func (g ChainCWProvider) GetChainWriter(ctx context.Context, params ccipcommon.C
 	return cw, nil
 }
 
+// isFeedChain returns true if the default price feed chain or the feed chain of a token aggregator is the given chain.
+func isFeedChain(ofc ccipcommon.OffChainConfig, chainSelector cciptypes.ChainSelector) bool {
+	if ofc.CommitEmpty() {
+		return false
+	}
+
+	if ofc.Commit.PriceFeedChainSelector == chainSelector {
+		return true
+	}
+	for _, info := range ofc.Commit.TokenInfo {
+		if info.PriceSource() == pluginconfig.TokenPriceSourceAggregator &&
+			info.AggregatorChain(ofc.Commit.PriceFeedChainSelector) == chainSelector {
+			return true
+		}
+	}
+	return false
+}
+
 func isUSDCEnabled(ofc ccipcommon.OffChainConfig) bool {
 	if ofc.ExecEmpty() {
 		return false
@@ -0,0 +1,80 @@
+package ccipevm
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	chainsel "github.com/smartcontractkit/chain-selectors"
+
+	"github.com/smartcontractkit/chainlink-ccip/pkg/consts"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+	"github.com/smartcontractkit/chainlink-ccip/pluginconfig"
+	"github.com/smartcontractkit/chainlink-common/pkg/loop"
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+
+	ccipcommon "github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/common"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	evmrelaytypes "github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/types"
+)
+
+func TestChainCWProvider_GetChainReader_FeedChains(t *testing.T) {
+	defaultFeedChain := chainsel.TEST_90000001
+	tokenFeedChain := chainsel.TEST_90000002
+	otherChain := chainsel.TEST_90000003
+	ofc := ccipcommon.OffChainConfig{Commit: &pluginconfig.CommitOffchainConfig{
+		PriceFeedChainSelector: cciptypes.ChainSelector(defaultFeedChain.Selector),
+		TokenInfo: map[cciptypes.UnknownEncodedAddress]pluginconfig.TokenInfo{
+			"0x1": {
+				AggregatorAddress: "0x2e03388D351BF87CF2409EFf18C45Df59775Fbb2",
+				FeedChainSelector: cciptypes.ChainSelector(tokenFeedChain.Selector),
+				DeviationPPB:      cciptypes.NewBigInt(big.NewInt(1)),
+				Decimals:          18,
+			},
+		},
+	}}
+
+	tests := []struct {
+		name       string
+		chain      chainsel.Chain
+		wantFeedCR bool
+	}{
+		{name: "default price feed chain", chain: defaultFeedChain, wantFeedCR: true},
+		{name: "feed chain of a token", chain: tokenFeedChain, wantFeedCR: true},
+		{name: "not a feed chain", chain: otherChain, wantFeedCR: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			relayer := &configRecordingRelayer{}
+			_, err := ChainCWProvider{}.GetChainReader(t.Context(), ccipcommon.ChainReaderProviderOpts{
+				Lggr:          logger.Test(t),
+				Relayer:       relayer,
+				ChainID:       "1",
+				DestChainID:   "2",
+				HomeChainID:   "2",
+				Ofc:           ofc,
+				ChainSelector: cciptypes.ChainSelector(tt.chain.Selector),
+				ChainFamily:   chainsel.FamilyEVM,
+			})
+			require.NoError(t, err)
+
+			var cfg evmrelaytypes.ChainReaderConfig
+			require.NoError(t, json.Unmarshal(relayer.contractReaderConfig, &cfg))
+			_, ok := cfg.Contracts[consts.ContractNamePriceAggregator]
+			require.Equal(t, tt.wantFeedCR, ok)
+		})
+	}
+}
+
+type configRecordingRelayer struct {
+	loop.Relayer
+	contractReaderConfig []byte
+}
+
+func (r *configRecordingRelayer) NewContractReader(_ context.Context, contractReaderConfig []byte) (types.ContractReader, error) {
+	r.contractReaderConfig = contractReaderConfig
+	return nil, nil
+}
@@ -39,6 +39,16 @@ func NewExecutePluginCodecV1(extraDataCodec ccipcommon.ExtraDataCodec) *ExecuteP
 }
 
 func (e *ExecutePluginCodecV1) Encode(ctx context.Context, report cciptypes.ExecutePluginReport) ([]byte, error) {
+	evmReport, err := e.toEVMReport(report)
+	if err != nil {
+		return nil, err
+	}
+
+	return e.executeReportMethodInputs.PackValues([]interface{}{&evmReport})
+}
+
+// toEVMReport converts the report to the offramp execution reports.
+func (e *ExecutePluginCodecV1) toEVMReport(report cciptypes.ExecutePluginReport) ([]offramp.InternalExecutionReport, error) {
 	evmReport := make([]offramp.InternalExecutionReport, 0, len(report.ChainReports))
 
 	for _, chainReport := range report.ChainReports {
@@ -136,7 +146,7 @@ func (e *ExecutePluginCodecV1) Encode(ctx context.Context, report cciptypes.Exec
 		evmReport = append(evmReport, evmChainReport)
 	}
 
-	return e.executeReportMethodInputs.PackValues([]interface{}{&evmReport})
+	return evmReport, nil
 }
 
 func (e *ExecutePluginCodecV1) Decode(ctx context.Context, encodedReport []byte) (cciptypes.ExecutePluginReport, error) {
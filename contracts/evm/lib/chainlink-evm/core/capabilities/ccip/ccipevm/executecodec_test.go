@@ -11,6 +11,7 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
+	chainsel "github.com/smartcontractkit/chain-selectors"
 	"github.com/smartcontractkit/chainlink-ccip/chains/evm/gobindings/generated/v1_6_0/message_hasher"
 	"github.com/smartcontractkit/chainlink-ccip/chains/evm/gobindings/generated/v1_6_0/offramp"
 	"github.com/smartcontractkit/chainlink-ccip/chains/evm/gobindings/generated/v1_6_0/report_codec"
@@ -182,7 +183,7 @@ func TestExecutePluginCodecV1(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			codec := NewExecutePluginCodecV1(ccipcommon.NewExtraDataCodec(mockExtraDataCodec, mockExtraDataCodec))
+			codec := NewExecutePluginCodecV1(newTestExtraDataCodec(mockExtraDataCodec))
 			report := tc.report(randomExecuteReport(t, d, tc.chainSelector, tc.gasLimit, tc.destGasAmount))
 			bytes, err := codec.Encode(ctx, report)
 			if tc.expErr {
@@ -247,3 +248,11 @@ func Test_DecodeReport(t *testing.T) {
 
 	t.Logf("decoded: %+v", decoded)
 }
+
+// newTestExtraDataCodec returns an ExtraDataCodec that uses the given codec for every supported chain family.
+func newTestExtraDataCodec(codec ccipcommon.SourceChainExtraDataCodec) ccipcommon.ExtraDataCodec {
+	return ccipcommon.NewExtraDataCodec(ccipcommon.NewChainFamilyRegistry(map[string]ccipcommon.ChainFamily{
+		chainsel.FamilyEVM:    {ExtraDataCodec: codec},
+		chainsel.FamilySolana: {ExtraDataCodec: codec},
+	}))
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink-ccip/chains/evm/gobindings/generated/v1_6_0/offramp"
 	"github.com/smartcontractkit/chainlink-ccip/chains/evm/gobindings/generated/v1_6_0/onramp"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
 	"github.com/smartcontractkit/chainlink-common/pkg/hashutil"
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 	"github.com/smartcontractkit/chainlink-common/pkg/merklemulti"
@@ -41,6 +42,30 @@ func GetMessageHashes(
 	return ret, nil
 }
 
+// GetMessageHashesFromMessages returns the leaf hashes of the given chain-agnostic messages
+// as computed by the EVM offramp. It is used for messages sent from non-EVM sources.
+func GetMessageHashesFromMessages(
+	ctx context.Context,
+	lggr logger.Logger,
+	msgs []cciptypes.Message,
+	extraDataCodec ccipcommon.ExtraDataCodec,
+) ([][32]byte, error) {
+	msgHasher := ccipevm.NewMessageHasherV1(
+		lggr,
+		extraDataCodec,
+	)
+	var ret [][32]byte
+	for _, msg := range msgs {
+		msgHash, err := msgHasher.Hash(ctx, msg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to hash message (generic: %+v): %w", msg, err)
+		}
+		ret = append(ret, msgHash)
+	}
+
+	return ret, nil
+}
+
 // GetMerkleProof returns the merkle proof of inclusion for the given sequence number
 // in the given merkleRoot.
 //
@@ -100,7 +125,8 @@ func GetMerkleProof(
 // It converts the given ccipMessageSentEvents to offramp.InternalAny2EVMRampMessage
 // and then to offramp.InternalExecutionReport.
 //
-// The offchainTokenData is not currently supported and is set to an empty slice.
+// The offchainTokenData is not currently supported and is set to an empty slice, messages transferring
+// tokens through one of the offchainTokenDataPools are rejected with ccipcommon.ErrOffchainTokenDataNotSupported.
 func CreateExecutionReport(
 	srcChainSel uint64,
 	onrampAddress common.Address,
@@ -108,10 +134,34 @@ func CreateExecutionReport(
 	hashes [][32]byte,
 	flags *big.Int,
 	extraDataCodec ccipcommon.ExtraDataCodec,
+	offchainTokenDataPools []cciptypes.UnknownAddress,
 ) (offramp.InternalExecutionReport, error) {
-	var any2EVMs []offramp.InternalAny2EVMRampMessage
+	var ccipMsgs []cciptypes.Message
 	for _, event := range ccipMessageSentEvents {
-		ccipMsg := ccipevm.EVM2AnyToCCIPMsg(onrampAddress, event.Message)
+		ccipMsgs = append(ccipMsgs, ccipevm.EVM2AnyToCCIPMsg(onrampAddress, event.Message))
+	}
+
+	return CreateExecutionReportFromMessages(srcChainSel, ccipMsgs, hashes, flags, extraDataCodec, offchainTokenDataPools)
+}
+
+// CreateExecutionReportFromMessages creates an offramp.InternalExecutionReport from
+// chain-agnostic messages, e.g. messages sent from non-EVM sources.
+//
+// The offchainTokenData is not currently supported and is set to an empty slice, messages transferring
+// tokens through one of the offchainTokenDataPools are rejected with ccipcommon.ErrOffchainTokenDataNotSupported.
+func CreateExecutionReportFromMessages(
+	srcChainSel uint64,
+	ccipMsgs []cciptypes.Message,
+	hashes [][32]byte,
+	flags *big.Int,
+	extraDataCodec ccipcommon.ExtraDataCodec,
+	offchainTokenDataPools []cciptypes.UnknownAddress,
+) (offramp.InternalExecutionReport, error) {
+	var any2EVMs []offramp.InternalAny2EVMRampMessage
+	for _, ccipMsg := range ccipMsgs {
+		if err := ccipcommon.CheckOffchainTokenData(ccipMsg, offchainTokenDataPools); err != nil {
+			return offramp.InternalExecutionReport{}, err
+		}
 		any2EVM, err := ccipevm.CCIPMsgToAny2EVMMessage(ccipMsg, extraDataCodec)
 		if err != nil {
 			return offramp.InternalExecutionReport{}, fmt.Errorf("failed to convert ccip message to any2evm message: %w", err)
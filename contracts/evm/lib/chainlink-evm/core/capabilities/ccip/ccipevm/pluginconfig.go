@@ -1,7 +1,12 @@
 package ccipevm
 
 import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
 	chainsel "github.com/smartcontractkit/chain-selectors"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+	"github.com/smartcontractkit/chainlink-common/pkg/loop"
 	ccipcommon "github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/common"
 	"github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/ocrimpls"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
@@ -19,5 +24,17 @@ func InitializePluginConfig(lggr logger.Logger, extraDataCodec ccipcommon.ExtraD
 		GasEstimateProvider:        NewGasEstimateProvider(extraDataCodec),
 		RMNCrypto:                  NewEVMRMNCrypto(lggr.Named(chainsel.FamilyEVM).Named("RMNCrypto")),
 		ContractTransmitterFactory: ocrimpls.NewEVMContractTransmitterFactory(extraDataCodec),
+		ExecuteReportSimulatorFactory: func(relayer loop.Relayer, offRamp []byte) (cciptypes.ExecuteReportSimulator, error) {
+			evmService, err := relayer.EVM()
+			if err != nil {
+				return nil, fmt.Errorf("get evm service: %w", err)
+			}
+			return NewExecuteReportSimulator(
+				lggr.Named(chainsel.FamilyEVM).Named("ExecuteReportSimulator"),
+				extraDataCodec,
+				evmService,
+				common.BytesToAddress(offRamp),
+			), nil
+		},
 	}
 }
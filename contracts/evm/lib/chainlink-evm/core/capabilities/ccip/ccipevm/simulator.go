@@ -0,0 +1,161 @@
+package ccipevm
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/smartcontractkit/chainlink-ccip/chains/evm/gobindings/generated/v1_6_0/offramp"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+	evmtypes "github.com/smartcontractkit/chainlink-common/pkg/types/chains/evm"
+	evmclient "github.com/smartcontractkit/chainlink-evm/pkg/client"
+
+	ccipcommon "github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/common"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// ContractCaller performs eth_calls on the destination chain, it is implemented by the EVM relayer service.
+type ContractCaller interface {
+	CallContract(ctx context.Context, msg *evmtypes.CallMsg, blockNumber *big.Int) ([]byte, error)
+}
+
+// ExecuteReportSimulator simulates execute reports with an eth_call of the offramp executeSingleMessage function
+// for each message. The call is made from the offramp itself, executeSingleMessage can only be called by the
+// offramp. Merkle proofs, nonces and execution states are not checked, only the token transfers and the call to
+// the receiver are simulated.
+// Compatible with:
+// - "OffRamp 1.6.0"
+type ExecuteReportSimulator struct {
+	lggr       logger.Logger
+	caller     ContractCaller
+	offRamp    common.Address
+	codec      *ExecutePluginCodecV1
+	offRampABI abi.ABI
+}
+
+func NewExecuteReportSimulator(
+	lggr logger.Logger,
+	extraDataCodec ccipcommon.ExtraDataCodec,
+	caller ContractCaller,
+	offRamp common.Address,
+) *ExecuteReportSimulator {
+	abiParsed, err := abi.JSON(strings.NewReader(offramp.OffRampABI))
+	if err != nil {
+		panic(fmt.Errorf("parse multi offramp abi: %s", err))
+	}
+
+	return &ExecuteReportSimulator{
+		lggr:       lggr,
+		caller:     caller,
+		offRamp:    offRamp,
+		codec:      NewExecutePluginCodecV1(extraDataCodec),
+		offRampABI: abiParsed,
+	}
+}
+
+// SimulateExecute simulates the messages one by one, using at most half of the time left before the deadline of ctx
+// so that the rest of the observation can still be made. The simulation stops when that time is up, the failures of
+// the messages simulated so far are returned, the remaining messages are not simulated.
+func (s *ExecuteReportSimulator) SimulateExecute(
+	ctx context.Context,
+	report cciptypes.ExecutePluginReport,
+) ([]cciptypes.MessageSimulationFailure, error) {
+	evmReport, err := s.codec.toEVMReport(report)
+	if err != nil {
+		return nil, fmt.Errorf("convert report: %w", err)
+	}
+
+	simCtx := ctx
+	if deadline, ok := ctx.Deadline(); ok {
+		var cancel context.CancelFunc
+		simCtx, cancel = context.WithTimeout(ctx, time.Until(deadline)/2)
+		defer cancel()
+	}
+
+	var failures []cciptypes.MessageSimulationFailure
+	simulated := 0
+	for i, evmChainReport := range evmReport {
+		for j, message := range evmChainReport.Messages {
+			var offchainTokenData [][]byte
+			if j < len(evmChainReport.OffchainTokenData) {
+				offchainTokenData = evmChainReport.OffchainTokenData[j]
+			}
+
+			data, err := s.offRampABI.Pack("executeSingleMessage", message, offchainTokenData, []uint32{})
+			if err != nil {
+				return nil, fmt.Errorf("pack executeSingleMessage: %w", err)
+			}
+
+			_, err = s.caller.CallContract(simCtx, &evmtypes.CallMsg{
+				To:   s.offRamp,
+				From: s.offRamp,
+				Data: data,
+			}, nil)
+			if err != nil && ctx.Err() == nil && simCtx.Err() != nil {
+				s.lggr.Warnw("report simulation ran out of time, returning the failures of the simulated messages",
+					"simulated", simulated, "failures", len(failures))
+				return failures, nil
+			}
+			simulated++
+			if err == nil {
+				continue
+			}
+			jsonErr, reverted := revertError(err)
+			if !reverted {
+				return nil, fmt.Errorf("simulate message %d of source chain %d: %w",
+					message.Header.SequenceNumber, message.Header.SourceChainSelector, err)
+			}
+
+			reason := jsonErr.String()
+			s.lggr.Debugw("message simulation reverted",
+				"sourceChain", message.Header.SourceChainSelector,
+				"seqNum", message.Header.SequenceNumber,
+				"reason", reason)
+			failures = append(failures, cciptypes.MessageSimulationFailure{
+				SourceChainSelector: report.ChainReports[i].SourceChainSelector,
+				SequenceNumber:      cciptypes.SeqNum(message.Header.SequenceNumber),
+				MessageID:           message.Header.MessageId,
+				Reason:              reason,
+			})
+		}
+	}
+
+	return failures, nil
+}
+
+const (
+	// jsonRPCRevertCode is the code of the error of a call reverting with data, its data is the revert reason.
+	jsonRPCRevertCode = 3
+	// jsonRPCServerErrorCode is the generic server error code of geth.
+	jsonRPCServerErrorCode = -32000
+	// jsonRPCVMExecutionErrorCode is the code Nethermind and OpenEthereum return for a reverted call.
+	jsonRPCVMExecutionErrorCode = -32015
+)
+
+// revertError returns the JSON-RPC error of a reverted eth_call. Errors which are not JSON-RPC errors, like
+// transport errors, or JSON-RPC errors which are not reverts, like a missing block, are not reverts.
+// Geth returns the revert reason with code 3, and calls reverting without data with the server error code and the
+// exact vm.ErrExecutionReverted message.
+func revertError(err error) (*evmclient.JsonError, bool) {
+	jsonErr := evmclient.ExtractRPCErrorOrNil(err)
+	if jsonErr == nil {
+		return nil, false
+	}
+	switch {
+	case jsonErr.Code == jsonRPCRevertCode, jsonErr.Code == jsonRPCVMExecutionErrorCode:
+		return jsonErr, true
+	case jsonErr.Code == jsonRPCServerErrorCode && jsonErr.Message == vm.ErrExecutionReverted.Error():
+		return jsonErr, true
+	default:
+		return jsonErr, false
+	}
+}
+
+// Interface compliance check
+var _ cciptypes.ExecuteReportSimulator = (*ExecuteReportSimulator)(nil)
@@ -0,0 +1,214 @@
+package ccipevm
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-ccip/chains/evm/gobindings/generated/v1_6_0/offramp"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+	evmtypes "github.com/smartcontractkit/chainlink-common/pkg/types/chains/evm"
+	evmclient "github.com/smartcontractkit/chainlink-evm/pkg/client"
+	"github.com/smartcontractkit/chainlink-evm/pkg/utils"
+
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/common/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// fakeCaller returns the error configured for the sequence number of the simulated message, the calls of the
+// hanging sequence numbers only return when their context is done.
+type fakeCaller struct {
+	t       *testing.T
+	offRamp common.Address
+	errs    map[uint64]error
+	hanging map[uint64]bool
+}
+
+func (c *fakeCaller) CallContract(ctx context.Context, msg *evmtypes.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	require.Nil(c.t, blockNumber)
+	require.Equal(c.t, c.offRamp, common.Address(msg.To))
+	require.Equal(c.t, c.offRamp, common.Address(msg.From))
+
+	offRampABI, err := offramp.OffRampMetaData.GetAbi()
+	require.NoError(c.t, err)
+	method := offRampABI.Methods["executeSingleMessage"]
+	require.Equal(c.t, method.ID, msg.Data[:4])
+	args, err := method.Inputs.Unpack(msg.Data[4:])
+	require.NoError(c.t, err)
+	message := *abi.ConvertType(args[0], new(offramp.InternalAny2EVMRampMessage)).(*offramp.InternalAny2EVMRampMessage)
+
+	if c.hanging[message.Header.SequenceNumber] {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+	return nil, c.errs[message.Header.SequenceNumber]
+}
+
+func TestExecuteReportSimulator_SimulateExecute(t *testing.T) {
+	const srcChain = cciptypes.ChainSelector(5009297550715157269) // ETH mainnet chain selector
+	offRamp := utils.RandomAddress()
+
+	mockExtraDataCodec := mocks.NewSourceChainExtraDataCodec(t)
+	mockExtraDataCodec.On("DecodeExtraArgsToMap", mock.Anything).Return(map[string]any{
+		"gasLimit": big.NewInt(200_000),
+	}, nil).Maybe()
+
+	messages := make([]cciptypes.Message, 3)
+	for i := range messages {
+		messages[i] = cciptypes.Message{
+			Header: cciptypes.RampMessageHeader{
+				MessageID:           utils.RandomBytes32(),
+				SourceChainSelector: srcChain,
+				DestChainSelector:   1,
+				SequenceNumber:      cciptypes.SeqNum(i + 1),
+			},
+			Sender:    utils.RandomAddress().Bytes(),
+			Receiver:  utils.RandomAddress().Bytes(),
+			ExtraArgs: []byte{0x1},
+		}
+	}
+	report := cciptypes.ExecutePluginReport{ChainReports: []cciptypes.ExecutePluginReportSingleChain{{
+		SourceChainSelector: srcChain,
+		Messages:            messages,
+		OffchainTokenData:   make([][][]byte, len(messages)),
+		Proofs:              []cciptypes.Bytes32{utils.RandomBytes32()},
+		ProofFlagBits:       cciptypes.NewBigInt(big.NewInt(1)),
+	}}}
+
+	revertErr := evmclient.JsonError{Code: 3, Message: "execution reverted", Data: "0x0a8d6e8c"}
+	revertNoDataErr := evmclient.JsonError{Code: -32000, Message: "execution reverted"}
+	vmExecutionErr := evmclient.JsonError{Code: -32015, Message: "VM execution error."}
+
+	testCases := []struct {
+		name        string
+		errs        map[uint64]error
+		expFailures []cciptypes.MessageSimulationFailure
+		expErr      string
+	}{
+		{
+			name: "all messages succeed",
+		},
+		{
+			name: "reverted message is returned",
+			errs: map[uint64]error{2: revertErr},
+			expFailures: []cciptypes.MessageSimulationFailure{{
+				SourceChainSelector: srcChain,
+				SequenceNumber:      2,
+				MessageID:           messages[1].Header.MessageID,
+				Reason:              revertErr.String(),
+			}},
+		},
+		{
+			name: "reverts without data and vm execution errors are returned",
+			errs: map[uint64]error{1: revertNoDataErr, 3: vmExecutionErr},
+			expFailures: []cciptypes.MessageSimulationFailure{{
+				SourceChainSelector: srcChain,
+				SequenceNumber:      1,
+				MessageID:           messages[0].Header.MessageID,
+				Reason:              revertNoDataErr.String(),
+			}, {
+				SourceChainSelector: srcChain,
+				SequenceNumber:      3,
+				MessageID:           messages[2].Header.MessageID,
+				Reason:              vmExecutionErr.String(),
+			}},
+		},
+		{
+			name:   "rpc failure fails the simulation",
+			errs:   map[uint64]error{1: errors.New("connection refused")},
+			expErr: "connection refused",
+		},
+		{
+			name:   "transport error mentioning a revert is not a revert",
+			errs:   map[uint64]error{1: errors.New("post https://rpc: execution reverted by proxy")},
+			expErr: "execution reverted by proxy",
+		},
+		{
+			name:   "rpc error which is not a revert fails the simulation",
+			errs:   map[uint64]error{2: evmclient.JsonError{Code: -32000, Message: "header not found"}},
+			expErr: "header not found",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			simulator := NewExecuteReportSimulator(
+				logger.TestLogger(t),
+				newTestExtraDataCodec(mockExtraDataCodec),
+				&fakeCaller{t: t, offRamp: offRamp, errs: tc.errs},
+				offRamp,
+			)
+
+			failures, err := simulator.SimulateExecute(testutils.Context(t), report)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expFailures, failures)
+		})
+	}
+}
+
+func TestExecuteReportSimulator_SimulateExecute_Deadline(t *testing.T) {
+	const srcChain = cciptypes.ChainSelector(5009297550715157269) // ETH mainnet chain selector
+	offRamp := utils.RandomAddress()
+
+	mockExtraDataCodec := mocks.NewSourceChainExtraDataCodec(t)
+	mockExtraDataCodec.On("DecodeExtraArgsToMap", mock.Anything).Return(map[string]any{
+		"gasLimit": big.NewInt(200_000),
+	}, nil).Maybe()
+
+	messages := make([]cciptypes.Message, 3)
+	for i := range messages {
+		messages[i] = cciptypes.Message{
+			Header: cciptypes.RampMessageHeader{
+				MessageID:           utils.RandomBytes32(),
+				SourceChainSelector: srcChain,
+				DestChainSelector:   1,
+				SequenceNumber:      cciptypes.SeqNum(i + 1),
+			},
+			Sender:    utils.RandomAddress().Bytes(),
+			Receiver:  utils.RandomAddress().Bytes(),
+			ExtraArgs: []byte{0x1},
+		}
+	}
+	report := cciptypes.ExecutePluginReport{ChainReports: []cciptypes.ExecutePluginReportSingleChain{{
+		SourceChainSelector: srcChain,
+		Messages:            messages,
+		OffchainTokenData:   make([][][]byte, len(messages)),
+		Proofs:              []cciptypes.Bytes32{utils.RandomBytes32()},
+		ProofFlagBits:       cciptypes.NewBigInt(big.NewInt(1)),
+	}}}
+
+	revertErr := evmclient.JsonError{Code: 3, Message: "execution reverted", Data: "0x0a8d6e8c"}
+	simulator := NewExecuteReportSimulator(
+		logger.TestLogger(t),
+		newTestExtraDataCodec(mockExtraDataCodec),
+		&fakeCaller{t: t, offRamp: offRamp, errs: map[uint64]error{1: revertErr}, hanging: map[uint64]bool{2: true}},
+		offRamp,
+	)
+
+	ctx, cancel := context.WithTimeout(testutils.Context(t), time.Second)
+	defer cancel()
+	start := time.Now()
+	failures, err := simulator.SimulateExecute(ctx, report)
+	require.NoError(t, err)
+	// the simulation stops after half of the time left, the observation still has time for the rest.
+	require.Less(t, time.Since(start), 900*time.Millisecond)
+	require.NoError(t, ctx.Err())
+	require.Equal(t, []cciptypes.MessageSimulationFailure{{
+		SourceChainSelector: srcChain,
+		SequenceNumber:      1,
+		MessageID:           messages[0].Header.MessageID,
+		Reason:              revertErr.String(),
+	}}, failures)
+}
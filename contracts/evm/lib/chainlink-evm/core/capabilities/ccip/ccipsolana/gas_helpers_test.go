@@ -56,7 +56,10 @@ func Test_calculateMessageMaxGas(t *testing.T) {
 			}
 			// Set the source chain selector to be EVM for now
 			msg.Header.SourceChainSelector = ccipocr3.ChainSelector(chainsel.SOLANA_TESTNET.Selector)
-			ep := EstimateProvider{extraDataCodec: ccipcommon.NewExtraDataCodec(ccipevm.ExtraDataCodec{}, ExtraDataCodec{})}
+			ep := EstimateProvider{extraDataCodec: ccipcommon.NewExtraDataCodec(ccipcommon.NewChainFamilyRegistry(map[string]ccipcommon.ChainFamily{
+				chainsel.FamilyEVM:    {ExtraDataCodec: ccipevm.ExtraDataCodec{}},
+				chainsel.FamilySolana: {ExtraDataCodec: ExtraDataCodec{}},
+			}))}
 			got := ep.CalculateMessageMaxGas(msg)
 			t.Log(got)
 			assert.Equalf(t, tt.want, got, "calculateMessageMaxGas(%v, %v)", tt.args.dataLen, tt.args.numTokens)
@@ -96,7 +99,10 @@ func TestCalculateMaxGas(t *testing.T) {
 			}
 
 			msg.Header.SourceChainSelector = ccipocr3.ChainSelector(chainsel.SOLANA_TESTNET.Selector)
-			ep := EstimateProvider{extraDataCodec: ccipcommon.NewExtraDataCodec(ccipevm.ExtraDataCodec{}, ExtraDataCodec{})}
+			ep := EstimateProvider{extraDataCodec: ccipcommon.NewExtraDataCodec(ccipcommon.NewChainFamilyRegistry(map[string]ccipcommon.ChainFamily{
+				chainsel.FamilyEVM:    {ExtraDataCodec: ccipevm.ExtraDataCodec{}},
+				chainsel.FamilySolana: {ExtraDataCodec: ExtraDataCodec{}},
+			}))}
 			gotTree := ep.CalculateMerkleTreeGas(tt.numRequests)
 			gotMsg := ep.CalculateMessageMaxGas(msg)
 			t.Log("want", tt.want, "got", gotTree+gotMsg)
@@ -0,0 +1,157 @@
+package ccipsolana
+
+import (
+	"fmt"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/gobindings/ccip_common"
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/gobindings/ccip_router"
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/utils/state"
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/utils/tokens"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/common"
+)
+
+// SVM2AnyToCCIPMsg converts a message emitted by the SVM router in a CCIPMessageSent event
+// into a chain-agnostic ccipocr3.Message.
+func SVM2AnyToCCIPMsg(onRampAddress solana.PublicKey, svm2Any ccip_router.SVM2AnyRampMessage) cciptypes.Message {
+	var tokenAmounts []cciptypes.RampTokenAmount
+	for _, ta := range svm2Any.TokenAmounts {
+		tokenAmounts = append(tokenAmounts, cciptypes.RampTokenAmount{
+			SourcePoolAddress: ta.SourcePoolAddress.Bytes(),
+			DestTokenAddress:  ta.DestTokenAddress,
+			DestExecData:      ta.DestExecData,
+			ExtraData:         ta.ExtraData,
+			Amount:            decodeLEToBigInt(ta.Amount.LeBytes[:]),
+		})
+	}
+	return cciptypes.Message{
+		Header: cciptypes.RampMessageHeader{
+			MessageID:           svm2Any.Header.MessageId,
+			SourceChainSelector: cciptypes.ChainSelector(svm2Any.Header.SourceChainSelector),
+			DestChainSelector:   cciptypes.ChainSelector(svm2Any.Header.DestChainSelector),
+			SequenceNumber:      cciptypes.SeqNum(svm2Any.Header.SequenceNumber),
+			Nonce:               svm2Any.Header.Nonce,
+			OnRamp:              onRampAddress.Bytes(),
+		},
+		Sender:         svm2Any.Sender.Bytes(),
+		Data:           svm2Any.Data,
+		Receiver:       svm2Any.Receiver,
+		ExtraArgs:      svm2Any.ExtraArgs,
+		FeeToken:       svm2Any.FeeToken.Bytes(),
+		FeeTokenAmount: decodeLEToBigInt(svm2Any.FeeTokenAmount.LeBytes[:]),
+		FeeValueJuels:  decodeLEToBigInt(svm2Any.FeeValueJuels.LeBytes[:]),
+		TokenAmounts:   tokenAmounts,
+	}
+}
+
+// ExecuteRemainingAccounts returns the remaining accounts the SVM offramp expects for executing the messaging part
+// of msg: the logic receiver, the offramp external execution signer of that receiver and the message accounts
+// from the extra args, writable according to the extra args bitmap.
+// Messages without a logic receiver (token transfers only) have no messaging accounts.
+func ExecuteRemainingAccounts(
+	offRampAddress solana.PublicKey,
+	msg cciptypes.Message,
+	extraDataCodec common.ExtraDataCodec,
+) (solana.AccountMetaSlice, error) {
+	if msg.Receiver.IsZeroOrEmpty() {
+		return nil, nil
+	}
+	if len(msg.Receiver) != solana.PublicKeyLength {
+		return nil, fmt.Errorf("invalid receiver length: %d", len(msg.Receiver))
+	}
+
+	extraDataDecodedMap, err := extraDataCodec.DecodeExtraArgs(msg.ExtraArgs, msg.Header.SourceChainSelector)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode extra args: %w", err)
+	}
+	ed, err := parseExtraDataMap(extraDataDecodedMap)
+	if err != nil {
+		return nil, fmt.Errorf("invalid extra args map: %w", err)
+	}
+
+	logicReceiver := solana.PublicKeyFromBytes(msg.Receiver)
+	externalExecutionSigner, _, err := state.FindExternalExecutionConfigPDA(logicReceiver, offRampAddress)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find external execution config PDA: %w", err)
+	}
+
+	accounts := solana.AccountMetaSlice{
+		solana.NewAccountMeta(logicReceiver, false, false),
+		solana.NewAccountMeta(externalExecutionSigner, false, false),
+	}
+	for i, account := range ed.accounts {
+		isWritable := ed.extraArgs.IsWritableBitmap&(1<<uint(i)) != 0
+		accounts = append(accounts, solana.NewAccountMeta(account, isWritable, false))
+	}
+	return accounts, nil
+}
+
+// ExecuteTokenReceiver returns the token receiver of msg, which is part of the SVM extra args.
+func ExecuteTokenReceiver(msg cciptypes.Message, extraDataCodec common.ExtraDataCodec) (solana.PublicKey, error) {
+	extraDataDecodedMap, err := extraDataCodec.DecodeExtraArgs(msg.ExtraArgs, msg.Header.SourceChainSelector)
+	if err != nil {
+		return solana.PublicKey{}, fmt.Errorf("failed to decode extra args: %w", err)
+	}
+	ed, err := parseExtraDataMap(extraDataDecodedMap)
+	if err != nil {
+		return solana.PublicKey{}, fmt.Errorf("invalid extra args map: %w", err)
+	}
+	return ed.tokenReceiver, nil
+}
+
+// minPoolLookupTableEntries is the number of entries every token pool lookup table starts with,
+// see tokens.TokenPool.ToTokenPoolEntries.
+const minPoolLookupTableEntries = 10
+
+// ExecuteTokenAccounts returns the remaining accounts the SVM offramp expects for releasing or minting one token
+// of a message sent from srcChainSel: the offramp signer of the token pool, the token account of the receiver,
+// the fee quoter billing config and the pool chain config of the source chain, followed by the entries of the
+// pool lookup table of the token, writable according to its token admin registry.
+func ExecuteTokenAccounts(
+	offRampAddress solana.PublicKey,
+	feeQuoterAddress solana.PublicKey,
+	srcChainSel uint64,
+	tokenReceiver solana.PublicKey,
+	registry ccip_common.TokenAdminRegistry,
+	poolLookupTable solana.PublicKeySlice,
+) (solana.AccountMetaSlice, error) {
+	if len(poolLookupTable) < minPoolLookupTableEntries {
+		return nil, fmt.Errorf("pool lookup table %s of token %s has %d entries, expected at least %d",
+			registry.LookupTable, registry.Mint, len(poolLookupTable), minPoolLookupTableEntries)
+	}
+	poolProgram := poolLookupTable[2]
+	tokenProgram := poolLookupTable[6]
+	mint := poolLookupTable[7]
+
+	offRampPoolSigner, _, err := state.FindExternalTokenPoolsSignerPDA(poolProgram, offRampAddress)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find offramp token pools signer PDA: %w", err)
+	}
+	userTokenAccount, _, err := tokens.FindAssociatedTokenAddress(tokenProgram, mint, tokenReceiver)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find token account of %s: %w", tokenReceiver, err)
+	}
+	tokenBillingConfig, _, err := state.FindFqPerChainPerTokenConfigPDA(srcChainSel, mint, feeQuoterAddress)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find token billing config PDA: %w", err)
+	}
+	poolChainConfig, _, err := tokens.TokenPoolChainConfigPDA(srcChainSel, mint, poolProgram)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find pool chain config PDA: %w", err)
+	}
+
+	accounts := solana.AccountMetaSlice{
+		solana.NewAccountMeta(offRampPoolSigner, false, false),
+		solana.NewAccountMeta(userTokenAccount, true, false),
+		solana.NewAccountMeta(tokenBillingConfig, false, false),
+		solana.NewAccountMeta(poolChainConfig, true, false),
+	}
+	// the writable indexes are a big endian bitmap over the lookup table entries.
+	writableBitmap := append(registry.WritableIndexes[0].Bytes(), registry.WritableIndexes[1].Bytes()...)
+	for i, entry := range poolLookupTable {
+		isWritable := i/8 < len(writableBitmap) && writableBitmap[i/8]&(0x80>>(i%8)) != 0
+		accounts = append(accounts, solana.NewAccountMeta(entry, isWritable, false))
+	}
+	return accounts, nil
+}
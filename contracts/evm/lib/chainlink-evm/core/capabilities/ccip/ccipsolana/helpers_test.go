@@ -0,0 +1,189 @@
+package ccipsolana
+
+import (
+	"math/big"
+	"testing"
+
+	bin "github.com/gagliardetto/binary"
+	"github.com/gagliardetto/solana-go"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/gobindings/ccip_common"
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/gobindings/ccip_router"
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/utils/state"
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/utils/tokens"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/utils"
+
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/common/mocks"
+)
+
+func TestSVM2AnyToCCIPMsg(t *testing.T) {
+	router := solana.MustPublicKeyFromBase58("Ccip842gzYHhvdDkSyi2YVCoAWPbYJoApMFzSxQroE9C")
+	sender := solana.NewWallet().PublicKey()
+	sourcePool := solana.NewWallet().PublicKey()
+	svm2Any := ccip_router.SVM2AnyRampMessage{
+		Header: ccip_router.RampMessageHeader{
+			MessageId:           utils.RandomBytes32(),
+			SourceChainSelector: 1,
+			DestChainSelector:   2,
+			SequenceNumber:      3,
+			Nonce:               4,
+		},
+		Sender:         sender,
+		Data:           []byte{1, 2, 3},
+		Receiver:       abiEncodedAddress(t),
+		ExtraArgs:      []byte{4, 5, 6},
+		FeeToken:       solana.SolMint,
+		FeeTokenAmount: ccip_router.CrossChainAmount{LeBytes: tokens.ToLittleEndianU256(100)},
+		FeeValueJuels:  ccip_router.CrossChainAmount{LeBytes: tokens.ToLittleEndianU256(200)},
+		TokenAmounts: []ccip_router.SVM2AnyTokenTransfer{
+			{
+				SourcePoolAddress: sourcePool,
+				DestTokenAddress:  abiEncodedAddress(t),
+				Amount:            ccip_router.CrossChainAmount{LeBytes: tokens.ToLittleEndianU256(300)},
+				DestExecData:      []byte{7},
+			},
+		},
+	}
+
+	msg := SVM2AnyToCCIPMsg(router, svm2Any)
+	require.Equal(t, cciptypes.Bytes32(svm2Any.Header.MessageId), msg.Header.MessageID)
+	require.Equal(t, cciptypes.ChainSelector(1), msg.Header.SourceChainSelector)
+	require.Equal(t, cciptypes.ChainSelector(2), msg.Header.DestChainSelector)
+	require.Equal(t, cciptypes.SeqNum(3), msg.Header.SequenceNumber)
+	require.Equal(t, uint64(4), msg.Header.Nonce)
+	require.Equal(t, cciptypes.UnknownAddress(router.Bytes()), msg.Header.OnRamp)
+	require.Equal(t, cciptypes.UnknownAddress(svm2Any.Sender.Bytes()), msg.Sender)
+	require.Equal(t, cciptypes.UnknownAddress(svm2Any.Receiver), msg.Receiver)
+	require.Equal(t, cciptypes.UnknownAddress(solana.SolMint.Bytes()), msg.FeeToken)
+	require.Equal(t, 0, msg.FeeTokenAmount.Cmp(big.NewInt(100)))
+	require.Equal(t, 0, msg.FeeValueJuels.Cmp(big.NewInt(200)))
+	require.Len(t, msg.TokenAmounts, 1)
+	require.Equal(t, cciptypes.UnknownAddress(sourcePool.Bytes()), msg.TokenAmounts[0].SourcePoolAddress)
+	require.Equal(t, 0, msg.TokenAmounts[0].Amount.Cmp(big.NewInt(300)))
+}
+
+func TestExecuteRemainingAccounts(t *testing.T) {
+	// program ids of the deployed offramp and test receiver, the keypairs of config are not available in tests.
+	offRamp := solana.MustPublicKeyFromBase58("offqSMQWgQud6WJz694LRzkeN5kMYpCHTpXQr3Rkcjm")
+	logicReceiver := solana.MustPublicKeyFromBase58("EvhgrPhTDt4LcSPS2kfJgH6T6XWZ6wT3X9ncDGLT1vui")
+	receiverConfigPDA, _, err := solana.FindProgramAddress([][]byte{[]byte("external_execution_config")}, logicReceiver)
+	require.NoError(t, err)
+	receiverTargetPDA, _, err := solana.FindProgramAddress([][]byte{[]byte("counter")}, logicReceiver)
+	require.NoError(t, err)
+
+	mockExtraDataCodec := mocks.NewSourceChainExtraDataCodec(t)
+	mockExtraDataCodec.On("DecodeExtraArgsToMap", mock.Anything).Return(map[string]any{
+		"ComputeUnits":            uint32(1000),
+		"AccountIsWritableBitmap": uint64(2),
+		"Accounts": [][32]byte{
+			[32]byte(receiverConfigPDA.Bytes()),
+			[32]byte(receiverTargetPDA.Bytes()),
+		},
+	}, nil).Maybe()
+	extraDataCodec := newTestExtraDataCodec(mockExtraDataCodec)
+
+	msg, _, _ := createEVM2SolanaMessages(t)
+	msg.Receiver = logicReceiver.Bytes()
+
+	accounts, err := ExecuteRemainingAccounts(offRamp, msg, extraDataCodec)
+	require.NoError(t, err)
+
+	externalExecutionSigner, _, err := state.FindExternalExecutionConfigPDA(logicReceiver, offRamp)
+	require.NoError(t, err)
+	require.False(t, externalExecutionSigner.IsZero())
+	require.Equal(t, solana.AccountMetaSlice{
+		solana.NewAccountMeta(logicReceiver, false, false),
+		solana.NewAccountMeta(externalExecutionSigner, false, false),
+		solana.NewAccountMeta(receiverConfigPDA, false, false),
+		solana.NewAccountMeta(receiverTargetPDA, true, false),
+	}, accounts)
+
+	t.Run("no logic receiver", func(t *testing.T) {
+		msg.Receiver = nil
+		accounts, err := ExecuteRemainingAccounts(offRamp, msg, extraDataCodec)
+		require.NoError(t, err)
+		require.Empty(t, accounts)
+	})
+
+	t.Run("invalid receiver", func(t *testing.T) {
+		msg.Receiver = []byte{1, 2}
+		_, err := ExecuteRemainingAccounts(offRamp, msg, extraDataCodec)
+		require.ErrorContains(t, err, "invalid receiver length")
+	})
+}
+
+func TestExecuteTokenReceiver(t *testing.T) {
+	tokenReceiver := solana.NewWallet().PublicKey()
+	mockExtraDataCodec := mocks.NewSourceChainExtraDataCodec(t)
+	mockExtraDataCodec.On("DecodeExtraArgsToMap", mock.Anything).Return(map[string]any{
+		"TokenReceiver": [32]byte(tokenReceiver.Bytes()),
+	}, nil).Maybe()
+
+	msg, _, _ := createEVM2SolanaMessages(t)
+	receiver, err := ExecuteTokenReceiver(msg, newTestExtraDataCodec(mockExtraDataCodec))
+	require.NoError(t, err)
+	require.Equal(t, tokenReceiver, receiver)
+}
+
+func TestExecuteTokenAccounts(t *testing.T) {
+	const srcChainSel = uint64(5009297550715157269)
+	// program ids of the deployed offramp, fee quoter and test token pool.
+	offRamp := solana.MustPublicKeyFromBase58("offqSMQWgQud6WJz694LRzkeN5kMYpCHTpXQr3Rkcjm")
+	feeQuoter := solana.MustPublicKeyFromBase58("FeeQPGkKDeRV1MgoYfMH6L8o3KeuYjwUZrgn4LRKfjHi")
+	poolProgram := solana.MustPublicKeyFromBase58("JuCcZ4smxAYv9QHJ36jshA7pA3FuQ3vQeWLUeAtZduJ")
+	tokenReceiver := solana.NewWallet().PublicKey()
+	mint := solana.NewWallet().PublicKey()
+
+	poolLookupTable := solana.PublicKeySlice{
+		solana.NewWallet().PublicKey(), // lookup table
+		solana.NewWallet().PublicKey(), // token admin registry
+		poolProgram,
+		solana.NewWallet().PublicKey(), // pool config
+		solana.NewWallet().PublicKey(), // pool token account
+		solana.NewWallet().PublicKey(), // pool signer
+		solana.TokenProgramID,
+		mint,
+		solana.NewWallet().PublicKey(), // fee token config
+		solana.NewWallet().PublicKey(), // router signer
+		solana.NewWallet().PublicKey(), // additional account
+	}
+	registry := ccip_common.TokenAdminRegistry{
+		LookupTable: poolLookupTable[0],
+		Mint:        mint,
+		// indexes 3, 4, 7 and 10 are writable, the bitmap starts with the most significant bit.
+		WritableIndexes: [2]bin.Uint128{{Hi: 1<<60 | 1<<59 | 1<<56 | 1<<53}, {}},
+	}
+
+	accounts, err := ExecuteTokenAccounts(offRamp, feeQuoter, srcChainSel, tokenReceiver, registry, poolLookupTable)
+	require.NoError(t, err)
+
+	offRampPoolSigner, _, err := state.FindExternalTokenPoolsSignerPDA(poolProgram, offRamp)
+	require.NoError(t, err)
+	userTokenAccount, _, err := tokens.FindAssociatedTokenAddress(solana.TokenProgramID, mint, tokenReceiver)
+	require.NoError(t, err)
+	tokenBillingConfig, _, err := state.FindFqPerChainPerTokenConfigPDA(srcChainSel, mint, feeQuoter)
+	require.NoError(t, err)
+	poolChainConfig, _, err := tokens.TokenPoolChainConfigPDA(srcChainSel, mint, poolProgram)
+	require.NoError(t, err)
+
+	expected := solana.AccountMetaSlice{
+		solana.NewAccountMeta(offRampPoolSigner, false, false),
+		solana.NewAccountMeta(userTokenAccount, true, false),
+		solana.NewAccountMeta(tokenBillingConfig, false, false),
+		solana.NewAccountMeta(poolChainConfig, true, false),
+	}
+	for i, entry := range poolLookupTable {
+		isWritable := i == 3 || i == 4 || i == 7 || i == 10
+		expected = append(expected, solana.NewAccountMeta(entry, isWritable, false))
+	}
+	require.Equal(t, expected, accounts)
+
+	t.Run("incomplete pool lookup table", func(t *testing.T) {
+		_, err := ExecuteTokenAccounts(offRamp, feeQuoter, srcChainSel, tokenReceiver, registry, poolLookupTable[:9])
+		require.ErrorContains(t, err, "has 9 entries, expected at least 10")
+	})
+}
@@ -0,0 +1,134 @@
+package manualexeclib
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/gobindings/ccip_offramp"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+	"github.com/smartcontractkit/chainlink-common/pkg/hashutil"
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/merklemulti"
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/ccipsolana"
+	ccipcommon "github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/common"
+)
+
+// GetMessageHashes returns the leaf hashes of the given messages as computed by the SVM offramp.
+// The messages are expected to have their OnRamp set to the address of the source chain onramp.
+func GetMessageHashes(
+	ctx context.Context,
+	lggr logger.Logger,
+	msgs []cciptypes.Message,
+	extraDataCodec ccipcommon.ExtraDataCodec,
+) ([][32]byte, error) {
+	msgHasher := ccipsolana.NewMessageHasherV1(
+		lggr,
+		extraDataCodec,
+	)
+	var ret [][32]byte
+	for _, msg := range msgs {
+		msgHash, err := msgHasher.Hash(ctx, msg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to hash message (generic: %+v): %w", msg, err)
+		}
+		ret = append(ret, msgHash)
+	}
+
+	return ret, nil
+}
+
+// GetMerkleProof returns the merkle proof of inclusion for the given sequence number
+// in the given merkleRoot.
+//
+// The SVM offramp only executes a single message per report, so unlike EVM no proof flags
+// are returned: the proof hashes are consumed in order from the leaf up to the root.
+//
+// In the event that:
+// 1. the calculated merkle root does not match the committed merkle root
+// 2. the sequence number is not found in the merkle root struct
+// an error is returned.
+func GetMerkleProof(
+	lggr logger.Logger,
+	merkleRoot ccip_offramp.MerkleRoot,
+	messageHashes [][32]byte,
+	msgSeqNr uint64,
+) ([][32]byte, error) {
+	mtree, err := merklemulti.NewTree(hashutil.NewKeccak(), messageHashes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create merkle tree: %w", err)
+	}
+
+	// calculate merkle root from tree, should match the committed root
+	root := mtree.Root()
+	if root != merkleRoot.MerkleRoot {
+		return nil, fmt.Errorf(
+			"merkle root mismatch, calculated != committed: %x != %x",
+			root, merkleRoot.MerkleRoot)
+	}
+
+	lggr.Debugw("merkle roots match", "calculated", hexutil.Encode(root[:]), "committed", hexutil.Encode(merkleRoot.MerkleRoot[:]))
+
+	if msgSeqNr < merkleRoot.MinSeqNr || msgSeqNr > merkleRoot.MaxSeqNr {
+		return nil, fmt.Errorf("msgSeqNr %d not found in merkle root struct, range: [%d, %d]",
+			msgSeqNr, merkleRoot.MinSeqNr, merkleRoot.MaxSeqNr)
+	}
+
+	proof, err := mtree.Prove([]int{int(msgSeqNr - merkleRoot.MinSeqNr)}) //nolint:gosec // bounded by the root size.
+	if err != nil {
+		return nil, fmt.Errorf("failed to prove: %w", err)
+	}
+
+	// a single leaf proof never combines two computed nodes, sanity check it anyway
+	// since the SVM offramp can't verify multi-proofs.
+	for _, flag := range proof.SourceFlags {
+		if flag {
+			return nil, errors.New("unexpected multi-proof for a single message")
+		}
+	}
+
+	return proof.Hashes, nil
+}
+
+// CreateExecutionReport creates the borsh encoded ccip_offramp.ExecutionReportSingleChain
+// for the given message, as expected by the SVM offramp manually_execute instruction.
+//
+// The offchainTokenData is not currently supported, it is left empty for every token transferred by the message.
+// Messages transferring tokens through one of the offchainTokenDataPools are rejected with
+// ccipcommon.ErrOffchainTokenDataNotSupported.
+func CreateExecutionReport(
+	ctx context.Context,
+	srcChainSel uint64,
+	msg cciptypes.Message,
+	proofs [][32]byte,
+	extraDataCodec ccipcommon.ExtraDataCodec,
+	offchainTokenDataPools []cciptypes.UnknownAddress,
+) ([]byte, error) {
+	if err := ccipcommon.CheckOffchainTokenData(msg, offchainTokenDataPools); err != nil {
+		return nil, err
+	}
+
+	reportProofs := make([]cciptypes.Bytes32, 0, len(proofs))
+	for _, proof := range proofs {
+		reportProofs = append(reportProofs, proof)
+	}
+
+	report, err := ccipsolana.NewExecutePluginCodecV1(extraDataCodec).Encode(ctx, cciptypes.ExecutePluginReport{
+		ChainReports: []cciptypes.ExecutePluginReportSingleChain{
+			{
+				SourceChainSelector: cciptypes.ChainSelector(srcChainSel),
+				Messages:            []cciptypes.Message{msg},
+				// not currently supported, the offramp expects an entry per token transfer.
+				OffchainTokenData: [][][]byte{make([][]byte, len(msg.TokenAmounts))},
+				Proofs:            reportProofs,
+			},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode execution report: %w", err)
+	}
+
+	return report, nil
+}
@@ -26,11 +26,15 @@ import (
 
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 
+	chainsel "github.com/smartcontractkit/chain-selectors"
 	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
 )
 
 func TestMessageHasher_EVM2SVM(t *testing.T) {
-	var extraDataCodec = ccipcommon.NewExtraDataCodec(ccipevm.ExtraDataCodec{}, ExtraDataCodec{})
+	var extraDataCodec = ccipcommon.NewExtraDataCodec(ccipcommon.NewChainFamilyRegistry(map[string]ccipcommon.ChainFamily{
+		chainsel.FamilyEVM:    {ExtraDataCodec: ccipevm.ExtraDataCodec{}},
+		chainsel.FamilySolana: {ExtraDataCodec: ExtraDataCodec{}},
+	}))
 	any2AnyMsg, any2SolanaMsg, msgAccounts := createEVM2SolanaMessages(t)
 	msgHasher := NewMessageHasherV1(logger.Test(t), extraDataCodec)
 	actualHash, err := msgHasher.Hash(testutils.Context(t), any2AnyMsg)
@@ -58,7 +62,7 @@ func TestMessageHasher_InvalidReceiver(t *testing.T) {
 			[32]byte(solana.SystemProgramID.Bytes()),
 		},
 	}, nil).Maybe()
-	msgHasher := NewMessageHasherV1(logger.Test(t), ccipcommon.NewExtraDataCodec(mockExtraDataCodec, mockExtraDataCodec))
+	msgHasher := NewMessageHasherV1(logger.Test(t), newTestExtraDataCodec(mockExtraDataCodec))
 	_, err := msgHasher.Hash(testutils.Context(t), any2AnyMsg)
 	require.Error(t, err)
 }
@@ -81,7 +85,7 @@ func TestMessageHasher_InvalidDestinationTokenAddress(t *testing.T) {
 			[32]byte(solana.SystemProgramID.Bytes()),
 		},
 	}, nil).Maybe()
-	msgHasher := NewMessageHasherV1(logger.Test(t), ccipcommon.NewExtraDataCodec(mockExtraDataCodec, mockExtraDataCodec))
+	msgHasher := NewMessageHasherV1(logger.Test(t), newTestExtraDataCodec(mockExtraDataCodec))
 	_, err := msgHasher.Hash(testutils.Context(t), any2AnyMsg)
 	require.Error(t, err)
 }
@@ -178,3 +182,11 @@ func abiEncodedAddress(t *testing.T) []byte {
 func abiEncodeUint32(data uint32) ([]byte, error) {
 	return utils.ABIEncode(`[{ "type": "uint32" }]`, data)
 }
+
+// newTestExtraDataCodec returns an ExtraDataCodec that uses the given codec for every supported chain family.
+func newTestExtraDataCodec(codec ccipcommon.SourceChainExtraDataCodec) ccipcommon.ExtraDataCodec {
+	return ccipcommon.NewExtraDataCodec(ccipcommon.NewChainFamilyRegistry(map[string]ccipcommon.ChainFamily{
+		chainsel.FamilyEVM:    {ExtraDataCodec: codec},
+		chainsel.FamilySolana: {ExtraDataCodec: codec},
+	}))
+}
@@ -1,60 +1,51 @@
 package common
 
 import (
+	"errors"
 	"fmt"
 
-	chainsel "github.com/smartcontractkit/chain-selectors"
 	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
 )
 
-// AddressCodec is a struct that holds the chain specific address codecs
+// AddressCodec is a struct that dispatches to the chain specific address codecs registered in a ChainFamilyRegistry
 type AddressCodec struct {
-	EVMAddressCodec    ChainSpecificAddressCodec
-	SolanaAddressCodec ChainSpecificAddressCodec
+	registry *ChainFamilyRegistry
 }
 
 // NewAddressCodec is a constructor for NewAddressCodec
-func NewAddressCodec(evmAddrCodec, solanaAddrCodec ChainSpecificAddressCodec) AddressCodec {
+func NewAddressCodec(registry *ChainFamilyRegistry) AddressCodec {
 	return AddressCodec{
-		EVMAddressCodec:    evmAddrCodec,
-		SolanaAddressCodec: solanaAddrCodec,
+		registry: registry,
 	}
 }
 
 // AddressBytesToString converts an address from bytes to string
 func (ac AddressCodec) AddressBytesToString(addr cciptypes.UnknownAddress, chainSelector cciptypes.ChainSelector) (string, error) {
-	family, err := chainsel.GetSelectorFamily(uint64(chainSelector))
+	codec, err := ac.getCodec(chainSelector)
 	if err != nil {
-		return "", fmt.Errorf("failed to get chain family for selector %d: %w", chainSelector, err)
+		return "", fmt.Errorf("unsupported family for address encode: %w", err)
 	}
 
-	switch family {
-	case chainsel.FamilyEVM:
-		return ac.EVMAddressCodec.AddressBytesToString(addr)
-
-	case chainsel.FamilySolana:
-		return ac.SolanaAddressCodec.AddressBytesToString(addr)
-
-	default:
-		return "", fmt.Errorf("unsupported family for address encode type %s", family)
-	}
+	return codec.AddressBytesToString(addr)
 }
 
 // AddressStringToBytes converts an address from string to bytes
 func (ac AddressCodec) AddressStringToBytes(addr string, chainSelector cciptypes.ChainSelector) (cciptypes.UnknownAddress, error) {
-	family, err := chainsel.GetSelectorFamily(uint64(chainSelector))
+	codec, err := ac.getCodec(chainSelector)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get chain family for selector %d: %w", chainSelector, err)
+		return nil, fmt.Errorf("unsupported family for address decode: %w", err)
 	}
 
-	switch family {
-	case chainsel.FamilyEVM:
-		return ac.EVMAddressCodec.AddressStringToBytes(addr)
-
-	case chainsel.FamilySolana:
-		return ac.SolanaAddressCodec.AddressStringToBytes(addr)
+	return codec.AddressStringToBytes(addr)
+}
 
-	default:
-		return nil, fmt.Errorf("unsupported family for address decode type %s", family)
+func (ac AddressCodec) getCodec(chainSelector cciptypes.ChainSelector) (ChainSpecificAddressCodec, error) {
+	cf, err := ac.registry.GetBySelector(chainSelector)
+	if err != nil {
+		return nil, err
+	}
+	if cf.AddressCodec == nil {
+		return nil, errors.New("no address codec registered")
 	}
+	return cf.AddressCodec, nil
 }
@@ -40,21 +40,21 @@ type ChainRWProvider interface {
 
 // MultiChainRW is a struct that implements the ChainRWProvider interface for all chains.
 type MultiChainRW struct {
-	cwProviderMap map[string]ChainRWProvider
+	registry *ChainFamilyRegistry
 }
 
 // NewCRCW is a constructor for MultiChainRW.
-func NewCRCW(cwProviderMap map[string]ChainRWProvider) *MultiChainRW {
+func NewCRCW(registry *ChainFamilyRegistry) *MultiChainRW {
 	return &MultiChainRW{
-		cwProviderMap: cwProviderMap,
+		registry: registry,
 	}
 }
 
 // GetChainReader returns a new ContractReader base on relay chain family.
 func (c *MultiChainRW) GetChainReader(ctx context.Context, params ChainReaderProviderOpts) (types.ContractReader, error) {
-	provider, exist := c.cwProviderMap[params.ChainFamily]
-	if !exist {
-		return nil, fmt.Errorf("unsupported chain family %s", params.ChainFamily)
+	provider, err := c.getProvider(params.ChainFamily)
+	if err != nil {
+		return nil, err
 	}
 
 	return provider.GetChainReader(ctx, params)
@@ -62,10 +62,21 @@ func (c *MultiChainRW) GetChainReader(ctx context.Context, params ChainReaderPro
 
 // GetChainWriter returns a new ContractWriter based on relay chain family.
 func (c *MultiChainRW) GetChainWriter(ctx context.Context, params ChainWriterProviderOpts) (types.ContractWriter, error) {
-	provider, exist := c.cwProviderMap[params.ChainFamily]
-	if !exist {
-		return nil, fmt.Errorf("unsupported chain family %s", params.ChainFamily)
+	provider, err := c.getProvider(params.ChainFamily)
+	if err != nil {
+		return nil, err
 	}
 
 	return provider.GetChainWriter(ctx, params)
 }
+
+func (c *MultiChainRW) getProvider(chainFamily string) (ChainRWProvider, error) {
+	cf, err := c.registry.Get(chainFamily)
+	if err != nil {
+		return nil, err
+	}
+	if cf.ChainRW == nil {
+		return nil, fmt.Errorf("no chain reader/writer provider registered for chain family %s", chainFamily)
+	}
+	return cf.ChainRW, nil
+}
@@ -8,13 +8,27 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/common"
 )
 
+// DefaultChainFamilyRegistry is the default ChainFamilyRegistry for CCIP initialized with all supported chain families.
+// Additional chain families can be added with DefaultChainFamilyRegistry.Register.
+var DefaultChainFamilyRegistry = common.NewChainFamilyRegistry(map[string]common.ChainFamily{
+	chainsel.FamilyEVM: {
+		AddressCodec:   ccipevm.AddressCodec{},
+		ExtraDataCodec: ccipevm.ExtraDataCodec{},
+		PluginConfig:   ccipevm.InitializePluginConfig,
+		ChainRW:        ccipevm.ChainCWProvider{},
+	},
+	chainsel.FamilySolana: {
+		AddressCodec:   ccipsolana.AddressCodec{},
+		ExtraDataCodec: ccipsolana.ExtraDataCodec{},
+		PluginConfig:   ccipsolana.InitializePluginConfig,
+		ChainRW:        ccipsolana.ChainRWProvider{},
+	},
+})
+
 // DefaultExtraDataCodec is the default ExtraDataCodec for CCIP initialized with all supported chain families.
-var DefaultExtraDataCodec = common.NewExtraDataCodec(ccipevm.ExtraDataCodec{}, ccipsolana.ExtraDataCodec{})
+var DefaultExtraDataCodec = common.NewExtraDataCodec(DefaultChainFamilyRegistry)
 
 // DefaultAddressCodec is the default AddressCodec for CCIP initialized with all supported chain families.
-var DefaultAddressCodec = common.NewAddressCodec(ccipevm.AddressCodec{}, ccipsolana.AddressCodec{})
+var DefaultAddressCodec = common.NewAddressCodec(DefaultChainFamilyRegistry)
 
-var DefaultCRCW = common.NewCRCW(map[string]common.ChainRWProvider{
-	chainsel.FamilyEVM:    ccipevm.ChainCWProvider{},
-	chainsel.FamilySolana: ccipsolana.ChainRWProvider{},
-})
+var DefaultCRCW = common.NewCRCW(DefaultChainFamilyRegistry)
@@ -1,24 +1,21 @@
 package common
 
 import (
+	"errors"
 	"fmt"
 
-	chainsel "github.com/smartcontractkit/chain-selectors"
-
 	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
 )
 
-// ExtraDataCodec is a struct that holds the chain specific extra data codec
+// ExtraDataCodec is a struct that dispatches to the chain specific extra data codecs registered in a ChainFamilyRegistry
 type ExtraDataCodec struct {
-	EVMExtraDataCodec    SourceChainExtraDataCodec
-	SolanaExtraDataCodec SourceChainExtraDataCodec
+	registry *ChainFamilyRegistry
 }
 
 // NewExtraDataCodec is a constructor for ExtraDataCodec
-func NewExtraDataCodec(evmExtraDataCodec, solanaExtraDataCodec SourceChainExtraDataCodec) ExtraDataCodec {
+func NewExtraDataCodec(registry *ChainFamilyRegistry) ExtraDataCodec {
 	return ExtraDataCodec{
-		EVMExtraDataCodec:    evmExtraDataCodec,
-		SolanaExtraDataCodec: solanaExtraDataCodec,
+		registry: registry,
 	}
 }
 
@@ -29,21 +26,12 @@ func (c ExtraDataCodec) DecodeExtraArgs(extraArgs cciptypes.Bytes, sourceChainSe
 		return nil, nil
 	}
 
-	family, err := chainsel.GetSelectorFamily(uint64(sourceChainSelector))
+	codec, err := c.getCodec(sourceChainSelector)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get chain family for selector %d: %w", sourceChainSelector, err)
+		return nil, fmt.Errorf("unsupported family for extra args: %w", err)
 	}
 
-	switch family {
-	case chainsel.FamilyEVM:
-		return c.EVMExtraDataCodec.DecodeExtraArgsToMap(extraArgs)
-
-	case chainsel.FamilySolana:
-		return c.SolanaExtraDataCodec.DecodeExtraArgsToMap(extraArgs)
-
-	default:
-		return nil, fmt.Errorf("unsupported family for extra args type %s", family)
-	}
+	return codec.DecodeExtraArgsToMap(extraArgs)
 }
 
 // DecodeTokenAmountDestExecData reformats bytes to chain-agnostic map[string]any for tokenAmount DestExecData field
@@ -53,19 +41,21 @@ func (c ExtraDataCodec) DecodeTokenAmountDestExecData(destExecData cciptypes.Byt
 		return nil, nil
 	}
 
-	family, err := chainsel.GetSelectorFamily(uint64(sourceChainSelector))
+	codec, err := c.getCodec(sourceChainSelector)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get chain family for selector %d: %w", sourceChainSelector, err)
+		return nil, fmt.Errorf("unsupported family for dest exec data: %w", err)
 	}
 
-	switch family {
-	case chainsel.FamilyEVM:
-		return c.EVMExtraDataCodec.DecodeDestExecDataToMap(destExecData)
-
-	case chainsel.FamilySolana:
-		return c.SolanaExtraDataCodec.DecodeDestExecDataToMap(destExecData)
+	return codec.DecodeDestExecDataToMap(destExecData)
+}
 
-	default:
-		return nil, fmt.Errorf("unsupported family for extra args type %s", family)
+func (c ExtraDataCodec) getCodec(sourceChainSelector cciptypes.ChainSelector) (SourceChainExtraDataCodec, error) {
+	cf, err := c.registry.GetBySelector(sourceChainSelector)
+	if err != nil {
+		return nil, err
+	}
+	if cf.ExtraDataCodec == nil {
+		return nil, errors.New("no extra data codec registered")
 	}
+	return cf.ExtraDataCodec, nil
 }
@@ -3,9 +3,10 @@ package common
 import (
 	"fmt"
 
-	chainsel "github.com/smartcontractkit/chain-selectors"
+	"github.com/smartcontractkit/chainlink-common/pkg/loop"
 
 	cctypes "github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/types"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
 
 	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
 )
@@ -19,32 +20,36 @@ type PluginConfig struct {
 	GasEstimateProvider        cciptypes.EstimateProvider
 	RMNCrypto                  cciptypes.RMNCrypto
 	ContractTransmitterFactory cctypes.ContractTransmitterFactory
+	// ExecuteReportSimulatorFactory optional method creating the simulator of execute reports on the destination
+	// chain, from the destination relayer and the offramp address.
+	ExecuteReportSimulatorFactory func(relayer loop.Relayer, offRamp []byte) (cciptypes.ExecuteReportSimulator, error)
 	// PriceOnlyCommitFn optional method override for price only commit reports.
 	PriceOnlyCommitFn string
 }
 
 // PluginConfigFactory is a factory for creating PluginConfig instances.
 type PluginConfigFactory struct {
-	EVMPluginConfig    PluginConfig
-	SolanaPluginConfig PluginConfig
+	lggr     logger.Logger
+	registry *ChainFamilyRegistry
 }
 
 // NewPluginConfigFactory is a constructor for PluginConfigFactory.
-func NewPluginConfigFactory(evmPluginConfig, solanaPluginConfig PluginConfig) *PluginConfigFactory {
+func NewPluginConfigFactory(lggr logger.Logger, registry *ChainFamilyRegistry) *PluginConfigFactory {
 	return &PluginConfigFactory{
-		EVMPluginConfig:    evmPluginConfig,
-		SolanaPluginConfig: solanaPluginConfig,
+		lggr:     lggr,
+		registry: registry,
 	}
 }
 
 // CreatePluginConfig creates a PluginConfig instance based on the chain family.
 func (f *PluginConfigFactory) CreatePluginConfig(chainFamily string) (PluginConfig, error) {
-	switch chainFamily {
-	case chainsel.FamilyEVM:
-		return f.EVMPluginConfig, nil
-	case chainsel.FamilySolana:
-		return f.SolanaPluginConfig, nil
-	default:
-		return PluginConfig{}, fmt.Errorf("unsupported chain family: %s", chainFamily)
+	cf, err := f.registry.Get(chainFamily)
+	if err != nil {
+		return PluginConfig{}, err
 	}
+	if cf.PluginConfig == nil {
+		return PluginConfig{}, fmt.Errorf("no plugin config registered for chain family: %s", chainFamily)
+	}
+
+	return cf.PluginConfig(f.lggr, NewExtraDataCodec(f.registry)), nil
 }
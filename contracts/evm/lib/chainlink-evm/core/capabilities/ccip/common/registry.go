@@ -0,0 +1,107 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	chainsel "github.com/smartcontractkit/chain-selectors"
+
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// PluginConfigInitializer initializes the PluginConfig of a chain family, i.e. the message hasher, commit and
+// execute codecs, RMN crypto and contract transmitter factory used when the chain family is the destination.
+type PluginConfigInitializer func(lggr logger.Logger, extraDataCodec ExtraDataCodec) PluginConfig
+
+// ChainFamily contains the chain specific components that a chain family provides to CCIP.
+type ChainFamily struct {
+	// AddressCodec encodes and decodes addresses of the chain family.
+	AddressCodec ChainSpecificAddressCodec
+	// ExtraDataCodec decodes extra args and dest exec data of messages sent from the chain family.
+	ExtraDataCodec SourceChainExtraDataCodec
+	// PluginConfig initializes the plugin config used when the chain family is the destination.
+	PluginConfig PluginConfigInitializer
+	// ChainRW provides the contract readers and writers of the chain family.
+	ChainRW ChainRWProvider
+}
+
+// ChainFamilyRegistry holds the components of every supported chain family, keyed by chain family.
+// Adding a new chain family only requires registering it, the dispatchers in this package
+// (AddressCodec, ExtraDataCodec, PluginConfigFactory and MultiChainRW) look the family up in the registry.
+type ChainFamilyRegistry struct {
+	mu       sync.RWMutex
+	families map[string]ChainFamily
+}
+
+// NewChainFamilyRegistry is a constructor for ChainFamilyRegistry.
+func NewChainFamilyRegistry(families map[string]ChainFamily) *ChainFamilyRegistry {
+	r := &ChainFamilyRegistry{
+		families: make(map[string]ChainFamily, len(families)),
+	}
+	for family, cf := range families {
+		r.families[family] = cf
+	}
+	return r
+}
+
+// Register adds a chain family to the registry. It returns an error if the family is already registered.
+func (r *ChainFamilyRegistry) Register(family string, cf ChainFamily) error {
+	if family == "" {
+		return errors.New("chain family must not be empty")
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.families[family]; exists {
+		return fmt.Errorf("chain family %s is already registered", family)
+	}
+	r.families[family] = cf
+	return nil
+}
+
+// Get returns the components registered for the given chain family.
+func (r *ChainFamilyRegistry) Get(family string) (ChainFamily, error) {
+	if r == nil {
+		return ChainFamily{}, errors.New("chain family registry is not initialized")
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	cf, exists := r.families[family]
+	if !exists {
+		return ChainFamily{}, fmt.Errorf("unsupported chain family %s", family)
+	}
+	return cf, nil
+}
+
+// GetBySelector returns the components registered for the chain family of the given chain selector.
+func (r *ChainFamilyRegistry) GetBySelector(chainSelector cciptypes.ChainSelector) (ChainFamily, error) {
+	family, err := chainsel.GetSelectorFamily(uint64(chainSelector))
+	if err != nil {
+		return ChainFamily{}, fmt.Errorf("failed to get chain family for selector %d: %w", chainSelector, err)
+	}
+	return r.Get(family)
+}
+
+// Families returns the registered chain families in sorted order.
+func (r *ChainFamilyRegistry) Families() []string {
+	if r == nil {
+		return nil
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	families := make([]string, 0, len(r.families))
+	for family := range r.families {
+		families = append(families, family)
+	}
+	sort.Strings(families)
+	return families
+}
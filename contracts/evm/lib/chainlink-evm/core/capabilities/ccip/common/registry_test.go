@@ -0,0 +1,102 @@
+package common_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	chainsel "github.com/smartcontractkit/chain-selectors"
+
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/common"
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/common/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+func TestChainFamilyRegistry_Register(t *testing.T) {
+	registry := common.NewChainFamilyRegistry(map[string]common.ChainFamily{
+		chainsel.FamilyEVM: {},
+	})
+
+	require.NoError(t, registry.Register(chainsel.FamilyAptos, common.ChainFamily{}))
+	require.Error(t, registry.Register(chainsel.FamilyAptos, common.ChainFamily{}))
+	require.Error(t, registry.Register("", common.ChainFamily{}))
+	require.Equal(t, []string{chainsel.FamilyAptos, chainsel.FamilyEVM}, registry.Families())
+
+	_, err := registry.Get(chainsel.FamilySolana)
+	require.Error(t, err)
+
+	_, err = registry.GetBySelector(cciptypes.ChainSelector(chainsel.APTOS_TESTNET.Selector))
+	require.NoError(t, err)
+}
+
+func TestChainFamilyRegistry_TestFamily(t *testing.T) {
+	aptosSelector := cciptypes.ChainSelector(chainsel.APTOS_TESTNET.Selector)
+	solanaSelector := cciptypes.ChainSelector(chainsel.SOLANA_DEVNET.Selector)
+
+	addrCodec := mocks.NewChainSpecificAddressCodec(t)
+	addrCodec.EXPECT().AddressBytesToString([]byte{0x1}).Return("0x1", nil)
+	addrCodec.EXPECT().AddressStringToBytes("0x1").Return([]byte{0x1}, nil)
+
+	extraDataCodec := mocks.NewSourceChainExtraDataCodec(t)
+	extraDataCodec.EXPECT().DecodeExtraArgsToMap(cciptypes.Bytes{0x2}).Return(map[string]any{"gasLimit": 1}, nil)
+	extraDataCodec.EXPECT().DecodeDestExecDataToMap(cciptypes.Bytes{0x3}).Return(map[string]any{"destGasAmount": 2}, nil)
+
+	registry := common.NewChainFamilyRegistry(nil)
+	require.NoError(t, registry.Register(chainsel.FamilyAptos, common.ChainFamily{
+		AddressCodec:   addrCodec,
+		ExtraDataCodec: extraDataCodec,
+		PluginConfig: func(lggr logger.Logger, extraDataCodec common.ExtraDataCodec) common.PluginConfig {
+			return common.PluginConfig{PriceOnlyCommitFn: "commitPriceOnly"}
+		},
+	}))
+
+	t.Run("address codec", func(t *testing.T) {
+		codec := common.NewAddressCodec(registry)
+
+		str, err := codec.AddressBytesToString([]byte{0x1}, aptosSelector)
+		require.NoError(t, err)
+		require.Equal(t, "0x1", str)
+
+		addr, err := codec.AddressStringToBytes("0x1", aptosSelector)
+		require.NoError(t, err)
+		require.Equal(t, cciptypes.UnknownAddress{0x1}, addr)
+
+		_, err = codec.AddressBytesToString([]byte{0x1}, solanaSelector)
+		require.Error(t, err)
+	})
+
+	t.Run("extra data codec", func(t *testing.T) {
+		codec := common.NewExtraDataCodec(registry)
+
+		extraArgs, err := codec.DecodeExtraArgs(cciptypes.Bytes{0x2}, aptosSelector)
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{"gasLimit": 1}, extraArgs)
+
+		destExecData, err := codec.DecodeTokenAmountDestExecData(cciptypes.Bytes{0x3}, aptosSelector)
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{"destGasAmount": 2}, destExecData)
+
+		_, err = codec.DecodeExtraArgs(cciptypes.Bytes{0x2}, solanaSelector)
+		require.Error(t, err)
+	})
+
+	t.Run("plugin config factory", func(t *testing.T) {
+		factory := common.NewPluginConfigFactory(logger.TestLogger(t), registry)
+
+		cfg, err := factory.CreatePluginConfig(chainsel.FamilyAptos)
+		require.NoError(t, err)
+		require.Equal(t, "commitPriceOnly", cfg.PriceOnlyCommitFn)
+
+		_, err = factory.CreatePluginConfig(chainsel.FamilySolana)
+		require.Error(t, err)
+	})
+
+	t.Run("chain reader writer", func(t *testing.T) {
+		crcw := common.NewCRCW(registry)
+
+		_, err := crcw.GetChainReader(t.Context(), common.ChainReaderProviderOpts{ChainFamily: chainsel.FamilyAptos})
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,27 @@
+package common
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+)
+
+// ErrOffchainTokenDataNotSupported is returned when a message transfers a token that requires offchain token data,
+// i.e. USDC or LBTC attestations, where it can't be provided.
+var ErrOffchainTokenDataNotSupported = errors.New("offchain token data is not supported")
+
+// CheckOffchainTokenData returns ErrOffchainTokenDataNotSupported if msg transfers a token through one of the
+// offchainTokenDataPools, the source pools whose transfers require offchain token data.
+func CheckOffchainTokenData(msg cciptypes.Message, offchainTokenDataPools []cciptypes.UnknownAddress) error {
+	for _, tokenAmount := range msg.TokenAmounts {
+		for _, pool := range offchainTokenDataPools {
+			if bytes.Equal(tokenAmount.SourcePoolAddress, pool) {
+				return fmt.Errorf("message %d transfers a token through source pool %s: %w",
+					msg.Header.SequenceNumber, pool, ErrOffchainTokenDataNotSupported)
+			}
+		}
+	}
+	return nil
+}
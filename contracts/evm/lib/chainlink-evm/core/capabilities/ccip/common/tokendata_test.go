@@ -0,0 +1,29 @@
+package common_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+
+	ccipcommon "github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/common"
+)
+
+func TestCheckOffchainTokenData(t *testing.T) {
+	usdcPool := cciptypes.UnknownAddress{0x01}
+	otherPool := cciptypes.UnknownAddress{0x02}
+	msg := func(pools ...cciptypes.UnknownAddress) cciptypes.Message {
+		m := cciptypes.Message{Header: cciptypes.RampMessageHeader{SequenceNumber: 5}}
+		for _, pool := range pools {
+			m.TokenAmounts = append(m.TokenAmounts, cciptypes.RampTokenAmount{SourcePoolAddress: pool})
+		}
+		return m
+	}
+
+	require.NoError(t, ccipcommon.CheckOffchainTokenData(msg(), []cciptypes.UnknownAddress{usdcPool}))
+	require.NoError(t, ccipcommon.CheckOffchainTokenData(msg(otherPool), []cciptypes.UnknownAddress{usdcPool}))
+	require.NoError(t, ccipcommon.CheckOffchainTokenData(msg(usdcPool), nil))
+	require.ErrorIs(t, ccipcommon.CheckOffchainTokenData(msg(otherPool, usdcPool), []cciptypes.UnknownAddress{usdcPool}),
+		ccipcommon.ErrOffchainTokenDataNotSupported)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math/big"
 	"strconv"
+	"sync"
 	"time"
 
 	"golang.org/x/exp/maps"
@@ -19,7 +20,9 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/types"
 	"github.com/smartcontractkit/chainlink-common/pkg/types/query/primitives"
 
+	"github.com/smartcontractkit/chainlink-ccip/execute/tokendata/observer"
 	"github.com/smartcontractkit/chainlink-ccip/pkg/consts"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/lanemonitor"
 	ccipreaderpkg "github.com/smartcontractkit/chainlink-ccip/pkg/reader"
 	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
 
@@ -30,11 +33,13 @@ import (
 
 	"github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/common"
 	configsevm "github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/configs/evm"
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/lanestatus"
 	"github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/launcher"
 	"github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/oraclecreator"
 	cctypes "github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/types"
 	"github.com/smartcontractkit/chainlink/v2/core/config"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	cciporm "github.com/smartcontractkit/chainlink/v2/core/services/ccip"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ocr2key"
@@ -69,8 +74,17 @@ type Delegate struct {
 	monitoringEndpointGen telemetry.MonitoringEndpointGenerator
 	capabilityConfig      config.Capabilities
 	evmConfigs            toml.EVMConfigs
+	ccipConfig            config.CCIP
 
 	isNewlyCreatedJob bool
+
+	launchersMu sync.RWMutex
+	// launchers are the capability launchers of the CCIP jobs, keyed by job ID.
+	launchers map[int32]launcher.Inspector
+	// laneTracker tracks the lanes served by the plugins of all the CCIP jobs.
+	laneTracker *lanestatus.Tracker
+	// laneMonitor checks the health of the lanes of the destination chains of the exec plugins, nil when disabled.
+	laneMonitor *lanemonitor.Monitor
 }
 
 func NewDelegate(
@@ -84,7 +98,12 @@ func NewDelegate(
 	monitoringEndpointGen telemetry.MonitoringEndpointGenerator,
 	capabilityConfig config.Capabilities,
 	evmConfigs toml.EVMConfigs,
+	ccipConfig config.CCIP,
 ) *Delegate {
+	orm, err := cciporm.NewORM(ds, lggr)
+	if err != nil {
+		lggr.Warnw("CCIP lanes are tracked without prices", "err", err)
+	}
 	return &Delegate{
 		lggr:                  lggr,
 		registrarConfig:       registrarConfig,
@@ -96,7 +115,28 @@ func NewDelegate(
 		monitoringEndpointGen: monitoringEndpointGen,
 		capabilityConfig:      capabilityConfig,
 		evmConfigs:            evmConfigs,
+		ccipConfig:            ccipConfig,
+		launchers:             make(map[int32]launcher.Inspector),
+		laneTracker:           lanestatus.NewTracker(lggr, orm),
+		laneMonitor:           newLaneMonitor(lggr, ccipConfig.LaneMonitor()),
+	}
+}
+
+func newLaneMonitor(lggr logger.Logger, cfg config.CCIPLaneMonitor) *lanemonitor.Monitor {
+	if !cfg.Enabled() {
+		return nil
 	}
+	return lanemonitor.NewMonitor(lggr.Named("CCIPLaneMonitor"), lanemonitor.Config{
+		Interval:          cfg.Interval(),
+		DiscoveryInterval: cfg.DiscoveryInterval(),
+		ExecScanLimit:     cfg.ExecScanLimit(),
+		Thresholds: lanemonitor.Thresholds{
+			CommitLag:        cfg.CommitLagThreshold(),
+			ExecLag:          cfg.ExecLagThreshold(),
+			OldestPendingAge: cfg.OldestPendingAgeThreshold(),
+			Cursed:           cfg.AlertOnCurse(),
+		},
+	})
 }
 
 func (d *Delegate) JobType() job.Type {
@@ -227,6 +267,12 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, spec job.Job) (services
 			hcr,
 			cciptypes.ChainSelector(homeChainChainSelector),
 			addressCodec,
+			d.laneTracker,
+			d.laneMonitor,
+			observer.NodeConfig{
+				Credentials:        d.ccipConfig.AttestationCredentials(),
+				PersistentCacheDir: d.ccipConfig.TokenData().PersistentCacheDir(),
+			},
 		)
 	} else {
 		oracleCreator = oraclecreator.NewBootstrapOracleCreator(
@@ -253,6 +299,10 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, spec job.Job) (services
 	// register the capability launcher with the registry syncer
 	registrySyncer.AddLauncher(capLauncher)
 
+	d.launchersMu.Lock()
+	d.launchers[spec.ID] = capLauncher
+	d.launchersMu.Unlock()
+
 	return []job.ServiceCtx{
 		homeChainContractReader,
 		registrySyncer,
@@ -263,7 +313,28 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, spec job.Job) (services
 
 func (d *Delegate) AfterJobCreated(spec job.Job) {}
 
-func (d *Delegate) BeforeJobDeleted(spec job.Job) {}
+func (d *Delegate) BeforeJobDeleted(spec job.Job) {
+	d.launchersMu.Lock()
+	defer d.launchersMu.Unlock()
+	delete(d.launchers, spec.ID)
+}
+
+// Launchers returns the capability launchers of the running CCIP jobs, keyed by job ID.
+func (d *Delegate) Launchers() map[int32]launcher.Inspector {
+	d.launchersMu.RLock()
+	defer d.launchersMu.RUnlock()
+	return maps.Clone(d.launchers)
+}
+
+// LaneTracker returns the tracker of the lanes served by the node.
+func (d *Delegate) LaneTracker() lanestatus.Reader {
+	return d.laneTracker
+}
+
+// LaneMonitor returns the monitor of the health of the lanes, nil when it's disabled.
+func (d *Delegate) LaneMonitor() *lanemonitor.Monitor {
+	return d.laneMonitor
+}
 
 func (d *Delegate) OnDeleteJob(ctx context.Context, spec job.Job) error {
 	// TODO: shut down needed services?
@@ -0,0 +1,438 @@
+// Package lanestatus keeps track of the state of the CCIP lanes served by the node, as seen by its OCR3 plugins.
+package lanestatus
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink-ccip/execute/exectypes"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/lanemonitor"
+	ccipreaderpkg "github.com/smartcontractkit/chainlink-ccip/pkg/reader"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/assets"
+
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/launcher"
+	cctypes "github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/types"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	cciporm "github.com/smartcontractkit/chainlink/v2/core/services/ccip"
+)
+
+const (
+	// maxTransmissions is the number of recent transmissions kept per plugin.
+	maxTransmissions = 20
+	// discoveryInterval is the interval between two discoveries of the lanes of a destination chain,
+	// the same as the default of the lane monitor.
+	discoveryInterval = 10 * time.Minute
+)
+
+// ExecState is the state of an exec plugin in the last OCR round.
+type ExecState struct {
+	State exectypes.PluginState
+	// SeqNr is the OCR sequence number of the round.
+	SeqNr     uint64
+	UpdatedAt time.Time
+}
+
+// ReportedMessage is a message included in the execution report of a Filter outcome of an exec plugin.
+// The exec plugin skips the message until it is executed or its inflight cache expiry passes, the tracker only
+// follows the outcomes: the message is kept until ExpiresAt even when it is executed earlier.
+type ReportedMessage struct {
+	MessageID  cciptypes.Bytes32
+	SeqNum     cciptypes.SeqNum
+	ReportedAt time.Time
+	ExpiresAt  time.Time
+}
+
+// Transmission is a report transmitted by a CCIP plugin of the node.
+type Transmission struct {
+	SeqNr         uint64
+	TransmittedAt time.Time
+	// Error is empty when the report was handed over to the chain writer successfully.
+	Error string
+}
+
+// PluginStatus is the state of a CCIP plugin of the node reporting to the destination chain of a lane, a plugin
+// runs for each OCR config of the DON.
+type PluginStatus struct {
+	// PluginType is either CCIPCommit or CCIPExec.
+	PluginType   string
+	ConfigDigest ocrtypes.ConfigDigest
+	// State is the role of the OCR config of the plugin in CCIPHome, unknown once the config is no longer there.
+	State launcher.PluginState
+	// Exec is the state of an exec plugin, zero for a commit plugin.
+	Exec ExecState
+	// Reported are the messages from the source chain of the lane reported by an exec plugin, by sequence number.
+	Reported []ReportedMessage
+	// Transmissions are the most recent reports transmitted by the plugin, newest first.
+	Transmissions []Transmission
+}
+
+// LaneStatus is the state of a CCIP lane served by the node.
+type LaneStatus struct {
+	SourceChainSelector cciptypes.ChainSelector
+	DestChainSelector   cciptypes.ChainSelector
+	// GasPrice is the last gas price of the source chain observed for the destination chain,
+	// nil if none was observed.
+	GasPrice *assets.Wei
+	// TokenPrices are the last token prices observed for the destination chain.
+	TokenPrices []cciporm.TokenPrice
+	// Plugins are the plugins reporting to the destination chain, the active ones first.
+	Plugins []PluginStatus
+}
+
+// Reader returns the state of the CCIP lanes served by the node.
+type Reader interface {
+	Lanes(ctx context.Context) ([]LaneStatus, error)
+}
+
+var _ Reader = (*Tracker)(nil)
+
+type reportedKey struct {
+	source cciptypes.ChainSelector
+	msgID  cciptypes.Bytes32
+}
+
+type pluginState struct {
+	donID         uint32
+	pluginType    cctypes.PluginType
+	ccipReader    ccipreaderpkg.CCIPReader
+	exec          ExecState
+	reported      map[reportedKey]ReportedMessage
+	transmissions []Transmission
+}
+
+type destState struct {
+	homeChain     ccipreaderpkg.HomeChain
+	plugins       map[ocrtypes.ConfigDigest]*pluginState
+	sources       []cciptypes.ChainSelector
+	lastDiscovery time.Time
+}
+
+// Tracker follows the CCIP plugins of the node through the wrappers returned by WrapFactory and WrapTransmitter.
+// Wrapping with a nil Tracker leaves the factories and transmitters untouched.
+type Tracker struct {
+	lggr logger.Logger
+	orm  cciporm.ORM
+	now  func() time.Time
+
+	mu    sync.Mutex
+	dests map[cciptypes.ChainSelector]*destState
+}
+
+// NewTracker creates a Tracker without any lanes. The prices of the lanes are read from the ORM,
+// they are left out if it is nil.
+func NewTracker(lggr logger.Logger, orm cciporm.ORM) *Tracker {
+	return &Tracker{
+		lggr:  lggr.Named("CCIPLaneStatus"),
+		orm:   orm,
+		now:   time.Now,
+		dests: make(map[cciptypes.ChainSelector]*destState),
+	}
+}
+
+func (t *Tracker) register(
+	dest cciptypes.ChainSelector,
+	digest ocrtypes.ConfigDigest,
+	donID uint32,
+	pluginType cctypes.PluginType,
+	homeChain ccipreaderpkg.HomeChain,
+	ccipReader ccipreaderpkg.CCIPReader,
+) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	d, ok := t.dests[dest]
+	if !ok {
+		d = &destState{plugins: make(map[ocrtypes.ConfigDigest]*pluginState)}
+		t.dests[dest] = d
+	}
+	d.homeChain = homeChain
+	d.plugins[digest] = &pluginState{
+		donID:      donID,
+		pluginType: pluginType,
+		ccipReader: ccipReader,
+		reported:   make(map[reportedKey]ReportedMessage),
+	}
+}
+
+func (t *Tracker) unregister(dest cciptypes.ChainSelector, digest ocrtypes.ConfigDigest) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	d, ok := t.dests[dest]
+	if !ok {
+		return
+	}
+	delete(d.plugins, digest)
+	if len(d.plugins) == 0 {
+		delete(t.dests, dest)
+	}
+}
+
+// plugin returns the state of the plugin with the config digest, the caller must hold the lock.
+func (t *Tracker) plugin(dest cciptypes.ChainSelector, digest ocrtypes.ConfigDigest) (*pluginState, bool) {
+	d, ok := t.dests[dest]
+	if !ok {
+		return nil, false
+	}
+	p, ok := d.plugins[digest]
+	return p, ok
+}
+
+// recordExecOutcome records the state of the exec plugin. The messages of the execution report of a Filter outcome
+// are recorded until the inflight cache expiry of the plugin passes.
+func (t *Tracker) recordExecOutcome(
+	dest cciptypes.ChainSelector,
+	digest ocrtypes.ConfigDigest,
+	seqNr uint64,
+	outcome exectypes.Outcome,
+	inflightExpiry time.Duration,
+) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	p, ok := t.plugin(dest, digest)
+	if !ok {
+		return
+	}
+	now := t.now()
+	p.exec = ExecState{State: outcome.State, SeqNr: seqNr, UpdatedAt: now}
+	if outcome.State != exectypes.Filter {
+		return
+	}
+	for _, chainReport := range outcome.Report.ChainReports {
+		for _, msg := range chainReport.Messages {
+			p.reported[reportedKey{source: chainReport.SourceChainSelector, msgID: msg.Header.MessageID}] = ReportedMessage{
+				MessageID:  msg.Header.MessageID,
+				SeqNum:     msg.Header.SequenceNumber,
+				ReportedAt: now,
+				ExpiresAt:  now.Add(inflightExpiry),
+			}
+		}
+	}
+}
+
+func (t *Tracker) recordTransmission(
+	dest cciptypes.ChainSelector,
+	digest ocrtypes.ConfigDigest,
+	transmission Transmission,
+) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	p, ok := t.plugin(dest, digest)
+	if !ok {
+		return
+	}
+	p.transmissions = append([]Transmission{transmission}, p.transmissions...)
+	if len(p.transmissions) > maxTransmissions {
+		p.transmissions = p.transmissions[:maxTransmissions]
+	}
+}
+
+// pluginSnapshot is a copy of the state of a plugin.
+type pluginSnapshot struct {
+	digest        ocrtypes.ConfigDigest
+	donID         uint32
+	pluginType    cctypes.PluginType
+	exec          ExecState
+	reported      map[cciptypes.ChainSelector][]ReportedMessage
+	transmissions []Transmission
+}
+
+// destSnapshot is a copy of the state of a destination chain, taken to query the home chain, the destination chain
+// and the ORM without holding the lock.
+type destSnapshot struct {
+	dest          cciptypes.ChainSelector
+	homeChain     ccipreaderpkg.HomeChain
+	ccipReader    ccipreaderpkg.CCIPReader
+	sources       []cciptypes.ChainSelector
+	lastDiscovery time.Time
+	plugins       []pluginSnapshot
+}
+
+func (t *Tracker) snapshot() []destSnapshot {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	now := t.now()
+	snapshots := make([]destSnapshot, 0, len(t.dests))
+	for dest, d := range t.dests {
+		s := destSnapshot{
+			dest:          dest,
+			homeChain:     d.homeChain,
+			sources:       d.sources,
+			lastDiscovery: d.lastDiscovery,
+		}
+		for digest, p := range d.plugins {
+			// the lanes are discovered with the reader of any plugin, they all read the same contracts.
+			if s.ccipReader == nil {
+				s.ccipReader = p.ccipReader
+			}
+			ps := pluginSnapshot{
+				digest:        digest,
+				donID:         p.donID,
+				pluginType:    p.pluginType,
+				exec:          p.exec,
+				reported:      make(map[cciptypes.ChainSelector][]ReportedMessage),
+				transmissions: slices.Clone(p.transmissions),
+			}
+			for key, msg := range p.reported {
+				if !now.Before(msg.ExpiresAt) {
+					delete(p.reported, key)
+					continue
+				}
+				ps.reported[key.source] = append(ps.reported[key.source], msg)
+			}
+			for _, msgs := range ps.reported {
+				sort.Slice(msgs, func(i, j int) bool { return msgs[i].SeqNum < msgs[j].SeqNum })
+			}
+			s.plugins = append(s.plugins, ps)
+		}
+		snapshots = append(snapshots, s)
+	}
+	sort.Slice(snapshots, func(i, j int) bool { return snapshots[i].dest < snapshots[j].dest })
+	return snapshots
+}
+
+// stateOrder orders the plugins of a lane by the role of their OCR config.
+var stateOrder = map[launcher.PluginState]int{
+	launcher.PluginStateActive:    0,
+	launcher.PluginStateCandidate: 1,
+	launcher.PluginStateUnknown:   2,
+}
+
+// Lanes implements Reader. The lanes of a destination chain are discovered like the lane monitor does, from the
+// source chain configs of the offRamp, and rediscovered every discoveryInterval.
+func (t *Tracker) Lanes(ctx context.Context) ([]LaneStatus, error) {
+	var lanes []LaneStatus
+	for _, s := range t.snapshot() {
+		sources := t.sources(ctx, s)
+		if len(sources) == 0 {
+			continue
+		}
+
+		plugins, err := t.pluginStates(ctx, s)
+		if err != nil {
+			return nil, err
+		}
+		gasPrices, tokenPrices, err := t.prices(ctx, s.dest)
+		if err != nil {
+			return nil, err
+		}
+		gasPriceBySource := make(map[cciptypes.ChainSelector]*assets.Wei, len(gasPrices))
+		for _, gasPrice := range gasPrices {
+			gasPriceBySource[cciptypes.ChainSelector(gasPrice.SourceChainSelector)] = gasPrice.GasPrice
+		}
+
+		for _, source := range sources {
+			statuses := make([]PluginStatus, 0, len(s.plugins))
+			for i, p := range s.plugins {
+				statuses = append(statuses, PluginStatus{
+					PluginType:    p.pluginType.String(),
+					ConfigDigest:  p.digest,
+					State:         plugins[i],
+					Exec:          p.exec,
+					Reported:      p.reported[source],
+					Transmissions: p.transmissions,
+				})
+			}
+			sort.Slice(statuses, func(i, j int) bool {
+				if statuses[i].PluginType != statuses[j].PluginType {
+					return statuses[i].PluginType < statuses[j].PluginType
+				}
+				if statuses[i].State != statuses[j].State {
+					return stateOrder[statuses[i].State] < stateOrder[statuses[j].State]
+				}
+				return statuses[i].ConfigDigest.Hex() < statuses[j].ConfigDigest.Hex()
+			})
+			lanes = append(lanes, LaneStatus{
+				SourceChainSelector: source,
+				DestChainSelector:   s.dest,
+				GasPrice:            gasPriceBySource[source],
+				TokenPrices:         tokenPrices,
+				Plugins:             statuses,
+			})
+		}
+	}
+
+	return lanes, nil
+}
+
+// sources returns the source chains of the lanes of the destination chain, discovering them again when the last
+// discovery is older than discoveryInterval. The last discovered sources are kept when the discovery fails.
+func (t *Tracker) sources(ctx context.Context, s destSnapshot) []cciptypes.ChainSelector {
+	if s.sources != nil && t.now().Sub(s.lastDiscovery) < discoveryInterval {
+		return s.sources
+	}
+	if s.homeChain == nil || s.ccipReader == nil {
+		return s.sources
+	}
+
+	sources, err := lanemonitor.DiscoverSources(ctx, t.lggr, s.homeChain, s.ccipReader, s.dest)
+	if err != nil {
+		t.lggr.Warnw("failed to discover lanes", "dest", s.dest, "err", err)
+		return s.sources
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if d, ok := t.dests[s.dest]; ok {
+		d.sources = sources
+		d.lastDiscovery = t.now()
+	}
+	return sources
+}
+
+// pluginStates returns the role in CCIPHome of the OCR config of each plugin of the snapshot.
+func (t *Tracker) pluginStates(ctx context.Context, s destSnapshot) ([]launcher.PluginState, error) {
+	type donPlugin struct {
+		donID      uint32
+		pluginType cctypes.PluginType
+	}
+	configs := make(map[donPlugin]ccipreaderpkg.ActiveAndCandidate)
+	states := make([]launcher.PluginState, 0, len(s.plugins))
+	for _, p := range s.plugins {
+		key := donPlugin{donID: p.donID, pluginType: p.pluginType}
+		c, ok := configs[key]
+		if !ok && s.homeChain != nil {
+			var err error
+			c, err = s.homeChain.GetOCRConfigs(ctx, p.donID, uint8(p.pluginType))
+			if err != nil {
+				return nil, fmt.Errorf("failed to get OCR configs of DON %d for plugin %s: %w", p.donID, p.pluginType, err)
+			}
+			configs[key] = c
+		}
+
+		switch p.digest {
+		case c.ActiveConfig.ConfigDigest:
+			states = append(states, launcher.PluginStateActive)
+		case c.CandidateConfig.ConfigDigest:
+			states = append(states, launcher.PluginStateCandidate)
+		default:
+			states = append(states, launcher.PluginStateUnknown)
+		}
+	}
+	return states, nil
+}
+
+// prices returns the last gas prices and token prices observed for the destination chain.
+func (t *Tracker) prices(
+	ctx context.Context,
+	dest cciptypes.ChainSelector,
+) ([]cciporm.GasPrice, []cciporm.TokenPrice, error) {
+	if t.orm == nil {
+		return nil, nil, nil
+	}
+	gasPrices, err := t.orm.GetGasPricesByDestChain(ctx, uint64(dest))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get gas prices of dest chain %d: %w", dest, err)
+	}
+	tokenPrices, err := t.orm.GetTokenPricesByDestChain(ctx, uint64(dest))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get token prices of dest chain %d: %w", dest, err)
+	}
+	return gasPrices, tokenPrices, nil
+}
@@ -0,0 +1,308 @@
+package lanestatus
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"testing"
+	"time"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/ocr3types"
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+
+	"github.com/smartcontractkit/chainlink-ccip/execute/exectypes"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/consts"
+	ocrtypecodec "github.com/smartcontractkit/chainlink-ccip/pkg/ocrtypecodec/v1"
+	ccipreaderpkg "github.com/smartcontractkit/chainlink-ccip/pkg/reader"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+	"github.com/smartcontractkit/chainlink-ccip/pluginconfig"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/assets"
+
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/launcher"
+	cctypes "github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/types"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	cciporm "github.com/smartcontractkit/chainlink/v2/core/services/ccip"
+	ormmocks "github.com/smartcontractkit/chainlink/v2/core/services/ccip/mocks"
+)
+
+const (
+	sourceA cciptypes.ChainSelector = 1
+	sourceB cciptypes.ChainSelector = 2
+	dest    cciptypes.ChainSelector = 3
+	sourceC cciptypes.ChainSelector = 4
+
+	donID uint32 = 1
+)
+
+func TestTracker_Lanes(t *testing.T) {
+	ctx := t.Context()
+	now := time.Date(2025, 5, 1, 10, 0, 0, 0, time.UTC)
+
+	execDigest := ocrtypes.ConfigDigest{1}
+	candidateDigest := ocrtypes.ConfigDigest{2}
+	commitDigest := ocrtypes.ConfigDigest{3}
+	homeChain := fakeHomeChain{configs: map[cctypes.PluginType]ccipreaderpkg.ActiveAndCandidate{
+		cctypes.PluginTypeCCIPExec: {
+			ActiveConfig:    ccipreaderpkg.OCR3ConfigWithMeta{ConfigDigest: execDigest},
+			CandidateConfig: ccipreaderpkg.OCR3ConfigWithMeta{ConfigDigest: candidateDigest},
+		},
+	}}
+	ccipReader := &fakeCCIPReader{onRamps: map[cciptypes.ChainSelector]cciptypes.UnknownAddress{
+		sourceA: cciptypes.UnknownAddress("onrampA"),
+		sourceB: cciptypes.UnknownAddress("onrampB"),
+		// the offRamp returns a zero onRamp for the chains without a source config.
+		sourceC: make(cciptypes.UnknownAddress, 20),
+	}}
+	orm := ormmocks.NewORM(t)
+	orm.On("GetGasPricesByDestChain", ctx, uint64(dest)).
+		Return([]cciporm.GasPrice{{SourceChainSelector: uint64(sourceA), GasPrice: assets.NewWeiI(100)}}, nil)
+	orm.On("GetTokenPricesByDestChain", ctx, uint64(dest)).
+		Return([]cciporm.TokenPrice{{TokenAddr: "0xabc", TokenPrice: assets.NewWeiI(5)}}, nil)
+
+	tracker := NewTracker(logger.TestLogger(t), orm)
+	tracker.now = func() time.Time { return now }
+
+	offchainConfig, err := pluginconfig.EncodeExecuteOffchainConfig(pluginconfig.ExecuteOffchainConfig{
+		InflightCacheExpiry: *commonconfig.MustNewDuration(time.Minute),
+	})
+	require.NoError(t, err)
+
+	outcome, err := ocrtypecodec.DefaultExecCodec.EncodeOutcome(exectypes.Outcome{
+		State: exectypes.Filter,
+		Report: cciptypes.ExecutePluginReport{
+			ChainReports: []cciptypes.ExecutePluginReportSingleChain{
+				{
+					SourceChainSelector: sourceB,
+					Messages: []cciptypes.Message{
+						{Header: cciptypes.RampMessageHeader{MessageID: cciptypes.Bytes32{2}, SequenceNumber: 8}},
+						{Header: cciptypes.RampMessageHeader{MessageID: cciptypes.Bytes32{1}, SequenceNumber: 7}},
+					},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	newPlugin := func(pluginType cctypes.PluginType, digest ocrtypes.ConfigDigest) ocr3types.ReportingPlugin[[]byte] {
+		factory := tracker.WrapFactory(fakeFactory{outcome: outcome}, dest, donID, pluginType, homeChain, ccipReader)
+		plugin, _, err2 := factory.NewReportingPlugin(ctx, ocr3types.ReportingPluginConfig{
+			ConfigDigest:   digest,
+			OffchainConfig: offchainConfig,
+		})
+		require.NoError(t, err2)
+		return plugin
+	}
+	execPlugin := newPlugin(cctypes.PluginTypeCCIPExec, execDigest)
+	candidatePlugin := newPlugin(cctypes.PluginTypeCCIPExec, candidateDigest)
+	commitPlugin := newPlugin(cctypes.PluginTypeCCIPCommit, commitDigest)
+	_, err = execPlugin.Outcome(ctx, ocr3types.OutcomeContext{SeqNr: 5}, nil, nil)
+	require.NoError(t, err)
+	_, err = commitPlugin.Outcome(ctx, ocr3types.OutcomeContext{SeqNr: 9}, nil, nil)
+	require.NoError(t, err)
+
+	transmitter := tracker.WrapTransmitter(fakeTransmitter{err: errors.New("reverted")}, dest)
+	require.Error(t, transmitter.Transmit(ctx, execDigest, 5, ocr3types.ReportWithInfo[[]byte]{}, nil))
+
+	lanes, err := tracker.Lanes(ctx)
+	require.NoError(t, err)
+	require.Len(t, lanes, 2)
+
+	tokenPrices := []cciporm.TokenPrice{{TokenAddr: "0xabc", TokenPrice: assets.NewWeiI(5)}}
+	plugins := func(reported []ReportedMessage) []PluginStatus {
+		return []PluginStatus{
+			{PluginType: "CCIPCommit", ConfigDigest: commitDigest, State: launcher.PluginStateUnknown},
+			{
+				PluginType:   "CCIPExec",
+				ConfigDigest: execDigest,
+				State:        launcher.PluginStateActive,
+				Exec:         ExecState{State: exectypes.Filter, SeqNr: 5, UpdatedAt: now},
+				Reported:     reported,
+				Transmissions: []Transmission{
+					{SeqNr: 5, TransmittedAt: now, Error: "reverted"},
+				},
+			},
+			{PluginType: "CCIPExec", ConfigDigest: candidateDigest, State: launcher.PluginStateCandidate},
+		}
+	}
+	assert.Equal(t, LaneStatus{
+		SourceChainSelector: sourceA,
+		DestChainSelector:   dest,
+		GasPrice:            assets.NewWeiI(100),
+		TokenPrices:         tokenPrices,
+		Plugins:             plugins(nil),
+	}, lanes[0])
+	assert.Equal(t, LaneStatus{
+		SourceChainSelector: sourceB,
+		DestChainSelector:   dest,
+		TokenPrices:         tokenPrices,
+		Plugins: plugins([]ReportedMessage{
+			{MessageID: cciptypes.Bytes32{1}, SeqNum: 7, ReportedAt: now, ExpiresAt: now.Add(time.Minute)},
+			{MessageID: cciptypes.Bytes32{2}, SeqNum: 8, ReportedAt: now, ExpiresAt: now.Add(time.Minute)},
+		}),
+	}, lanes[1])
+
+	// the reported messages expire, the lanes are not discovered again before the discovery interval.
+	tracker.now = func() time.Time { return now.Add(time.Minute) }
+	lanes, err = tracker.Lanes(ctx)
+	require.NoError(t, err)
+	require.Len(t, lanes, 2)
+	assert.Empty(t, lanes[1].Plugins[1].Reported)
+	assert.Equal(t, 1, ccipReader.discoveries)
+
+	// sourceA is no longer configured on the offRamp.
+	ccipReader.onRamps[sourceA] = make(cciptypes.UnknownAddress, 20)
+	tracker.now = func() time.Time { return now.Add(discoveryInterval) }
+	lanes, err = tracker.Lanes(ctx)
+	require.NoError(t, err)
+	require.Len(t, lanes, 1)
+	assert.Equal(t, sourceB, lanes[0].SourceChainSelector)
+	assert.Equal(t, 2, ccipReader.discoveries)
+
+	// the lanes are gone once the plugins of the destination chain are closed.
+	require.NoError(t, execPlugin.Close())
+	require.NoError(t, candidatePlugin.Close())
+	require.NoError(t, commitPlugin.Close())
+	lanes, err = tracker.Lanes(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, lanes)
+}
+
+func TestTracker_Nil(t *testing.T) {
+	var tracker *Tracker
+	factory := fakeFactory{}
+	transmitter := fakeTransmitter{}
+	assert.Equal(t, factory, tracker.WrapFactory(factory, dest, donID, cctypes.PluginTypeCCIPCommit, nil, nil))
+	assert.Equal(t, transmitter, tracker.WrapTransmitter(transmitter, dest))
+}
+
+type fakeHomeChain struct {
+	ccipreaderpkg.HomeChain
+	configs map[cctypes.PluginType]ccipreaderpkg.ActiveAndCandidate
+}
+
+func (f fakeHomeChain) GetFChain() (map[cciptypes.ChainSelector]int, error) {
+	return map[cciptypes.ChainSelector]int{dest: 1}, nil
+}
+
+func (f fakeHomeChain) GetAllChainConfigs() (map[cciptypes.ChainSelector]ccipreaderpkg.ChainConfig, error) {
+	return nil, nil
+}
+
+func (f fakeHomeChain) GetOCRConfigs(
+	_ context.Context,
+	_ uint32,
+	pluginType uint8,
+) (ccipreaderpkg.ActiveAndCandidate, error) {
+	return f.configs[cctypes.PluginType(pluginType)], nil
+}
+
+type fakeCCIPReader struct {
+	ccipreaderpkg.CCIPReader
+	onRamps     map[cciptypes.ChainSelector]cciptypes.UnknownAddress
+	discoveries int
+}
+
+func (f *fakeCCIPReader) DiscoverContracts(
+	context.Context,
+	[]cciptypes.ChainSelector,
+) (ccipreaderpkg.ContractAddresses, error) {
+	f.discoveries++
+	return ccipreaderpkg.ContractAddresses{consts.ContractNameOnRamp: maps.Clone(f.onRamps)}, nil
+}
+
+func (f *fakeCCIPReader) Sync(context.Context, ccipreaderpkg.ContractAddresses) error {
+	return nil
+}
+
+type fakeFactory struct {
+	outcome ocr3types.Outcome
+}
+
+func (f fakeFactory) NewReportingPlugin(
+	context.Context,
+	ocr3types.ReportingPluginConfig,
+) (ocr3types.ReportingPlugin[[]byte], ocr3types.ReportingPluginInfo, error) {
+	return fakePlugin(f), ocr3types.ReportingPluginInfo{}, nil
+}
+
+type fakePlugin struct {
+	outcome ocr3types.Outcome
+}
+
+func (p fakePlugin) Query(context.Context, ocr3types.OutcomeContext) (ocrtypes.Query, error) {
+	return nil, nil
+}
+
+func (p fakePlugin) Observation(context.Context, ocr3types.OutcomeContext, ocrtypes.Query) (ocrtypes.Observation, error) {
+	return nil, nil
+}
+
+func (p fakePlugin) ValidateObservation(
+	context.Context,
+	ocr3types.OutcomeContext,
+	ocrtypes.Query,
+	ocrtypes.AttributedObservation,
+) error {
+	return nil
+}
+
+func (p fakePlugin) ObservationQuorum(
+	context.Context,
+	ocr3types.OutcomeContext,
+	ocrtypes.Query,
+	[]ocrtypes.AttributedObservation,
+) (bool, error) {
+	return true, nil
+}
+
+func (p fakePlugin) Outcome(
+	context.Context,
+	ocr3types.OutcomeContext,
+	ocrtypes.Query,
+	[]ocrtypes.AttributedObservation,
+) (ocr3types.Outcome, error) {
+	return p.outcome, nil
+}
+
+func (p fakePlugin) Reports(context.Context, uint64, ocr3types.Outcome) ([]ocr3types.ReportPlus[[]byte], error) {
+	return nil, nil
+}
+
+func (p fakePlugin) ShouldAcceptAttestedReport(context.Context, uint64, ocr3types.ReportWithInfo[[]byte]) (bool, error) {
+	return true, nil
+}
+
+func (p fakePlugin) ShouldTransmitAcceptedReport(
+	context.Context,
+	uint64,
+	ocr3types.ReportWithInfo[[]byte],
+) (bool, error) {
+	return true, nil
+}
+
+func (p fakePlugin) Close() error {
+	return nil
+}
+
+type fakeTransmitter struct {
+	err error
+}
+
+func (f fakeTransmitter) Transmit(
+	context.Context,
+	ocrtypes.ConfigDigest,
+	uint64,
+	ocr3types.ReportWithInfo[[]byte],
+	[]ocrtypes.AttributedOnchainSignature,
+) error {
+	return f.err
+}
+
+func (f fakeTransmitter) FromAccount(context.Context) (ocrtypes.Account, error) {
+	return "", nil
+}
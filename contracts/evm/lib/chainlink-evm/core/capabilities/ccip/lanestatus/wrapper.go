@@ -0,0 +1,156 @@
+package lanestatus
+
+import (
+	"context"
+	"time"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/ocr3types"
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	ocrtypecodec "github.com/smartcontractkit/chainlink-ccip/pkg/ocrtypecodec/v1"
+	ccipreaderpkg "github.com/smartcontractkit/chainlink-ccip/pkg/reader"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+	"github.com/smartcontractkit/chainlink-ccip/pluginconfig"
+
+	cctypes "github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/types"
+)
+
+// WrapFactory wraps the factory of a CCIP plugin of the DON reporting to the destination chain, so that the lanes of
+// the destination chain are tracked while the plugin runs. The lanes are discovered with the CCIP reader.
+func (t *Tracker) WrapFactory(
+	origin ocr3types.ReportingPluginFactory[[]byte],
+	dest cciptypes.ChainSelector,
+	donID uint32,
+	pluginType cctypes.PluginType,
+	homeChain ccipreaderpkg.HomeChain,
+	ccipReader ccipreaderpkg.CCIPReader,
+) ocr3types.ReportingPluginFactory[[]byte] {
+	if t == nil {
+		return origin
+	}
+	return &reportingPluginFactory{
+		ReportingPluginFactory: origin,
+		tracker:                t,
+		dest:                   dest,
+		donID:                  donID,
+		pluginType:             pluginType,
+		homeChain:              homeChain,
+		ccipReader:             ccipReader,
+	}
+}
+
+// WrapTransmitter wraps the transmitter of a CCIP plugin reporting to the destination chain, so that its
+// transmissions are tracked.
+func (t *Tracker) WrapTransmitter(
+	origin ocr3types.ContractTransmitter[[]byte],
+	dest cciptypes.ChainSelector,
+) ocr3types.ContractTransmitter[[]byte] {
+	if t == nil {
+		return origin
+	}
+	return &contractTransmitter{
+		ContractTransmitter: origin,
+		tracker:             t,
+		dest:                dest,
+	}
+}
+
+type reportingPluginFactory struct {
+	ocr3types.ReportingPluginFactory[[]byte]
+	tracker    *Tracker
+	dest       cciptypes.ChainSelector
+	donID      uint32
+	pluginType cctypes.PluginType
+	homeChain  ccipreaderpkg.HomeChain
+	ccipReader ccipreaderpkg.CCIPReader
+}
+
+func (f *reportingPluginFactory) NewReportingPlugin(
+	ctx context.Context,
+	config ocr3types.ReportingPluginConfig,
+) (ocr3types.ReportingPlugin[[]byte], ocr3types.ReportingPluginInfo, error) {
+	plugin, info, err := f.ReportingPluginFactory.NewReportingPlugin(ctx, config)
+	if err != nil {
+		return nil, ocr3types.ReportingPluginInfo{}, err
+	}
+
+	var inflightExpiry time.Duration
+	if f.pluginType == cctypes.PluginTypeCCIPExec {
+		offchainConfig, err2 := pluginconfig.DecodeExecuteOffchainConfig(config.OffchainConfig)
+		if err2 != nil {
+			// the plugin would have failed to start already, but don't make it fail because of the tracker.
+			f.tracker.lggr.Warnw("failed to decode execute offchain config, reported messages are not tracked",
+				"configDigest", config.ConfigDigest, "err", err2)
+		}
+		inflightExpiry = offchainConfig.InflightCacheExpiry.Duration()
+	}
+
+	f.tracker.register(f.dest, config.ConfigDigest, f.donID, f.pluginType, f.homeChain, f.ccipReader)
+	return &reportingPlugin{
+		ReportingPlugin: plugin,
+		tracker:         f.tracker,
+		dest:            f.dest,
+		pluginType:      f.pluginType,
+		digest:          config.ConfigDigest,
+		inflightExpiry:  inflightExpiry,
+	}, info, nil
+}
+
+type reportingPlugin struct {
+	ocr3types.ReportingPlugin[[]byte]
+	tracker        *Tracker
+	dest           cciptypes.ChainSelector
+	pluginType     cctypes.PluginType
+	digest         ocrtypes.ConfigDigest
+	inflightExpiry time.Duration
+}
+
+func (p *reportingPlugin) Outcome(
+	ctx context.Context,
+	outctx ocr3types.OutcomeContext,
+	query ocrtypes.Query,
+	aos []ocrtypes.AttributedObservation,
+) (ocr3types.Outcome, error) {
+	outcome, err := p.ReportingPlugin.Outcome(ctx, outctx, query, aos)
+	if err != nil || p.pluginType != cctypes.PluginTypeCCIPExec {
+		return outcome, err
+	}
+
+	decoded, decodeErr := ocrtypecodec.DefaultExecCodec.DecodeOutcome(outcome)
+	if decodeErr != nil {
+		p.tracker.lggr.Debugw("failed to decode exec outcome", "seqNr", outctx.SeqNr, "err", decodeErr)
+		return outcome, nil
+	}
+	p.tracker.recordExecOutcome(p.dest, p.digest, outctx.SeqNr, decoded, p.inflightExpiry)
+	return outcome, nil
+}
+
+func (p *reportingPlugin) Close() error {
+	p.tracker.unregister(p.dest, p.digest)
+	return p.ReportingPlugin.Close()
+}
+
+type contractTransmitter struct {
+	ocr3types.ContractTransmitter[[]byte]
+	tracker *Tracker
+	dest    cciptypes.ChainSelector
+}
+
+func (c *contractTransmitter) Transmit(
+	ctx context.Context,
+	digest ocrtypes.ConfigDigest,
+	seqNr uint64,
+	rwi ocr3types.ReportWithInfo[[]byte],
+	sigs []ocrtypes.AttributedOnchainSignature,
+) error {
+	err := c.ContractTransmitter.Transmit(ctx, digest, seqNr, rwi, sigs)
+	transmission := Transmission{
+		SeqNr:         seqNr,
+		TransmittedAt: c.tracker.now(),
+	}
+	if err != nil {
+		transmission.Error = err.Error()
+	}
+	c.tracker.recordTransmission(c.dest, digest, transmission)
+	return err
+}
@@ -53,6 +53,7 @@ func New(
 		tickInterval:  tickInterval,
 		oracleCreator: oracleCreator,
 		instances:     make(map[registrysyncer.DonID]pluginRegistry),
+		pluginConfigs: make(map[registrysyncer.DonID][]pluginConfig),
 	}
 }
 
@@ -82,6 +83,10 @@ type launcher struct {
 	// This map uses the config digest as the key, and the instance as the value.
 	// We can have up to a maximum of 4 instances per CCIP DON (active/candidate) x (commit/exec)
 	instances map[registrysyncer.DonID]pluginRegistry
+
+	// pluginConfigs is a map of CCIP DON IDs to the OCR configs the running instances were launched with,
+	// along with whether each config was the active or the candidate one at the time.
+	pluginConfigs map[registrysyncer.DonID][]pluginConfig
 }
 
 // Launch implements registrysyncer.Launcher.
@@ -207,7 +212,7 @@ func (l *launcher) processUpdate(ctx context.Context, updated map[registrysyncer
 			return fmt.Errorf("invariant violation: expected to find CCIP DON %d in the map of running deployments", don.ID)
 		}
 
-		latestConfigs, err := getConfigsForDon(ctx, l.homeChainReader, don)
+		latestConfigs, err := getPluginConfigsForDon(ctx, l.homeChainReader, don)
 		if err != nil {
 			return err
 		}
@@ -219,7 +224,7 @@ func (l *launcher) processUpdate(ctx context.Context, updated map[registrysyncer
 			prevPlugins,
 			don,
 			l.oracleCreator,
-			latestConfigs)
+			configsOf(latestConfigs))
 		if err != nil {
 			return err
 		}
@@ -234,6 +239,7 @@ func (l *launcher) processUpdate(ctx context.Context, updated map[registrysyncer
 		}
 
 		l.instances[donID] = newPlugins
+		l.setPluginConfigs(donID, latestConfigs)
 		l.regState.IDsToDONs[donID] = updated[donID]
 	}
 
@@ -247,7 +253,7 @@ func (l *launcher) processAdded(ctx context.Context, added map[registrysyncer.Do
 	defer l.lock.Unlock()
 
 	for donID, don := range added {
-		configs, err := getConfigsForDon(ctx, l.homeChainReader, don)
+		configs, err := getPluginConfigsForDon(ctx, l.homeChainReader, don)
 		if err != nil {
 			return fmt.Errorf("failed to get current configs for don %d: %w", donID, err)
 		}
@@ -257,7 +263,7 @@ func (l *launcher) processAdded(ctx context.Context, added map[registrysyncer.Do
 			l.myP2PID,
 			don,
 			l.oracleCreator,
-			configs,
+			configsOf(configs),
 		)
 		if err != nil {
 			return fmt.Errorf("processAdded: call createDON %d: %w", donID, err)
@@ -278,6 +284,7 @@ func (l *launcher) processAdded(ctx context.Context, added map[registrysyncer.Do
 
 		// update state.
 		l.instances[donID] = newPlugins
+		l.setPluginConfigs(donID, configs)
 		l.regState.IDsToDONs[donID] = added[donID]
 	}
 
@@ -302,6 +309,7 @@ func (l *launcher) processRemoved(removed map[registrysyncer.DonID]registrysynce
 
 		// after a successful shutdown we can safely remove the DON deployment from the map.
 		delete(l.instances, id)
+		delete(l.pluginConfigs, id)
 		delete(l.regState.IDsToDONs, id)
 	}
 
@@ -378,6 +386,18 @@ func getConfigsForDon(
 	ctx context.Context,
 	homeChainReader ccipreader.HomeChain,
 	don registrysyncer.DON) ([]ccipreader.OCR3ConfigWithMeta, error) {
+	pluginConfigs, err := getPluginConfigsForDon(ctx, homeChainReader, don)
+	if err != nil {
+		return nil, err
+	}
+	return configsOf(pluginConfigs), nil
+}
+
+// getPluginConfigsForDon returns the non-empty OCR configs of the DON along with their role in CCIPHome.
+func getPluginConfigsForDon(
+	ctx context.Context,
+	homeChainReader ccipreader.HomeChain,
+	don registrysyncer.DON) ([]pluginConfig, error) {
 	// this should be a retryable error.
 	commitOCRConfigs, err := homeChainReader.GetOCRConfigs(ctx, don.ID, uint8(cctypes.PluginTypeCCIPCommit))
 	if err != nil {
@@ -391,16 +411,16 @@ func getConfigsForDon(
 			don.ID, err)
 	}
 
-	c := []ccipreader.OCR3ConfigWithMeta{
-		commitOCRConfigs.CandidateConfig,
-		commitOCRConfigs.ActiveConfig,
-		execOCRConfigs.CandidateConfig,
-		execOCRConfigs.ActiveConfig,
+	c := []pluginConfig{
+		{state: PluginStateCandidate, config: commitOCRConfigs.CandidateConfig},
+		{state: PluginStateActive, config: commitOCRConfigs.ActiveConfig},
+		{state: PluginStateCandidate, config: execOCRConfigs.CandidateConfig},
+		{state: PluginStateActive, config: execOCRConfigs.ActiveConfig},
 	}
 
-	ret := make([]ccipreader.OCR3ConfigWithMeta, 0, 4)
+	ret := make([]pluginConfig, 0, 4)
 	for _, config := range c {
-		if config.ConfigDigest != [32]byte{} {
+		if config.config.ConfigDigest != [32]byte{} {
 			ret = append(ret, config)
 		}
 	}
@@ -0,0 +1,278 @@
+package launcher
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	ccipreader "github.com/smartcontractkit/chainlink-ccip/pkg/reader"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+
+	cctypes "github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/types"
+	"github.com/smartcontractkit/chainlink/v2/core/services/registrysyncer"
+)
+
+// Inspector exposes what a launcher is running, and what it would do with a capability registry state,
+// without starting or stopping any OCR instances.
+type Inspector interface {
+	// Status returns the CCIP DONs and OCR instances the launcher is currently running.
+	Status() Status
+	// WhatIf returns the actions the launcher would take if the capability registry
+	// changed to the proposed state.
+	WhatIf(ctx context.Context, proposed registrysyncer.LocalRegistry) (Plan, error)
+}
+
+var _ Inspector = (*launcher)(nil)
+
+// PluginState is the role of an OCR config in CCIPHome.
+type PluginState string
+
+const (
+	PluginStateActive    PluginState = "active"
+	PluginStateCandidate PluginState = "candidate"
+	// PluginStateUnknown is used for instances whose config was not recorded by the launcher.
+	PluginStateUnknown PluginState = "unknown"
+)
+
+// pluginConfig is an OCR config of a CCIP DON along with its role in CCIPHome.
+type pluginConfig struct {
+	state  PluginState
+	config ccipreader.OCR3ConfigWithMeta
+}
+
+func (c pluginConfig) pluginStatus() PluginStatus {
+	return PluginStatus{
+		ConfigDigest:   c.config.ConfigDigest,
+		PluginType:     cctypes.PluginType(c.config.Config.PluginType).String(),
+		State:          c.state,
+		ConfigVersion:  c.config.Version,
+		ChainSelector:  c.config.Config.ChainSelector,
+		OffchainConfig: c.config.Config.OffchainConfig,
+	}
+}
+
+func configsOf(pluginConfigs []pluginConfig) []ccipreader.OCR3ConfigWithMeta {
+	configs := make([]ccipreader.OCR3ConfigWithMeta, 0, len(pluginConfigs))
+	for _, c := range pluginConfigs {
+		configs = append(configs, c.config)
+	}
+	return configs
+}
+
+// setPluginConfigs records the configs the instances of the DON were launched with.
+// The caller must hold the lock.
+func (l *launcher) setPluginConfigs(donID registrysyncer.DonID, configs []pluginConfig) {
+	if l.pluginConfigs == nil {
+		l.pluginConfigs = make(map[registrysyncer.DonID][]pluginConfig)
+	}
+	l.pluginConfigs[donID] = configs
+}
+
+// PluginStatus describes an OCR instance of a CCIP DON.
+type PluginStatus struct {
+	ConfigDigest ocrtypes.ConfigDigest
+	// PluginType is either CCIPCommit or CCIPExec.
+	PluginType string
+	State      PluginState
+	// ConfigVersion is the version of the OCR config in CCIPHome.
+	ConfigVersion uint32
+	// ChainSelector is the destination chain the plugin is reporting to.
+	ChainSelector cciptypes.ChainSelector
+	// OffchainConfig is the encoded commit or exec offchain config of the plugin.
+	OffchainConfig []byte
+}
+
+// DONStatus describes a CCIP DON the launcher is running OCR instances for.
+type DONStatus struct {
+	ID registrysyncer.DonID
+	// ConfigVersion is the version of the DON in the capability registry.
+	ConfigVersion uint32
+	// IsMember is false for bootstrap nodes, which run instances for DONs they are not a member of.
+	IsMember bool
+	Plugins  []PluginStatus
+}
+
+// Status is a snapshot of the CCIP DONs and OCR instances run by a launcher.
+type Status struct {
+	CapabilityID string
+	PeerID       string
+	IsBootstrap  bool
+	DONs         []DONStatus
+}
+
+// Status implements Inspector.
+func (l *launcher) Status() Status {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+
+	status := Status{
+		CapabilityID: l.capabilityID,
+		PeerID:       l.myP2PID.String(),
+		IsBootstrap:  l.oracleCreator != nil && l.oracleCreator.Type() == cctypes.OracleTypeBootstrap,
+		DONs:         make([]DONStatus, 0, len(l.instances)),
+	}
+	for donID, plugins := range l.instances {
+		don := l.regState.IDsToDONs[donID]
+		donStatus := DONStatus{
+			ID:            donID,
+			ConfigVersion: don.ConfigVersion,
+			IsMember:      isMemberOfDON(don, l.myP2PID),
+			Plugins:       make([]PluginStatus, 0, len(plugins)),
+		}
+
+		recorded := make(map[ocrtypes.ConfigDigest]pluginConfig, len(l.pluginConfigs[donID]))
+		for _, c := range l.pluginConfigs[donID] {
+			recorded[c.config.ConfigDigest] = c
+		}
+		for digest := range plugins {
+			c, ok := recorded[digest]
+			if !ok {
+				donStatus.Plugins = append(donStatus.Plugins, PluginStatus{
+					ConfigDigest: digest,
+					PluginType:   "Unknown",
+					State:        PluginStateUnknown,
+				})
+				continue
+			}
+			donStatus.Plugins = append(donStatus.Plugins, c.pluginStatus())
+		}
+		sortPlugins(donStatus.Plugins)
+		status.DONs = append(status.DONs, donStatus)
+	}
+	sort.Slice(status.DONs, func(i, j int) bool { return status.DONs[i].ID < status.DONs[j].ID })
+
+	return status
+}
+
+// ActionType is what the launcher does with an OCR instance when processing a capability registry change.
+type ActionType string
+
+const (
+	// ActionLaunch creates and starts an OCR instance for a config digest.
+	ActionLaunch ActionType = "launch"
+	// ActionShutdown closes the OCR instance of a config digest.
+	ActionShutdown ActionType = "shutdown"
+	// ActionPromote keeps running the OCR instance of a candidate config that became the active one.
+	ActionPromote ActionType = "promote"
+)
+
+// Action is a change to an OCR instance of a CCIP DON.
+type Action struct {
+	DonID  registrysyncer.DonID
+	Type   ActionType
+	Plugin PluginStatus
+}
+
+// Plan is the outcome of diffing the capability registry state processed by a launcher with a proposed state.
+type Plan struct {
+	Added   []registrysyncer.DonID
+	Removed []registrysyncer.DonID
+	Updated []registrysyncer.DonID
+	Actions []Action
+}
+
+// WhatIf implements Inspector. The OCR configs of added and updated DONs are read from the home chain,
+// as the launcher would do on its next tick.
+func (l *launcher) WhatIf(ctx context.Context, proposed registrysyncer.LocalRegistry) (Plan, error) {
+	if ready := l.homeChainReader.Ready(); ready != nil {
+		return Plan{}, fmt.Errorf("home chain reader is not ready: %w", ready)
+	}
+
+	l.lock.RLock()
+	diffRes, err := diff(l.capabilityID, l.regState, proposed)
+	running := make(map[registrysyncer.DonID][]pluginConfig, len(l.pluginConfigs))
+	for donID := range l.instances {
+		running[donID] = l.pluginConfigs[donID]
+	}
+	l.lock.RUnlock()
+	if err != nil {
+		return Plan{}, fmt.Errorf("failed to diff capability registry states: %w", err)
+	}
+
+	plan := Plan{
+		Added:   sortedDONIDs(diffRes.added),
+		Removed: sortedDONIDs(diffRes.removed),
+		Updated: sortedDONIDs(diffRes.updated),
+	}
+	for _, donID := range plan.Removed {
+		plan.Actions = append(plan.Actions, planDON(donID, running[donID], nil)...)
+	}
+	for _, donID := range plan.Added {
+		don := diffRes.added[donID]
+		if !isMemberOfDON(don, l.myP2PID) && l.oracleCreator.Type() == cctypes.OracleTypePlugin {
+			continue
+		}
+		latestConfigs, err := getPluginConfigsForDon(ctx, l.homeChainReader, don)
+		if err != nil {
+			return Plan{}, fmt.Errorf("failed to get current configs for don %d: %w", donID, err)
+		}
+		plan.Actions = append(plan.Actions, planDON(donID, nil, latestConfigs)...)
+	}
+	for _, donID := range plan.Updated {
+		don := diffRes.updated[donID]
+		if !isMemberOfDON(don, l.myP2PID) {
+			// updateDON leaves the running instances untouched.
+			continue
+		}
+		latestConfigs, err := getPluginConfigsForDon(ctx, l.homeChainReader, don)
+		if err != nil {
+			return Plan{}, fmt.Errorf("failed to get current configs for don %d: %w", donID, err)
+		}
+		plan.Actions = append(plan.Actions, planDON(donID, running[donID], latestConfigs)...)
+	}
+
+	return plan, nil
+}
+
+// planDON returns the actions needed to transition the instances of a DON from the previous to the latest configs,
+// mirroring pluginRegistry.TransitionFrom.
+func planDON(donID registrysyncer.DonID, prevConfigs, latestConfigs []pluginConfig) []Action {
+	prev := make(map[ocrtypes.ConfigDigest]pluginConfig, len(prevConfigs))
+	for _, c := range prevConfigs {
+		prev[c.config.ConfigDigest] = c
+	}
+	latest := make(map[ocrtypes.ConfigDigest]pluginConfig, len(latestConfigs))
+	for _, c := range latestConfigs {
+		latest[c.config.ConfigDigest] = c
+	}
+
+	var actions []Action
+	for _, c := range prevConfigs {
+		if _, ok := latest[c.config.ConfigDigest]; !ok {
+			actions = append(actions, Action{DonID: donID, Type: ActionShutdown, Plugin: c.pluginStatus()})
+		}
+	}
+	for _, c := range latestConfigs {
+		p, ok := prev[c.config.ConfigDigest]
+		switch {
+		case !ok:
+			actions = append(actions, Action{DonID: donID, Type: ActionLaunch, Plugin: c.pluginStatus()})
+		case p.state == PluginStateCandidate && c.state == PluginStateActive:
+			actions = append(actions, Action{DonID: donID, Type: ActionPromote, Plugin: c.pluginStatus()})
+		}
+	}
+	return actions
+}
+
+func sortedDONIDs(dons map[registrysyncer.DonID]registrysyncer.DON) []registrysyncer.DonID {
+	ids := make([]registrysyncer.DonID, 0, len(dons))
+	for id := range dons {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+func sortPlugins(plugins []PluginStatus) {
+	sort.Slice(plugins, func(i, j int) bool {
+		if plugins[i].PluginType != plugins[j].PluginType {
+			return plugins[i].PluginType < plugins[j].PluginType
+		}
+		if plugins[i].State != plugins[j].State {
+			return plugins[i].State < plugins[j].State
+		}
+		return plugins[i].ConfigDigest.Hex() < plugins[j].ConfigDigest.Hex()
+	})
+}
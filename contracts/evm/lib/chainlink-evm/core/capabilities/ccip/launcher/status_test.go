@@ -0,0 +1,169 @@
+package launcher
+
+import (
+	"testing"
+
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+	ragep2ptypes "github.com/smartcontractkit/libocr/ragep2p/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	ccipreaderpkg "github.com/smartcontractkit/chainlink-ccip/pkg/reader"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/utils"
+	cctypes "github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/types"
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/types/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/registrysyncer"
+)
+
+func Test_launcher_Status(t *testing.T) {
+	commitActive := newPluginConfig(cctypes.PluginTypeCCIPCommit, PluginStateActive)
+	execCandidate := newPluginConfig(cctypes.PluginTypeCCIPExec, PluginStateCandidate)
+	unrecorded := ocrtypes.ConfigDigest(utils.RandomBytes32())
+
+	oracleCreator := mocks.NewOracleCreator(t)
+	oracleCreator.EXPECT().Type().Return(cctypes.OracleTypePlugin)
+	l := &launcher{
+		capabilityID:  defaultCapability.ID,
+		myP2PID:       p2pID1,
+		oracleCreator: oracleCreator,
+		regState: registrysyncer.LocalRegistry{
+			IDsToDONs: map[registrysyncer.DonID]registrysyncer.DON{
+				1: {DON: getDON(1, []ragep2ptypes.PeerID{p2pID1}, 3), CapabilityConfigurations: defaultCapCfgs},
+				2: {DON: getDON(2, []ragep2ptypes.PeerID{p2pID2}, 1), CapabilityConfigurations: defaultCapCfgs},
+			},
+		},
+		instances: map[registrysyncer.DonID]pluginRegistry{
+			2: {unrecorded: mocks.NewCCIPOracle(t)},
+			1: {
+				execCandidate.config.ConfigDigest: mocks.NewCCIPOracle(t),
+				commitActive.config.ConfigDigest:  mocks.NewCCIPOracle(t),
+			},
+		},
+		pluginConfigs: map[registrysyncer.DonID][]pluginConfig{
+			1: {commitActive, execCandidate},
+		},
+	}
+
+	status := l.Status()
+	assert.Equal(t, defaultCapability.ID, status.CapabilityID)
+	assert.Equal(t, p2pID1.String(), status.PeerID)
+	assert.False(t, status.IsBootstrap)
+	require.Len(t, status.DONs, 2)
+
+	assert.Equal(t, DONStatus{
+		ID:            1,
+		ConfigVersion: 3,
+		IsMember:      true,
+		Plugins:       []PluginStatus{commitActive.pluginStatus(), execCandidate.pluginStatus()},
+	}, status.DONs[0])
+	assert.Equal(t, DONStatus{
+		ID:            2,
+		ConfigVersion: 1,
+		IsMember:      false,
+		Plugins:       []PluginStatus{{ConfigDigest: unrecorded, PluginType: "Unknown", State: PluginStateUnknown}},
+	}, status.DONs[1])
+}
+
+func Test_launcher_WhatIf(t *testing.T) {
+	var (
+		// DON 1 runs an active and a candidate commit config and an active exec config.
+		// The commit candidate gets promoted and a new exec candidate is proposed.
+		commitActive    = newPluginConfig(cctypes.PluginTypeCCIPCommit, PluginStateActive)
+		commitCandidate = newPluginConfig(cctypes.PluginTypeCCIPCommit, PluginStateCandidate)
+		commitPromoted  = pluginConfig{state: PluginStateActive, config: commitCandidate.config}
+		execActive      = newPluginConfig(cctypes.PluginTypeCCIPExec, PluginStateActive)
+		execCandidate   = newPluginConfig(cctypes.PluginTypeCCIPExec, PluginStateCandidate)
+		// DON 2 is added and DON 3 is removed.
+		addedCommit   = newPluginConfig(cctypes.PluginTypeCCIPCommit, PluginStateActive)
+		removedCommit = newPluginConfig(cctypes.PluginTypeCCIPCommit, PluginStateActive)
+	)
+
+	homeChainReader := mocks.NewHomeChainReader(t)
+	homeChainReader.On("Ready").Return(nil)
+	homeChainReader.On("GetOCRConfigs", mock.Anything, uint32(1), uint8(cctypes.PluginTypeCCIPCommit)).
+		Return(ccipreaderpkg.ActiveAndCandidate{ActiveConfig: commitPromoted.config}, nil)
+	homeChainReader.On("GetOCRConfigs", mock.Anything, uint32(1), uint8(cctypes.PluginTypeCCIPExec)).
+		Return(ccipreaderpkg.ActiveAndCandidate{ActiveConfig: execActive.config, CandidateConfig: execCandidate.config}, nil)
+	homeChainReader.On("GetOCRConfigs", mock.Anything, uint32(2), uint8(cctypes.PluginTypeCCIPCommit)).
+		Return(ccipreaderpkg.ActiveAndCandidate{ActiveConfig: addedCommit.config}, nil)
+	homeChainReader.On("GetOCRConfigs", mock.Anything, uint32(2), uint8(cctypes.PluginTypeCCIPExec)).
+		Return(ccipreaderpkg.ActiveAndCandidate{}, nil)
+	oracleCreator := mocks.NewOracleCreator(t)
+	oracleCreator.EXPECT().Type().Return(cctypes.OracleTypePlugin)
+
+	// the oracle mocks fail the test if the what-if run starts or closes any of them.
+	l := &launcher{
+		capabilityID:    defaultCapability.ID,
+		myP2PID:         p2pID1,
+		lggr:            logger.TestLogger(t),
+		homeChainReader: homeChainReader,
+		oracleCreator:   oracleCreator,
+		regState: registrysyncer.LocalRegistry{
+			IDsToCapabilities: map[string]registrysyncer.Capability{defaultCapability.ID: defaultCapability},
+			IDsToDONs: map[registrysyncer.DonID]registrysyncer.DON{
+				1: defaultRegistryDon,
+				3: {DON: getDON(3, []ragep2ptypes.PeerID{p2pID1}, 0), CapabilityConfigurations: defaultCapCfgs},
+			},
+		},
+		instances: map[registrysyncer.DonID]pluginRegistry{
+			1: {
+				commitActive.config.ConfigDigest:    mocks.NewCCIPOracle(t),
+				commitCandidate.config.ConfigDigest: mocks.NewCCIPOracle(t),
+				execActive.config.ConfigDigest:      mocks.NewCCIPOracle(t),
+			},
+			3: {removedCommit.config.ConfigDigest: mocks.NewCCIPOracle(t)},
+		},
+		pluginConfigs: map[registrysyncer.DonID][]pluginConfig{
+			1: {commitCandidate, commitActive, execActive},
+			3: {removedCommit},
+		},
+	}
+
+	proposed := registrysyncer.LocalRegistry{
+		IDsToCapabilities: map[string]registrysyncer.Capability{defaultCapability.ID: defaultCapability},
+		IDsToDONs: map[registrysyncer.DonID]registrysyncer.DON{
+			1: {DON: getDON(1, []ragep2ptypes.PeerID{p2pID1}, 1), CapabilityConfigurations: defaultCapCfgs},
+			2: {DON: getDON(2, []ragep2ptypes.PeerID{p2pID1, p2pID2}, 0), CapabilityConfigurations: defaultCapCfgs},
+			// not a member of DON 4, so nothing is launched for it.
+			4: {DON: getDON(4, []ragep2ptypes.PeerID{p2pID2}, 0), CapabilityConfigurations: defaultCapCfgs},
+		},
+	}
+
+	plan, err := l.WhatIf(testutils.Context(t), proposed)
+	require.NoError(t, err)
+	assert.Equal(t, []registrysyncer.DonID{2, 4}, plan.Added)
+	assert.Equal(t, []registrysyncer.DonID{3}, plan.Removed)
+	assert.Equal(t, []registrysyncer.DonID{1}, plan.Updated)
+	assert.Equal(t, []Action{
+		{DonID: 3, Type: ActionShutdown, Plugin: removedCommit.pluginStatus()},
+		{DonID: 2, Type: ActionLaunch, Plugin: addedCommit.pluginStatus()},
+		{DonID: 1, Type: ActionShutdown, Plugin: commitActive.pluginStatus()},
+		{DonID: 1, Type: ActionPromote, Plugin: commitPromoted.pluginStatus()},
+		{DonID: 1, Type: ActionLaunch, Plugin: execCandidate.pluginStatus()},
+	}, plan.Actions)
+
+	// the state of the launcher is untouched.
+	assert.Len(t, l.instances, 2)
+	assert.Len(t, l.instances[1], 3)
+	assert.Equal(t, uint32(0), l.regState.IDsToDONs[1].ConfigVersion)
+
+	_, err = l.WhatIf(testutils.Context(t), registrysyncer.LocalRegistry{})
+	require.ErrorContains(t, err, "failed to find capability")
+}
+
+func newPluginConfig(pluginType cctypes.PluginType, state PluginState) pluginConfig {
+	return pluginConfig{
+		state: state,
+		config: ccipreaderpkg.OCR3ConfigWithMeta{
+			ConfigDigest: utils.RandomBytes32(),
+			Config: ccipreaderpkg.OCR3Config{
+				PluginType: uint8(pluginType),
+				Nodes:      getOCR3Nodes(1),
+			},
+		},
+	}
+}
@@ -0,0 +1,158 @@
+package msgstatus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	chainsel "github.com/smartcontractkit/chain-selectors"
+
+	"github.com/smartcontractkit/chainlink-ccip/execute/exectypes"
+	"github.com/smartcontractkit/chainlink-ccip/execute/report"
+	ccipreaderpkg "github.com/smartcontractkit/chainlink-ccip/pkg/reader"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/ccipevm"
+	ccipcommon "github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/common"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+var (
+	// ErrNotCommitted is returned when a manual execution is proposed for a message whose merkle root
+	// is not committed on the destination chain yet.
+	ErrNotCommitted = errors.New("message is not committed yet")
+	// ErrAlreadyExecuted is returned when a manual execution is proposed for a message that was already
+	// successfully executed.
+	ErrAlreadyExecuted = errors.New("message is already executed")
+)
+
+// ManualExecProposal is an execution report for a single message, to be submitted to the manuallyExecute
+// function of the offRamp by an operator.
+type ManualExecProposal struct {
+	Lifecycle ccipreaderpkg.MessageLifecycle
+	Report    cciptypes.ExecutePluginReportSingleChain
+	// EncodedReport is the report encoded with the execute plugin codec of the destination chain family.
+	EncodedReport []byte
+}
+
+// ManualExecProposal builds an execution report for a committed message that is not successfully executed yet.
+// The proofs are generated from all the messages of the commit report covering the message. The offchain token data
+// of the message is fetched with the token data observers of the exec plugin of the destination chain,
+// ErrTokenDataConfigUnknown or ErrTokenDataNotReady are returned when it can't be.
+func (t *Tracker) ManualExecProposal(
+	ctx context.Context,
+	sourceChainSelector, destChainSelector cciptypes.ChainSelector,
+	offRampAddress string,
+	msgID cciptypes.Bytes32,
+) (proposal ManualExecProposal, err error) {
+	destChainFamily, err := chainsel.GetSelectorFamily(uint64(destChainSelector))
+	if err != nil {
+		return ManualExecProposal{}, fmt.Errorf("failed to get chain family from chain selector %d: %w",
+			destChainSelector, err)
+	}
+	pluginConfig, err := ccipcommon.NewPluginConfigFactory(t.lggr, t.registry).CreatePluginConfig(destChainFamily)
+	if err != nil {
+		return ManualExecProposal{}, fmt.Errorf("failed to create plugin config for chain family %s: %w",
+			destChainFamily, err)
+	}
+
+	var commitData exectypes.CommitData
+	var msgTokenData exectypes.MessageTokenData
+	err = t.withCCIPReader(ctx, []cciptypes.ChainSelector{sourceChainSelector, destChainSelector}, destChainSelector,
+		offRampAddress, func(ccipReader *laneReader) error {
+			lifecycle, err1 := ccipReader.MessageStatus(ctx, sourceChainSelector, msgID)
+			if err1 != nil {
+				return err1
+			}
+			proposal.Lifecycle = lifecycle
+			if lifecycle.Committed == nil {
+				return ErrNotCommitted
+			}
+			if !lifecycle.IsPending() {
+				return ErrAlreadyExecuted
+			}
+
+			msgs, err1 := ccipReader.MsgsBetweenSeqNums(ctx, sourceChainSelector, lifecycle.Committed.SeqNumsRange)
+			if err1 != nil {
+				return fmt.Errorf("failed to read the messages of the commit report: %w", err1)
+			}
+			commitData = exectypes.CommitData{
+				SourceChain:         sourceChainSelector,
+				MerkleRoot:          lifecycle.Committed.MerkleRoot,
+				SequenceNumberRange: lifecycle.Committed.SeqNumsRange,
+				Messages:            msgs,
+			}
+
+			msgTokenData, err1 = t.observeTokenData(
+				ctx, destChainSelector, pluginConfig.TokenDataEncoder, ccipReader.readers, lifecycle.Message)
+			return err1
+		})
+	if err != nil {
+		return proposal, err
+	}
+
+	for _, msg := range commitData.Messages {
+		hash, err1 := pluginConfig.MessageHasher.Hash(ctx, msg)
+		if err1 != nil {
+			return proposal, fmt.Errorf("failed to hash message %d: %w", msg.Header.SequenceNumber, err1)
+		}
+		commitData.Hashes = append(commitData.Hashes, hash)
+		// only the token data of the executed message is part of the report.
+		tokenData := exectypes.NewMessageTokenData()
+		if msg.Header.SequenceNumber == proposal.Lifecycle.Message.Header.SequenceNumber {
+			tokenData = msgTokenData
+		}
+		commitData.MessageTokenData = append(commitData.MessageTokenData, tokenData)
+	}
+
+	proposal.Report, err = buildManualExecReport(t.lggr, commitData, proposal.Lifecycle.Message.Header.SequenceNumber)
+	if err != nil {
+		return proposal, fmt.Errorf("failed to build execution report: %w", err)
+	}
+	proposal.EncodedReport, err = pluginConfig.ExecutePluginCodec.Encode(ctx, cciptypes.ExecutePluginReport{
+		ChainReports: []cciptypes.ExecutePluginReportSingleChain{proposal.Report},
+	})
+	if err != nil {
+		return proposal, fmt.Errorf("failed to encode execution report: %w", err)
+	}
+
+	return proposal, nil
+}
+
+// buildManualExecReport builds the report executing the message with the sequence number, with the proofs of its
+// inclusion in the merkle root of the commit report.
+func buildManualExecReport(
+	lggr logger.Logger,
+	commitData exectypes.CommitData,
+	seqNum cciptypes.SeqNum,
+) (cciptypes.ExecutePluginReportSingleChain, error) {
+	if !commitData.SequenceNumberRange.Contains(seqNum) {
+		return cciptypes.ExecutePluginReportSingleChain{}, fmt.Errorf(
+			"message %d is not in the commit report range %s", seqNum, commitData.SequenceNumberRange)
+	}
+	tree, err := report.ConstructMerkleTree(commitData, lggr)
+	if err != nil {
+		return cciptypes.ExecutePluginReportSingleChain{}, fmt.Errorf("failed to construct merkle tree: %w", err)
+	}
+	if root := tree.Root(); root != commitData.MerkleRoot {
+		return cciptypes.ExecutePluginReportSingleChain{}, fmt.Errorf(
+			"merkle root mismatch, calculated != committed: %s != %s", cciptypes.Bytes32(root), commitData.MerkleRoot)
+	}
+
+	idx := int(seqNum - commitData.SequenceNumberRange.Start())
+	proof, err := tree.Prove([]int{idx})
+	if err != nil {
+		return cciptypes.ExecutePluginReportSingleChain{}, fmt.Errorf("failed to prove message %d: %w", seqNum, err)
+	}
+	proofs := make([]cciptypes.Bytes32, 0, len(proof.Hashes))
+	for _, h := range proof.Hashes {
+		proofs = append(proofs, h)
+	}
+	return cciptypes.ExecutePluginReportSingleChain{
+		SourceChainSelector: commitData.SourceChain,
+		Messages:            []cciptypes.Message{commitData.Messages[idx]},
+		OffchainTokenData:   [][][]byte{commitData.MessageTokenData[idx].ToByteSlice()},
+		Proofs:              proofs,
+		ProofFlagBits:       cciptypes.BigInt{Int: ccipevm.BoolsToBitFlags(proof.SourceFlags)},
+	}, nil
+}
@@ -0,0 +1,134 @@
+package msgstatus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/smartcontractkit/chainlink-ccip/execute/exectypes"
+	"github.com/smartcontractkit/chainlink-ccip/execute/tokendata/observer"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/contractreader"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+	"github.com/smartcontractkit/chainlink-ccip/pluginconfig"
+
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/launcher"
+	cctypes "github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/types"
+)
+
+var (
+	// ErrTokenDataConfigUnknown is returned when a manual execution is proposed for a message transferring tokens,
+	// while the node doesn't run an exec plugin for the destination chain to take the token data observers from.
+	ErrTokenDataConfigUnknown = errors.New("token data observers of the destination chain are unknown, " +
+		"the node doesn't run its exec plugin")
+	// ErrTokenDataNotReady is returned when a manual execution is proposed for a message whose offchain token data
+	// (e.g. USDC or LBTC attestation) is not available yet.
+	ErrTokenDataNotReady = errors.New("token data of the message is not ready")
+)
+
+// TokenDataConfig is the config the offchain token data of the messages are fetched with, as the exec plugin does.
+type TokenDataConfig struct {
+	// Observers returns the token data observers of the exec plugin of the destination chain, false when they are
+	// unknown. Messages transferring tokens can't be manually executed without them.
+	Observers func(dest cciptypes.ChainSelector) ([]pluginconfig.TokenDataObserverConfig, bool)
+	// Node is the node local config of the token data observers.
+	Node observer.NodeConfig
+}
+
+// LauncherTokenDataObservers returns the token data observers of the active exec plugins run by the launchers.
+func LauncherTokenDataObservers(
+	launchers func() map[int32]launcher.Inspector,
+) func(dest cciptypes.ChainSelector) ([]pluginconfig.TokenDataObserverConfig, bool) {
+	return func(dest cciptypes.ChainSelector) ([]pluginconfig.TokenDataObserverConfig, bool) {
+		for _, l := range launchers() {
+			for _, don := range l.Status().DONs {
+				for _, p := range don.Plugins {
+					if p.PluginType != cctypes.PluginTypeCCIPExec.String() || p.State != launcher.PluginStateActive ||
+						p.ChainSelector != dest {
+						continue
+					}
+					offchainConfig, err := pluginconfig.DecodeExecuteOffchainConfig(p.OffchainConfig)
+					if err != nil {
+						continue
+					}
+					return offchainConfig.TokenDataObservers, true
+				}
+			}
+		}
+		return nil, false
+	}
+}
+
+// observeTokenData returns the offchain token data of the message, fetched with the token data observers of the
+// exec plugin of the destination chain. Tokens not supported by any observer don't need offchain token data.
+func (t *Tracker) observeTokenData(
+	ctx context.Context,
+	destChainSelector cciptypes.ChainSelector,
+	encoder cciptypes.TokenDataEncoder,
+	readers map[cciptypes.ChainSelector]contractreader.ContractReaderFacade,
+	msg cciptypes.Message,
+) (exectypes.MessageTokenData, error) {
+	if len(msg.TokenAmounts) == 0 {
+		return exectypes.NewMessageTokenData(), nil
+	}
+	if t.tokenData.Observers == nil {
+		return exectypes.MessageTokenData{}, ErrTokenDataConfigUnknown
+	}
+	configs, ok := t.tokenData.Observers(destChainSelector)
+	if !ok {
+		return exectypes.MessageTokenData{}, ErrTokenDataConfigUnknown
+	}
+
+	// the proposal is built once, the token data is fetched in the foreground instead of by background workers.
+	tokenDataObserver, err := observer.NewConfigBasedCompositeObservers(ctx, t.lggr, destChainSelector,
+		cciptypes.Bytes32{}, foregroundObservers(configs), encoder, readers, t.addrCodec, t.tokenData.Node)
+	if err != nil {
+		return exectypes.MessageTokenData{}, fmt.Errorf("failed to create token data observers: %w", err)
+	}
+	defer func() {
+		if cerr := tokenDataObserver.Close(); cerr != nil {
+			t.lggr.Warnw("failed to close token data observers", "err", cerr)
+		}
+	}()
+
+	header := msg.Header
+	observations, err := tokenDataObserver.Observe(ctx, exectypes.MessageObservations{
+		header.SourceChainSelector: {header.SequenceNumber: msg},
+	})
+	if err != nil {
+		return exectypes.MessageTokenData{}, fmt.Errorf("failed to observe token data: %w", err)
+	}
+	tokenData := observations[header.SourceChainSelector][header.SequenceNumber]
+	for i, td := range tokenData.TokenData {
+		if td.IsReady() {
+			continue
+		}
+		if td.Error != nil {
+			return exectypes.MessageTokenData{}, fmt.Errorf("%w: token %d: %w", ErrTokenDataNotReady, i, td.Error)
+		}
+		return exectypes.MessageTokenData{}, fmt.Errorf("%w: token %d", ErrTokenDataNotReady, i)
+	}
+	return tokenData, nil
+}
+
+// foregroundObservers returns a copy of the observer configs without background workers.
+func foregroundObservers(configs []pluginconfig.TokenDataObserverConfig) []pluginconfig.TokenDataObserverConfig {
+	foreground := make([]pluginconfig.TokenDataObserverConfig, 0, len(configs))
+	for _, c := range configs {
+		switch {
+		case c.USDCCCTPObserverConfig != nil:
+			usdc := *c.USDCCCTPObserverConfig
+			usdc.NumWorkers = 0
+			c.USDCCCTPObserverConfig = &usdc
+		case c.LBTCObserverConfig != nil:
+			lbtc := *c.LBTCObserverConfig
+			lbtc.NumWorkers = 0
+			c.LBTCObserverConfig = &lbtc
+		case c.HTTPAttestationObserverConfig != nil:
+			httpAttestation := *c.HTTPAttestationObserverConfig
+			httpAttestation.NumWorkers = 0
+			c.HTTPAttestationObserverConfig = &httpAttestation
+		}
+		foreground = append(foreground, c)
+	}
+	return foreground
+}
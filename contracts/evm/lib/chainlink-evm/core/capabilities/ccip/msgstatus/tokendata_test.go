@@ -0,0 +1,91 @@
+package msgstatus
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-ccip/execute/exectypes"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+	"github.com/smartcontractkit/chainlink-ccip/pluginconfig"
+
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/launcher"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+func TestLauncherTokenDataObservers(t *testing.T) {
+	dest := cciptypes.ChainSelector(2)
+	lbtc := pluginconfig.TokenDataObserverConfig{
+		Type:               pluginconfig.LBTCHandlerType,
+		Version:            "1.0",
+		LBTCObserverConfig: &pluginconfig.LBTCObserverConfig{WorkerConfig: pluginconfig.WorkerConfig{NumWorkers: 5}},
+	}
+	activeConfig, err := pluginconfig.EncodeExecuteOffchainConfig(pluginconfig.ExecuteOffchainConfig{
+		TokenDataObservers: []pluginconfig.TokenDataObserverConfig{lbtc},
+	})
+	require.NoError(t, err)
+	candidateConfig, err := pluginconfig.EncodeExecuteOffchainConfig(pluginconfig.ExecuteOffchainConfig{})
+	require.NoError(t, err)
+
+	observers := LauncherTokenDataObservers(func() map[int32]launcher.Inspector {
+		return map[int32]launcher.Inspector{1: fakeInspector{status: launcher.Status{DONs: []launcher.DONStatus{{
+			Plugins: []launcher.PluginStatus{
+				{PluginType: "CCIPExec", State: launcher.PluginStateCandidate, ChainSelector: dest, OffchainConfig: candidateConfig},
+				{PluginType: "CCIPCommit", State: launcher.PluginStateActive, ChainSelector: dest},
+				{PluginType: "CCIPExec", State: launcher.PluginStateActive, ChainSelector: dest, OffchainConfig: activeConfig},
+			},
+		}}}}}
+	})
+
+	configs, ok := observers(dest)
+	require.True(t, ok)
+	require.Len(t, configs, 1)
+	assert.Equal(t, pluginconfig.LBTCHandlerType, configs[0].Type)
+
+	_, ok = observers(cciptypes.ChainSelector(3))
+	assert.False(t, ok)
+
+	// the proposal doesn't start background workers.
+	foreground := foregroundObservers(configs)
+	assert.Equal(t, 0, foreground[0].LBTCObserverConfig.NumWorkers)
+	assert.Equal(t, 5, configs[0].LBTCObserverConfig.NumWorkers)
+}
+
+func TestTracker_ObserveTokenData(t *testing.T) {
+	ctx := t.Context()
+	dest := cciptypes.ChainSelector(2)
+	tracker := NewTracker(logger.TestLogger(t), nil, TokenDataConfig{})
+
+	// messages without tokens don't need token data.
+	tokenData, err := tracker.observeTokenData(ctx, dest, nil, nil, cciptypes.Message{})
+	require.NoError(t, err)
+	assert.Equal(t, exectypes.NewMessageTokenData(), tokenData)
+
+	msg := cciptypes.Message{TokenAmounts: []cciptypes.RampTokenAmount{{}}}
+	_, err = tracker.observeTokenData(ctx, dest, nil, nil, msg)
+	require.ErrorIs(t, err, ErrTokenDataConfigUnknown)
+
+	tracker.tokenData.Observers = func(cciptypes.ChainSelector) ([]pluginconfig.TokenDataObserverConfig, bool) {
+		return nil, false
+	}
+	_, err = tracker.observeTokenData(ctx, dest, nil, nil, msg)
+	require.ErrorIs(t, err, ErrTokenDataConfigUnknown)
+
+	// tokens not supported by any observer don't need offchain token data.
+	tracker.tokenData.Observers = func(cciptypes.ChainSelector) ([]pluginconfig.TokenDataObserverConfig, bool) {
+		return nil, true
+	}
+	tokenData, err = tracker.observeTokenData(ctx, dest, nil, nil, msg)
+	require.NoError(t, err)
+	assert.Equal(t, exectypes.NewMessageTokenData(exectypes.NewNoopTokenData()), tokenData)
+}
+
+type fakeInspector struct {
+	launcher.Inspector
+	status launcher.Status
+}
+
+func (f fakeInspector) Status() launcher.Status {
+	return f.status
+}
@@ -0,0 +1,318 @@
+package msgstatus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+
+	chainsel "github.com/smartcontractkit/chain-selectors"
+
+	"github.com/smartcontractkit/chainlink-ccip/pkg/consts"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/contractreader"
+	ccipreaderpkg "github.com/smartcontractkit/chainlink-ccip/pkg/reader"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+	"github.com/smartcontractkit/chainlink-common/pkg/loop"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+
+	ccipcommon "github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/common"
+	defaults "github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/common/default"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// Tracker resolves the lifecycle of CCIP messages and the curses of their lanes using the relayers of the node.
+// The contract readers are created on the first query of a lane and reused by the next ones, so the status of a
+// message can be checked without access to the readers of the running plugins. They are unbound and closed
+// when the Tracker is closed.
+type Tracker struct {
+	services.StateMachine
+	lggr      logger.Logger
+	relayers  map[types.RelayID]loop.Relayer
+	crcw      ccipcommon.ChainRWProvider
+	addrCodec cciptypes.AddressCodec
+	registry  *ccipcommon.ChainFamilyRegistry
+	tokenData TokenDataConfig
+
+	mu sync.Mutex
+	// contractReaders are the started contract readers keyed by the chain and the lane they read.
+	contractReaders map[contractReaderKey]*trackedContractReader
+}
+
+// NewTracker creates a Tracker using the default chain family registry. The token data config is only used to
+// propose manual executions.
+func NewTracker(lggr logger.Logger, relayers map[types.RelayID]loop.Relayer, tokenData TokenDataConfig) *Tracker {
+	return &Tracker{
+		lggr:            lggr.Named("CCIPMessageStatus"),
+		relayers:        relayers,
+		crcw:            defaults.DefaultCRCW,
+		addrCodec:       defaults.DefaultAddressCodec,
+		registry:        defaults.DefaultChainFamilyRegistry,
+		tokenData:       tokenData,
+		contractReaders: make(map[contractReaderKey]*trackedContractReader),
+	}
+}
+
+func (t *Tracker) Start(context.Context) error {
+	return t.StartOnce("CCIPMessageStatus", func() error { return nil })
+}
+
+// Close unbinds the contracts bound by the queries, which unregisters their log poller filters,
+// and closes the contract readers.
+func (t *Tracker) Close() error {
+	return t.StopOnce("CCIPMessageStatus", func() error {
+		ctx, cancel := t.NewCtx()
+		defer cancel()
+
+		t.mu.Lock()
+		defer t.mu.Unlock()
+		var err error
+		for key, cr := range t.contractReaders {
+			err = errors.Join(err, cr.close(ctx))
+			delete(t.contractReaders, key)
+		}
+		return err
+	})
+}
+
+func (t *Tracker) Name() string { return t.lggr.Name() }
+
+func (t *Tracker) HealthReport() map[string]error {
+	return map[string]error{t.Name(): t.Healthy()}
+}
+
+// MessageStatus returns the lifecycle of the message sent from the source chain to the destination chain
+// whose offRamp is deployed at offRampAddress.
+func (t *Tracker) MessageStatus(
+	ctx context.Context,
+	sourceChainSelector, destChainSelector cciptypes.ChainSelector,
+	offRampAddress string,
+	msgID cciptypes.Bytes32,
+) (lifecycle ccipreaderpkg.MessageLifecycle, err error) {
+	err = t.withCCIPReader(ctx, []cciptypes.ChainSelector{sourceChainSelector, destChainSelector}, destChainSelector,
+		offRampAddress, func(ccipReader *laneReader) error {
+			lifecycle, err = ccipReader.MessageStatus(ctx, sourceChainSelector, msgID)
+			return err
+		})
+	return lifecycle, err
+}
+
+// CurseInfo returns the RMN curses applying to the destination chain whose offRamp is deployed at offRampAddress.
+func (t *Tracker) CurseInfo(
+	ctx context.Context,
+	destChainSelector cciptypes.ChainSelector,
+	offRampAddress string,
+) (curseInfo ccipreaderpkg.CurseInfo, err error) {
+	err = t.withCCIPReader(ctx, []cciptypes.ChainSelector{destChainSelector}, destChainSelector, offRampAddress,
+		func(ccipReader *laneReader) error {
+			curseInfo, err = ccipReader.GetRmnCurseInfo(ctx)
+			return err
+		})
+	return curseInfo, err
+}
+
+// NewLaneReader returns a CCIPReader bound to the contracts of the lane from the source chain to the destination
+// chain whose offRamp is deployed at offRampAddress. The contract readers of the lane are shared with the other queries
+// of the Tracker and stay open when the reader is closed.
+func (t *Tracker) NewLaneReader(
+	ctx context.Context,
+	sourceChainSelector, destChainSelector cciptypes.ChainSelector,
+	offRampAddress string,
+) (ccipreaderpkg.CCIPReader, error) {
+	reader, err := t.newCCIPReader(ctx, []cciptypes.ChainSelector{sourceChainSelector, destChainSelector},
+		destChainSelector, offRampAddress)
+	if err != nil {
+		return nil, err
+	}
+	return reader, nil
+}
+
+// withCCIPReader calls fn with a CCIPReader bound to the contracts of the chains. The reader is closed once fn
+// returns.
+func (t *Tracker) withCCIPReader(
+	ctx context.Context,
+	chains []cciptypes.ChainSelector,
+	destChainSelector cciptypes.ChainSelector,
+	offRampAddress string,
+	fn func(ccipReader *laneReader) error,
+) (err error) {
+	ccipReader, err := t.newCCIPReader(ctx, chains, destChainSelector, offRampAddress)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = errors.Join(err, ccipReader.Close())
+	}()
+	return fn(ccipReader)
+}
+
+// newCCIPReader returns a CCIPReader bound to the contracts of the chains, discovered from the offRamp
+// of the destination chain.
+func (t *Tracker) newCCIPReader(
+	ctx context.Context,
+	chains []cciptypes.ChainSelector,
+	destChainSelector cciptypes.ChainSelector,
+	offRampAddress string,
+) (_ *laneReader, err error) {
+	offRamp, err := t.addrCodec.AddressStringToBytes(offRampAddress, destChainSelector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid offRamp address %s: %w", offRampAddress, err)
+	}
+	destChainID, err := chainsel.GetChainIDFromSelector(uint64(destChainSelector))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get chain ID from chain selector %d: %w", destChainSelector, err)
+	}
+
+	reader := &laneReader{readers: make(map[cciptypes.ChainSelector]contractreader.ContractReaderFacade)}
+	defer func() {
+		if err != nil {
+			err = errors.Join(err, reader.Close())
+		}
+	}()
+	contractReaders := make(map[cciptypes.ChainSelector]*trackedContractReader, len(chains))
+	for _, chainSelector := range chains {
+		cr, err1 := t.contractReader(ctx, contractReaderKey{
+			chainSelector:     chainSelector,
+			destChainSelector: destChainSelector,
+			offRamp:           offRampAddress,
+		}, destChainID)
+		if err1 != nil {
+			return nil, err1
+		}
+		contractReaders[chainSelector] = cr
+		reader.readers[chainSelector] = cr.ContractReader
+	}
+
+	// the offRamp is bound by the reader, the onRamp is discovered from the offRamp's source chain config
+	reader.CCIPReader = ccipreaderpkg.NewCCIPChainReader(
+		ctx, t.lggr, reader.readers, nil, destChainSelector, offRamp, t.addrCodec)
+
+	contracts, err := reader.DiscoverContracts(ctx, chains)
+	if err != nil {
+		return nil, fmt.Errorf("failed to discover contracts: %w", err)
+	}
+	// the offRamp is bound by the reader itself
+	contracts = contracts.Append(consts.ContractNameOffRamp, destChainSelector, offRamp)
+	if err = reader.Sync(ctx, contracts); err != nil {
+		return nil, fmt.Errorf("failed to bind contracts: %w", err)
+	}
+	for contractName, addresses := range contracts {
+		for chainSelector, address := range addresses {
+			cr, ok := contractReaders[chainSelector]
+			if !ok || len(address) == 0 {
+				continue
+			}
+			addressStr, err1 := t.addrCodec.AddressBytesToString(address, chainSelector)
+			if err1 != nil {
+				return nil, fmt.Errorf("invalid %s address of chain %d: %w", contractName, chainSelector, err1)
+			}
+			cr.track(types.BoundContract{Name: contractName, Address: addressStr})
+		}
+	}
+	return reader, nil
+}
+
+// laneReader is a CCIPReader reading through the contract readers of the Tracker. Closing it leaves them open.
+type laneReader struct {
+	ccipreaderpkg.CCIPReader
+	// readers are the contract readers keyed by their chain.
+	readers map[cciptypes.ChainSelector]contractreader.ContractReaderFacade
+}
+
+func (r *laneReader) Close() error {
+	if r.CCIPReader == nil {
+		return nil
+	}
+	return r.CCIPReader.Close()
+}
+
+// contractReaderKey identifies a contract reader of the Tracker. The contracts bound by a reader depend on the lane,
+// so the readers are not shared between lanes.
+type contractReaderKey struct {
+	chainSelector     cciptypes.ChainSelector
+	destChainSelector cciptypes.ChainSelector
+	offRamp           string
+}
+
+// trackedContractReader is a started contract reader with the contracts bound on it.
+type trackedContractReader struct {
+	types.ContractReader
+
+	mu    sync.Mutex
+	bound map[types.BoundContract]struct{}
+}
+
+func (cr *trackedContractReader) track(contract types.BoundContract) {
+	cr.mu.Lock()
+	defer cr.mu.Unlock()
+	cr.bound[contract] = struct{}{}
+}
+
+// close unbinds the tracked contracts and closes the contract reader.
+func (cr *trackedContractReader) close(ctx context.Context) error {
+	cr.mu.Lock()
+	defer cr.mu.Unlock()
+	var err error
+	if len(cr.bound) > 0 {
+		err = cr.Unbind(ctx, slices.Collect(maps.Keys(cr.bound)))
+	}
+	return errors.Join(err, cr.ContractReader.Close())
+}
+
+// contractReader returns the started contract reader of the key, creating it on first use.
+func (t *Tracker) contractReader(
+	ctx context.Context,
+	key contractReaderKey,
+	destChainID string,
+) (*trackedContractReader, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if cr, ok := t.contractReaders[key]; ok {
+		return cr, nil
+	}
+	cr, err := t.newContractReader(ctx, key.chainSelector, destChainID)
+	if err != nil {
+		return nil, err
+	}
+	tracked := &trackedContractReader{ContractReader: cr, bound: make(map[types.BoundContract]struct{})}
+	t.contractReaders[key] = tracked
+	return tracked, nil
+}
+
+func (t *Tracker) newContractReader(
+	ctx context.Context,
+	chainSelector cciptypes.ChainSelector,
+	destChainID string,
+) (types.ContractReader, error) {
+	chainFamily, err := chainsel.GetSelectorFamily(uint64(chainSelector))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get chain family from chain selector %d: %w", chainSelector, err)
+	}
+	chainID, err := chainsel.GetChainIDFromSelector(uint64(chainSelector))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get chain ID from chain selector %d: %w", chainSelector, err)
+	}
+
+	relayer, ok := t.relayers[types.NewRelayID(chainFamily, chainID)]
+	if !ok {
+		return nil, fmt.Errorf("no relayer configured for %s chain %s", chainFamily, chainID)
+	}
+
+	cr, err := t.crcw.GetChainReader(ctx, ccipcommon.ChainReaderProviderOpts{
+		Lggr:          t.lggr,
+		Relayer:       relayer,
+		ChainID:       chainID,
+		DestChainID:   destChainID,
+		ChainSelector: chainSelector,
+		ChainFamily:   chainFamily,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create contract reader for chain %s: %w", chainID, err)
+	}
+	if err := cr.Start(ctx); err != nil {
+		return nil, errors.Join(fmt.Errorf("failed to start contract reader for chain %s: %w", chainID, err), cr.Close())
+	}
+	return cr, nil
+}
@@ -0,0 +1,90 @@
+package msgstatus
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	chainsel "github.com/smartcontractkit/chain-selectors"
+
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+	"github.com/smartcontractkit/chainlink-common/pkg/loop"
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+
+	ccipcommon "github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/common"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+func TestTracker_ContractReadersAreReused(t *testing.T) {
+	ctx := t.Context()
+	source := cciptypes.ChainSelector(chainsel.TEST_90000001.Selector)
+	dest := cciptypes.ChainSelector(chainsel.TEST_90000002.Selector)
+	relayers := map[types.RelayID]loop.Relayer{
+		types.NewRelayID(chainsel.FamilyEVM, strconv.FormatUint(chainsel.TEST_90000001.EvmChainID, 10)): nil,
+		types.NewRelayID(chainsel.FamilyEVM, strconv.FormatUint(chainsel.TEST_90000002.EvmChainID, 10)): nil,
+	}
+	crcw := &fakeCRCW{}
+	tracker := NewTracker(logger.TestLogger(t), relayers, TokenDataConfig{})
+	tracker.crcw = crcw
+	require.NoError(t, tracker.Start(ctx))
+
+	lane := contractReaderKey{chainSelector: source, destChainSelector: dest, offRamp: "0x01"}
+	cr, err := tracker.contractReader(ctx, lane, "90000002")
+	require.NoError(t, err)
+	cr.track(types.BoundContract{Name: "OnRamp", Address: "0x02"})
+
+	// the next queries of the lane reuse the started reader, its filters are registered once.
+	again, err := tracker.contractReader(ctx, lane, "90000002")
+	require.NoError(t, err)
+	assert.Same(t, cr, again)
+	require.Len(t, crcw.readers, 1)
+	assert.Equal(t, 1, crcw.readers[0].starts)
+
+	// another offRamp binds other contracts, it gets its own reader.
+	_, err = tracker.contractReader(ctx, contractReaderKey{chainSelector: source, destChainSelector: dest, offRamp: "0x03"},
+		"90000002")
+	require.NoError(t, err)
+	require.Len(t, crcw.readers, 2)
+
+	require.NoError(t, tracker.Close())
+	assert.Equal(t, []types.BoundContract{{Name: "OnRamp", Address: "0x02"}}, crcw.readers[0].unbound)
+	assert.Empty(t, crcw.readers[1].unbound)
+	for _, r := range crcw.readers {
+		assert.Equal(t, 1, r.closes)
+	}
+}
+
+type fakeCRCW struct {
+	ccipcommon.ChainRWProvider
+	readers []*fakeContractReader
+}
+
+func (f *fakeCRCW) GetChainReader(context.Context, ccipcommon.ChainReaderProviderOpts) (types.ContractReader, error) {
+	cr := &fakeContractReader{}
+	f.readers = append(f.readers, cr)
+	return cr, nil
+}
+
+type fakeContractReader struct {
+	types.UnimplementedContractReader
+	starts, closes int
+	unbound        []types.BoundContract
+}
+
+func (f *fakeContractReader) Start(context.Context) error {
+	f.starts++
+	return nil
+}
+
+func (f *fakeContractReader) Close() error {
+	f.closes++
+	return nil
+}
+
+func (f *fakeContractReader) Unbind(_ context.Context, bindings []types.BoundContract) error {
+	f.unbound = append(f.unbound, bindings...)
+	return nil
+}
@@ -18,19 +18,21 @@ import (
 
 	defaults "github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/common/default"
 
-	"github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/ccipevm"
-	"github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/ccipsolana"
 	ccipcommon "github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/common"
 
 	commitocr3 "github.com/smartcontractkit/chainlink-ccip/commit"
 	"github.com/smartcontractkit/chainlink-ccip/commit/merkleroot/rmn"
 	execocr3 "github.com/smartcontractkit/chainlink-ccip/execute"
+	"github.com/smartcontractkit/chainlink-ccip/execute/tokendata/observer"
 	"github.com/smartcontractkit/chainlink-ccip/pkg/consts"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/contractreader"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/lanemonitor"
 	ccipreaderpkg "github.com/smartcontractkit/chainlink-ccip/pkg/reader"
 	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
 	"github.com/smartcontractkit/chainlink-ccip/pluginconfig"
 	"github.com/smartcontractkit/chainlink-common/pkg/loop"
 	"github.com/smartcontractkit/chainlink-common/pkg/types"
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/lanestatus"
 	"github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/ocrimpls"
 	cctypes "github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/types"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
@@ -67,6 +69,11 @@ type pluginOracleCreator struct {
 	homeChainSelector     cciptypes.ChainSelector
 	relayers              map[types.RelayID]loop.Relayer
 	addressCodec          cciptypes.AddressCodec
+	laneTracker           *lanestatus.Tracker
+	// laneMonitor monitors the lanes of the destination chains of the exec plugins, nil when disabled.
+	laneMonitor *lanemonitor.Monitor
+	// tokenDataConfig is the node local config of the exec token data observers.
+	tokenDataConfig observer.NodeConfig
 }
 
 func NewPluginOracleCreator(
@@ -85,6 +92,9 @@ func NewPluginOracleCreator(
 	homeChainReader ccipreaderpkg.HomeChain,
 	homeChainSelector cciptypes.ChainSelector,
 	addressCodec cciptypes.AddressCodec,
+	laneTracker *lanestatus.Tracker,
+	laneMonitor *lanemonitor.Monitor,
+	tokenDataConfig observer.NodeConfig,
 ) cctypes.OracleCreator {
 	return &pluginOracleCreator{
 		ocrKeyBundles:         ocrKeyBundles,
@@ -102,6 +112,9 @@ func NewPluginOracleCreator(
 		homeChainReader:       homeChainReader,
 		homeChainSelector:     homeChainSelector,
 		addressCodec:          addressCodec,
+		laneTracker:           laneTracker,
+		laneMonitor:           laneMonitor,
+		tokenDataConfig:       tokenDataConfig,
 	}
 }
 
@@ -203,6 +216,18 @@ func (i *pluginOracleCreator) Create(ctx context.Context, donID uint32, config c
 		return nil, fmt.Errorf("failed to create factory and transmitter: %w", err)
 	}
 
+	// the lanes of the destination chain are discovered and checked through a CCIP reader of the plugin.
+	var laneReader ccipreaderpkg.CCIPReader
+	if i.laneTracker != nil || (i.laneMonitor != nil && pluginType == cctypes.PluginTypeCCIPExec) {
+		laneReader, err = i.createLaneReader(ctx, config, contractReaders, chainWriters)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create lane reader: %w", err)
+		}
+	}
+	factory = i.laneTracker.WrapFactory(
+		factory, config.Config.ChainSelector, donID, pluginType, i.homeChainReader, laneReader)
+	transmitter = i.laneTracker.WrapTransmitter(transmitter, config.Config.ChainSelector)
+
 	telemetryType, err := pluginTypeToTelemetryType(pluginType)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get telemetry type: %w", err)
@@ -241,7 +266,12 @@ func (i *pluginOracleCreator) Create(ctx context.Context, donID uint32, config c
 		return nil, err
 	}
 
-	closers := make([]io.Closer, 0, len(contractReaders)+len(chainWriters))
+	closers := make([]io.Closer, 0, len(contractReaders)+len(chainWriters)+1)
+	if i.laneMonitor != nil && pluginType == cctypes.PluginTypeCCIPExec {
+		// the destination is removed from the monitor before its contract readers are closed.
+		removeDest := i.laneMonitor.AddDestination(config.Config.ChainSelector, i.homeChainReader, laneReader)
+		closers = append(closers, closerFunc(func() error { removeDest(); return nil }))
+	}
 	for _, cr := range contractReaders {
 		closers = append(closers, cr)
 	}
@@ -309,22 +339,26 @@ func (i *pluginOracleCreator) createFactoryAndTransmitter(
 			pluginConfig.PriceOnlyCommitFn,
 		)
 	} else if config.Config.PluginType == uint8(cctypes.PluginTypeCCIPExec) {
+		reportSimulator := i.createReportSimulator(destRelayID, config.Config.OfframpAddress, pluginConfig)
 		factory = execocr3.NewExecutePluginFactory(
 			execocr3.PluginFactoryParams{
 				Lggr: i.lggr.
 					Named("CCIPExecPlugin").
 					Named(destRelayID.String()).
 					Named(offrampAddrStr),
-				DonID:            donID,
-				OcrConfig:        ccipreaderpkg.OCR3ConfigWithMeta(config),
-				ExecCodec:        pluginConfig.ExecutePluginCodec,
-				MsgHasher:        pluginConfig.MessageHasher,
-				AddrCodec:        i.addressCodec,
-				HomeChainReader:  i.homeChainReader,
-				TokenDataEncoder: pluginConfig.TokenDataEncoder,
-				EstimateProvider: pluginConfig.GasEstimateProvider,
-				ContractReaders:  contractReaders,
-				ContractWriters:  chainWriters,
+				DonID:               donID,
+				OcrConfig:           ccipreaderpkg.OCR3ConfigWithMeta(config),
+				ExecCodec:           pluginConfig.ExecutePluginCodec,
+				MsgHasher:           pluginConfig.MessageHasher,
+				AddrCodec:           i.addressCodec,
+				HomeChainReader:     i.homeChainReader,
+				TokenDataEncoder:    pluginConfig.TokenDataEncoder,
+				EstimateProvider:    pluginConfig.GasEstimateProvider,
+				ExtraArgsDecoder:    ccipcommon.NewExtraDataCodec(defaults.DefaultChainFamilyRegistry),
+				ContractReaders:     contractReaders,
+				ContractWriters:     chainWriters,
+				TokenDataNodeConfig: i.tokenDataConfig,
+				ReportSimulator:     reportSimulator,
 			})
 		factory = promwrapper.NewReportingPluginFactory[[]byte](factory, i.lggr, destChainID, "CCIPExec")
 		transmitter = pluginConfig.ContractTransmitterFactory.NewExecTransmitter(
@@ -339,6 +373,58 @@ func (i *pluginOracleCreator) createFactoryAndTransmitter(
 	return factory, transmitter, nil
 }
 
+// createLaneReader returns the CCIP reader the lane tracker and the lane monitor discover and check the lanes of the
+// destination chain with, it reads through the contract readers of the plugin like the plugin does.
+func (i *pluginOracleCreator) createLaneReader(
+	ctx context.Context,
+	config cctypes.OCR3ConfigWithMeta,
+	contractReaders map[cciptypes.ChainSelector]types.ContractReader,
+	chainWriters map[cciptypes.ChainSelector]types.ContractWriter,
+) (ccipreaderpkg.CCIPReader, error) {
+	lggr := i.lggr.Named("CCIPLaneReader").Named(fmt.Sprintf("%d", config.Config.ChainSelector))
+	readers := make(map[cciptypes.ChainSelector]contractreader.ContractReaderFacade, len(contractReaders))
+	for chain, cr := range contractReaders {
+		chainID, err := chainsel.GetChainIDFromSelector(uint64(chain))
+		if err != nil {
+			return nil, fmt.Errorf("failed to get chain ID from selector %d: %w", chain, err)
+		}
+		readers[chain] = contractreader.NewExtendedContractReader(contractreader.NewObserverReader(cr, lggr, chainID))
+	}
+	return ccipreaderpkg.NewCCIPChainReader(
+		ctx,
+		lggr,
+		readers,
+		chainWriters,
+		config.Config.ChainSelector,
+		config.Config.OfframpAddress,
+		i.addressCodec,
+	), nil
+}
+
+// createReportSimulator returns the simulator of execute reports on the destination chain, or nil when the chain
+// family doesn't provide one. Reports are only simulated when enabled in the offchain config.
+func (i *pluginOracleCreator) createReportSimulator(
+	destRelayID types.RelayID,
+	offRamp []byte,
+	pluginConfig ccipcommon.PluginConfig,
+) cciptypes.ExecuteReportSimulator {
+	if pluginConfig.ExecuteReportSimulatorFactory == nil {
+		return nil
+	}
+	relayer, ok := i.relayers[destRelayID]
+	if !ok {
+		i.lggr.Warnw("no relayer found for dest relay ID, not simulating execute reports", "destRelayID", destRelayID)
+		return nil
+	}
+	reportSimulator, err := pluginConfig.ExecuteReportSimulatorFactory(relayer, offRamp)
+	if err != nil {
+		i.lggr.Warnw("unable to create execute report simulator, not simulating execute reports",
+			"destRelayID", destRelayID, "err", err)
+		return nil
+	}
+	return reportSimulator
+}
+
 func (i *pluginOracleCreator) createReadersAndWriters(
 	ctx context.Context,
 	destChainID string,
@@ -469,10 +555,9 @@ func decodeAndValidateOffchainConfig(
 
 // initializerPluginConfig initializes the plugin config for the given chain family.
 func initializerPluginConfig(destChainFamily string, lggr logger.Logger) (ccipcommon.PluginConfig, error) {
-	extraDataCodec := defaults.DefaultExtraDataCodec
 	pluginConfig, err := ccipcommon.NewPluginConfigFactory(
-		ccipevm.InitializePluginConfig(lggr, extraDataCodec),
-		ccipsolana.InitializePluginConfig(lggr, extraDataCodec),
+		lggr,
+		defaults.DefaultChainFamilyRegistry,
 	).CreatePluginConfig(destChainFamily)
 	if err != nil {
 		return ccipcommon.PluginConfig{}, fmt.Errorf("failed to create plugin config: %w", err)
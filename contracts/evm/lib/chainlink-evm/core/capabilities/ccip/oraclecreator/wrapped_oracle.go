@@ -40,3 +40,10 @@ func (o *wrappedOracle) Close() error {
 
 	return errors.Join(errs...)
 }
+
+// closerFunc closes a resource of the oracle with a function.
+type closerFunc func() error
+
+func (f closerFunc) Close() error {
+	return f()
+}
@@ -0,0 +1,168 @@
+package ccipmessage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	ccipreaderpkg "github.com/smartcontractkit/chainlink-ccip/pkg/reader"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/triggers/ccipmessage/ccipmessagecap"
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/triggers/logevent"
+)
+
+const ID = "ccip-message-trigger@1.0.0"
+
+// CCIP Message Trigger Capability Input
+type Input struct {
+}
+
+// LaneReaderFactory creates the CCIPReaders of the lanes watched by the triggers.
+type LaneReaderFactory interface {
+	NewLaneReader(
+		ctx context.Context,
+		sourceChainSelector, destChainSelector cciptypes.ChainSelector,
+		offRampAddress string,
+	) (ccipreaderpkg.CCIPReader, error)
+}
+
+// CCIP Message Trigger Capabilities Manager
+// Manages the CCIP message triggers of the workflows using an underlying triggerStore
+type TriggerService struct {
+	services.StateMachine
+	capabilities.CapabilityInfo
+	capabilities.Validator[ccipmessagecap.Config, Input, capabilities.TriggerResponse]
+	lggr          logger.Logger
+	triggers      logevent.CapabilitiesStore[ccipMessageTrigger, capabilities.TriggerResponse]
+	readers       LaneReaderFactory
+	triggerConfig Config
+}
+
+// Common capability level config across all workflows
+type Config struct {
+	// PollPeriod is the delay between two polls of a lane, in milliseconds.
+	PollPeriod uint32 `json:"pollPeriod"`
+	// QueryCount is the maximum number of sent messages read per poll.
+	QueryCount uint64 `json:"queryCount"`
+	// LookbackSeqNums is the number of messages sent before the registration of a trigger that are matched.
+	LookbackSeqNums uint64 `json:"lookbackSeqNums"`
+}
+
+var _ capabilities.TriggerCapability = (*TriggerService)(nil)
+var _ services.Service = &TriggerService{}
+
+// Creates a new CCIP Message Trigger Service.
+// Polling of a lane starts when a trigger is registered.
+func NewTriggerService(ctx context.Context,
+	lggr logger.Logger,
+	readers LaneReaderFactory,
+	triggerConfig Config) (*TriggerService, error) {
+	s := &TriggerService{
+		lggr:          logger.Named(lggr, "CCIPMessageTriggerCapabilityService"),
+		triggers:      logevent.NewCapabilitiesStore[ccipMessageTrigger, capabilities.TriggerResponse](),
+		readers:       readers,
+		triggerConfig: triggerConfig,
+	}
+	var err error
+	s.CapabilityInfo, err = s.Info(ctx)
+	if err != nil {
+		return s, err
+	}
+	s.Validator = capabilities.NewValidator[ccipmessagecap.Config, Input, capabilities.TriggerResponse](capabilities.ValidatorArgs{Info: s.CapabilityInfo})
+	return s, nil
+}
+
+func (s *TriggerService) Info(ctx context.Context) (capabilities.CapabilityInfo, error) {
+	return capabilities.NewCapabilityInfo(
+		ID,
+		capabilities.CapabilityTypeTrigger,
+		"A trigger that listens for CCIP messages of a sender or receiver reaching a stage and starts a workflow run.",
+	)
+}
+
+// Register a new trigger
+// Can register triggers before the service is actively polling
+func (s *TriggerService) RegisterTrigger(ctx context.Context,
+	req capabilities.TriggerRegistrationRequest) (<-chan capabilities.TriggerResponse, error) {
+	if req.Config == nil {
+		return nil, errors.New("config is required to register a CCIP message trigger")
+	}
+	reqConfig, err := s.ValidateConfig(req.Config)
+	if err != nil {
+		return nil, err
+	}
+	filter, err := newMessageFilter(reqConfig)
+	if err != nil {
+		return nil, err
+	}
+	var respCh chan capabilities.TriggerResponse
+	ok := s.IfNotStopped(func() {
+		respCh, err = s.triggers.InsertIfNotExists(req.TriggerID, func() (*ccipMessageTrigger, chan capabilities.TriggerResponse, error) {
+			reader, tErr := s.readers.NewLaneReader(ctx, filter.sourceChainSelector, filter.destChainSelector, reqConfig.OffRamp)
+			if tErr != nil {
+				return nil, nil, fmt.Errorf("error creating reader of lane %d->%d: %w",
+					filter.sourceChainSelector, filter.destChainSelector, tErr)
+			}
+			l, ch, tErr := newCCIPMessageTrigger(ctx, s.lggr, req.Metadata.WorkflowID, filter, reader, s.triggerConfig)
+			if tErr != nil {
+				return l, ch, errors.Join(tErr, reader.Close())
+			}
+			tErr = l.Start(ctx)
+			return l, ch, tErr
+		})
+	})
+	if !ok {
+		return nil, errors.New("cannot create new trigger since CCIPMessageTriggerCapabilityService has been stopped")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("create new trigger failed %w", err)
+	}
+	s.lggr.Infow("RegisterTrigger", "triggerId", req.TriggerID, "WorkflowID", req.Metadata.WorkflowID)
+	return respCh, nil
+}
+
+func (s *TriggerService) UnregisterTrigger(ctx context.Context, req capabilities.TriggerRegistrationRequest) error {
+	trigger, ok := s.triggers.Read(req.TriggerID)
+	if !ok {
+		return fmt.Errorf("triggerId %s not found", req.TriggerID)
+	}
+	// Stop polling the lane and close its reader
+	err := trigger.Close()
+	if err != nil {
+		return fmt.Errorf("error closing trigger %s: %w", req.TriggerID, err)
+	}
+	s.triggers.Delete(req.TriggerID)
+	s.lggr.Infow("UnregisterTrigger", "triggerId", req.TriggerID, "WorkflowID", req.Metadata.WorkflowID)
+	return nil
+}
+
+// Start the service.
+func (s *TriggerService) Start(ctx context.Context) error {
+	return s.StartOnce("CCIPMessageTriggerCapabilityService", func() error {
+		s.lggr.Info("Starting CCIPMessageTriggerCapabilityService")
+		return nil
+	})
+}
+
+// Close stops the Service.
+// After this call the Service cannot be started again,
+// The service will need to be re-built to start polling again.
+func (s *TriggerService) Close() error {
+	return s.StopOnce("CCIPMessageTriggerCapabilityService", func() error {
+		s.lggr.Infow("Stopping CCIPMessageTriggerCapabilityService")
+		triggers := s.triggers.ReadAll()
+		return services.MultiCloser(triggers).Close()
+	})
+}
+
+func (s *TriggerService) HealthReport() map[string]error {
+	return map[string]error{s.Name(): s.Healthy()}
+}
+
+func (s *TriggerService) Name() string {
+	return s.lggr.Name()
+}
@@ -0,0 +1,460 @@
+package ccipmessage
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	ccipreaderpkg "github.com/smartcontractkit/chainlink-ccip/pkg/reader"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	"github.com/smartcontractkit/chainlink-common/pkg/types/query/primitives"
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+
+	defaults "github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/common/default"
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/triggers/ccipmessage/ccipmessagecap"
+)
+
+const (
+	defaultSendChannelBufferSize = 1000
+	// maxPendingMessages is the maximum number of matched messages followed until their next stages, the oldest
+	// messages are no longer followed once it is reached.
+	maxPendingMessages = 1000
+	// commitReportsBatch is the number of commit reports read at once while matching the pending messages.
+	commitReportsBatch = 100
+	// maxCommitPagesPerPoll limits the number of commit report queries per poll, the remaining reports are read
+	// by the next polls.
+	maxCommitPagesPerPoll = 10
+)
+
+// ccipMessageTrigger polls a lane with a CCIPReader in a loop with a periodic delay of pollPeriod
+// milliseconds. Only finalized messages are matched, they are followed until they are successfully executed.
+type ccipMessageTrigger struct {
+	ch   chan<- capabilities.TriggerResponse
+	lggr logger.Logger
+
+	filter        messageFilter
+	reader        ccipreaderpkg.CCIPReader
+	triggerConfig Config
+	nextSeqNum    cciptypes.SeqNum
+	// pending are the matched messages waiting for their next stages.
+	pending map[cciptypes.Bytes32]*pendingMessage
+	// commitsSince is the timestamp the commit reports are read from, it starts at zero so that the commits
+	// of the messages sent before the registration of the trigger are found.
+	commitsSince time.Time
+
+	ticker   *time.Ticker
+	stopChan services.StopChan
+	done     chan bool
+}
+
+type pendingMessage struct {
+	msg cciptypes.Message
+	// committed is the merkle root covering the message, nil until it is committed.
+	committed *ccipreaderpkg.MessageCommitted
+	// executedCursor is the cursor of the last ExecutionStateChanged event of the message.
+	executedCursor string
+}
+
+// Construct for ccipMessageTrigger struct
+func newCCIPMessageTrigger(ctx context.Context,
+	lggr logger.Logger,
+	workflowID string,
+	filter messageFilter,
+	reader ccipreaderpkg.CCIPReader,
+	triggerConfig Config) (*ccipMessageTrigger, chan capabilities.TriggerResponse, error) {
+	// Get the latest finalized message of the lane to start polling from
+	latest, err := reader.LatestMsgSeqNum(ctx, filter.sourceChainSelector)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error getting latest sequence number of chain %d: %w", filter.sourceChainSelector, err)
+	}
+	nextSeqNum := cciptypes.SeqNum(1)
+	if uint64(latest) >= triggerConfig.LookbackSeqNums {
+		nextSeqNum = latest + 1 - cciptypes.SeqNum(triggerConfig.LookbackSeqNums)
+	}
+
+	if triggerConfig.PollPeriod == 0 {
+		triggerConfig.PollPeriod = 1000
+	}
+	if triggerConfig.QueryCount == 0 {
+		triggerConfig.QueryCount = 20
+	}
+
+	callbackCh := make(chan capabilities.TriggerResponse, defaultSendChannelBufferSize)
+	l := &ccipMessageTrigger{
+		ch:   callbackCh,
+		lggr: logger.Named(lggr, "CCIPMessageTrigger."+workflowID),
+
+		filter:        filter,
+		reader:        reader,
+		triggerConfig: triggerConfig,
+		nextSeqNum:    nextSeqNum,
+		pending:       make(map[cciptypes.Bytes32]*pendingMessage),
+
+		ticker:   time.NewTicker(time.Duration(triggerConfig.PollPeriod) * time.Millisecond),
+		stopChan: make(services.StopChan),
+		done:     make(chan bool),
+	}
+	return l, callbackCh, nil
+}
+
+func (l *ccipMessageTrigger) Start(ctx context.Context) error {
+	go l.listen()
+	return nil
+}
+
+func (l *ccipMessageTrigger) listen() {
+	ctx, cancel := l.stopChan.NewCtx()
+	defer cancel()
+	defer close(l.done)
+
+	for {
+		select {
+		case <-ctx.Done():
+			l.lggr.Infow("Closing trigger server", "sourceChainSelector", l.filter.sourceChainSelector,
+				"destChainSelector", l.filter.destChainSelector)
+			return
+		case <-l.ticker.C:
+			l.pollSent(ctx)
+			l.pollPending(ctx)
+		}
+	}
+}
+
+// pollSent matches the messages finalized on the source chain since the last poll.
+func (l *ccipMessageTrigger) pollSent(ctx context.Context) {
+	latest, err := l.reader.LatestMsgSeqNum(ctx, l.filter.sourceChainSelector)
+	if err != nil {
+		l.lggr.Errorw("LatestMsgSeqNum failure", "err", err)
+		return
+	}
+	if latest < l.nextSeqNum {
+		return
+	}
+	end := min(latest, l.nextSeqNum+cciptypes.SeqNum(l.triggerConfig.QueryCount)-1)
+	msgs, err := l.reader.MsgsBetweenSeqNums(ctx, l.filter.sourceChainSelector, cciptypes.NewSeqNumRange(l.nextSeqNum, end))
+	if err != nil {
+		l.lggr.Errorw("MsgsBetweenSeqNums failure", "err", err, "start", l.nextSeqNum, "end", end)
+		return
+	}
+	for _, msg := range msgs {
+		if !l.filter.matches(msg) {
+			continue
+		}
+		if l.filter.stages[ccipmessagecap.StageSent] {
+			l.ch <- createTriggerResponse(msg, ccipmessagecap.StageSent, ccipreaderpkg.MessageLifecycle{})
+		}
+		if l.filter.stages[ccipmessagecap.StageCommitted] || l.filter.stages[ccipmessagecap.StageExecuted] {
+			l.addPending(msg)
+		}
+	}
+	l.nextSeqNum = end + 1
+}
+
+// addPending follows the message until its next stages, the oldest pending message is no longer followed
+// if there are too many of them.
+func (l *ccipMessageTrigger) addPending(msg cciptypes.Message) {
+	if len(l.pending) >= maxPendingMessages {
+		var oldest *pendingMessage
+		for _, p := range l.pending {
+			if oldest == nil || p.msg.Header.SequenceNumber < oldest.msg.Header.SequenceNumber {
+				oldest = p
+			}
+		}
+		l.lggr.Warnw("Too many pending messages, no longer following the oldest one",
+			"maxPendingMessages", maxPendingMessages, "msgID", oldest.msg.Header.MessageID,
+			"seqNum", oldest.msg.Header.SequenceNumber)
+		delete(l.pending, oldest.msg.Header.MessageID)
+	}
+	l.pending[msg.Header.MessageID] = &pendingMessage{msg: msg}
+}
+
+// pollPending checks if the pending messages reached their next stages. The messages are no longer followed
+// once they are successfully executed, or committed if only the commit is matched.
+func (l *ccipMessageTrigger) pollPending(ctx context.Context) {
+	if len(l.pending) == 0 {
+		return
+	}
+	l.pollCommitted(ctx)
+	l.pollExecuted(ctx)
+}
+
+// pollCommitted matches the pending messages with the merkle roots of the commit reports accepted since the
+// last poll, the reports are read in batches instead of per message.
+func (l *ccipMessageTrigger) pollCommitted(ctx context.Context) {
+	uncommitted := make(map[cciptypes.SeqNum]*pendingMessage)
+	for _, p := range l.pending {
+		if p.committed == nil {
+			uncommitted[p.msg.Header.SequenceNumber] = p
+		}
+	}
+
+	for page := 0; page < maxCommitPagesPerPoll && len(uncommitted) > 0; page++ {
+		reports, err := l.reader.CommitReportsGTETimestamp(ctx, l.commitsSince, primitives.Unconfirmed, commitReportsBatch)
+		if err != nil {
+			l.lggr.Errorw("CommitReportsGTETimestamp failure", "err", err, "since", l.commitsSince)
+			return
+		}
+		for _, report := range reports {
+			l.matchCommitReport(report, uncommitted)
+		}
+		if len(reports) == 0 {
+			return
+		}
+
+		// reports sharing the last timestamp are read again, make sure the next page advances
+		next := reports[len(reports)-1].Timestamp
+		if len(reports) == commitReportsBatch && !next.After(l.commitsSince) {
+			next = l.commitsSince.Add(time.Second)
+		}
+		l.commitsSince = next
+		if len(reports) < commitReportsBatch {
+			return
+		}
+	}
+}
+
+// matchCommitReport reports the uncommitted messages covered by the merkle roots of the commit report.
+func (l *ccipMessageTrigger) matchCommitReport(
+	report cciptypes.CommitPluginReportWithMeta,
+	uncommitted map[cciptypes.SeqNum]*pendingMessage,
+) {
+	rootsByBlessing := []struct {
+		blessed bool
+		roots   []cciptypes.MerkleRootChain
+	}{
+		{blessed: true, roots: report.Report.BlessedMerkleRoots},
+		{blessed: false, roots: report.Report.UnblessedMerkleRoots},
+	}
+	for _, r := range rootsByBlessing {
+		for _, root := range r.roots {
+			if root.ChainSel != l.filter.sourceChainSelector {
+				continue
+			}
+			for seqNum := root.SeqNumsRange.Start(); seqNum <= root.SeqNumsRange.End(); seqNum++ {
+				p, ok := uncommitted[seqNum]
+				if !ok {
+					continue
+				}
+				delete(uncommitted, seqNum)
+				p.committed = &ccipreaderpkg.MessageCommitted{
+					MerkleRoot:      root.MerkleRoot,
+					SeqNumsRange:    root.SeqNumsRange,
+					Blessed:         r.blessed,
+					ReportTimestamp: report.Timestamp,
+					BlockNum:        report.BlockNum,
+				}
+				if l.filter.stages[ccipmessagecap.StageCommitted] {
+					l.ch <- createTriggerResponse(p.msg, ccipmessagecap.StageCommitted,
+						ccipreaderpkg.MessageLifecycle{Message: p.msg, Committed: p.committed})
+				}
+				if !l.filter.stages[ccipmessagecap.StageExecuted] {
+					delete(l.pending, p.msg.Header.MessageID)
+				}
+			}
+		}
+	}
+}
+
+// pollExecuted reads the executions of the committed pending messages, with one query per merkle root.
+func (l *ccipMessageTrigger) pollExecuted(ctx context.Context) {
+	byRoot := make(map[cciptypes.SeqNumRange][]*pendingMessage)
+	for _, p := range l.pending {
+		if p.committed != nil {
+			byRoot[p.committed.SeqNumsRange] = append(byRoot[p.committed.SeqNumsRange], p)
+		}
+	}
+	seqNumRanges := make([]cciptypes.SeqNumRange, 0, len(byRoot))
+	for seqNumRange := range byRoot {
+		seqNumRanges = append(seqNumRanges, seqNumRange)
+	}
+	slices.SortFunc(seqNumRanges, func(a, b cciptypes.SeqNumRange) int {
+		return cmp.Compare(a.Start(), b.Start())
+	})
+
+	for _, seqNumRange := range seqNumRanges {
+		executed, err := l.reader.MessagesExecuted(ctx, l.filter.sourceChainSelector, seqNumRange)
+		if err != nil {
+			l.lggr.Errorw("MessagesExecuted failure", "err", err, "seqNumRange", seqNumRange)
+			continue
+		}
+
+		pending := byRoot[seqNumRange]
+		slices.SortFunc(pending, func(a, b *pendingMessage) int {
+			return cmp.Compare(a.msg.Header.SequenceNumber, b.msg.Header.SequenceNumber)
+		})
+		for _, p := range pending {
+			// a failed execution is followed by a manual execution, which is reported as well
+			execution, ok := executed[p.msg.Header.SequenceNumber]
+			if !ok || execution.EventCursor == p.executedCursor {
+				continue
+			}
+			p.executedCursor = execution.EventCursor
+			lifecycle := ccipreaderpkg.MessageLifecycle{Message: p.msg, Committed: p.committed, Executed: &execution}
+			l.ch <- createTriggerResponse(p.msg, ccipmessagecap.StageExecuted, lifecycle)
+			if !lifecycle.IsPending() {
+				delete(l.pending, p.msg.Header.MessageID)
+			}
+		}
+	}
+}
+
+// Create CCIP message trigger capability response
+func createTriggerResponse(
+	msg cciptypes.Message,
+	stage ccipmessagecap.Stage,
+	lifecycle ccipreaderpkg.MessageLifecycle,
+) capabilities.TriggerResponse {
+	msgMap, err := decodeMessage(msg)
+	if err != nil {
+		return capabilities.TriggerResponse{
+			Err: fmt.Errorf("error decoding message as map[string]any: %w", err),
+		}
+	}
+
+	output := &ccipmessagecap.Output{
+		Stage:               stage,
+		MessageID:           msg.Header.MessageID.String(),
+		SourceChainSelector: strconv.FormatUint(uint64(msg.Header.SourceChainSelector), 10),
+		DestChainSelector:   strconv.FormatUint(uint64(msg.Header.DestChainSelector), 10),
+		SeqNum:              uint64(msg.Header.SequenceNumber),
+		Message:             msgMap,
+	}
+	eventID := fmt.Sprintf("%s-%s", msg.Header.MessageID, stage)
+	switch stage {
+	case ccipmessagecap.StageCommitted:
+		output.MerkleRoot = lifecycle.Committed.MerkleRoot.String()
+	case ccipmessagecap.StageExecuted:
+		output.ExecutionState = lifecycle.Executed.State.String()
+		eventID = fmt.Sprintf("%s-%s", eventID, lifecycle.Executed.EventCursor)
+	}
+
+	wrappedPayload, err := values.WrapMap(output)
+	if err != nil {
+		return capabilities.TriggerResponse{
+			Err: fmt.Errorf("error wrapping trigger event: %w", err),
+		}
+	}
+
+	return capabilities.TriggerResponse{
+		Event: capabilities.TriggerEvent{
+			TriggerType: ID,
+			ID:          eventID,
+			Outputs:     wrappedPayload,
+		},
+	}
+}
+
+// decodeMessage returns the JSON representation of the message as a map. Numbers are kept as strings,
+// chain selectors do not fit in the integers of the workflow values.
+func decodeMessage(msg cciptypes.Message) (ccipmessagecap.OutputMessage, error) {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+	var m map[string]any
+	if err = d.Decode(&m); err != nil {
+		return nil, err
+	}
+	return numbersToStrings(m).(map[string]any), nil
+}
+
+func numbersToStrings(v any) any {
+	switch v := v.(type) {
+	case json.Number:
+		return v.String()
+	case map[string]any:
+		for k, e := range v {
+			v[k] = numbersToStrings(e)
+		}
+	case []any:
+		for i, e := range v {
+			v[i] = numbersToStrings(e)
+		}
+	}
+	return v
+}
+
+// Close stops polling the lane and closes its reader.
+// This function is called when UnregisterTrigger is called individually for a workflow.
+// When the whole capability service is stopped, all the triggers are closed.
+func (l *ccipMessageTrigger) Close() error {
+	close(l.stopChan)
+	<-l.done
+	l.ticker.Stop()
+	return l.reader.Close()
+}
+
+// messageFilter matches the messages of a lane sent by the sender or to the receiver of the trigger config.
+type messageFilter struct {
+	sourceChainSelector cciptypes.ChainSelector
+	destChainSelector   cciptypes.ChainSelector
+	sender              cciptypes.UnknownAddress
+	receiver            cciptypes.UnknownAddress
+	stages              map[ccipmessagecap.Stage]bool
+}
+
+func newMessageFilter(cfg *ccipmessagecap.Config) (messageFilter, error) {
+	var f messageFilter
+	sourceChainSelector, err := strconv.ParseUint(cfg.SourceChainSelector, 10, 64)
+	if err != nil {
+		return f, fmt.Errorf("invalid sourceChainSelector: %w", err)
+	}
+	destChainSelector, err := strconv.ParseUint(cfg.DestChainSelector, 10, 64)
+	if err != nil {
+		return f, fmt.Errorf("invalid destChainSelector: %w", err)
+	}
+	f.sourceChainSelector = cciptypes.ChainSelector(sourceChainSelector)
+	f.destChainSelector = cciptypes.ChainSelector(destChainSelector)
+
+	if cfg.Sender == nil && cfg.Receiver == nil {
+		return f, errors.New("at least one of sender or receiver is required")
+	}
+	if cfg.Sender != nil {
+		f.sender, err = defaults.DefaultAddressCodec.AddressStringToBytes(*cfg.Sender, f.sourceChainSelector)
+		if err != nil {
+			return f, fmt.Errorf("invalid sender: %w", err)
+		}
+	}
+	if cfg.Receiver != nil {
+		f.receiver, err = defaults.DefaultAddressCodec.AddressStringToBytes(*cfg.Receiver, f.destChainSelector)
+		if err != nil {
+			return f, fmt.Errorf("invalid receiver: %w", err)
+		}
+	}
+
+	stages := cfg.Stages
+	if len(stages) == 0 {
+		stages = []ccipmessagecap.Stage{ccipmessagecap.StageSent, ccipmessagecap.StageCommitted, ccipmessagecap.StageExecuted}
+	}
+	f.stages = make(map[ccipmessagecap.Stage]bool, len(stages))
+	for _, stage := range stages {
+		f.stages[stage] = true
+	}
+	return f, nil
+}
+
+func (f messageFilter) matches(msg cciptypes.Message) bool {
+	if msg.Header.DestChainSelector != f.destChainSelector {
+		return false
+	}
+	if f.sender != nil && !addressEqual(msg.Sender, f.sender) {
+		return false
+	}
+	return f.receiver == nil || addressEqual(msg.Receiver, f.receiver)
+}
+
+// addressEqual compares addresses ignoring their left padding, EVM receivers of the messages are abi encoded.
+func addressEqual(a, b cciptypes.UnknownAddress) bool {
+	return bytes.Equal(bytes.TrimLeft(a, "\x00"), bytes.TrimLeft(b, "\x00"))
+}
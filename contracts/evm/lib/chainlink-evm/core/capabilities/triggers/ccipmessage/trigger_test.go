@@ -0,0 +1,244 @@
+package ccipmessage
+
+import (
+	"context"
+	"encoding/binary"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	chainsel "github.com/smartcontractkit/chain-selectors"
+
+	ccipreaderpkg "github.com/smartcontractkit/chainlink-ccip/pkg/reader"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+	"github.com/smartcontractkit/chainlink-common/pkg/types/query/primitives"
+
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/triggers/ccipmessage/ccipmessagecap"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+var (
+	source   = cciptypes.ChainSelector(chainsel.TEST_90000001.Selector)
+	dest     = cciptypes.ChainSelector(chainsel.ETHEREUM_MAINNET.Selector)
+	sender   = common.HexToAddress("0x2E396ecbc8223Ebc16EC45136228AE5EDB649943")
+	receiver = common.HexToAddress("0x779877A7B0D9E8603169DdbD7836e478b4624789")
+)
+
+func TestCCIPMessageTrigger_Poll(t *testing.T) {
+	ctx := t.Context()
+
+	matching := newMessage(2, sender)
+	reader := &fakeLaneReader{
+		latest: 2,
+		msgs:   []cciptypes.Message{newMessage(1, common.Address{1}), matching},
+	}
+	filter, err := newMessageFilter(&ccipmessagecap.Config{
+		SourceChainSelector: strconv.FormatUint(uint64(source), 10),
+		DestChainSelector:   strconv.FormatUint(uint64(dest), 10),
+		OffRamp:             common.Address{2}.Hex(),
+		Sender:              ptr(sender.Hex()),
+		Receiver:            ptr(receiver.Hex()),
+	})
+	require.NoError(t, err)
+
+	trigger, ch, err := newCCIPMessageTrigger(ctx, logger.TestLogger(t), "workflow", filter, reader, Config{LookbackSeqNums: 2})
+	require.NoError(t, err)
+	t.Cleanup(trigger.ticker.Stop)
+	assert.Equal(t, cciptypes.SeqNum(1), trigger.nextSeqNum)
+
+	// only the message of the sender is matched
+	trigger.pollSent(ctx)
+	output := receive(t, ch, matching.Header.MessageID.String()+"-sent")
+	assert.Equal(t, ccipmessagecap.StageSent, output.Stage)
+	assert.Equal(t, strconv.FormatUint(uint64(source), 10), output.SourceChainSelector)
+	assert.Equal(t, strconv.FormatUint(uint64(dest), 10), output.DestChainSelector)
+	assert.Equal(t, uint64(2), output.SeqNum)
+	assert.Equal(t, strconv.FormatUint(uint64(source), 10), output.Message["header"].(map[string]any)["sourceChainSelector"])
+	assert.Empty(t, output.MerkleRoot)
+	assert.Equal(t, cciptypes.SeqNum(3), trigger.nextSeqNum)
+
+	// nothing is reported until the message is committed
+	trigger.pollPending(ctx)
+	assert.Empty(t, ch)
+
+	// the commit and the execution of the messages are read in batches
+	reader.reports = []cciptypes.CommitPluginReportWithMeta{{
+		Report: cciptypes.CommitPluginReport{BlessedMerkleRoots: []cciptypes.MerkleRootChain{{
+			ChainSel:     source,
+			SeqNumsRange: cciptypes.NewSeqNumRange(1, 2),
+			MerkleRoot:   cciptypes.Bytes32{7},
+		}}},
+		Timestamp: time.Unix(100, 0),
+	}}
+	reader.executed = map[cciptypes.SeqNum]ccipreaderpkg.MessageExecuted{
+		2: {State: ccipreaderpkg.ExecutionStateFailure, EventCursor: "a"},
+	}
+	trigger.pollPending(ctx)
+	output = receive(t, ch, matching.Header.MessageID.String()+"-committed")
+	assert.Equal(t, cciptypes.Bytes32{7}.String(), output.MerkleRoot)
+	output = receive(t, ch, matching.Header.MessageID.String()+"-executed-a")
+	assert.Equal(t, "failure", output.ExecutionState)
+	require.Contains(t, trigger.pending, matching.Header.MessageID)
+	assert.Equal(t, time.Unix(100, 0), trigger.commitsSince)
+	assert.Equal(t, []cciptypes.SeqNumRange{cciptypes.NewSeqNumRange(1, 2)}, reader.executedQueries)
+
+	// the same commit and execution are not reported twice
+	trigger.pollPending(ctx)
+	assert.Empty(t, ch)
+
+	// the message is no longer followed once it is manually executed
+	reader.executed[2] = ccipreaderpkg.MessageExecuted{State: ccipreaderpkg.ExecutionStateSuccess, EventCursor: "b"}
+	trigger.pollPending(ctx)
+	output = receive(t, ch, matching.Header.MessageID.String()+"-executed-b")
+	assert.Equal(t, "success", output.ExecutionState)
+	assert.Empty(t, trigger.pending)
+}
+
+func TestCCIPMessageTrigger_MaxPending(t *testing.T) {
+	ctx := t.Context()
+
+	msgs := make([]cciptypes.Message, 0, maxPendingMessages+1)
+	for seqNum := cciptypes.SeqNum(1); seqNum <= maxPendingMessages+1; seqNum++ {
+		msg := newMessage(seqNum, sender)
+		binary.BigEndian.PutUint64(msg.Header.MessageID[:], uint64(seqNum))
+		msgs = append(msgs, msg)
+	}
+	reader := &fakeLaneReader{latest: maxPendingMessages + 1, msgs: msgs}
+	filter, err := newMessageFilter(&ccipmessagecap.Config{
+		SourceChainSelector: strconv.FormatUint(uint64(source), 10),
+		DestChainSelector:   strconv.FormatUint(uint64(dest), 10),
+		OffRamp:             common.Address{2}.Hex(),
+		Sender:              ptr(sender.Hex()),
+		Stages:              []ccipmessagecap.Stage{ccipmessagecap.StageExecuted},
+	})
+	require.NoError(t, err)
+
+	trigger, _, err := newCCIPMessageTrigger(ctx, logger.TestLogger(t), "workflow", filter, reader,
+		Config{QueryCount: maxPendingMessages + 1, LookbackSeqNums: maxPendingMessages + 1})
+	require.NoError(t, err)
+	t.Cleanup(trigger.ticker.Stop)
+
+	// the oldest message is no longer followed
+	trigger.pollSent(ctx)
+	assert.Len(t, trigger.pending, maxPendingMessages)
+	assert.NotContains(t, trigger.pending, msgs[0].Header.MessageID)
+	assert.Contains(t, trigger.pending, msgs[maxPendingMessages].Header.MessageID)
+}
+
+func TestNewMessageFilter(t *testing.T) {
+	cfg := ccipmessagecap.Config{
+		SourceChainSelector: strconv.FormatUint(uint64(source), 10),
+		DestChainSelector:   strconv.FormatUint(uint64(dest), 10),
+		OffRamp:             common.Address{2}.Hex(),
+	}
+	_, err := newMessageFilter(&cfg)
+	require.ErrorContains(t, err, "at least one of sender or receiver is required")
+
+	cfg.Receiver = ptr("not an address")
+	_, err = newMessageFilter(&cfg)
+	require.ErrorContains(t, err, "invalid receiver")
+
+	cfg.Receiver = ptr(receiver.Hex())
+	cfg.Stages = []ccipmessagecap.Stage{ccipmessagecap.StageExecuted}
+	filter, err := newMessageFilter(&cfg)
+	require.NoError(t, err)
+	assert.Equal(t, map[ccipmessagecap.Stage]bool{ccipmessagecap.StageExecuted: true}, filter.stages)
+	assert.True(t, filter.matches(newMessage(1, common.Address{1})))
+
+	msg := newMessage(1, sender)
+	msg.Header.DestChainSelector = source
+	assert.False(t, filter.matches(msg))
+}
+
+func receive(t *testing.T, ch <-chan capabilities.TriggerResponse, eventID string) ccipmessagecap.Output {
+	require.NotEmpty(t, ch)
+	resp := <-ch
+	require.NoError(t, resp.Err)
+	assert.Equal(t, ID, resp.Event.TriggerType)
+	assert.Equal(t, eventID, resp.Event.ID)
+
+	var output ccipmessagecap.Output
+	require.NoError(t, resp.Event.Outputs.UnwrapTo(&output))
+	return output
+}
+
+func newMessage(seqNum cciptypes.SeqNum, from common.Address) cciptypes.Message {
+	return cciptypes.Message{
+		Header: cciptypes.RampMessageHeader{
+			MessageID:           cciptypes.Bytes32{byte(seqNum)},
+			SourceChainSelector: source,
+			DestChainSelector:   dest,
+			SequenceNumber:      seqNum,
+		},
+		Sender:   from.Bytes(),
+		Receiver: common.LeftPadBytes(receiver.Bytes(), 32),
+	}
+}
+
+func ptr[T any](v T) *T { return &v }
+
+type fakeLaneReader struct {
+	ccipreaderpkg.CCIPReader
+	latest          cciptypes.SeqNum
+	msgs            []cciptypes.Message
+	reports         []cciptypes.CommitPluginReportWithMeta
+	executed        map[cciptypes.SeqNum]ccipreaderpkg.MessageExecuted
+	executedQueries []cciptypes.SeqNumRange
+}
+
+func (f *fakeLaneReader) LatestMsgSeqNum(context.Context, cciptypes.ChainSelector) (cciptypes.SeqNum, error) {
+	return f.latest, nil
+}
+
+func (f *fakeLaneReader) MsgsBetweenSeqNums(
+	_ context.Context,
+	_ cciptypes.ChainSelector,
+	seqNumRange cciptypes.SeqNumRange,
+) ([]cciptypes.Message, error) {
+	var msgs []cciptypes.Message
+	for _, msg := range f.msgs {
+		if seqNumRange.Contains(msg.Header.SequenceNumber) {
+			msgs = append(msgs, msg)
+		}
+	}
+	return msgs, nil
+}
+
+func (f *fakeLaneReader) CommitReportsGTETimestamp(
+	_ context.Context,
+	ts time.Time,
+	_ primitives.ConfidenceLevel,
+	limit int,
+) ([]cciptypes.CommitPluginReportWithMeta, error) {
+	var reports []cciptypes.CommitPluginReportWithMeta
+	for _, report := range f.reports {
+		if !report.Timestamp.Before(ts) && len(reports) < limit {
+			reports = append(reports, report)
+		}
+	}
+	return reports, nil
+}
+
+func (f *fakeLaneReader) MessagesExecuted(
+	_ context.Context,
+	_ cciptypes.ChainSelector,
+	seqNumRange cciptypes.SeqNumRange,
+) (map[cciptypes.SeqNum]ccipreaderpkg.MessageExecuted, error) {
+	f.executedQueries = append(f.executedQueries, seqNumRange)
+	executed := make(map[cciptypes.SeqNum]ccipreaderpkg.MessageExecuted)
+	for seqNum, e := range f.executed {
+		if seqNumRange.Contains(seqNum) {
+			executed[seqNum] = e
+		}
+	}
+	return executed, nil
+}
+
+func (f *fakeLaneReader) Close() error {
+	return nil
+}
@@ -160,6 +160,11 @@ func NewApp(s *Shell) *cli.App {
 			Usage:       "Commands for Bridges communicating with External Adapters",
 			Subcommands: initBrideSubCmds(s),
 		},
+		{
+			Name:        "ccip",
+			Usage:       "Commands for CCIP",
+			Subcommands: initCCIPSubCmds(s),
+		},
 		{
 			Name:        "config",
 			Usage:       "Commands for the node's configuration",
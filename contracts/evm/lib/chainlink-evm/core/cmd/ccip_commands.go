@@ -0,0 +1,542 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func initCCIPSubCmds(s *Shell) []cli.Command {
+	return []cli.Command{
+		{
+			Name:      "message-status",
+			Usage:     "Show the lifecycle of a CCIP message: sent, committed, executed or why it is still pending",
+			ArgsUsage: "<message ID>",
+			Action:    s.CCIPMessageStatus,
+			Flags:     ccipMessageFlags,
+		},
+		{
+			Name:      "manual-exec",
+			Usage:     "Build the execution report to manually execute a committed CCIP message, without sending it",
+			ArgsUsage: "<message ID>",
+			Action:    s.CCIPManualExec,
+			Flags:     ccipMessageFlags,
+		},
+		{
+			Name:   "lanes",
+			Usage:  "List the CCIP lanes served by the node with their prices and the state of the plugins reporting to them",
+			Action: s.CCIPLanes,
+		},
+		{
+			Name:   "launcher-status",
+			Usage:  "List the CCIP DONs and OCR instances run by the CCIP capability launchers of the node",
+			Action: s.CCIPLauncherStatus,
+		},
+		{
+			Name:      "launcher-what-if",
+			Usage:     "Show what the CCIP capability launcher of a job would launch, shut down or promote for a proposed registry state",
+			ArgsUsage: "<job ID>",
+			Action:    s.CCIPLauncherWhatIf,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:     "registry",
+					Usage:    "Path to the proposed capability registry state, as JSON in the format stored by the registry syncer",
+					Required: true,
+				},
+			},
+		},
+		{
+			Name:   "offchain-configs",
+			Usage:  "Show the decoded commit and exec offchain configs of the OCR instances run by the node",
+			Action: s.CCIPOffchainConfigs,
+		},
+		{
+			Name:   "curse-state",
+			Usage:  "Show the RMN curses applying to a CCIP destination chain",
+			Action: s.CCIPCurseState,
+			Flags: []cli.Flag{
+				cli.Uint64Flag{
+					Name:     "dest-chain-selector",
+					Usage:    "Chain selector of the destination chain",
+					Required: true,
+				},
+				cli.StringFlag{
+					Name:     "offramp",
+					Usage:    "Address of the OffRamp on the destination chain",
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+var ccipMessageFlags = []cli.Flag{
+	cli.Uint64Flag{
+		Name:     "source-chain-selector",
+		Usage:    "Chain selector of the chain the message was sent from",
+		Required: true,
+	},
+	cli.Uint64Flag{
+		Name:     "dest-chain-selector",
+		Usage:    "Chain selector of the chain the message is sent to",
+		Required: true,
+	},
+	cli.StringFlag{
+		Name:     "offramp",
+		Usage:    "Address of the OffRamp on the destination chain",
+		Required: true,
+	},
+}
+
+// CCIPMessageStatusPresenter implements TableRenderer for a CCIPMessageStatusResource.
+type CCIPMessageStatusPresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.CCIPMessageStatusResource
+}
+
+var ccipMessageStatusHeaders = []string{
+	"Message ID", "Stage", "Seq Num", "Sent Block", "Sent At", "Finalized", "Merkle Root", "Blessed",
+	"Report Timestamp", "Execution State", "Execution Event", "Pending Reasons",
+}
+
+// ToRow presents the CCIPMessageStatusResource as a slice of strings.
+func (p *CCIPMessageStatusPresenter) ToRow() []string {
+	var merkleRoot, blessed, reportTimestamp string
+	if p.Committed != nil {
+		merkleRoot = p.Committed.MerkleRoot.String()
+		blessed = strconv.FormatBool(p.Committed.Blessed)
+		reportTimestamp = p.Committed.ReportTimestamp.Format(time.RFC3339)
+	}
+	var executionState, executionEvent string
+	if p.Executed != nil {
+		executionState = p.Executed.State.String()
+		executionEvent = p.Executed.EventCursor
+	}
+	pendingReasons := make([]string, 0, len(p.PendingReasons))
+	for _, reason := range p.PendingReasons {
+		pendingReasons = append(pendingReasons, string(reason))
+	}
+
+	return []string{
+		p.GetID(),
+		p.Stage,
+		strconv.FormatUint(uint64(p.Sent.SeqNum), 10),
+		strconv.FormatUint(p.Sent.BlockNum, 10),
+		p.Sent.Timestamp.Format(time.RFC3339),
+		strconv.FormatBool(p.Sent.Finalized),
+		merkleRoot,
+		blessed,
+		reportTimestamp,
+		executionState,
+		executionEvent,
+		strings.Join(pendingReasons, ", "),
+	}
+}
+
+// RenderTable implements TableRenderer
+func (p CCIPMessageStatusPresenter) RenderTable(rt RendererTable) error {
+	renderList(ccipMessageStatusHeaders, [][]string{p.ToRow()}, rt.Writer)
+	return nil
+}
+
+// CCIPMessageStatus shows the lifecycle of a CCIP message.
+func (s *Shell) CCIPMessageStatus(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the message ID"))
+	}
+
+	resp, err := s.HTTP.Get(s.ctx(), fmt.Sprintf("/v2/ccip/messages/%s?%s", c.Args().First(), ccipMessageQuery(c)))
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &CCIPMessageStatusPresenter{}, "CCIP Message Status")
+}
+
+func ccipMessageQuery(c *cli.Context) string {
+	v := url.Values{}
+	v.Add("sourceChainSelector", strconv.FormatUint(c.Uint64("source-chain-selector"), 10))
+	v.Add("destChainSelector", strconv.FormatUint(c.Uint64("dest-chain-selector"), 10))
+	v.Add("offRamp", c.String("offramp"))
+	return v.Encode()
+}
+
+// CCIPManualExecPresenter implements TableRenderer for a CCIPManualExecProposalResource.
+type CCIPManualExecPresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.CCIPManualExecProposalResource
+}
+
+var ccipManualExecHeaders = []string{"Message ID", "Seq Num", "Merkle Root", "Proofs", "Proof Flag Bits"}
+
+// ToRow presents the CCIPManualExecProposalResource as a slice of strings.
+func (p *CCIPManualExecPresenter) ToRow() []string {
+	proofs := make([]string, 0, len(p.Report.Proofs))
+	for _, proof := range p.Report.Proofs {
+		proofs = append(proofs, proof.String())
+	}
+
+	return []string{
+		p.GetID(),
+		strconv.FormatUint(uint64(p.SeqNum), 10),
+		p.MerkleRoot.String(),
+		strings.Join(proofs, ", "),
+		p.Report.ProofFlagBits.String(),
+	}
+}
+
+// RenderTable implements TableRenderer
+func (p CCIPManualExecPresenter) RenderTable(rt RendererTable) error {
+	renderList(ccipManualExecHeaders, [][]string{p.ToRow()}, rt.Writer)
+	_, err := fmt.Fprintf(rt, "\nEncoded report:\n%s\n", p.EncodedReport)
+	return err
+}
+
+// CCIPManualExec builds the execution report to manually execute a committed CCIP message.
+func (s *Shell) CCIPManualExec(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the message ID"))
+	}
+
+	path := fmt.Sprintf("/v2/ccip/messages/%s/manual_exec?%s", c.Args().First(), ccipMessageQuery(c))
+	resp, err := s.HTTP.Post(s.ctx(), path, nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &CCIPManualExecPresenter{}, "CCIP Manual Execution Proposal")
+}
+
+// CCIPLaneStatusPresenter implements TableRenderer for a CCIPLaneStatusResource.
+type CCIPLaneStatusPresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.CCIPLaneStatusResource
+}
+
+var ccipLaneStatusHeaders = []string{
+	"Source Chain Selector", "Dest Chain Selector", "Gas Price", "Token Prices", "Plugin Type", "Config Digest", "State",
+	"Exec State", "Exec Seq Nr", "Reported Messages", "Last Transmission",
+}
+
+// ToRows presents the CCIPLaneStatusResource as a row per plugin reporting to the destination chain.
+func (p *CCIPLaneStatusPresenter) ToRows() [][]string {
+	lane := []string{
+		strconv.FormatUint(p.SourceChainSelector, 10),
+		strconv.FormatUint(p.DestChainSelector, 10),
+		p.GasPrice,
+		strconv.Itoa(len(p.TokenPrices)),
+	}
+	if len(p.Plugins) == 0 {
+		return [][]string{append(lane, "", "", "", "", "", "", "")}
+	}
+
+	rows := make([][]string, 0, len(p.Plugins))
+	for _, plugin := range p.Plugins {
+		var execState, execSeqNr string
+		if plugin.Exec != nil {
+			execState = plugin.Exec.State
+			execSeqNr = strconv.FormatUint(plugin.Exec.SeqNr, 10)
+		}
+		var lastTransmission string
+		if len(plugin.Transmissions) > 0 {
+			t := plugin.Transmissions[0]
+			lastTransmission = fmt.Sprintf("seqNr %d at %s", t.SeqNr, t.TransmittedAt.Format(time.RFC3339))
+			if t.Error != "" {
+				lastTransmission += ": " + t.Error
+			}
+		}
+		rows = append(rows, append(slices.Clone(lane),
+			plugin.PluginType,
+			plugin.ConfigDigest,
+			plugin.State,
+			execState,
+			execSeqNr,
+			strconv.Itoa(len(plugin.Reported)),
+			lastTransmission,
+		))
+	}
+	return rows
+}
+
+// CCIPLaneStatusPresenters implements TableRenderer for a slice of CCIPLaneStatusPresenter.
+type CCIPLaneStatusPresenters []CCIPLaneStatusPresenter
+
+// RenderTable implements TableRenderer
+func (ps CCIPLaneStatusPresenters) RenderTable(rt RendererTable) error {
+	var rows [][]string
+	for _, p := range ps {
+		rows = append(rows, p.ToRows()...)
+	}
+	renderList(ccipLaneStatusHeaders, rows, rt.Writer)
+	return nil
+}
+
+// CCIPLanes lists the CCIP lanes served by the node.
+func (s *Shell) CCIPLanes(_ *cli.Context) (err error) {
+	resp, err := s.HTTP.Get(s.ctx(), "/v2/ccip/lanes")
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &CCIPLaneStatusPresenters{}, "CCIP Lanes")
+}
+
+// CCIPLauncherStatusPresenter implements TableRenderer for a CCIPLauncherStatusResource.
+type CCIPLauncherStatusPresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.CCIPLauncherStatusResource
+}
+
+var ccipLauncherStatusHeaders = []string{
+	"Job ID", "Capability", "Bootstrap", "DON ID", "DON Config Version", "Member", "Plugin Type", "State",
+	"Config Digest", "OCR Config Version", "Chain Selector",
+}
+
+// ToRows presents the CCIPLauncherStatusResource as a row per OCR instance.
+func (p *CCIPLauncherStatusPresenter) ToRows() [][]string {
+	var rows [][]string
+	for _, don := range p.DONs {
+		for _, plugin := range don.Plugins {
+			rows = append(rows, []string{
+				p.GetID(),
+				p.CapabilityID,
+				strconv.FormatBool(p.IsBootstrap),
+				strconv.FormatUint(uint64(don.ID), 10),
+				strconv.FormatUint(uint64(don.ConfigVersion), 10),
+				strconv.FormatBool(don.IsMember),
+				plugin.PluginType,
+				plugin.State,
+				plugin.ConfigDigest,
+				strconv.FormatUint(uint64(plugin.ConfigVersion), 10),
+				strconv.FormatUint(plugin.ChainSelector, 10),
+			})
+		}
+	}
+	return rows
+}
+
+// CCIPLauncherStatusPresenters implements TableRenderer for a slice of CCIPLauncherStatusPresenter.
+type CCIPLauncherStatusPresenters []CCIPLauncherStatusPresenter
+
+// RenderTable implements TableRenderer
+func (ps CCIPLauncherStatusPresenters) RenderTable(rt RendererTable) error {
+	var rows [][]string
+	for _, p := range ps {
+		rows = append(rows, p.ToRows()...)
+	}
+	renderList(ccipLauncherStatusHeaders, rows, rt.Writer)
+	return nil
+}
+
+// CCIPLauncherStatus lists the CCIP DONs and OCR instances run by the CCIP capability launchers.
+func (s *Shell) CCIPLauncherStatus(_ *cli.Context) (err error) {
+	resp, err := s.HTTP.Get(s.ctx(), "/v2/ccip/launchers")
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &CCIPLauncherStatusPresenters{}, "CCIP Launcher Status")
+}
+
+// CCIPLauncherPlanPresenter implements TableRenderer for a CCIPLauncherPlanResource.
+type CCIPLauncherPlanPresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.CCIPLauncherPlanResource
+}
+
+var ccipLauncherPlanHeaders = []string{
+	"DON ID", "Action", "Plugin Type", "State", "Config Digest", "OCR Config Version", "Chain Selector",
+}
+
+// ToRows presents the CCIPLauncherPlanResource as a row per action.
+func (p *CCIPLauncherPlanPresenter) ToRows() [][]string {
+	rows := make([][]string, 0, len(p.Actions))
+	for _, a := range p.Actions {
+		rows = append(rows, []string{
+			strconv.FormatUint(uint64(a.DonID), 10),
+			a.Action,
+			a.Plugin.PluginType,
+			a.Plugin.State,
+			a.Plugin.ConfigDigest,
+			strconv.FormatUint(uint64(a.Plugin.ConfigVersion), 10),
+			strconv.FormatUint(a.Plugin.ChainSelector, 10),
+		})
+	}
+	return rows
+}
+
+// RenderTable implements TableRenderer
+func (p CCIPLauncherPlanPresenter) RenderTable(rt RendererTable) error {
+	if _, err := fmt.Fprintf(rt, "Added DONs: %v\nRemoved DONs: %v\nUpdated DONs: %v\n\n",
+		p.Added, p.Removed, p.Updated); err != nil {
+		return err
+	}
+	renderList(ccipLauncherPlanHeaders, p.ToRows(), rt.Writer)
+	return nil
+}
+
+// CCIPLauncherWhatIf shows the actions the CCIP capability launcher of a job would take for a proposed
+// capability registry state, without executing them.
+func (s *Shell) CCIPLauncherWhatIf(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the job ID"))
+	}
+
+	registry, err := os.ReadFile(c.String("registry"))
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "could not read capability registry state"))
+	}
+
+	path := fmt.Sprintf("/v2/ccip/launchers/%s/what-if", c.Args().First())
+	resp, err := s.HTTP.Post(s.ctx(), path, bytes.NewReader(registry))
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &CCIPLauncherPlanPresenter{}, "CCIP Launcher What-If")
+}
+
+// CCIPOffchainConfigPresenter implements TableRenderer for a CCIPOffchainConfigResource.
+type CCIPOffchainConfigPresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.CCIPOffchainConfigResource
+}
+
+var ccipOffchainConfigHeaders = []string{"Job ID", "DON ID", "Plugin Type", "State", "Config Digest", "Chain Selector"}
+
+// ToRow presents the CCIPOffchainConfigResource as a slice of strings.
+func (p *CCIPOffchainConfigPresenter) ToRow() []string {
+	return []string{
+		strconv.FormatInt(int64(p.JobID), 10),
+		strconv.FormatUint(uint64(p.DonID), 10),
+		p.Plugin.PluginType,
+		p.Plugin.State,
+		p.Plugin.ConfigDigest,
+		strconv.FormatUint(p.Plugin.ChainSelector, 10),
+	}
+}
+
+// CCIPOffchainConfigPresenters implements TableRenderer for a slice of CCIPOffchainConfigPresenter.
+type CCIPOffchainConfigPresenters []CCIPOffchainConfigPresenter
+
+// RenderTable implements TableRenderer. The offchain config of each plugin is printed as JSON below its row.
+func (ps CCIPOffchainConfigPresenters) RenderTable(rt RendererTable) error {
+	for _, p := range ps {
+		renderList(ccipOffchainConfigHeaders, [][]string{p.ToRow()}, rt.Writer)
+		offchainConfig, err := json.MarshalIndent(p.OffchainConfig, "", "  ")
+		if err != nil {
+			return err
+		}
+		if _, err = fmt.Fprintf(rt, "%s\n\n", offchainConfig); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CCIPOffchainConfigs shows the decoded offchain configs of the CCIP OCR instances run by the node.
+func (s *Shell) CCIPOffchainConfigs(_ *cli.Context) (err error) {
+	resp, err := s.HTTP.Get(s.ctx(), "/v2/ccip/offchain_configs")
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &CCIPOffchainConfigPresenters{}, "CCIP Offchain Configs")
+}
+
+// CCIPCurseStatePresenter implements TableRenderer for a CCIPCurseStateResource.
+type CCIPCurseStatePresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.CCIPCurseStateResource
+}
+
+var ccipCurseStateHeaders = []string{"Dest Chain Selector", "Global Curse", "Cursed Destination", "Cursed Source Chains"}
+
+// ToRow presents the CCIPCurseStateResource as a slice of strings.
+func (p *CCIPCurseStatePresenter) ToRow() []string {
+	cursedSourceChains := make([]string, 0, len(p.CursedSourceChains))
+	for _, chainSelector := range p.CursedSourceChains {
+		cursedSourceChains = append(cursedSourceChains, strconv.FormatUint(chainSelector, 10))
+	}
+
+	return []string{
+		p.GetID(),
+		strconv.FormatBool(p.GlobalCurse),
+		strconv.FormatBool(p.CursedDestination),
+		strings.Join(cursedSourceChains, ", "),
+	}
+}
+
+// RenderTable implements TableRenderer
+func (p CCIPCurseStatePresenter) RenderTable(rt RendererTable) error {
+	renderList(ccipCurseStateHeaders, [][]string{p.ToRow()}, rt.Writer)
+	return nil
+}
+
+// CCIPCurseState shows the RMN curses applying to a CCIP destination chain.
+func (s *Shell) CCIPCurseState(c *cli.Context) (err error) {
+	v := url.Values{}
+	v.Add("destChainSelector", strconv.FormatUint(c.Uint64("dest-chain-selector"), 10))
+	v.Add("offRamp", c.String("offramp"))
+
+	resp, err := s.HTTP.Get(s.ctx(), "/v2/ccip/curses?"+v.Encode())
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &CCIPCurseStatePresenter{}, "CCIP Curse State")
+}
@@ -36,6 +36,7 @@ type AppConfig interface {
 
 	AuditLogger() AuditLogger
 	AutoPprof() AutoPprof
+	CCIP() CCIP
 	Capabilities() Capabilities
 	Workflows() Workflows
 	Database() Database
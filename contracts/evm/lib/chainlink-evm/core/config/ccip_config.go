@@ -0,0 +1,40 @@
+package config
+
+import "time"
+
+type CCIPTokenData interface {
+	PersistentCacheDir() string
+}
+
+type CCIPPriceHistory interface {
+	Retention() time.Duration
+	PruneInterval() time.Duration
+}
+
+type CCIPLaneMonitor interface {
+	Enabled() bool
+	Interval() time.Duration
+	DiscoveryInterval() time.Duration
+	ExecScanLimit() uint64
+	CommitLagThreshold() uint64
+	ExecLagThreshold() uint64
+	OldestPendingAgeThreshold() time.Duration
+	AlertOnCurse() bool
+}
+
+type CCIPMessageTrigger interface {
+	Enabled() bool
+	PollPeriod() time.Duration
+	QueryCount() uint64
+	LookbackSeqNums() uint64
+}
+
+type CCIP interface {
+	// AttestationCredentials returns the auth header values of the token data attestation APIs, keyed by the
+	// credentials name referenced from the CCIP exec offchain config.
+	AttestationCredentials() map[string]string
+	TokenData() CCIPTokenData
+	PriceHistory() CCIPPriceHistory
+	LaneMonitor() CCIPLaneMonitor
+	MessageTrigger() CCIPMessageTrigger
+}
@@ -62,6 +62,7 @@ type Core struct {
 	Telemetry        Telemetry        `toml:",omitempty"`
 	Workflows        Workflows        `toml:",omitempty"`
 	CRE              CreConfig        `toml:",omitempty"`
+	CCIP             CCIP             `toml:",omitempty"`
 }
 
 // SetFrom updates c with any non-nil values from f. (currently TOML field only!)
@@ -104,6 +105,7 @@ func (c *Core) SetFrom(f *Core) {
 	c.Tracing.setFrom(&f.Tracing)
 	c.Telemetry.setFrom(&f.Telemetry)
 	c.CRE.setFrom(&f.CRE)
+	c.CCIP.setFrom(&f.CCIP)
 }
 
 func (c *Core) ValidateConfig() (err error) {
@@ -136,6 +138,7 @@ type Secrets struct {
 	EVM        EthKeys                  `toml:",omitempty"` // choose EVM as the TOML field name to align with relayer config convention
 	P2PKey     P2PKey                   `toml:",omitempty"`
 	CRE        CreSecrets               `toml:",omitempty"`
+	CCIP       CCIPSecrets              `toml:",omitempty"`
 }
 
 type EthKeys struct {
@@ -1651,6 +1654,192 @@ func (c *CreSecrets) validateMerge(f *CreSecrets) (err error) {
 	return err
 }
 
+type CCIP struct {
+	TokenData      CCIPTokenData      `toml:",omitempty"`
+	PriceHistory   CCIPPriceHistory   `toml:",omitempty"`
+	LaneMonitor    CCIPLaneMonitor    `toml:",omitempty"`
+	MessageTrigger CCIPMessageTrigger `toml:",omitempty"`
+}
+
+func (c *CCIP) setFrom(f *CCIP) {
+	c.TokenData.setFrom(&f.TokenData)
+	c.PriceHistory.setFrom(&f.PriceHistory)
+	c.LaneMonitor.setFrom(&f.LaneMonitor)
+	c.MessageTrigger.setFrom(&f.MessageTrigger)
+}
+
+type CCIPTokenData struct {
+	PersistentCacheDir *string
+}
+
+func (c *CCIPTokenData) setFrom(f *CCIPTokenData) {
+	if v := f.PersistentCacheDir; v != nil {
+		c.PersistentCacheDir = v
+	}
+}
+
+func (c *CCIPTokenData) ValidateConfig() (err error) {
+	if *c.PersistentCacheDir != "" && !isValidFilePath(*c.PersistentCacheDir) {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "PersistentCacheDir", Value: *c.PersistentCacheDir, Msg: "must be a valid directory path"})
+	}
+	return
+}
+
+type CCIPPriceHistory struct {
+	Retention     *commonconfig.Duration
+	PruneInterval *commonconfig.Duration
+}
+
+func (c *CCIPPriceHistory) setFrom(f *CCIPPriceHistory) {
+	if v := f.Retention; v != nil {
+		c.Retention = v
+	}
+	if v := f.PruneInterval; v != nil {
+		c.PruneInterval = v
+	}
+}
+
+func (c *CCIPPriceHistory) ValidateConfig() (err error) {
+	if c.PruneInterval.Duration() == 0 {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "PruneInterval", Value: *c.PruneInterval, Msg: "must be greater than zero"})
+	}
+	return
+}
+
+type CCIPLaneMonitor struct {
+	Enabled                   *bool
+	Interval                  *commonconfig.Duration
+	DiscoveryInterval         *commonconfig.Duration
+	ExecScanLimit             *uint64
+	CommitLagThreshold        *uint64
+	ExecLagThreshold          *uint64
+	OldestPendingAgeThreshold *commonconfig.Duration
+	AlertOnCurse              *bool
+}
+
+func (c *CCIPLaneMonitor) setFrom(f *CCIPLaneMonitor) {
+	if v := f.Enabled; v != nil {
+		c.Enabled = v
+	}
+	if v := f.Interval; v != nil {
+		c.Interval = v
+	}
+	if v := f.DiscoveryInterval; v != nil {
+		c.DiscoveryInterval = v
+	}
+	if v := f.ExecScanLimit; v != nil {
+		c.ExecScanLimit = v
+	}
+	if v := f.CommitLagThreshold; v != nil {
+		c.CommitLagThreshold = v
+	}
+	if v := f.ExecLagThreshold; v != nil {
+		c.ExecLagThreshold = v
+	}
+	if v := f.OldestPendingAgeThreshold; v != nil {
+		c.OldestPendingAgeThreshold = v
+	}
+	if v := f.AlertOnCurse; v != nil {
+		c.AlertOnCurse = v
+	}
+}
+
+func (c *CCIPLaneMonitor) ValidateConfig() (err error) {
+	if c.Interval.Duration() == 0 {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "Interval", Value: *c.Interval, Msg: "must be greater than zero"})
+	}
+	if c.DiscoveryInterval.Duration() == 0 {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "DiscoveryInterval", Value: *c.DiscoveryInterval, Msg: "must be greater than zero"})
+	}
+	if *c.ExecScanLimit == 0 {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "ExecScanLimit", Value: *c.ExecScanLimit, Msg: "must be greater than zero"})
+	}
+	return
+}
+
+type CCIPMessageTrigger struct {
+	Enabled         *bool
+	PollPeriod      *commonconfig.Duration
+	QueryCount      *uint64
+	LookbackSeqNums *uint64
+}
+
+func (c *CCIPMessageTrigger) setFrom(f *CCIPMessageTrigger) {
+	if v := f.Enabled; v != nil {
+		c.Enabled = v
+	}
+	if v := f.PollPeriod; v != nil {
+		c.PollPeriod = v
+	}
+	if v := f.QueryCount; v != nil {
+		c.QueryCount = v
+	}
+	if v := f.LookbackSeqNums; v != nil {
+		c.LookbackSeqNums = v
+	}
+}
+
+func (c *CCIPMessageTrigger) ValidateConfig() (err error) {
+	if c.PollPeriod.Duration() == 0 {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "PollPeriod", Value: *c.PollPeriod, Msg: "must be greater than zero"})
+	}
+	if *c.QueryCount == 0 {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "QueryCount", Value: *c.QueryCount, Msg: "must be greater than zero"})
+	}
+	return
+}
+
+type CCIPAttestationCredentials struct {
+	// AuthHeaderValue is the value of the auth header sent to the attestation API
+	AuthHeaderValue *models.Secret
+}
+
+type CCIPSecrets struct {
+	// AttestationCredentials are referenced by name from the token data observers of the CCIP exec offchain config
+	AttestationCredentials map[string]CCIPAttestationCredentials `toml:",omitempty"`
+}
+
+func (c *CCIPSecrets) SetFrom(f *CCIPSecrets) (err error) {
+	err = c.validateMerge(f)
+	if err != nil {
+		return err
+	}
+
+	if c.AttestationCredentials != nil && f.AttestationCredentials != nil {
+		for k, v := range f.AttestationCredentials {
+			c.AttestationCredentials[k] = v
+		}
+	} else if v := f.AttestationCredentials; v != nil {
+		c.AttestationCredentials = v
+	}
+
+	return nil
+}
+
+func (c *CCIPSecrets) validateMerge(f *CCIPSecrets) (err error) {
+	if c.AttestationCredentials != nil && f.AttestationCredentials != nil {
+		for k := range f.AttestationCredentials {
+			if _, exists := c.AttestationCredentials[k]; exists {
+				err = multierr.Append(err, configutils.ErrOverride{Name: fmt.Sprintf("AttestationCredentials[\"%s\"]", k)})
+			}
+		}
+	}
+
+	return err
+}
+
+func (c *CCIPSecrets) ValidateConfig() (err error) {
+	for name, creds := range c.AttestationCredentials {
+		if name == "" {
+			err = multierr.Append(err, configutils.ErrEmpty{Name: "AttestationCredentials", Msg: "name must be provided and non-empty"})
+		}
+		if creds.AuthHeaderValue == nil || *creds.AuthHeaderValue == "" {
+			err = multierr.Append(err, configutils.ErrMissing{Name: fmt.Sprintf("AttestationCredentials[\"%s\"].AuthHeaderValue", name), Msg: "must be provided and non-empty"})
+		}
+	}
+	return err
+}
+
 type EngineExecutionRateLimit struct {
 	GlobalRPS      *float64
 	GlobalBurst    *int
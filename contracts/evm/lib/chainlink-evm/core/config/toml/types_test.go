@@ -82,6 +82,42 @@ func TestMercurySecrets_emptyURL(t *testing.T) {
 	assert.Equal(t, "URL: missing: must be provided and non-empty", err.Error())
 }
 
+func TestCCIPSecrets_ValidateConfig(t *testing.T) {
+	cs := CCIPSecrets{
+		AttestationCredentials: map[string]CCIPAttestationCredentials{
+			"api1": {AuthHeaderValue: models.NewSecret("key1")},
+		},
+	}
+	assert.NoError(t, cs.ValidateConfig())
+
+	cs.AttestationCredentials["api2"] = CCIPAttestationCredentials{}
+	err := cs.ValidateConfig()
+	assert.Error(t, err)
+	assert.Equal(t, `AttestationCredentials["api2"].AuthHeaderValue: missing: must be provided and non-empty`, err.Error())
+}
+
+func TestCCIPSecrets_SetFrom(t *testing.T) {
+	cs := CCIPSecrets{
+		AttestationCredentials: map[string]CCIPAttestationCredentials{
+			"api1": {AuthHeaderValue: models.NewSecret("key1")},
+		},
+	}
+	require.NoError(t, cs.SetFrom(&CCIPSecrets{
+		AttestationCredentials: map[string]CCIPAttestationCredentials{
+			"api2": {AuthHeaderValue: models.NewSecret("key2")},
+		},
+	}))
+	assert.Len(t, cs.AttestationCredentials, 2)
+
+	err := cs.SetFrom(&CCIPSecrets{
+		AttestationCredentials: map[string]CCIPAttestationCredentials{
+			"api1": {AuthHeaderValue: models.NewSecret("key3")},
+		},
+	})
+	assert.Error(t, err)
+	assert.Equal(t, `AttestationCredentials["api1"]: overrides (duplicate keys or list elements) are not allowed for multiple secrets files`, err.Error())
+}
+
 func Test_validateDBURL(t *testing.T) {
 	t.Parallel()
 
@@ -15,12 +15,20 @@ import (
 
 	feeds "github.com/smartcontractkit/chainlink/v2/core/services/feeds"
 
+	http "net/http"
+
 	job "github.com/smartcontractkit/chainlink/v2/core/services/job"
 
 	jsonserializable "github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
 
 	keystore "github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 
+	lanestatus "github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/lanestatus"
+
+	launcher "github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/launcher"
+
+	msgstatus "github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/msgstatus"
+
 	logger "github.com/smartcontractkit/chainlink/v2/core/logger"
 
 	logpoller "github.com/smartcontractkit/chainlink-evm/pkg/logpoller"
@@ -497,6 +505,194 @@ func (_c *Application_GetAuditLogger_Call) RunAndReturn(run func() audit.AuditLo
 	return _c
 }
 
+// GetCCIPLaneMonitor provides a mock function with no fields
+func (_m *Application) GetCCIPLaneMonitor() http.Handler {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCCIPLaneMonitor")
+	}
+
+	var r0 http.Handler
+	if rf, ok := ret.Get(0).(func() http.Handler); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(http.Handler)
+		}
+	}
+
+	return r0
+}
+
+// Application_GetCCIPLaneMonitor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCCIPLaneMonitor'
+type Application_GetCCIPLaneMonitor_Call struct {
+	*mock.Call
+}
+
+// GetCCIPLaneMonitor is a helper method to define mock.On call
+func (_e *Application_Expecter) GetCCIPLaneMonitor() *Application_GetCCIPLaneMonitor_Call {
+	return &Application_GetCCIPLaneMonitor_Call{Call: _e.mock.On("GetCCIPLaneMonitor")}
+}
+
+func (_c *Application_GetCCIPLaneMonitor_Call) Run(run func()) *Application_GetCCIPLaneMonitor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Application_GetCCIPLaneMonitor_Call) Return(_a0 http.Handler) *Application_GetCCIPLaneMonitor_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_GetCCIPLaneMonitor_Call) RunAndReturn(run func() http.Handler) *Application_GetCCIPLaneMonitor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCCIPLanes provides a mock function with no fields
+func (_m *Application) GetCCIPLanes() lanestatus.Reader {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCCIPLanes")
+	}
+
+	var r0 lanestatus.Reader
+	if rf, ok := ret.Get(0).(func() lanestatus.Reader); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(lanestatus.Reader)
+		}
+	}
+
+	return r0
+}
+
+// Application_GetCCIPLanes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCCIPLanes'
+type Application_GetCCIPLanes_Call struct {
+	*mock.Call
+}
+
+// GetCCIPLanes is a helper method to define mock.On call
+func (_e *Application_Expecter) GetCCIPLanes() *Application_GetCCIPLanes_Call {
+	return &Application_GetCCIPLanes_Call{Call: _e.mock.On("GetCCIPLanes")}
+}
+
+func (_c *Application_GetCCIPLanes_Call) Run(run func()) *Application_GetCCIPLanes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Application_GetCCIPLanes_Call) Return(_a0 lanestatus.Reader) *Application_GetCCIPLanes_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_GetCCIPLanes_Call) RunAndReturn(run func() lanestatus.Reader) *Application_GetCCIPLanes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCCIPLaunchers provides a mock function with no fields
+func (_m *Application) GetCCIPLaunchers() map[int32]launcher.Inspector {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCCIPLaunchers")
+	}
+
+	var r0 map[int32]launcher.Inspector
+	if rf, ok := ret.Get(0).(func() map[int32]launcher.Inspector); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[int32]launcher.Inspector)
+		}
+	}
+
+	return r0
+}
+
+// Application_GetCCIPLaunchers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCCIPLaunchers'
+type Application_GetCCIPLaunchers_Call struct {
+	*mock.Call
+}
+
+// GetCCIPLaunchers is a helper method to define mock.On call
+func (_e *Application_Expecter) GetCCIPLaunchers() *Application_GetCCIPLaunchers_Call {
+	return &Application_GetCCIPLaunchers_Call{Call: _e.mock.On("GetCCIPLaunchers")}
+}
+
+func (_c *Application_GetCCIPLaunchers_Call) Run(run func()) *Application_GetCCIPLaunchers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Application_GetCCIPLaunchers_Call) Return(_a0 map[int32]launcher.Inspector) *Application_GetCCIPLaunchers_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_GetCCIPLaunchers_Call) RunAndReturn(run func() map[int32]launcher.Inspector) *Application_GetCCIPLaunchers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCCIPMessageTracker provides a mock function with no fields
+func (_m *Application) GetCCIPMessageTracker() *msgstatus.Tracker {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCCIPMessageTracker")
+	}
+
+	var r0 *msgstatus.Tracker
+	if rf, ok := ret.Get(0).(func() *msgstatus.Tracker); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*msgstatus.Tracker)
+		}
+	}
+
+	return r0
+}
+
+// Application_GetCCIPMessageTracker_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCCIPMessageTracker'
+type Application_GetCCIPMessageTracker_Call struct {
+	*mock.Call
+}
+
+// GetCCIPMessageTracker is a helper method to define mock.On call
+func (_e *Application_Expecter) GetCCIPMessageTracker() *Application_GetCCIPMessageTracker_Call {
+	return &Application_GetCCIPMessageTracker_Call{Call: _e.mock.On("GetCCIPMessageTracker")}
+}
+
+func (_c *Application_GetCCIPMessageTracker_Call) Run(run func()) *Application_GetCCIPMessageTracker_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Application_GetCCIPMessageTracker_Call) Return(_a0 *msgstatus.Tracker) *Application_GetCCIPMessageTracker_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_GetCCIPMessageTracker_Call) RunAndReturn(run func() *msgstatus.Tracker) *Application_GetCCIPMessageTracker_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetConfig provides a mock function with no fields
 func (_m *Application) GetConfig() chainlink.GeneralConfig {
 	ret := _m.Called()
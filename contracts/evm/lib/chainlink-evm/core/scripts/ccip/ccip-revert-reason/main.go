@@ -3,6 +3,7 @@ package main
 import (
 	"flag"
 	"fmt"
+	"strings"
 
 	"github.com/ethereum/go-ethereum/ethclient"
 
@@ -11,7 +12,12 @@ import (
 )
 
 var (
-	errorCodeString = flag.String("errorCode", "", "Error code string (e.g. 0x08c379a0)")
+	errorCodeString = flag.String("errorCode", "", "Error code string (e.g. 0x08c379a0) or Solana program error "+
+		`(e.g. "custom program error: 0x1b5b" or {"InstructionError":[0,{"Custom":7003}]})`)
+	definitions = flag.String("abi", "", "Comma separated extra ABI, compiler artifact or Anchor IDL json files "+
+		"to decode receiver errors by name (e.g. out/Bridgl.sol/Bridgl.json,target/idl/bridgl.json)")
+	svmProgram = flag.String("svmProgram", "", "Solana program that failed, one of router, feequoter, offramp, "+
+		"rmnremote, common or the name of an IDL given with -abi. Every matching program error is printed when empty")
 
 	chainID     = flag.Uint64("chainId", 0, "Chain ID for the transaction (e.g. 420)")
 	txHash      = flag.String("txHash", "", "Transaction hash (e.g. 0x97be8559164442595aba46b5f849c23257905b78e72ee43d9b998b28eee78b84)")
@@ -33,12 +39,18 @@ func main() {
 		return
 	}
 
+	err := handler.LoadDefinitions(strings.Split(*definitions, ",")...)
+	if err != nil {
+		fmt.Printf("Error loading definitions: %v\n", err)
+		return
+	}
+
 	errorString, err := getErrorString()
 	if err != nil {
 		fmt.Printf("Error getting error string: %v\n", err)
 		return
 	}
-	decodedError, err := handler.DecodeErrorStringFromABI(errorString)
+	decodedError, err := handler.DecodeErrorString(errorString, *svmProgram)
 	if err != nil {
 		fmt.Printf("Error decoding error string: %v\n", err)
 		return
@@ -0,0 +1,614 @@
+// Command fee-quoter-capture captures the fees of the evm conformance cases of the chainlink-ccip feequoter package
+// with Router.getFee eth_calls. For each case it records the block, the Router address and the call data of the
+// eth_call, the fee or the revert it returned, and the FeeQuoter state the fee was computed from, read at the same
+// block.
+//
+// With -rpcURL the messages of the cases are quoted on a live chain through -router at -block. Without it, every
+// case runs on a fresh simulated chain running the v1.6.0 FeeQuoter, OnRamp and RMNRemote and the Router bytecode,
+// configured with the snapshot of the case.
+//
+// The command and the flags the cases were captured with are recorded in the header of the cases file. Run it from
+// this directory:
+//
+//	go run . -block=0 -cases=../../../../../chainlink-ccip/pkg/feequoter/testdata/evm.json -router= -rpcURL=
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"math/big"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+
+	"github.com/smartcontractkit/chainlink-ccip/chains/evm/gobindings/generated/latest/router"
+	"github.com/smartcontractkit/chainlink-ccip/chains/evm/gobindings/generated/v1_6_0/fee_quoter"
+	"github.com/smartcontractkit/chainlink-ccip/chains/evm/gobindings/generated/v1_6_0/onramp"
+	"github.com/smartcontractkit/chainlink-ccip/chains/evm/gobindings/generated/v1_6_0/rmn_remote"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/feequoter"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+)
+
+var (
+	casesFile = flag.String("cases", "../../../../../chainlink-ccip/pkg/feequoter/testdata/evm.json",
+		"Conformance cases to capture, they are overwritten with the captures")
+	rpcURL     = flag.String("rpcURL", "", "RPC URL of the source chain, the cases run on simulated chains when empty")
+	routerAddr = flag.String("router", "", "Router to quote the fees with on the chain of -rpcURL")
+	block      = flag.Uint64("block", 0, "Block to quote the fees at on the chain of -rpcURL, the latest when 0")
+)
+
+// The v1.6.0 FeeQuoter deployment defaults of the simulated chains, they are not used by getFee.
+var (
+	linkToken                    = common.HexToAddress("0x514910771AF9Ca656af840dff83E8264EcF986CA")
+	maxFeeJuelsPerMsg            = new(big.Int).Mul(big.NewInt(200), big.NewInt(1e18))
+	tokenPriceStalenessThreshold = uint32(24 * 60 * 60)
+)
+
+// deployerKey deploys the contracts of the simulated chains, it is a well known development key.
+const deployerKey = "ac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80"
+
+// conformanceCase mirrors the conformance cases of the feequoter package tests.
+type conformanceCase struct {
+	Name        string             `json:"name"`
+	Source      string             `json:"source"`
+	BlockNumber uint64             `json:"blockNumber,omitempty"`
+	Router      string             `json:"router,omitempty"`
+	CallData    hexutil.Bytes      `json:"callData,omitempty"`
+	Snapshot    feequoter.Snapshot `json:"snapshot"`
+	Message     feequoter.Message  `json:"message"`
+	Fee         string             `json:"fee,omitempty"`
+	Revert      string             `json:"revert,omitempty"`
+	Error       string             `json:"error,omitempty"`
+}
+
+// captureHeader records how the cases of a file were captured.
+type captureHeader struct {
+	Directory  string            `json:"directory"`
+	Command    string            `json:"command"`
+	Parameters map[string]string `json:"parameters"`
+}
+
+// conformanceFixture is a file of conformance cases.
+type conformanceFixture struct {
+	Capture *captureHeader    `json:"capture,omitempty"`
+	Cases   []conformanceCase `json:"cases"`
+}
+
+// chainClient is the client of the chain the fees are quoted on.
+type chainClient interface {
+	bind.ContractBackend
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+func main() {
+	flag.Parse()
+	ctx := context.Background()
+
+	b, err := os.ReadFile(*casesFile)
+	panicErr(err)
+	var fixture conformanceFixture
+	panicErr(json.Unmarshal(b, &fixture))
+	fixture.Capture = newCaptureHeader()
+
+	cases := fixture.Cases
+	for i, tc := range cases {
+		var captured conformanceCase
+		if *rpcURL == "" {
+			captured, err = captureSimulated(ctx, tc)
+		} else {
+			captured, err = captureLive(ctx, tc)
+		}
+		if err != nil {
+			panic(fmt.Errorf("capture %q: %w", tc.Name, err))
+		}
+		cases[i] = captured
+		fmt.Printf("%s: block %d, fee %q, revert %q\n", tc.Name, captured.BlockNumber, captured.Fee, captured.Revert)
+	}
+
+	b, err = json.MarshalIndent(fixture, "", "  ")
+	panicErr(err)
+	panicErr(os.WriteFile(*casesFile, append(b, '\n'), 0o600))
+}
+
+// newCaptureHeader returns the header of the captured cases, with the values of all the flags so that the capture can
+// be repeated with the exact same parameters.
+func newCaptureHeader() *captureHeader {
+	header := &captureHeader{
+		Directory:  "core/scripts/ccip/fee-quoter-capture",
+		Command:    "go run .",
+		Parameters: map[string]string{},
+	}
+	flag.VisitAll(func(f *flag.Flag) {
+		header.Command += fmt.Sprintf(" -%s=%s", f.Name, f.Value)
+		header.Parameters[f.Name] = f.Value.String()
+	})
+	return header
+}
+
+func captureLive(ctx context.Context, tc conformanceCase) (conformanceCase, error) {
+	client, err := ethclient.DialContext(ctx, *rpcURL)
+	if err != nil {
+		return conformanceCase{}, err
+	}
+	defer client.Close()
+
+	blockNumber := new(big.Int).SetUint64(*block)
+	if *block == 0 {
+		blockNumber = nil
+	}
+	header, err := client.HeaderByNumber(ctx, blockNumber)
+	if err != nil {
+		return conformanceCase{}, fmt.Errorf("get block: %w", err)
+	}
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		return conformanceCase{}, fmt.Errorf("get chain id: %w", err)
+	}
+	tc.Source = fmt.Sprintf("Router.getFee eth_call on chain %s", chainID)
+	return capture(ctx, client, common.HexToAddress(*routerAddr), header.Number, tc)
+}
+
+func captureSimulated(ctx context.Context, tc conformanceCase) (conformanceCase, error) {
+	backend, routerAddress, err := newSimulatedChain(ctx, tc.Snapshot)
+	if err != nil {
+		return conformanceCase{}, err
+	}
+	defer backend.Close()
+	client := backend.Client()
+
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		return conformanceCase{}, err
+	}
+	header, err := client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return conformanceCase{}, fmt.Errorf("get block: %w", err)
+	}
+	tc.Source = fmt.Sprintf("Router.getFee eth_call on a simulated chain %s running the v1.6.0 FeeQuoter, OnRamp and "+
+		"RMNRemote, captured by core/scripts/ccip/fee-quoter-capture", chainID)
+	return capture(ctx, client, routerAddress, header.Number, tc)
+}
+
+// newSimulatedChain starts a simulated chain with the contracts of the lane of the snapshot deployed, and returns it
+// with the address of the Router. The deployments are deterministic, every chain has the same addresses.
+func newSimulatedChain(ctx context.Context, snapshot feequoter.Snapshot) (*simulated.Backend, common.Address, error) {
+	key, err := crypto.HexToECDSA(deployerKey)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+	deployer := crypto.PubkeyToAddress(key.PublicKey)
+	backend := simulated.NewBackend(types.GenesisAlloc{
+		deployer: {Balance: new(big.Int).Mul(big.NewInt(1e6), big.NewInt(1e18))},
+	})
+
+	chainID, err := backend.Client().ChainID(ctx)
+	if err != nil {
+		_ = backend.Close()
+		return nil, common.Address{}, err
+	}
+	auth, err := bind.NewKeyedTransactorWithChainID(key, chainID)
+	if err != nil {
+		_ = backend.Close()
+		return nil, common.Address{}, err
+	}
+	routerAddress, err := deploy(ctx, backend, auth, snapshot)
+	if err != nil {
+		_ = backend.Close()
+		return nil, common.Address{}, err
+	}
+	return backend, routerAddress, nil
+}
+
+// deploy deploys the Router, the v1.6.0 OnRamp, FeeQuoter and RMNRemote of the lane of the snapshot, and sets the
+// prices of the snapshot. The last block is as old as the snapshot relative to its gas price update.
+func deploy(ctx context.Context, backend *simulated.Backend, auth *bind.TransactOpts, snapshot feequoter.Snapshot,
+) (common.Address, error) {
+	client := backend.Client()
+	source, dest := uint64(snapshot.SourceChainSelector), uint64(snapshot.DestChainSelector)
+	mined := func(tx *types.Transaction, err error) error {
+		if err != nil {
+			return err
+		}
+		backend.Commit()
+		receipt, err := client.TransactionReceipt(ctx, tx.Hash())
+		if err != nil {
+			return err
+		}
+		if receipt.Status != types.ReceiptStatusSuccessful {
+			return fmt.Errorf("tx %s reverted", tx.Hash())
+		}
+		return nil
+	}
+
+	rmnAddress, tx, _, err := rmn_remote.DeployRMNRemote(auth, client, source, common.Address{})
+	if err = mined(tx, err); err != nil {
+		return common.Address{}, fmt.Errorf("deploy RMNRemote: %w", err)
+	}
+
+	cfg := snapshot.DestChainConfig
+	var feeTokens []common.Address
+	var premiums []fee_quoter.FeeQuoterPremiumMultiplierWeiPerEthArgs
+	for _, token := range sortedKeys(snapshot.FeeTokens) {
+		feeTokens = append(feeTokens, common.HexToAddress(string(token)))
+		premiums = append(premiums, fee_quoter.FeeQuoterPremiumMultiplierWeiPerEthArgs{
+			Token:                      common.HexToAddress(string(token)),
+			PremiumMultiplierWeiPerEth: snapshot.FeeTokens[token].PremiumMultiplierWeiPerEth,
+		})
+	}
+	// disabled transfer fee configs are stored as the default config, they can't be set
+	var transferFeeConfigs []fee_quoter.FeeQuoterTokenTransferFeeConfigSingleTokenArgs
+	for _, token := range sortedKeys(snapshot.TokenTransferFeeConfigs) {
+		c := snapshot.TokenTransferFeeConfigs[token]
+		if !c.IsEnabled {
+			continue
+		}
+		transferFeeConfigs = append(transferFeeConfigs, fee_quoter.FeeQuoterTokenTransferFeeConfigSingleTokenArgs{
+			Token: common.HexToAddress(string(token)),
+			TokenTransferFeeConfig: fee_quoter.FeeQuoterTokenTransferFeeConfig{
+				MinFeeUSDCents:    c.MinFeeUSDCents,
+				MaxFeeUSDCents:    c.MaxFeeUSDCents,
+				DeciBps:           c.DeciBps,
+				DestGasOverhead:   c.DestGasOverhead,
+				DestBytesOverhead: c.DestBytesOverhead,
+				IsEnabled:         c.IsEnabled,
+			},
+		})
+	}
+	feeQuoterAddress, tx, feeQuoter, err := fee_quoter.DeployFeeQuoter(auth, client,
+		fee_quoter.FeeQuoterStaticConfig{
+			MaxFeeJuelsPerMsg:            maxFeeJuelsPerMsg,
+			LinkToken:                    linkToken,
+			TokenPriceStalenessThreshold: tokenPriceStalenessThreshold,
+		},
+		[]common.Address{auth.From},
+		feeTokens,
+		nil,
+		[]fee_quoter.FeeQuoterTokenTransferFeeConfigArgs{{DestChainSelector: dest, TokenTransferFeeConfigs: transferFeeConfigs}},
+		premiums,
+		[]fee_quoter.FeeQuoterDestChainConfigArgs{{
+			DestChainSelector: dest,
+			DestChainConfig: fee_quoter.FeeQuoterDestChainConfig{
+				IsEnabled:                         cfg.IsEnabled,
+				MaxNumberOfTokensPerMsg:           cfg.MaxNumberOfTokensPerMsg,
+				MaxDataBytes:                      cfg.MaxDataBytes,
+				MaxPerMsgGasLimit:                 cfg.MaxPerMsgGasLimit,
+				DestGasOverhead:                   cfg.DestGasOverhead,
+				DestGasPerPayloadByteBase:         uint8(cfg.DestGasPerPayloadByteBase),
+				DestGasPerPayloadByteHigh:         uint8(cfg.DestGasPerPayloadByteHigh),
+				DestGasPerPayloadByteThreshold:    uint16(cfg.DestGasPerPayloadByteThreshold),
+				DestDataAvailabilityOverheadGas:   cfg.DestDataAvailabilityOverheadGas,
+				DestGasPerDataAvailabilityByte:    cfg.DestGasPerDataAvailabilityByte,
+				DestDataAvailabilityMultiplierBps: cfg.DestDataAvailabilityMultiplierBps,
+				ChainFamilySelector:               cfg.ChainFamilySelector,
+				EnforceOutOfOrder:                 cfg.EnforceOutOfOrder,
+				DefaultTokenFeeUSDCents:           cfg.DefaultTokenFeeUSDCents,
+				DefaultTokenDestGasOverhead:       cfg.DefaultTokenDestGasOverhead,
+				DefaultTxGasLimit:                 cfg.DefaultTxGasLimit,
+				GasMultiplierWeiPerEth:            cfg.GasMultiplierWeiPerEth,
+				GasPriceStalenessThreshold:        cfg.GasPriceStalenessThreshold,
+				NetworkFeeUSDCents:                cfg.NetworkFeeUSDCents,
+			},
+		}},
+	)
+	if err = mined(tx, err); err != nil {
+		return common.Address{}, fmt.Errorf("deploy FeeQuoter: %w", err)
+	}
+
+	routerAddress, tx, routerContract, err := router.DeployRouter(auth, client,
+		common.HexToAddress(string(snapshot.WrappedNative)), rmnAddress)
+	if err = mined(tx, err); err != nil {
+		return common.Address{}, fmt.Errorf("deploy Router: %w", err)
+	}
+
+	// getFee doesn't call the nonce manager and the token admin registry, any address will do
+	onRampAddress, tx, _, err := onramp.DeployOnRamp(auth, client,
+		onramp.OnRampStaticConfig{
+			ChainSelector:      source,
+			RmnRemote:          rmnAddress,
+			NonceManager:       auth.From,
+			TokenAdminRegistry: auth.From,
+		},
+		onramp.OnRampDynamicConfig{FeeQuoter: feeQuoterAddress, FeeAggregator: auth.From},
+		[]onramp.OnRampDestChainConfigArgs{{DestChainSelector: dest, Router: routerAddress}},
+	)
+	if err = mined(tx, err); err != nil {
+		return common.Address{}, fmt.Errorf("deploy OnRamp: %w", err)
+	}
+	err = mined(routerContract.ApplyRampUpdates(auth,
+		[]router.RouterOnRamp{{DestChainSelector: dest, OnRamp: onRampAddress}}, nil, nil))
+	if err != nil {
+		return common.Address{}, fmt.Errorf("set OnRamp: %w", err)
+	}
+
+	var prices fee_quoter.InternalPriceUpdates
+	for _, token := range sortedKeys(snapshot.TokenPrices) {
+		if price := snapshot.TokenPrices[token]; !price.Timestamp.IsZero() {
+			prices.TokenPriceUpdates = append(prices.TokenPriceUpdates, fee_quoter.InternalTokenPriceUpdate{
+				SourceToken: common.HexToAddress(string(token)),
+				UsdPerToken: price.Value.Int,
+			})
+		}
+	}
+	if !snapshot.GasPrice.Timestamp.IsZero() {
+		prices.GasPriceUpdates = []fee_quoter.InternalGasPriceUpdate{{
+			DestChainSelector: dest,
+			UsdPerUnitGas:     snapshot.GasPrice.Value.Int,
+		}}
+	}
+	if err = mined(feeQuoter.UpdatePrices(auth, prices)); err != nil {
+		return common.Address{}, fmt.Errorf("update prices: %w", err)
+	}
+
+	if age := snapshot.Timestamp.Sub(snapshot.GasPrice.Timestamp); age > 0 {
+		if err = backend.AdjustTime(age.Truncate(time.Second)); err != nil {
+			return common.Address{}, fmt.Errorf("age gas price: %w", err)
+		}
+	}
+	return routerAddress, nil
+}
+
+// capture quotes the fee of the message of the case with a Router.getFee eth_call at the block, and replaces the
+// snapshot of the case with the state of the FeeQuoter at the block.
+func capture(ctx context.Context, client chainClient, routerAddress common.Address, blockNumber *big.Int,
+	tc conformanceCase) (conformanceCase, error) {
+	routerABI, err := router.RouterMetaData.GetAbi()
+	if err != nil {
+		return conformanceCase{}, err
+	}
+	dest := uint64(tc.Snapshot.DestChainSelector)
+	msg := router.ClientEVM2AnyMessage{
+		Receiver:     tc.Message.Receiver,
+		Data:         tc.Message.Data,
+		TokenAmounts: []router.ClientEVMTokenAmount{},
+		FeeToken:     address(tc.Message.FeeToken),
+		ExtraArgs:    tc.Message.ExtraArgs,
+	}
+	for _, tokenAmount := range tc.Message.TokenAmounts {
+		msg.TokenAmounts = append(msg.TokenAmounts, router.ClientEVMTokenAmount{
+			Token:  address(tokenAmount.Token),
+			Amount: tokenAmount.Amount.Int,
+		})
+	}
+	callData, err := routerABI.Pack("getFee", dest, msg)
+	if err != nil {
+		return conformanceCase{}, err
+	}
+
+	tc.BlockNumber = blockNumber.Uint64()
+	tc.Router = routerAddress.Hex()
+	tc.CallData = callData
+	tc.Fee, tc.Revert, tc.Error = "", "", ""
+	out, err := client.CallContract(ctx, ethereum.CallMsg{To: &routerAddress, Data: callData}, blockNumber)
+	if err != nil {
+		if tc.Revert, err = decodeRevert(err); err != nil {
+			return conformanceCase{}, fmt.Errorf("call getFee: %w", err)
+		}
+	} else {
+		fee, err := routerABI.Unpack("getFee", out)
+		if err != nil {
+			return conformanceCase{}, fmt.Errorf("unpack getFee: %w", err)
+		}
+		tc.Fee = fee[0].(*big.Int).String()
+	}
+
+	tc.Snapshot, err = readSnapshot(ctx, client, routerAddress, blockNumber, dest, tc.Message)
+	return tc, err
+}
+
+// readSnapshot reads the state of the FeeQuoter of the lane to the destination chain the fee of the message is
+// computed from.
+func readSnapshot(ctx context.Context, client chainClient, routerAddress common.Address, blockNumber *big.Int,
+	dest uint64, msg feequoter.Message) (feequoter.Snapshot, error) {
+	opts := &bind.CallOpts{Context: ctx, BlockNumber: blockNumber}
+	routerContract, err := router.NewRouter(routerAddress, client)
+	if err != nil {
+		return feequoter.Snapshot{}, err
+	}
+	onRampAddress, err := routerContract.GetOnRamp(opts, dest)
+	if err != nil {
+		return feequoter.Snapshot{}, fmt.Errorf("get OnRamp: %w", err)
+	}
+	onRamp, err := onramp.NewOnRamp(onRampAddress, client)
+	if err != nil {
+		return feequoter.Snapshot{}, err
+	}
+	staticConfig, err := onRamp.GetStaticConfig(opts)
+	if err != nil {
+		return feequoter.Snapshot{}, fmt.Errorf("get OnRamp static config: %w", err)
+	}
+	dynamicConfig, err := onRamp.GetDynamicConfig(opts)
+	if err != nil {
+		return feequoter.Snapshot{}, fmt.Errorf("get OnRamp dynamic config: %w", err)
+	}
+	feeQuoter, err := fee_quoter.NewFeeQuoter(dynamicConfig.FeeQuoter, client)
+	if err != nil {
+		return feequoter.Snapshot{}, err
+	}
+	header, err := client.HeaderByNumber(ctx, blockNumber)
+	if err != nil {
+		return feequoter.Snapshot{}, fmt.Errorf("get block: %w", err)
+	}
+
+	cfg, err := feeQuoter.GetDestChainConfig(opts, dest)
+	if err != nil {
+		return feequoter.Snapshot{}, fmt.Errorf("get dest chain config: %w", err)
+	}
+	gasPrice, err := feeQuoter.GetDestinationChainGasPrice(opts, dest)
+	if err != nil {
+		return feequoter.Snapshot{}, fmt.Errorf("get gas price: %w", err)
+	}
+	wrappedNative, err := routerContract.GetWrappedNative(opts)
+	if err != nil {
+		return feequoter.Snapshot{}, fmt.Errorf("get wrapped native: %w", err)
+	}
+	snapshot := feequoter.Snapshot{
+		SourceChainSelector: cciptypes.ChainSelector(staticConfig.ChainSelector),
+		DestChainSelector:   cciptypes.ChainSelector(dest),
+		Timestamp:           time.Unix(int64(header.Time), 0).UTC(),
+		DestChainConfig: cciptypes.FeeQuoterDestChainConfig{
+			IsEnabled:                         cfg.IsEnabled,
+			MaxNumberOfTokensPerMsg:           cfg.MaxNumberOfTokensPerMsg,
+			MaxDataBytes:                      cfg.MaxDataBytes,
+			MaxPerMsgGasLimit:                 cfg.MaxPerMsgGasLimit,
+			DestGasOverhead:                   cfg.DestGasOverhead,
+			DestGasPerPayloadByteBase:         uint32(cfg.DestGasPerPayloadByteBase),
+			DestGasPerPayloadByteHigh:         uint32(cfg.DestGasPerPayloadByteHigh),
+			DestGasPerPayloadByteThreshold:    uint32(cfg.DestGasPerPayloadByteThreshold),
+			DestDataAvailabilityOverheadGas:   cfg.DestDataAvailabilityOverheadGas,
+			DestGasPerDataAvailabilityByte:    cfg.DestGasPerDataAvailabilityByte,
+			DestDataAvailabilityMultiplierBps: cfg.DestDataAvailabilityMultiplierBps,
+			DefaultTokenFeeUSDCents:           cfg.DefaultTokenFeeUSDCents,
+			DefaultTokenDestGasOverhead:       cfg.DefaultTokenDestGasOverhead,
+			DefaultTxGasLimit:                 cfg.DefaultTxGasLimit,
+			GasMultiplierWeiPerEth:            cfg.GasMultiplierWeiPerEth,
+			NetworkFeeUSDCents:                cfg.NetworkFeeUSDCents,
+			GasPriceStalenessThreshold:        cfg.GasPriceStalenessThreshold,
+			EnforceOutOfOrder:                 cfg.EnforceOutOfOrder,
+			ChainFamilySelector:               cfg.ChainFamilySelector,
+		},
+		GasPrice:                timestamped(gasPrice),
+		TokenPrices:             map[cciptypes.UnknownEncodedAddress]cciptypes.TimestampedBig{},
+		FeeTokens:               map[cciptypes.UnknownEncodedAddress]feequoter.FeeTokenConfig{},
+		TokenTransferFeeConfigs: map[cciptypes.UnknownEncodedAddress]feequoter.TokenTransferFeeConfig{},
+		WrappedNative:           cciptypes.UnknownEncodedAddress(wrappedNative.Hex()),
+	}
+
+	feeTokens, err := feeQuoter.GetFeeTokens(opts)
+	if err != nil {
+		return feequoter.Snapshot{}, fmt.Errorf("get fee tokens: %w", err)
+	}
+	for _, token := range feeTokens {
+		multiplier, err := feeQuoter.GetPremiumMultiplierWeiPerEth(opts, token)
+		if err != nil {
+			return feequoter.Snapshot{}, fmt.Errorf("get premium multiplier of %s: %w", token, err)
+		}
+		snapshot.FeeTokens[cciptypes.UnknownEncodedAddress(token.Hex())] = feequoter.FeeTokenConfig{
+			PremiumMultiplierWeiPerEth: multiplier,
+		}
+	}
+
+	tokens := append(slices.Clone(feeTokens), wrappedNative, address(msg.FeeToken))
+	for _, tokenAmount := range msg.TokenAmounts {
+		token := address(tokenAmount.Token)
+		tokens = append(tokens, token)
+		transferFeeConfig, err := feeQuoter.GetTokenTransferFeeConfig(opts, dest, token)
+		if err != nil {
+			return feequoter.Snapshot{}, fmt.Errorf("get transfer fee config of %s: %w", token, err)
+		}
+		if transferFeeConfig.IsEnabled {
+			snapshot.TokenTransferFeeConfigs[cciptypes.UnknownEncodedAddress(token.Hex())] =
+				feequoter.TokenTransferFeeConfig{
+					MinFeeUSDCents:    transferFeeConfig.MinFeeUSDCents,
+					MaxFeeUSDCents:    transferFeeConfig.MaxFeeUSDCents,
+					DeciBps:           transferFeeConfig.DeciBps,
+					DestGasOverhead:   transferFeeConfig.DestGasOverhead,
+					DestBytesOverhead: transferFeeConfig.DestBytesOverhead,
+					IsEnabled:         transferFeeConfig.IsEnabled,
+				}
+		}
+	}
+	for _, token := range tokens {
+		if token == (common.Address{}) {
+			continue
+		}
+		price, err := feeQuoter.GetTokenPrice(opts, token)
+		if err != nil {
+			return feequoter.Snapshot{}, fmt.Errorf("get price of %s: %w", token, err)
+		}
+		if price.Timestamp != 0 {
+			snapshot.TokenPrices[cciptypes.UnknownEncodedAddress(token.Hex())] = timestamped(price)
+		}
+	}
+	return snapshot, nil
+}
+
+// decodeRevert returns the custom error a call reverted with, formatted as a Solidity error with its arguments.
+func decodeRevert(err error) (string, error) {
+	var dataErr interface{ ErrorData() interface{} }
+	if !errors.As(err, &dataErr) {
+		return "", err
+	}
+	data, ok := dataErr.ErrorData().(string)
+	if !ok {
+		return "", err
+	}
+	revert, decodeErr := hexutil.Decode(data)
+	if decodeErr != nil || len(revert) < 4 {
+		return "", err
+	}
+
+	for _, metadata := range []*bind.MetaData{
+		router.RouterMetaData, onramp.OnRampMetaData, fee_quoter.FeeQuoterMetaData, rmn_remote.RMNRemoteMetaData,
+	} {
+		contractABI, abiErr := metadata.GetAbi()
+		if abiErr != nil {
+			return "", abiErr
+		}
+		customErr, abiErr := contractABI.ErrorByID([4]byte(revert[:4]))
+		if abiErr != nil {
+			continue
+		}
+		args, abiErr := customErr.Inputs.Unpack(revert[4:])
+		if abiErr != nil {
+			return "", fmt.Errorf("unpack %s: %w", customErr.Name, abiErr)
+		}
+		return formatError(customErr, args), nil
+	}
+	return "", fmt.Errorf("unknown revert %s: %w", data, err)
+}
+
+func formatError(customErr *abi.Error, args []interface{}) string {
+	formatted := make([]string, len(args))
+	for i, arg := range args {
+		if b, ok := arg.([]byte); ok {
+			formatted[i] = hexutil.Encode(b)
+		} else {
+			formatted[i] = fmt.Sprint(arg)
+		}
+	}
+	return fmt.Sprintf("%s(%s)", customErr.Name, strings.Join(formatted, ", "))
+}
+
+func timestamped(price fee_quoter.InternalTimestampedPackedUint224) cciptypes.TimestampedBig {
+	return cciptypes.TimestampedBig{
+		Timestamp: time.Unix(int64(price.Timestamp), 0).UTC(),
+		Value:     cciptypes.NewBigInt(price.Value),
+	}
+}
+
+// address returns the EVM address of a token, the zero address when it isn't set.
+func address(token cciptypes.UnknownEncodedAddress) common.Address {
+	if token == "" {
+		return common.Address{}
+	}
+	return common.HexToAddress(string(token))
+}
+
+func sortedKeys[V any](m map[cciptypes.UnknownEncodedAddress]V) []cciptypes.UnknownEncodedAddress {
+	keys := make([]cciptypes.UnknownEncodedAddress, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+func panicErr(err error) {
+	if err != nil {
+		panic(err)
+	}
+}
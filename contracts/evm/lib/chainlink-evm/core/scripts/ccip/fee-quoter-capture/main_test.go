@@ -0,0 +1,62 @@
+package main
+
+import (
+	"encoding/json"
+	"math/big"
+	"os"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-ccip/chains/evm/gobindings/generated/latest/router"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/feequoter"
+)
+
+// TestReplayCases replays the recorded Router.getFee eth_calls of the evm conformance cases on simulated chains
+// deployed from their snapshots, and checks they return the recorded fees and reverts.
+func TestReplayCases(t *testing.T) {
+	b, err := os.ReadFile(*casesFile)
+	require.NoError(t, err)
+	var fixture conformanceFixture
+	require.NoError(t, json.Unmarshal(b, &fixture))
+	require.NotNil(t, fixture.Capture)
+	require.Empty(t, fixture.Capture.Parameters["rpcURL"], "only the cases captured on simulated chains can be replayed")
+	require.NotEmpty(t, fixture.Cases)
+
+	routerABI, err := router.RouterMetaData.GetAbi()
+	require.NoError(t, err)
+
+	for _, tc := range fixture.Cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			ctx := t.Context()
+			backend, routerAddress, err := newSimulatedChain(ctx, tc.Snapshot)
+			require.NoError(t, err)
+			t.Cleanup(func() { _ = backend.Close() })
+			client := backend.Client()
+
+			header, err := client.HeaderByNumber(ctx, nil)
+			require.NoError(t, err)
+			require.Equal(t, tc.BlockNumber, header.Number.Uint64())
+			require.Equal(t, tc.Router, routerAddress.Hex())
+
+			out, err := client.CallContract(ctx, ethereum.CallMsg{To: &routerAddress, Data: tc.CallData}, header.Number)
+			if tc.Revert != "" {
+				revert, decodeErr := decodeRevert(err)
+				require.NoError(t, decodeErr)
+				assert.Equal(t, tc.Revert, revert)
+				return
+			}
+			require.NoError(t, err)
+			fee, err := routerABI.Unpack("getFee", out)
+			require.NoError(t, err)
+			assert.Equal(t, tc.Fee, fee[0].(*big.Int).String())
+
+			// the offline quote of the snapshot is the fee of the eth_call
+			q, err := feequoter.QuoteFee(tc.Snapshot, tc.Message)
+			require.NoError(t, err)
+			assert.Equal(t, tc.Fee, q.Fee.String())
+		})
+	}
+}
@@ -83,3 +83,94 @@ type EVM2EVMOffRampGasLimitOverride struct {
 	ReceiverExecutionGasLimit *big.Int
 	TokenGasOverrides         []*big.Int
 }
+
+type InternalRampMessageHeader struct {
+	MessageId           [32]byte
+	SourceChainSelector uint64
+	DestChainSelector   uint64
+	SequenceNumber      uint64
+	Nonce               uint64
+}
+
+type InternalEVM2AnyTokenTransfer struct {
+	SourcePoolAddress common.Address
+	DestTokenAddress  []byte
+	ExtraData         []byte
+	Amount            *big.Int
+	DestExecData      []byte
+}
+
+type InternalEVM2AnyRampMessage struct {
+	Header         InternalRampMessageHeader
+	Sender         common.Address
+	Data           []byte
+	Receiver       []byte
+	ExtraArgs      []byte
+	FeeToken       common.Address
+	FeeTokenAmount *big.Int
+	FeeValueJuels  *big.Int
+	TokenAmounts   []InternalEVM2AnyTokenTransfer
+}
+
+type OnRampCCIPMessageSent struct {
+	DestChainSelector uint64
+	SequenceNumber    uint64
+	Message           InternalEVM2AnyRampMessage
+	Raw               types.Log
+}
+
+type InternalMerkleRoot struct {
+	SourceChainSelector uint64
+	OnRampAddress       []byte
+	MinSeqNr            uint64
+	MaxSeqNr            uint64
+	MerkleRoot          [32]byte
+}
+
+type OffRampCommitReportAccepted struct {
+	BlessedMerkleRoots   []InternalMerkleRoot
+	UnblessedMerkleRoots []InternalMerkleRoot
+	PriceUpdates         InternalPriceUpdates
+	Raw                  types.Log
+}
+
+type InternalAny2EVMTokenTransfer struct {
+	SourcePoolAddress []byte
+	DestTokenAddress  common.Address
+	DestGasAmount     uint32
+	ExtraData         []byte
+	Amount            *big.Int
+}
+
+type InternalAny2EVMRampMessage struct {
+	Header       InternalRampMessageHeader
+	Sender       []byte
+	Data         []byte
+	Receiver     common.Address
+	GasLimit     *big.Int
+	TokenAmounts []InternalAny2EVMTokenTransfer
+}
+
+type InternalExecutionReportV16 struct {
+	SourceChainSelector uint64
+	Messages            []InternalAny2EVMRampMessage
+	OffchainTokenData   [][][]byte
+	Proofs              [][32]byte
+	ProofFlagBits       *big.Int
+}
+
+type OffRampExecutionStateChanged struct {
+	SourceChainSelector uint64
+	SequenceNumber      uint64
+	MessageId           [32]byte
+	MessageHash         [32]byte
+	State               uint8
+	ReturnData          []byte
+	GasUsed             *big.Int
+	Raw                 types.Log
+}
+
+type OffRampGasLimitOverride struct {
+	ReceiverExecutionGasLimit *big.Int
+	TokenGasOverrides         []uint32
+}
@@ -323,3 +323,176 @@ func ProofFlagsToBits(proofFlags []bool) *big.Int {
 	}
 	return encodedFlags
 }
+
+var (
+	// Any2EVMMessageHash is the ANY_2_EVM_MESSAGE_HASH of the 1.6 OffRamp, keccak256("Any2EVMMessageHashV1").
+	Any2EVMMessageHash = Keccak256Fixed([]byte("Any2EVMMessageHashV1"))
+
+	evmExtraArgsV1Tag = []byte{0x97, 0xa6, 0x57, 0xc9}
+	evmExtraArgsV2Tag = []byte{0x18, 0x1d, 0xcf, 0x10}
+)
+
+// LeafHasherV16 hashes the messages of 1.6 lanes the same way as Internal._hash(Any2EVMRampMessage).
+type LeafHasherV16 struct {
+	metaDataHash [32]byte
+	ctx          Ctx[[32]byte]
+}
+
+func NewLeafHasherV16(sourceChainSelector, destChainSelector uint64, onRamp common.Address, ctx Ctx[[32]byte]) (*LeafHasherV16, error) {
+	metaDataHashInput, err := ABIEncode(
+		`[
+{"name": "any2EVMMessageHash", "type":"bytes32"},
+{"name": "sourceChainSelector", "type":"uint64"},
+{"name": "destChainSelector", "type":"uint64"},
+{"name": "onRampHash", "type":"bytes32"}
+]`,
+		Any2EVMMessageHash,
+		sourceChainSelector,
+		destChainSelector,
+		ctx.Hash(common.LeftPadBytes(onRamp.Bytes(), 32)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &LeafHasherV16{
+		metaDataHash: ctx.Hash(metaDataHashInput),
+		ctx:          ctx,
+	}, nil
+}
+
+func (t *LeafHasherV16) HashLeaf(msg InternalAny2EVMRampMessage) ([32]byte, error) {
+	encodedTokens, err := ABIEncode(
+		`[{"components": [
+{"name": "sourcePoolAddress", "type": "bytes"},
+{"name": "destTokenAddress", "type": "address"},
+{"name": "destGasAmount", "type": "uint32"},
+{"name": "extraData", "type": "bytes"},
+{"name": "amount", "type": "uint256"}
+],"type": "tuple[]"}]`,
+		msg.TokenAmounts,
+	)
+	if err != nil {
+		return [32]byte{}, err
+	}
+
+	packedFixedSizeFields, err := ABIEncode(
+		`[
+{"name": "messageId", "type":"bytes32"},
+{"name": "receiver", "type":"address"},
+{"name": "sequenceNumber", "type":"uint64"},
+{"name": "gasLimit", "type":"uint256"},
+{"name": "nonce", "type":"uint64"}
+]`,
+		msg.Header.MessageId,
+		msg.Receiver,
+		msg.Header.SequenceNumber,
+		msg.GasLimit,
+		msg.Header.Nonce,
+	)
+	if err != nil {
+		return [32]byte{}, err
+	}
+
+	packedValues, err := ABIEncode(
+		`[
+{"name": "leafDomainSeparator","type":"bytes32"},
+{"name": "metaDataHash", "type":"bytes32"},
+{"name": "fixedSizeFieldsHash", "type":"bytes32"},
+{"name": "senderHash", "type":"bytes32"},
+{"name": "dataHash", "type":"bytes32"},
+{"name": "tokenAmountsHash", "type":"bytes32"}
+]`,
+		[32]byte{},
+		t.metaDataHash,
+		t.ctx.Hash(packedFixedSizeFields),
+		t.ctx.Hash(msg.Sender),
+		t.ctx.Hash(msg.Data),
+		t.ctx.Hash(encodedTokens),
+	)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return t.ctx.Hash(packedValues), nil
+}
+
+// Any2EVMMessageFromEVM2Any converts a message sent by a 1.6 EVM OnRamp into the message executed by a 1.6 OffRamp.
+func Any2EVMMessageFromEVM2Any(msg InternalEVM2AnyRampMessage) (InternalAny2EVMRampMessage, error) {
+	gasLimit, err := decodeExtraArgsGasLimit(msg.ExtraArgs)
+	if err != nil {
+		return InternalAny2EVMRampMessage{}, err
+	}
+
+	tokenAmounts := make([]InternalAny2EVMTokenTransfer, 0, len(msg.TokenAmounts))
+	for _, ta := range msg.TokenAmounts {
+		destGasAmount, err := abiDecodeUint32(ta.DestExecData)
+		if err != nil {
+			return InternalAny2EVMRampMessage{}, fmt.Errorf("decode dest gas amount of token %x: %w", ta.DestTokenAddress, err)
+		}
+		tokenAmounts = append(tokenAmounts, InternalAny2EVMTokenTransfer{
+			// remote pool addresses are abi-encoded addresses when the remote chain is EVM.
+			SourcePoolAddress: common.LeftPadBytes(ta.SourcePoolAddress.Bytes(), 32),
+			DestTokenAddress:  common.BytesToAddress(ta.DestTokenAddress),
+			DestGasAmount:     destGasAmount,
+			ExtraData:         ta.ExtraData,
+			Amount:            ta.Amount,
+		})
+	}
+
+	return InternalAny2EVMRampMessage{
+		Header:       msg.Header,
+		Sender:       common.LeftPadBytes(msg.Sender.Bytes(), 32),
+		Data:         msg.Data,
+		Receiver:     common.BytesToAddress(msg.Receiver),
+		GasLimit:     gasLimit,
+		TokenAmounts: tokenAmounts,
+	}, nil
+}
+
+// decodeExtraArgsGasLimit returns the gas limit of EVMExtraArgsV1 or GenericExtraArgsV2 encoded extra args.
+func decodeExtraArgsGasLimit(extraArgs []byte) (*big.Int, error) {
+	if len(extraArgs) < 4 {
+		return nil, fmt.Errorf("extra args too short: %x", extraArgs)
+	}
+
+	var argsABI string
+	switch {
+	case bytes.Equal(extraArgs[:4], evmExtraArgsV1Tag):
+		argsABI = `[{"name": "gasLimit", "type": "uint256"}]`
+	case bytes.Equal(extraArgs[:4], evmExtraArgsV2Tag):
+		argsABI = `[{"name": "gasLimit", "type": "uint256"}, {"name": "allowOutOfOrderExecution", "type": "bool"}]`
+	default:
+		return nil, fmt.Errorf("unknown extra args tag %x", extraArgs[:4])
+	}
+
+	values, err := abiDecode(argsABI, extraArgs[4:])
+	if err != nil {
+		return nil, fmt.Errorf("decode extra args: %w", err)
+	}
+	gasLimit, ok := values[0].(*big.Int)
+	if !ok {
+		return nil, fmt.Errorf("unexpected gas limit type %T", values[0])
+	}
+	return gasLimit, nil
+}
+
+func abiDecodeUint32(data []byte) (uint32, error) {
+	values, err := abiDecode(`[{"name": "destGasAmount", "type": "uint32"}]`, data)
+	if err != nil {
+		return 0, err
+	}
+	v, ok := values[0].(uint32)
+	if !ok {
+		return 0, fmt.Errorf("unexpected uint32 type %T", values[0])
+	}
+	return v, nil
+}
+
+// abiDecode is the equivalent of abi.decode.
+func abiDecode(abiStr string, data []byte) ([]interface{}, error) {
+	inDef := fmt.Sprintf(`[{ "name" : "method", "type": "function", "outputs": %s}]`, abiStr)
+	inAbi, err := abi.JSON(strings.NewReader(inDef))
+	if err != nil {
+		return nil, err
+	}
+	return inAbi.Unpack("method", data)
+}
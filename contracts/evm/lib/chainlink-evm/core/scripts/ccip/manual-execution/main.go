@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"encoding/csv"
 	"encoding/hex"
 	"encoding/json"
 	"errors"
@@ -27,24 +28,44 @@ import (
 
 const NumberOfBlocks = 20000
 
+// laneVersion is the version of the lane, detected from the typeAndVersion of the off_ramp.
+type laneVersion int
+
+const (
+	// laneVersionV15 lanes have a commit_store and an EVM2EVMOffRamp per source chain.
+	laneVersionV15 laneVersion = iota
+	// laneVersionV16 lanes have a single OffRamp that both commits and executes messages from all source chains.
+	laneVersionV16
+)
+
 // Config represents configuration fields
 type Config struct {
-	SrcNodeURL       string `json:"src_rpc"`
-	DestNodeURL      string `json:"dest_rpc"`
-	DestOwner        string `json:"dest_owner_key"`
-	CommitStore      string `json:"commit_store"`
-	OffRamp          string `json:"off_ramp"`
-	DestStartBlock   uint64 `json:"dest_start_block"`
-	SourceChainTx    string `json:"source_chain_tx"`
-	CCIPMsgID        string `json:"ccip_msg_id"`
-	DestDeployedAt   uint64 `json:"dest_deployed_at"`
-	GasLimitOverride uint64 `json:"gas_limit_override"`
+	SrcNodeURL       string          `json:"src_rpc"`
+	DestNodeURL      string          `json:"dest_rpc"`
+	DestOwner        string          `json:"dest_owner_key"`
+	CommitStore      string          `json:"commit_store"`
+	OffRamp          string          `json:"off_ramp"`
+	DestStartBlock   uint64          `json:"dest_start_block"`
+	SourceChainTx    string          `json:"source_chain_tx"`
+	CCIPMsgID        string          `json:"ccip_msg_id"`
+	Messages         []MessageConfig `json:"messages"`
+	DestDeployedAt   uint64          `json:"dest_deployed_at"`
+	GasLimitOverride uint64          `json:"gas_limit_override"`
+}
+
+// MessageConfig identifies a single message to manually execute.
+// CCIPMsgID is optional when the ccip-send transaction sent a single message.
+type MessageConfig struct {
+	SourceChainTx string `json:"source_chain_tx"`
+	CCIPMsgID     string `json:"ccip_msg_id"`
 }
 
 type execArgs struct {
 	cfg               Config
+	version           laneVersion
 	seqNum            uint64
 	msgID             [32]byte
+	configMsgID       common.Hash // the ccip_msg_id of the message, zero when it isn't set
 	sourceChain       *ethclient.Client
 	sourceChainID     *big.Int
 	destChain         *ethclient.Client
@@ -60,6 +81,8 @@ type execArgs struct {
 func main() {
 	configPath := flag.String("configFile", "./config.json", "config for manually executing a failed ccip message "+
 		"which has been successfully committed but failed to get executed")
+	messagesPath := flag.String("messagesFile", "", "optional csv of message_id,transaction_hash lines with a header, "+
+		"the messages are executed in addition to the ones of the config")
 	flag.Parse()
 
 	if *configPath == "" {
@@ -82,17 +105,27 @@ func main() {
 	"commit_store": "",
 	"off_ramp": "",
 	"dest_start_block": "",
-	"ccip_send_tx": "",
-	"source_start_block": "",
+	"source_chain_tx": "",
+	"ccip_msg_id": "",
+	"messages": [{"source_chain_tx": "", "ccip_msg_id": ""}],
 	"dest_deployed_at": 0,
 	"gas_limit_override": 0,
 }`)
 		os.Exit(1)
 	}
+	if *messagesPath != "" {
+		var msgs []MessageConfig
+		msgs, err = readMessagesFile(*messagesPath)
+		if err != nil {
+			log.Println("unable to read the messages csv at ", *messagesPath, "error - ", err)
+			os.Exit(1)
+		}
+		cfg.Messages = append(cfg.Messages, msgs...)
+	}
 	// mandatory fields check
 	err = cfg.verifyConfig()
 	if err != nil {
-		log.Println("config validation failed:")
+		log.Println("config validation failed:", err)
 
 		os.Exit(1)
 	}
@@ -102,13 +135,63 @@ func main() {
 		log.Println("error instantiating manual execution args ", err)
 		os.Exit(1)
 	}
-	err = args.execute()
-	if err != nil {
-		log.Println("manual execution was not successful - ", err)
+
+	// a failing message doesn't stop the batch, all failures are reported at the end.
+	var errs error
+	for _, msg := range cfg.messages() {
+		err = args.forMessage(msg).run()
+		if err != nil {
+			log.Printf("manual execution of message %s in tx %s was not successful - %v", msg.CCIPMsgID, msg.SourceChainTx, err)
+			errs = errors.Join(errs, fmt.Errorf("tx %s msg %s: %w", msg.SourceChainTx, msg.CCIPMsgID, err))
+			continue
+		}
+		log.Printf("manually executed message %s in tx %s", msg.CCIPMsgID, msg.SourceChainTx)
+	}
+	if errs != nil {
+		log.Println("manual execution was not successful - ", errs)
 		os.Exit(1)
 	}
 }
 
+// readMessagesFile reads the messages to execute from a csv file with a header line,
+// in the message_id,transaction_hash format of the batch_runner.
+func readMessagesFile(path string) ([]MessageConfig, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	records, err := csv.NewReader(f).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, nil
+	}
+
+	msgs := make([]MessageConfig, 0, len(records)-1)
+	for i, record := range records[1:] {
+		if len(record) != 2 {
+			return nil, fmt.Errorf("line %d: want 2 values, got %d", i+2, len(record))
+		}
+		msgs = append(msgs, MessageConfig{
+			CCIPMsgID:     strings.TrimSpace(record[0]),
+			SourceChainTx: strings.TrimSpace(record[1]),
+		})
+	}
+	return msgs, nil
+}
+
+// messages returns all the messages to execute, the single source_chain_tx and ccip_msg_id one first.
+func (cfg Config) messages() []MessageConfig {
+	var msgs []MessageConfig
+	if cfg.SourceChainTx != "" {
+		msgs = append(msgs, MessageConfig{SourceChainTx: cfg.SourceChainTx, CCIPMsgID: cfg.CCIPMsgID})
+	}
+	return append(msgs, cfg.Messages...)
+}
+
 func (cfg Config) verifyConfig() (errs error) {
 	if cfg.SrcNodeURL == "" {
 		errs = errors.Join(errs, errors.New("must set src_rpc - source chain rpc"))
@@ -119,8 +202,19 @@ func (cfg Config) verifyConfig() (errs error) {
 	if cfg.DestOwner == "" {
 		errs = errors.Join(errs, errors.New("must set dest_owner_key - destination user private key"))
 	}
-	if cfg.SourceChainTx == "" {
-		errs = errors.Join(errs, errors.New("must set source_chain_tx - txHash of ccip-send request"))
+	msgs := cfg.messages()
+	if len(msgs) == 0 {
+		errs = errors.Join(errs, errors.New("must set source_chain_tx or messages - txHash of ccip-send request"))
+	}
+	for i, msg := range msgs {
+		if msg.SourceChainTx == "" {
+			errs = errors.Join(errs, fmt.Errorf("must set source_chain_tx of message %d", i))
+		}
+		if msg.CCIPMsgID != "" {
+			if err := verifyMsgID(msg.CCIPMsgID); err != nil {
+				errs = errors.Join(errs, fmt.Errorf("check the ccip_msg_id of message %d - %w", i, err))
+			}
+		}
 	}
 
 	if cfg.DestStartBlock == 0 && cfg.DestDeployedAt == 0 {
@@ -131,11 +225,8 @@ dest_start_block - the block number from which events will be filtered at destin
 	if cfg.GasLimitOverride == 0 {
 		errs = errors.Join(errs, errors.New("must set gas_limit_override - new value of gas limit for ccip-send request"))
 	}
-	err := helpers.VerifyAddress(cfg.CommitStore)
-	if err != nil {
-		errs = errors.Join(errs, fmt.Errorf("check the commit_store address - %w", err))
-	}
-	err = helpers.VerifyAddress(cfg.OffRamp)
+	// commit_store is only required by 1.5 lanes, it's checked once the lane version is known.
+	err := helpers.VerifyAddress(cfg.OffRamp)
 	if err != nil {
 		errs = errors.Join(errs, fmt.Errorf("check the off_ramp address - %w", err))
 	}
@@ -143,6 +234,18 @@ dest_start_block - the block number from which events will be filtered at destin
 	return
 }
 
+// verifyMsgID checks the message ID is 32 hex encoded bytes, with or without the 0x prefix.
+func verifyMsgID(msgID string) error {
+	b, err := hex.DecodeString(strings.TrimPrefix(strings.ToLower(msgID), "0x"))
+	if err != nil {
+		return err
+	}
+	if len(b) != common.HashLength {
+		return fmt.Errorf("want %d bytes, got %d", common.HashLength, len(b))
+	}
+	return nil
+}
+
 func (args *execArgs) populateValues() error {
 	var err error
 	cfg := args.cfg
@@ -174,18 +277,70 @@ func (args *execArgs) populateValues() error {
 	}
 	log.Println("--- Owner address---/n", args.destUser.From.Hex())
 
-	var txReceipt *types.Receipt
-	txReceipt, err = args.sourceChain.TransactionReceipt(context.Background(), common.HexToHash(cfg.SourceChainTx))
+	args.destLatestBlock, err = args.destChain.BlockNumber(context.Background())
 	if err != nil {
 		return err
 	}
-	args.srcStartBlock = big.NewInt(0).Sub(txReceipt.BlockNumber, big.NewInt(NumberOfBlocks))
-	args.destLatestBlock, err = args.destChain.BlockNumber(context.Background())
+
+	typeAndVersion, err := helpers.TypeAndVersion(args.destChain, cfg.OffRamp)
+	if err != nil {
+		return fmt.Errorf("unable to get the typeAndVersion of the off_ramp: %w", err)
+	}
+	switch {
+	case strings.HasPrefix(typeAndVersion, "EVM2EVMOffRamp "):
+		args.version = laneVersionV15
+		err = helpers.VerifyAddress(cfg.CommitStore)
+		if err != nil {
+			return fmt.Errorf("check the commit_store address - %w", err)
+		}
+	case strings.HasPrefix(typeAndVersion, "OffRamp 1.6"):
+		args.version = laneVersionV16
+	default:
+		return fmt.Errorf("unsupported off_ramp %q", typeAndVersion)
+	}
+	log.Println("--- OffRamp ---", typeAndVersion)
+	return nil
+}
+
+// forMessage returns the execution args of a single message, sharing the clients of args.
+func (args *execArgs) forMessage(msg MessageConfig) *execArgs {
+	msgArgs := *args
+	msgArgs.cfg.SourceChainTx = msg.SourceChainTx
+	msgArgs.cfg.CCIPMsgID = msg.CCIPMsgID
+	msgArgs.configMsgID = common.Hash{}
+	if msg.CCIPMsgID != "" {
+		msgArgs.configMsgID = common.HexToHash(msg.CCIPMsgID)
+	}
+	return &msgArgs
+}
+
+// run finds the message of the configured source_chain_tx and manually executes it.
+func (args *execArgs) run() error {
+	err := args.populateMessageValues()
+	if err != nil {
+		return fmt.Errorf("error instantiating manual execution args: %w", err)
+	}
+	if args.version == laneVersionV16 {
+		return args.executeV16()
+	}
+	return args.execute()
+}
+
+func (args *execArgs) populateMessageValues() error {
+	var err error
+	cfg := args.cfg
+	var txReceipt *types.Receipt
+	txReceipt, err = args.sourceChain.TransactionReceipt(context.Background(), common.HexToHash(cfg.SourceChainTx))
 	if err != nil {
 		return err
 	}
+	args.srcStartBlock = big.NewInt(0).Sub(txReceipt.BlockNumber, big.NewInt(NumberOfBlocks))
 
-	err = args.seqNumFromCCIPSendRequested(txReceipt.Logs)
+	if args.version == laneVersionV16 {
+		err = args.seqNumFromCCIPMessageSent(txReceipt.Logs)
+	} else {
+		err = args.seqNumFromCCIPSendRequested(txReceipt.Logs)
+	}
 	if err != nil {
 		return err
 	}
@@ -339,6 +494,191 @@ func (args *execArgs) execute() error {
 	return nil
 }
 
+// executeV16 manually executes the message on a 1.6 OffRamp, where the commit reports are
+// accepted by the OffRamp itself and may contain merkle roots of several source chains.
+func (args *execArgs) executeV16() error {
+	srcChainSelector := GetCCIPChainSelector(args.sourceChainID.Uint64())
+	destChainSelector := GetCCIPChainSelector(args.destChainID.Uint64())
+
+	iterator, err := helpers.FilterCommitReportAccepted(args.destChain, &bind.FilterOpts{Start: args.destStartBlock}, args.cfg.OffRamp)
+	if err != nil {
+		return err
+	}
+
+	var merkleRoot *helpers.InternalMerkleRoot
+	for merkleRoot == nil && iterator.Next() {
+		var eventReport *helpers.OffRampCommitReportAccepted
+		eventReport, err = iterator.CommitReportAcceptedFromLog()
+		if err != nil {
+			return err
+		}
+
+		for _, root := range append(eventReport.BlessedMerkleRoots, eventReport.UnblessedMerkleRoots...) {
+			if root.SourceChainSelector == srcChainSelector && root.MinSeqNr <= args.seqNum && root.MaxSeqNr >= args.seqNum {
+				merkleRoot = &root
+				log.Println("Found root")
+				break
+			}
+		}
+	}
+	iterator.Close()
+	if merkleRoot == nil {
+		return fmt.Errorf("unable to find seq num %d in commit report", args.seqNum)
+	}
+	log.Println("Executing request manually")
+	seqNr := args.seqNum
+	// Build a merkle tree for the report
+	mctx := helpers.NewKeccakCtx()
+	leafHasher, err := helpers.NewLeafHasherV16(srcChainSelector, destChainSelector, args.OnRamp, mctx)
+	if err != nil {
+		return err
+	}
+
+	var leaves [][32]byte
+	var curr, prove int
+	var tokenData [][][]byte
+	var msgs []helpers.InternalAny2EVMRampMessage
+
+	messageSentIterator, err := helpers.FilterCCIPMessageSent(args.sourceChain, &bind.FilterOpts{
+		Start: args.srcStartBlock.Uint64(),
+	}, args.OnRamp.Hex(), destChainSelector)
+	if err != nil {
+		return err
+	}
+
+	for messageSentIterator.Next() {
+		var event *helpers.OnRampCCIPMessageSent
+		event, err = messageSentIterator.CCIPMessageSentEventFromLog()
+		if err != nil {
+			return err
+		}
+		if event.SequenceNumber > merkleRoot.MaxSeqNr || event.SequenceNumber < merkleRoot.MinSeqNr {
+			continue
+		}
+		log.Println("Found seq num in merkle root", event.SequenceNumber, merkleRoot.MinSeqNr, merkleRoot.MaxSeqNr)
+		var msg helpers.InternalAny2EVMRampMessage
+		msg, err = helpers.Any2EVMMessageFromEVM2Any(event.Message)
+		if err != nil {
+			return err
+		}
+		var hash [32]byte
+		hash, err = leafHasher.HashLeaf(msg)
+		if err != nil {
+			return err
+		}
+		leaves = append(leaves, hash)
+		if event.SequenceNumber == seqNr && msg.Header.MessageId == args.msgID {
+			log.Printf("Found proving %d %+v\n\n", curr, msg)
+			msgs = append(msgs, msg)
+			tokenData = append(tokenData, make([][]byte, len(msg.TokenAmounts)))
+			prove = curr
+		}
+		curr++
+	}
+
+	messageSentIterator.Close()
+	if len(msgs) == 0 {
+		return fmt.Errorf("unable to find msg with seqNr %d", seqNr)
+	}
+
+	expectedNumberOfLeaves := merkleRoot.MaxSeqNr - merkleRoot.MinSeqNr + 1
+	if uint64(len(leaves)) != expectedNumberOfLeaves {
+		return fmt.Errorf("not enough leaves gather to build a commit root - want %d got %d. Please set NumberOfBlocks const to a higher value", expectedNumberOfLeaves, len(leaves))
+	}
+
+	tree, err := helpers.NewTree(mctx, leaves)
+	if err != nil {
+		return err
+	}
+	if tree.Root() != merkleRoot.MerkleRoot {
+		return errors.New("root doesn't match. cannot execute")
+	}
+
+	proof := tree.Prove([]int{prove})
+	report := helpers.InternalExecutionReportV16{
+		SourceChainSelector: srcChainSelector,
+		Messages:            msgs,
+		OffchainTokenData:   tokenData,
+		Proofs:              proof.Hashes,
+		ProofFlagBits:       helpers.ProofFlagsToBits(proof.SourceFlags),
+	}
+
+	// the OffRamp requires a token gas override per token transfer, 0 keeps the committed dest gas amount.
+	gasLimitOverrides := make([]helpers.OffRampGasLimitOverride, 0, len(report.Messages))
+	for _, msg := range report.Messages {
+		gasLimitOverrides = append(gasLimitOverrides, helpers.OffRampGasLimitOverride{
+			ReceiverExecutionGasLimit: new(big.Int).SetUint64(args.cfg.GasLimitOverride),
+			TokenGasOverrides:         make([]uint32, len(msg.TokenAmounts)),
+		})
+	}
+
+	// GasLimit may need to be raised if the TX is reverting. Must be set to a value larger than the GasLimitOverride.
+	// args.destUser.GasLimit = 5000000
+	tx, err := helpers.ManuallyExecuteV16(args.destChain, args.destUser, args.cfg.OffRamp,
+		[]helpers.InternalExecutionReportV16{report}, [][]helpers.OffRampGasLimitOverride{gasLimitOverrides})
+	if err != nil {
+		return err
+	}
+	// wait for tx confirmation
+	err = helpers.WaitForSuccessfulTxReceipt(args.destChain, tx.Hash())
+	if err != nil {
+		log.Println("Failures may be due to insufficient gas, try increasing args.destUser.GasLimit.")
+		return err
+	}
+
+	// check if the message got successfully delivered
+	changed, err := helpers.FilterExecutionStateChangedV16(args.destChain, &bind.FilterOpts{
+		Start: args.destStartBlock,
+	}, args.cfg.OffRamp, srcChainSelector, args.seqNum, args.msgID)
+	if err != nil {
+		return err
+	}
+	if changed != 2 {
+		return errors.New("manual execution did not result in ExecutionStateChanged as success")
+	}
+	return nil
+}
+
+// seqNumFromCCIPMessageSent is the 1.6 equivalent of seqNumFromCCIPSendRequested.
+func (args *execArgs) seqNumFromCCIPMessageSent(logs []*types.Log) error {
+	abi, err := abi.JSON(strings.NewReader(helpers.OnRampV16ABI))
+	if err != nil {
+		return err
+	}
+	abiEvent, ok := abi.Events["CCIPMessageSent"]
+	if !ok {
+		return errors.New("no CCIPMessageSent event found in ABI")
+	}
+	destChainSelector := GetCCIPChainSelector(args.destChainID.Uint64())
+
+	for _, sentLog := range logs {
+		if len(sentLog.Topics) == 0 || sentLog.Topics[0] != abiEvent.ID ||
+			sentLog.TxHash != common.HexToHash(args.cfg.SourceChainTx) {
+			continue
+		}
+		onRampContract := bind.NewBoundContract(sentLog.Address, abi, args.sourceChain, args.sourceChain, args.sourceChain)
+
+		var event helpers.OnRampCCIPMessageSent
+		err = onRampContract.UnpackLog(&event, "CCIPMessageSent", *sentLog)
+		if err != nil {
+			return err
+		}
+		if event.DestChainSelector != destChainSelector {
+			continue
+		}
+		if args.configMsgID != (common.Hash{}) && event.Message.Header.MessageId != args.configMsgID {
+			continue
+		}
+
+		args.OnRamp = sentLog.Address
+		args.seqNum = event.SequenceNumber
+		args.msgID = event.Message.Header.MessageId
+		return nil
+	}
+
+	return fmt.Errorf("no CCIPMessageSent log to the destination chain found in txReceipt for txhash %s", args.cfg.SourceChainTx)
+}
+
 func (args *execArgs) seqNumFromCCIPSendRequested(logs []*types.Log) error {
 	abi, err := abi.JSON(strings.NewReader(helpers.OnRampABI))
 	if err != nil {
@@ -375,8 +715,7 @@ func (args *execArgs) seqNumFromCCIPSendRequested(logs []*types.Log) error {
 			return err
 		}
 
-		if args.cfg.CCIPMsgID != "" &&
-			"0x"+hex.EncodeToString(event.Message.MessageID[:]) != args.cfg.CCIPMsgID {
+		if args.configMsgID != (common.Hash{}) && event.Message.MessageID != args.configMsgID {
 			continue
 		}
 
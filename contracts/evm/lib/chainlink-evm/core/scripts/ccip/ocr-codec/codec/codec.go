@@ -0,0 +1,288 @@
+package codec
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	ocrtypecodec "github.com/smartcontractkit/chainlink-ccip/pkg/ocrtypecodec/v1"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+
+	ccipcommon "github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/common"
+	defaults "github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/common/default"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+const (
+	PluginCommit = "commit"
+	PluginExec   = "exec"
+
+	KindQuery       = "query"
+	KindObservation = "observation"
+	KindOutcome     = "outcome"
+	KindReport      = "report"
+)
+
+// Target selects the OCR type of a blob. Family is only needed for reports, which are encoded
+// by the offRamp of the destination chain.
+type Target struct {
+	Plugin string
+	Kind   string
+	Family string
+}
+
+func (t Target) String() string {
+	if t.Kind == KindReport {
+		return fmt.Sprintf("%s %s %s", t.Family, t.Plugin, t.Kind)
+	}
+	return fmt.Sprintf("%s %s", t.Plugin, t.Kind)
+}
+
+// typeCodec decodes a blob to its Go type and encodes the JSON of that type back to a blob.
+type typeCodec struct {
+	decode func(data []byte) (any, error)
+	encode func(jsonData []byte) ([]byte, error)
+}
+
+func newTypeCodec[T any](decode func([]byte) (T, error), encode func(T) ([]byte, error)) typeCodec {
+	return typeCodec{
+		decode: func(data []byte) (any, error) {
+			return decode(data)
+		},
+		encode: func(jsonData []byte) ([]byte, error) {
+			var value T
+			if err := json.Unmarshal(jsonData, &value); err != nil {
+				return nil, errors.Wrap(err, "error parsing json")
+			}
+			return encode(value)
+		},
+	}
+}
+
+func newReportCodec[T any](codec interface {
+	Encode(context.Context, T) ([]byte, error)
+	Decode(context.Context, []byte) (T, error)
+}) typeCodec {
+	return newTypeCodec(
+		func(data []byte) (T, error) { return codec.Decode(context.Background(), data) },
+		func(report T) ([]byte, error) { return codec.Encode(context.Background(), report) },
+	)
+}
+
+func codecFor(target Target) (typeCodec, error) {
+	commitCodec, execCodec := ocrtypecodec.DefaultCommitCodec, ocrtypecodec.DefaultExecCodec
+
+	switch target.Plugin + "/" + target.Kind {
+	case PluginCommit + "/" + KindQuery:
+		return newTypeCodec(commitCodec.DecodeQuery, commitCodec.EncodeQuery), nil
+	case PluginCommit + "/" + KindObservation:
+		return newTypeCodec(commitCodec.DecodeObservation, commitCodec.EncodeObservation), nil
+	case PluginCommit + "/" + KindOutcome:
+		return newTypeCodec(commitCodec.DecodeOutcome, commitCodec.EncodeOutcome), nil
+	case PluginExec + "/" + KindQuery:
+		return typeCodec{}, errors.New("the exec plugin query is always empty")
+	case PluginExec + "/" + KindObservation:
+		return newTypeCodec(execCodec.DecodeObservation, execCodec.EncodeObservation), nil
+	case PluginExec + "/" + KindOutcome:
+		return newTypeCodec(execCodec.DecodeOutcome, execCodec.EncodeOutcome), nil
+	case PluginCommit + "/" + KindReport, PluginExec + "/" + KindReport:
+		return reportCodecFor(target)
+	}
+	return typeCodec{}, errors.Errorf("unknown plugin %q or kind %q, expected one of [%s] and one of [%s]",
+		target.Plugin, target.Kind, strings.Join([]string{PluginCommit, PluginExec}, ", "),
+		strings.Join([]string{KindQuery, KindObservation, KindOutcome, KindReport}, ", "))
+}
+
+// reportCodecFor returns the report codec of the destination chain family, provided by the plugin config
+// registered for the family.
+func reportCodecFor(target Target) (typeCodec, error) {
+	pluginConfig, err := ccipcommon.NewPluginConfigFactory(logger.NullLogger, defaults.DefaultChainFamilyRegistry).
+		CreatePluginConfig(target.Family)
+	if err != nil {
+		return typeCodec{}, errors.Wrapf(err, "unknown chain family %q, expected one of [%s]",
+			target.Family, strings.Join(Families(), ", "))
+	}
+
+	if target.Plugin == PluginCommit {
+		if pluginConfig.CommitPluginCodec == nil {
+			return typeCodec{}, errors.Errorf("chain family %q has no commit report codec", target.Family)
+		}
+		return newReportCodec[cciptypes.CommitPluginReport](pluginConfig.CommitPluginCodec), nil
+	}
+	if pluginConfig.ExecutePluginCodec == nil {
+		return typeCodec{}, errors.Errorf("chain family %q has no exec report codec", target.Family)
+	}
+	return newReportCodec[cciptypes.ExecutePluginReport](pluginConfig.ExecutePluginCodec), nil
+}
+
+// Families returns the chain families whose reports can be decoded.
+func Families() []string {
+	return defaults.DefaultChainFamilyRegistry.Families()
+}
+
+// Decode decodes a blob, e.g. an observation found in the logs, to its Go type.
+// The protobuf codecs expect the blobs produced by the plugins and may panic on a truncated or malformed one,
+// which is returned as an error.
+func Decode(target Target, data []byte) (decoded any, err error) {
+	c, err := codecFor(target)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = errors.Errorf("error decoding %s: malformed blob: %v", target, r)
+		}
+	}()
+	decoded, err = c.decode(data)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error decoding %s", target)
+	}
+	return decoded, nil
+}
+
+// DecodeJSON decodes a blob to indented JSON.
+func DecodeJSON(target Target, data []byte) ([]byte, error) {
+	decoded, err := Decode(target, data)
+	if err != nil {
+		return nil, err
+	}
+	return json.MarshalIndent(decoded, "", "  ")
+}
+
+// Encode encodes the JSON of an OCR type, e.g. the output of DecodeJSON edited to craft a test fixture.
+func Encode(target Target, jsonData []byte) ([]byte, error) {
+	c, err := codecFor(target)
+	if err != nil {
+		return nil, err
+	}
+	encoded, err := c.encode(jsonData)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error encoding %s", target)
+	}
+	return encoded, nil
+}
+
+// ParseBlob parses a hex blob, with or without 0x prefix, falling back to base64 as found in the telemetry.
+// A blob that is valid in both encodings is parsed as hex unless asBase64 is set.
+func ParseBlob(blob string, asBase64 bool) ([]byte, error) {
+	blob = strings.TrimSpace(blob)
+	if !asBase64 {
+		if data, err := hex.DecodeString(strings.TrimPrefix(blob, "0x")); err == nil {
+			return data, nil
+		}
+		if strings.HasPrefix(blob, "0x") {
+			return nil, errors.New("invalid hex blob")
+		}
+	}
+	data, err := base64.StdEncoding.DecodeString(blob)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid base64 blob")
+	}
+	return data, nil
+}
+
+// FormatBlob formats an encoded blob as 0x prefixed hex, or base64 when asBase64 is set.
+func FormatBlob(data []byte, asBase64 bool) string {
+	if asBase64 {
+		return base64.StdEncoding.EncodeToString(data)
+	}
+	return "0x" + hex.EncodeToString(data)
+}
+
+// Diff decodes two blobs of the same type and returns their differences, one per JSON path,
+// e.g. `merkleRootOutcome.outcomeType: 1 -> 2`. Nothing is returned when they are equal.
+func Diff(target Target, a, b []byte) ([]string, error) {
+	decodedA, err := decodeGeneric(target, a)
+	if err != nil {
+		return nil, errors.Wrap(err, "first blob")
+	}
+	decodedB, err := decodeGeneric(target, b)
+	if err != nil {
+		return nil, errors.Wrap(err, "second blob")
+	}
+
+	var diffs []string
+	diffValues("", decodedA, decodedB, &diffs)
+	return diffs, nil
+}
+
+// decodeGeneric decodes a blob to the generic JSON representation of its type.
+func decodeGeneric(target Target, data []byte) (any, error) {
+	jsonData, err := DecodeJSON(target, data)
+	if err != nil {
+		return nil, err
+	}
+	var generic any
+	if err = json.Unmarshal(jsonData, &generic); err != nil {
+		return nil, errors.Wrap(err, "error parsing json")
+	}
+	return generic, nil
+}
+
+func diffValues(path string, a, b any, diffs *[]string) {
+	mapA, isMapA := a.(map[string]any)
+	mapB, isMapB := b.(map[string]any)
+	if isMapA && isMapB {
+		keys := make(map[string]struct{}, len(mapA)+len(mapB))
+		for key := range mapA {
+			keys[key] = struct{}{}
+		}
+		for key := range mapB {
+			keys[key] = struct{}{}
+		}
+		sorted := make([]string, 0, len(keys))
+		for key := range keys {
+			sorted = append(sorted, key)
+		}
+		sort.Strings(sorted)
+		for _, key := range sorted {
+			diffValues(joinPath(path, key), mapA[key], mapB[key], diffs)
+		}
+		return
+	}
+
+	sliceA, isSliceA := a.([]any)
+	sliceB, isSliceB := b.([]any)
+	if isSliceA && isSliceB {
+		for i := 0; i < len(sliceA) || i < len(sliceB); i++ {
+			var itemA, itemB any
+			if i < len(sliceA) {
+				itemA = sliceA[i]
+			}
+			if i < len(sliceB) {
+				itemB = sliceB[i]
+			}
+			diffValues(fmt.Sprintf("%s[%d]", path, i), itemA, itemB, diffs)
+		}
+		return
+	}
+
+	jsonA, jsonB := formatValue(a), formatValue(b)
+	if jsonA != jsonB {
+		*diffs = append(*diffs, fmt.Sprintf("%s: %s -> %s", path, jsonA, jsonB))
+	}
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+func formatValue(value any) string {
+	if value == nil {
+		return "<none>"
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(data)
+}
@@ -0,0 +1,94 @@
+package codec
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-ccip/commit/committypes"
+	"github.com/smartcontractkit/chainlink-ccip/commit/merkleroot"
+	ocrtypecodec "github.com/smartcontractkit/chainlink-ccip/pkg/ocrtypecodec/v1"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+)
+
+var commitOutcome = Target{Plugin: PluginCommit, Kind: KindOutcome}
+
+func encodeCommitOutcome(t *testing.T, outcomeType merkleroot.OutcomeType, inflightSeqNr cciptypes.SeqNum) []byte {
+	encoded, err := ocrtypecodec.DefaultCommitCodec.EncodeOutcome(committypes.Outcome{
+		MerkleRootOutcome: merkleroot.Outcome{OutcomeType: outcomeType},
+		MainOutcome:       committypes.MainOutcome{InflightPriceOcrSequenceNumber: inflightSeqNr},
+	})
+	require.NoError(t, err)
+	return encoded
+}
+
+func TestDecodeEncode(t *testing.T) {
+	blob := encodeCommitOutcome(t, merkleroot.ReportIntervalsSelected, 10)
+
+	jsonData, err := DecodeJSON(commitOutcome, blob)
+	require.NoError(t, err)
+	require.Contains(t, string(jsonData), `"outcomeType": 1`)
+
+	encoded, err := Encode(commitOutcome, jsonData)
+	require.NoError(t, err)
+	require.Equal(t, blob, encoded)
+
+	_, err = Decode(commitOutcome, blob[:len(blob)/2])
+	require.ErrorContains(t, err, "error decoding commit outcome")
+
+	_, err = Decode(Target{Plugin: PluginExec, Kind: KindQuery}, blob)
+	require.ErrorContains(t, err, "always empty")
+	_, err = Decode(Target{Plugin: PluginCommit, Kind: KindReport, Family: "aptos"}, blob)
+	require.ErrorContains(t, err, "unknown chain family")
+	_, err = Decode(Target{Plugin: "rmn", Kind: KindOutcome}, blob)
+	require.ErrorContains(t, err, "unknown plugin")
+}
+
+func TestDiff(t *testing.T) {
+	a := encodeCommitOutcome(t, merkleroot.ReportIntervalsSelected, 10)
+	b := encodeCommitOutcome(t, merkleroot.ReportGenerated, 11)
+
+	diffs, err := Diff(commitOutcome, a, a)
+	require.NoError(t, err)
+	require.Empty(t, diffs)
+
+	diffs, err = Diff(commitOutcome, a, b)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"mainOutcome.inflightPriceOcrSequenceNumber: 10 -> 11",
+		"merkleRootOutcome.outcomeType: 1 -> 2",
+	}, diffs)
+}
+
+func TestParseBlob(t *testing.T) {
+	data := []byte{0xde, 0xad, 0xbe, 0xef}
+
+	for _, blob := range []string{"0xdeadbeef", "deadbeef", " 0xdeadbeef\n", base64.StdEncoding.EncodeToString(data)} {
+		parsed, err := ParseBlob(blob, false)
+		require.NoError(t, err)
+		require.Equal(t, data, parsed, blob)
+	}
+
+	// "deadbeef" is also valid base64
+	parsed, err := ParseBlob("deadbeef", true)
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x75, 0xe6, 0x9d, 0x6d, 0xe7, 0x9f}, parsed)
+
+	_, err = ParseBlob("0xnothex", false)
+	require.Error(t, err)
+
+	require.Equal(t, "0xdeadbeef", FormatBlob(data, false))
+	require.Equal(t, "3q2+7w==", FormatBlob(data, true))
+}
+
+func TestReportCodecs(t *testing.T) {
+	require.Equal(t, []string{"evm", "solana"}, Families())
+
+	for _, family := range Families() {
+		for _, plugin := range []string{PluginCommit, PluginExec} {
+			_, err := codecFor(Target{Plugin: plugin, Kind: KindReport, Family: family})
+			require.NoError(t, err, "%s %s report", family, plugin)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package command
+
+import (
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/smartcontractkit/chainlink/core/scripts/ccip/ocr-codec/codec"
+)
+
+// DecodeCmd decodes a blob to JSON
+var DecodeCmd = &cobra.Command{
+	Use:   "decode [blob]",
+	Short: "Decode a hex or base64 blob to JSON.",
+	Long:  `Decodes a blob of the given plugin and kind to JSON, the blob is read from stdin when omitted or "-".`,
+	Args:  cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		blob, err := readBlob(args, 0)
+		if err != nil {
+			log.Fatal("failed to read blob: ", err)
+		}
+		decoded, err := codec.DecodeJSON(target, blob)
+		if err != nil {
+			log.Fatal("failed to decode blob: ", err)
+		}
+		fmt.Println(string(decoded))
+	},
+}
+
+// EncodeCmd encodes JSON to a blob
+var EncodeCmd = &cobra.Command{
+	Use:   "encode [json file]",
+	Short: "Encode JSON to a hex or base64 blob.",
+	Long: `Encodes the JSON of the given plugin and kind, as printed by decode, to a blob.
+The JSON is read from stdin when the file is omitted or "-".`,
+	Args: cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		var jsonData []byte
+		var err error
+		if len(args) == 1 && args[0] != "-" {
+			jsonData, err = os.ReadFile(args[0])
+		} else {
+			var input string
+			input, err = readArg(args, 0)
+			jsonData = []byte(input)
+		}
+		if err != nil {
+			log.Fatal("failed to read json: ", err)
+		}
+
+		encoded, err := codec.Encode(target, jsonData)
+		if err != nil {
+			log.Fatal("failed to encode json: ", err)
+		}
+		fmt.Println(codec.FormatBlob(encoded, asBase64))
+	},
+}
+
+// DiffCmd prints the differences between two blobs
+var DiffCmd = &cobra.Command{
+	Use:   "diff <blob> <blob>",
+	Short: "Diff two hex or base64 blobs.",
+	Long: `Decodes two blobs of the given plugin and kind, e.g. the outcomes of two consecutive rounds,
+and prints their differences, one per JSON path.`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		blobA, err := readBlob(args, 0)
+		if err != nil {
+			log.Fatal("failed to read first blob: ", err)
+		}
+		blobB, err := readBlob(args, 1)
+		if err != nil {
+			log.Fatal("failed to read second blob: ", err)
+		}
+
+		diffs, err := codec.Diff(target, blobA, blobB)
+		if err != nil {
+			log.Fatal("failed to diff blobs: ", err)
+		}
+		if len(diffs) == 0 {
+			fmt.Println("no differences")
+			return
+		}
+		for _, diff := range diffs {
+			fmt.Println(diff)
+		}
+	},
+}
+
+func readBlob(args []string, i int) ([]byte, error) {
+	blob, err := readArg(args, i)
+	if err != nil {
+		return nil, err
+	}
+	return codec.ParseBlob(blob, asBase64)
+}
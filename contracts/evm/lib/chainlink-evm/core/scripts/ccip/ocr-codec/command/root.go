@@ -0,0 +1,63 @@
+package command
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	chainsel "github.com/smartcontractkit/chain-selectors"
+
+	"github.com/smartcontractkit/chainlink/core/scripts/ccip/ocr-codec/codec"
+)
+
+var (
+	target   codec.Target
+	asBase64 bool
+)
+
+// RootCmd represents the base command when called without any subcommands
+var RootCmd = &cobra.Command{
+	Use:   "ccip-ocr-codec",
+	Short: "ChainLink CLI tool to inspect CCIP OCR blobs",
+	Long: `ccip-ocr-codec decodes the queries, observations, outcomes and reports of the CCIP commit and exec plugins,
+e.g. found in the logs or the telemetry, re-encodes them from JSON and diffs them.`,
+}
+
+// Execute adds all child commands to the root command and sets flags appropriately.
+// This is called by main.main(). It only needs to happen once to the RootCmd.
+func Execute() {
+	if err := RootCmd.Execute(); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+}
+
+func init() {
+	RootCmd.PersistentFlags().StringVar(&target.Plugin, "plugin", codec.PluginCommit,
+		fmt.Sprintf("OCR plugin, one of [%s, %s]", codec.PluginCommit, codec.PluginExec))
+	RootCmd.PersistentFlags().StringVar(&target.Kind, "kind", codec.KindOutcome,
+		fmt.Sprintf("OCR type, one of [%s]", strings.Join(
+			[]string{codec.KindQuery, codec.KindObservation, codec.KindOutcome, codec.KindReport}, ", ")))
+	RootCmd.PersistentFlags().StringVar(&target.Family, "family", chainsel.FamilyEVM,
+		fmt.Sprintf("chain family of the destination chain, for reports only, one of [%s]",
+			strings.Join(codec.Families(), ", ")))
+	RootCmd.PersistentFlags().BoolVar(&asBase64, "base64", false,
+		"read and write blobs as base64 instead of hex")
+
+	RootCmd.AddCommand(DecodeCmd, EncodeCmd, DiffCmd)
+}
+
+// readArg returns the argument, or stdin when the argument is "-" or missing.
+func readArg(args []string, i int) (string, error) {
+	if i < len(args) && args[i] != "-" {
+		return args[i], nil
+	}
+	data, err := io.ReadAll(os.Stdin)
+	if err != nil {
+		return "", fmt.Errorf("failed to read stdin: %w", err)
+	}
+	return string(data), nil
+}
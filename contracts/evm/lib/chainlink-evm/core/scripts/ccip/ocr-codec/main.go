@@ -0,0 +1,9 @@
+package main
+
+import (
+	"github.com/smartcontractkit/chainlink/core/scripts/ccip/ocr-codec/command"
+)
+
+func main() {
+	command.Execute()
+}
@@ -0,0 +1,93 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/pkg/errors"
+)
+
+var (
+	// extraABIs are the user supplied EVM ABIs, decoded in addition to the bundled CCIP ABIs.
+	extraABIs []string
+	// extraIDLs are the user supplied Anchor IDLs, decoded in addition to the CCIP SVM program errors.
+	extraIDLs []anchorIDL
+)
+
+// anchorIDL is the part of an Anchor IDL needed to decode the program custom errors.
+type anchorIDL struct {
+	// Name is set by IDLs generated before Anchor 0.30, newer ones set Metadata.Name.
+	Name     string `json:"name"`
+	Metadata struct {
+		Name string `json:"name"`
+	} `json:"metadata"`
+	Errors []anchorIDLError `json:"errors"`
+}
+
+type anchorIDLError struct {
+	Code uint32 `json:"code"`
+	Name string `json:"name"`
+	Msg  string `json:"msg"`
+}
+
+func (idl anchorIDL) programName() string {
+	if idl.Metadata.Name != "" {
+		return idl.Metadata.Name
+	}
+	return idl.Name
+}
+
+// LoadDefinitions registers extra EVM ABIs and Anchor IDLs, e.g. the ones of a CCIP receiver,
+// so that their errors are decoded by name.
+// EVM files are either a raw ABI array or a compiler artifact with an "abi" field.
+func LoadDefinitions(paths ...string) error {
+	for _, path := range paths {
+		path = strings.TrimSpace(path)
+		if path == "" {
+			continue
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return errors.Wrapf(err, "error reading %s", path)
+		}
+		if err = loadDefinition(data); err != nil {
+			return errors.Wrapf(err, "error loading %s", path)
+		}
+	}
+	return nil
+}
+
+func loadDefinition(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if len(data) > 0 && data[0] == '[' {
+		return addABI(string(data))
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return errors.Wrap(err, "error parsing json")
+	}
+	if contractABI, ok := fields["abi"]; ok {
+		return addABI(string(contractABI))
+	}
+	if _, ok := fields["errors"]; ok {
+		var idl anchorIDL
+		if err := json.Unmarshal(data, &idl); err != nil {
+			return errors.Wrap(err, "error parsing Anchor IDL")
+		}
+		extraIDLs = append(extraIDLs, idl)
+		return nil
+	}
+	return errors.New("neither an ABI, a compiler artifact nor an Anchor IDL")
+}
+
+func addABI(contractABI string) error {
+	if _, err := abi.JSON(strings.NewReader(contractABI)); err != nil {
+		return errors.Wrap(err, "error parsing ABI")
+	}
+	extraABIs = append(extraABIs, contractABI)
+	return nil
+}
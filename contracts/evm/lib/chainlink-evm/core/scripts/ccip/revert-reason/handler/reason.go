@@ -40,7 +40,7 @@ import (
 // RevertReasonFromErrorCodeString attempts to decode an error code string
 func (h *BaseHandler) RevertReasonFromErrorCodeString(errorCodeString string) (string, error) {
 	errorCodeString = strings.TrimPrefix(errorCodeString, "0x")
-	return DecodeErrorStringFromABI(errorCodeString)
+	return DecodeErrorString(errorCodeString, "")
 }
 
 // RevertReasonFromTx attempts to fetch more info on failed TX
@@ -88,18 +88,16 @@ func DecodeErrorStringFromABI(errorString string) (string, error) {
 
 				// If exec error, the actual error is within the revert reason
 				if errorName == "ExecutionError" || errorName == "TokenRateLimitError" || errorName == "TokenHandlingError" || errorName == "ReceiverError" {
-					// Get the inner type, which is `bytes`
-					fmt.Printf("Error is \"%v\" \ninner error: ", errorName)
-					var errorBytes []byte
-					if errorName == "TokenHandlingError" {
-						errorBytes = v.([]interface{})[1].([]byte)
-					} else {
-						errorBytes = v.([]interface{})[0].([]byte)
-					}
+					// The inner error is the last `bytes` argument, nested errors are decoded recursively.
+					errorBytes := innerErrorBytes(v)
 					if len(errorBytes) < 4 {
-						return "[reverted without error code]", nil
+						return fmt.Sprintf("error is \"%v\"\ninner error: [reverted without error code]", errorName), nil
+					}
+					innerError, err3 := DecodeErrorStringFromABI(hex.EncodeToString(errorBytes))
+					if err3 != nil {
+						return "", errors.Wrapf(err3, "error decoding inner error of %s", errorName)
 					}
-					return DecodeErrorStringFromABI(hex.EncodeToString(errorBytes))
+					return fmt.Sprintf("error is \"%v\"\ninner error: %s", errorName, innerError), nil
 				}
 				return fmt.Sprintf("error is \"%v\" args %v\n", errorName, v), nil
 			}
@@ -139,8 +137,20 @@ func DecodeErrorStringFromABI(errorString string) (string, error) {
 	return "", errors.Errorf(`cannot match error with contract ABI. Error code "%s"`, errorString)
 }
 
+// innerErrorBytes returns the last `bytes` argument of an error wrapping another one, e.g.
+// ReceiverError(bytes err) or TokenHandlingError(address target, bytes err).
+func innerErrorBytes(unpacked interface{}) []byte {
+	args, _ := unpacked.([]interface{})
+	for i := len(args) - 1; i >= 0; i-- {
+		if b, ok := args[i].([]byte); ok {
+			return b
+		}
+	}
+	return nil
+}
+
 func getAllABIs() []string {
-	return []string{
+	return append([]string{
 		rmn_contract.RMNContractABI,
 		lock_release_token_pool_1_4_0.LockReleaseTokenPoolABI,
 		burn_mint_token_pool_1_2_0.BurnMintTokenPoolABI,
@@ -159,7 +169,7 @@ func getAllABIs() []string {
 		onramp.OnRampABI,
 		offramp.OffRampABI,
 		maybe_revert_message_receiver.MaybeRevertMessageReceiverABI,
-	}
+	}, extraABIs...)
 }
 
 func GetErrorForTx(client *ethclient.Client, txHash string, requester string) (string, error) {
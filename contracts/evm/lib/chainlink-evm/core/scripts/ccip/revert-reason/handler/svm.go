@@ -0,0 +1,127 @@
+package handler
+
+import (
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	solccip "github.com/smartcontractkit/chainlink-ccip/chains/solana/utils/ccip"
+)
+
+// ccipProgramErrors are the custom errors of the CCIP SVM programs, keyed by the name accepted as program filter.
+// The offramp and rmnremote codes overlap, in which case every match is returned unless a program is given.
+var ccipProgramErrors = map[string]svmProgramErrors{
+	"router": {
+		offset: solccip.CcipRouterErrorCodeOffset,
+		name:   func(value uint32) string { return solccip.CcipRouterError(value).String() },
+	},
+	"feequoter": {
+		offset: solccip.FeeQuoterErrorCodeOffset,
+		name:   func(value uint32) string { return solccip.FeeQuoterError(value).String() },
+	},
+	"offramp": {
+		offset: solccip.CcipOfframpErrorCodeOffset,
+		name:   func(value uint32) string { return solccip.CcipOfframpError(value).String() },
+	},
+	"rmnremote": {
+		offset: solccip.RmnRemoteErrorCodeOffset,
+		name:   func(value uint32) string { return solccip.RmnRemoteError(value).String() },
+	},
+	"common": {
+		offset: solccip.CommonCcipErrorCodeOffset,
+		name:   func(value uint32) string { return solccip.CommonCcipError(value).String() },
+	},
+}
+
+// svmProgramErrors decodes the custom errors of a program from its error enum.
+type svmProgramErrors struct {
+	offset uint32
+	// name returns the name of the enum value, empty if there is none.
+	name func(value uint32) string
+}
+
+func (e svmProgramErrors) errorName(code uint32) (string, bool) {
+	if code < e.offset {
+		return "", false
+	}
+	name := e.name(code - e.offset)
+	return name, name != ""
+}
+
+var (
+	// e.g. "Program Ccip842gzYHhvdDkSyi2YVCoAWPbYJoApMFzSxQroE9C failed: custom program error: 0x1b5b"
+	customProgramErrorRegex = regexp.MustCompile(`custom program error: 0x([0-9a-fA-F]+)`)
+	// e.g. {"InstructionError":[0,{"Custom":7003}]}
+	instructionErrorRegex = regexp.MustCompile(`"Custom"\s*:\s*(\d+)`)
+	// e.g. "AnchorError occurred. Error Code: InvalidVersion. Error Number: 7003. Error Message: ..."
+	anchorErrorNumberRegex = regexp.MustCompile(`Error Number: (\d+)`)
+)
+
+// DecodeErrorString decodes either an EVM revert data or a Solana program failure.
+// svmProgram optionally restricts the SVM decoding to a single program, see DecodeSVMError.
+func DecodeErrorString(errorString string, svmProgram string) (string, error) {
+	if _, ok := parseSVMErrorCode(errorString); ok {
+		return DecodeSVMError(errorString, svmProgram)
+	}
+	return DecodeErrorStringFromABI(errorString)
+}
+
+// DecodeSVMError decodes the error code of a failed Solana transaction, given either as the
+// custom program error of the transaction logs, the InstructionError of the transaction status
+// or the AnchorError log line.
+// program is one of router, feequoter, offramp, rmnremote, common or the name of a loaded IDL.
+// When empty, every CCIP program and loaded IDL defining the code is returned.
+func DecodeSVMError(errorString string, program string) (string, error) {
+	code, ok := parseSVMErrorCode(errorString)
+	if !ok {
+		return "", errors.Errorf(`no Solana error code found in "%s"`, errorString)
+	}
+
+	if anchorError, ok := anchorErrors[code]; ok {
+		return fmt.Sprintf("anchor error \"%s\": %s (%d)", anchorError.name, anchorError.msg, code), nil
+	}
+
+	var matches []string
+	for _, name := range slices.Sorted(maps.Keys(ccipProgramErrors)) {
+		if program != "" && program != name {
+			continue
+		}
+		if errorName, ok := ccipProgramErrors[name].errorName(code); ok {
+			matches = append(matches, fmt.Sprintf("%s error \"%s\"", name, errorName))
+		}
+	}
+	for _, idl := range extraIDLs {
+		if program != "" && program != idl.programName() {
+			continue
+		}
+		for _, idlError := range idl.Errors {
+			if idlError.Code == code {
+				matches = append(matches, fmt.Sprintf("%s error \"%s\": %s", idl.programName(), idlError.Name, idlError.Msg))
+			}
+		}
+	}
+
+	if len(matches) == 0 {
+		return "", errors.Errorf(`cannot match error with program errors. Error code %d`, code)
+	}
+	return strings.Join(matches, " or ") + fmt.Sprintf(" (%d)", code), nil
+}
+
+func parseSVMErrorCode(errorString string) (uint32, bool) {
+	if m := customProgramErrorRegex.FindStringSubmatch(errorString); m != nil {
+		code, err := strconv.ParseUint(m[1], 16, 32)
+		return uint32(code), err == nil
+	}
+	for _, re := range []*regexp.Regexp{instructionErrorRegex, anchorErrorNumberRegex} {
+		if m := re.FindStringSubmatch(errorString); m != nil {
+			code, err := strconv.ParseUint(m[1], 10, 32)
+			return uint32(code), err == nil
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,137 @@
+package handler
+
+import (
+	"encoding/hex"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-ccip/chains/evm/gobindings/generated/v1_6_0/offramp"
+)
+
+const bridglErrorsABI = `[
+	{"type":"error","name":"InsufficientFees","inputs":[{"name":"balance","type":"uint256"},{"name":"amount","type":"uint256"}]},
+	{"type":"error","name":"WrapperDoesNotExist","inputs":[{"name":"chainSelector","type":"uint64"},{"name":"bridglAddress","type":"bytes"},{"name":"underlyingToken","type":"bytes"}]}
+]`
+
+const bridglIDL = `{
+	"address": "11111111111111111111111111111111",
+	"metadata": {"name": "bridgl", "version": "0.1.0", "spec": "0.1.0"},
+	"errors": [
+		{"code": 6000, "name": "InvalidCaller", "msg": "Caller is not the configured CCIP router"}
+	]
+}`
+
+func TestDecodeSVMError(t *testing.T) {
+	tests := []struct {
+		name        string
+		errorString string
+		program     string
+		expected    string
+	}{
+		{
+			name:        "custom program error log",
+			errorString: "Program Ccip842gzYHhvdDkSyi2YVCoAWPbYJoApMFzSxQroE9C failed: custom program error: 0x1b5b",
+			expected:    `router error "InvalidVersion" (7003)`,
+		},
+		{
+			name:        "instruction error",
+			errorString: `{"InstructionError":[0,{"Custom":7003}]}`,
+			expected:    `router error "InvalidVersion" (7003)`,
+		},
+		{
+			name:        "anchor error log",
+			errorString: "AnchorError occurred. Error Code: InvalidVersion. Error Number: 7003. Error Message: Invalid version.",
+			expected:    `router error "InvalidVersion" (7003)`,
+		},
+		{
+			name:        "anchor framework error",
+			errorString: `{"InstructionError":[2,{"Custom":2006}]}`,
+			expected:    `anchor error "ConstraintSeeds": A seeds constraint was violated (2006)`,
+		},
+		{
+			name:        "anchor account error",
+			errorString: `{"InstructionError":[0,{"Custom":3012}]}`,
+			expected:    `anchor error "AccountNotInitialized": The program expected this account to be already initialized (3012)`,
+		},
+		{
+			name:        "error missing from the hand maintained enums before",
+			errorString: `{"InstructionError":[0,{"Custom":7001}]}`,
+			expected:    `router error "InvalidRMNRemoteAddress" (7001)`,
+		},
+		{
+			name:        "common error",
+			errorString: `{"InstructionError":[0,{"Custom":10007}]}`,
+			expected:    `common error "InvalidChainFamilySelector" (10007)`,
+		},
+		{
+			name:        "overlapping codes",
+			errorString: `{"InstructionError":[0,{"Custom":9000}]}`,
+			expected:    `offramp error "InvalidSequenceInterval" or rmnremote error "Unauthorized" (9000)`,
+		},
+		{
+			name:        "program filter",
+			errorString: `{"InstructionError":[0,{"Custom":9000}]}`,
+			program:     "rmnremote",
+			expected:    `rmnremote error "Unauthorized" (9000)`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DecodeSVMError(tt.errorString, tt.program)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, got)
+		})
+	}
+
+	_, err := DecodeSVMError(`{"InstructionError":[0,{"Custom":1}]}`, "")
+	require.ErrorContains(t, err, "cannot match error")
+
+	// past the last router error
+	_, err = DecodeSVMError(`{"InstructionError":[0,{"Custom":7999}]}`, "router")
+	require.ErrorContains(t, err, "cannot match error")
+}
+
+func TestLoadDefinitions(t *testing.T) {
+	t.Cleanup(func() {
+		extraABIs, extraIDLs = nil, nil
+	})
+
+	dir := t.TempDir()
+	artifactPath := filepath.Join(dir, "Bridgl.json")
+	require.NoError(t, os.WriteFile(artifactPath, []byte(`{"abi": `+bridglErrorsABI+`}`), 0o600))
+	idlPath := filepath.Join(dir, "bridgl_idl.json")
+	require.NoError(t, os.WriteFile(idlPath, []byte(bridglIDL), 0o600))
+	require.NoError(t, LoadDefinitions(artifactPath, idlPath))
+
+	// an IDL error is decoded by name
+	got, err := DecodeErrorString("Program failed: custom program error: 0x1770", "bridgl")
+	require.NoError(t, err)
+	require.Equal(t, `bridgl error "InvalidCaller": Caller is not the configured CCIP router (6000)`, got)
+
+	// a receiver error nested in the OffRamp ReceiverError is decoded recursively
+	bridglABI, err := abi.JSON(strings.NewReader(bridglErrorsABI))
+	require.NoError(t, err)
+	insufficientFees := bridglABI.Errors["InsufficientFees"]
+	args, err := insufficientFees.Inputs.Pack(big.NewInt(1), big.NewInt(2))
+	require.NoError(t, err)
+	innerError := append(insufficientFees.ID.Bytes()[:4], args...)
+
+	offRampABI, err := offramp.OffRampMetaData.GetAbi()
+	require.NoError(t, err)
+	receiverError := offRampABI.Errors["ReceiverError"]
+	args, err = receiverError.Inputs.Pack(innerError)
+	require.NoError(t, err)
+	outerError := append(receiverError.ID.Bytes()[:4], args...)
+
+	got, err = DecodeErrorString(hex.EncodeToString(outerError), "")
+	require.NoError(t, err)
+	require.Equal(t, "error is \"ReceiverError\"\ninner error: error is \"InsufficientFees\" args [1 2]\n", got)
+
+	require.Error(t, LoadDefinitions(filepath.Join(dir, "missing.json")))
+}
@@ -25,6 +25,68 @@ func (_m *ORM) EXPECT() *ORM_Expecter {
 	return &ORM_Expecter{mock: &_m.Mock}
 }
 
+// GetGasPriceHistory provides a mock function with given fields: ctx, destChainSelector, sourceChainSelector, from, to
+func (_m *ORM) GetGasPriceHistory(ctx context.Context, destChainSelector uint64, sourceChainSelector uint64, from time.Time, to time.Time) ([]ccip.GasPriceHistory, error) {
+	ret := _m.Called(ctx, destChainSelector, sourceChainSelector, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGasPriceHistory")
+	}
+
+	var r0 []ccip.GasPriceHistory
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, time.Time, time.Time) ([]ccip.GasPriceHistory, error)); ok {
+		return rf(ctx, destChainSelector, sourceChainSelector, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, time.Time, time.Time) []ccip.GasPriceHistory); ok {
+		r0 = rf(ctx, destChainSelector, sourceChainSelector, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]ccip.GasPriceHistory)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, destChainSelector, sourceChainSelector, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ORM_GetGasPriceHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGasPriceHistory'
+type ORM_GetGasPriceHistory_Call struct {
+	*mock.Call
+}
+
+// GetGasPriceHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - destChainSelector uint64
+//   - sourceChainSelector uint64
+//   - from time.Time
+//   - to time.Time
+func (_e *ORM_Expecter) GetGasPriceHistory(ctx interface{}, destChainSelector interface{}, sourceChainSelector interface{}, from interface{}, to interface{}) *ORM_GetGasPriceHistory_Call {
+	return &ORM_GetGasPriceHistory_Call{Call: _e.mock.On("GetGasPriceHistory", ctx, destChainSelector, sourceChainSelector, from, to)}
+}
+
+func (_c *ORM_GetGasPriceHistory_Call) Run(run func(ctx context.Context, destChainSelector uint64, sourceChainSelector uint64, from time.Time, to time.Time)) *ORM_GetGasPriceHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64), args[3].(time.Time), args[4].(time.Time))
+	})
+	return _c
+}
+
+func (_c *ORM_GetGasPriceHistory_Call) Return(_a0 []ccip.GasPriceHistory, _a1 error) *ORM_GetGasPriceHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ORM_GetGasPriceHistory_Call) RunAndReturn(run func(context.Context, uint64, uint64, time.Time, time.Time) ([]ccip.GasPriceHistory, error)) *ORM_GetGasPriceHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetGasPricesByDestChain provides a mock function with given fields: ctx, destChainSelector
 func (_m *ORM) GetGasPricesByDestChain(ctx context.Context, destChainSelector uint64) ([]ccip.GasPrice, error) {
 	ret := _m.Called(ctx, destChainSelector)
@@ -84,6 +146,68 @@ func (_c *ORM_GetGasPricesByDestChain_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// GetTokenPriceHistory provides a mock function with given fields: ctx, destChainSelector, tokenAddr, from, to
+func (_m *ORM) GetTokenPriceHistory(ctx context.Context, destChainSelector uint64, tokenAddr string, from time.Time, to time.Time) ([]ccip.TokenPriceHistory, error) {
+	ret := _m.Called(ctx, destChainSelector, tokenAddr, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTokenPriceHistory")
+	}
+
+	var r0 []ccip.TokenPriceHistory
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, string, time.Time, time.Time) ([]ccip.TokenPriceHistory, error)); ok {
+		return rf(ctx, destChainSelector, tokenAddr, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, string, time.Time, time.Time) []ccip.TokenPriceHistory); ok {
+		r0 = rf(ctx, destChainSelector, tokenAddr, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]ccip.TokenPriceHistory)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, string, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, destChainSelector, tokenAddr, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ORM_GetTokenPriceHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTokenPriceHistory'
+type ORM_GetTokenPriceHistory_Call struct {
+	*mock.Call
+}
+
+// GetTokenPriceHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - destChainSelector uint64
+//   - tokenAddr string
+//   - from time.Time
+//   - to time.Time
+func (_e *ORM_Expecter) GetTokenPriceHistory(ctx interface{}, destChainSelector interface{}, tokenAddr interface{}, from interface{}, to interface{}) *ORM_GetTokenPriceHistory_Call {
+	return &ORM_GetTokenPriceHistory_Call{Call: _e.mock.On("GetTokenPriceHistory", ctx, destChainSelector, tokenAddr, from, to)}
+}
+
+func (_c *ORM_GetTokenPriceHistory_Call) Run(run func(ctx context.Context, destChainSelector uint64, tokenAddr string, from time.Time, to time.Time)) *ORM_GetTokenPriceHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(string), args[3].(time.Time), args[4].(time.Time))
+	})
+	return _c
+}
+
+func (_c *ORM_GetTokenPriceHistory_Call) Return(_a0 []ccip.TokenPriceHistory, _a1 error) *ORM_GetTokenPriceHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ORM_GetTokenPriceHistory_Call) RunAndReturn(run func(context.Context, uint64, string, time.Time, time.Time) ([]ccip.TokenPriceHistory, error)) *ORM_GetTokenPriceHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetTokenPricesByDestChain provides a mock function with given fields: ctx, destChainSelector
 func (_m *ORM) GetTokenPricesByDestChain(ctx context.Context, destChainSelector uint64) ([]ccip.TokenPrice, error) {
 	ret := _m.Called(ctx, destChainSelector)
@@ -143,6 +267,63 @@ func (_c *ORM_GetTokenPricesByDestChain_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// PrunePriceHistory provides a mock function with given fields: ctx, retention
+func (_m *ORM) PrunePriceHistory(ctx context.Context, retention ccip.PriceHistoryRetention) (int64, error) {
+	ret := _m.Called(ctx, retention)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PrunePriceHistory")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, ccip.PriceHistoryRetention) (int64, error)); ok {
+		return rf(ctx, retention)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, ccip.PriceHistoryRetention) int64); ok {
+		r0 = rf(ctx, retention)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, ccip.PriceHistoryRetention) error); ok {
+		r1 = rf(ctx, retention)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ORM_PrunePriceHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PrunePriceHistory'
+type ORM_PrunePriceHistory_Call struct {
+	*mock.Call
+}
+
+// PrunePriceHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - retention ccip.PriceHistoryRetention
+func (_e *ORM_Expecter) PrunePriceHistory(ctx interface{}, retention interface{}) *ORM_PrunePriceHistory_Call {
+	return &ORM_PrunePriceHistory_Call{Call: _e.mock.On("PrunePriceHistory", ctx, retention)}
+}
+
+func (_c *ORM_PrunePriceHistory_Call) Run(run func(ctx context.Context, retention ccip.PriceHistoryRetention)) *ORM_PrunePriceHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(ccip.PriceHistoryRetention))
+	})
+	return _c
+}
+
+func (_c *ORM_PrunePriceHistory_Call) Return(_a0 int64, _a1 error) *ORM_PrunePriceHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ORM_PrunePriceHistory_Call) RunAndReturn(run func(context.Context, ccip.PriceHistoryRetention) (int64, error)) *ORM_PrunePriceHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpsertGasPricesForDestChain provides a mock function with given fields: ctx, destChainSelector, gasPrices
 func (_m *ORM) UpsertGasPricesForDestChain(ctx context.Context, destChainSelector uint64, gasPrices []ccip.GasPrice) (int64, error) {
 	ret := _m.Called(ctx, destChainSelector, gasPrices)
@@ -84,6 +84,25 @@ func (o *observedORM) UpsertTokenPricesForDestChain(ctx context.Context, destCha
 	})
 }
 
+func (o *observedORM) GetGasPriceHistory(ctx context.Context, destChainSelector uint64, sourceChainSelector uint64, from, to time.Time) ([]GasPriceHistory, error) {
+	return withObservedQueryAndResults(o, "GetGasPriceHistory", destChainSelector, func() ([]GasPriceHistory, error) {
+		return o.ORM.GetGasPriceHistory(ctx, destChainSelector, sourceChainSelector, from, to)
+	})
+}
+
+func (o *observedORM) GetTokenPriceHistory(ctx context.Context, destChainSelector uint64, tokenAddr string, from, to time.Time) ([]TokenPriceHistory, error) {
+	return withObservedQueryAndResults(o, "GetTokenPriceHistory", destChainSelector, func() ([]TokenPriceHistory, error) {
+		return o.ORM.GetTokenPriceHistory(ctx, destChainSelector, tokenAddr, from, to)
+	})
+}
+
+// PrunePriceHistory is not bound to a chain, it is tracked with the destChainSelector label set to 0.
+func (o *observedORM) PrunePriceHistory(ctx context.Context, retention PriceHistoryRetention) (int64, error) {
+	return withObservedQueryAndRowsAffected(o, "PrunePriceHistory", 0, func() (int64, error) {
+		return o.ORM.PrunePriceHistory(ctx, retention)
+	})
+}
+
 func withObservedQueryAndRowsAffected(o *observedORM, queryName string, chainSelector uint64, query func() (int64, error)) (int64, error) {
 	rowsAffected, err := withObservedQuery(o, queryName, chainSelector, query)
 	if err == nil {
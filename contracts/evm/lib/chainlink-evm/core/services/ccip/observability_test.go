@@ -70,6 +70,22 @@ func Test_MetricsAreTrackedForAllMethods(t *testing.T) {
 	assert.Equal(t, len(gasPrices), len(gas))
 	assert.Equal(t, len(gasPrices), counterFromGaugeByLabels(ccipORM.datasetSize, "GetGasPricesByDestChain", "100"))
 	assert.Equal(t, 1, counterFromHistogramByLabels(t, ccipORM.queryDuration, "GetGasPricesByDestChain", "100"))
+
+	gasHistory, err := ccipORM.GetGasPriceHistory(ctx, 100, 200, time.Now().Add(-time.Hour), time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.Len(t, gasHistory, 1)
+	assert.Equal(t, 1, counterFromGaugeByLabels(ccipORM.datasetSize, "GetGasPriceHistory", "100"))
+	assert.Equal(t, 1, counterFromHistogramByLabels(t, ccipORM.queryDuration, "GetGasPriceHistory", "100"))
+
+	tokenHistory, err := ccipORM.GetTokenPriceHistory(ctx, 100, "", time.Now().Add(-time.Hour), time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.Len(t, tokenHistory, len(tokenPrices))
+	assert.Equal(t, len(tokenPrices), counterFromGaugeByLabels(ccipORM.datasetSize, "GetTokenPriceHistory", "100"))
+
+	deleted, err := ccipORM.PrunePriceHistory(ctx, PriceHistoryRetention{GasPrices: time.Hour, TokenPrices: time.Hour})
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), deleted)
+	assert.Equal(t, 1, counterFromHistogramByLabels(t, ccipORM.queryDuration, "PrunePriceHistory", "0"))
 }
 
 func counterFromHistogramByLabels(t *testing.T, histogramVec *prometheus.HistogramVec, labels ...string) int {
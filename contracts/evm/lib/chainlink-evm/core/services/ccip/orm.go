@@ -10,6 +10,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink-evm/pkg/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
 )
 
 type GasPrice struct {
@@ -22,12 +23,42 @@ type TokenPrice struct {
 	TokenPrice *assets.Wei
 }
 
+// GasPriceHistory is a gas price this node observed for a destination chain at ObservedAt.
+// It's the price written to ccip.observed_gas_prices, not the price committed onchain by the DON.
+type GasPriceHistory struct {
+	SourceChainSelector uint64
+	GasPrice            *assets.Wei
+	ObservedAt          time.Time
+}
+
+// TokenPriceHistory is a token price this node observed for a destination chain at ObservedAt.
+// It's the price written to ccip.observed_token_prices, not the price committed onchain by the DON.
+type TokenPriceHistory struct {
+	TokenAddr  string
+	TokenPrice *assets.Wei
+	ObservedAt time.Time
+}
+
+// PriceHistoryRetention is how long the price history is kept, a zero duration keeps it forever.
+type PriceHistoryRetention struct {
+	GasPrices   time.Duration
+	TokenPrices time.Duration
+}
+
 type ORM interface {
 	GetGasPricesByDestChain(ctx context.Context, destChainSelector uint64) ([]GasPrice, error)
 	GetTokenPricesByDestChain(ctx context.Context, destChainSelector uint64) ([]TokenPrice, error)
 
 	UpsertGasPricesForDestChain(ctx context.Context, destChainSelector uint64, gasPrices []GasPrice) (int64, error)
 	UpsertTokenPricesForDestChain(ctx context.Context, destChainSelector uint64, tokenPrices []TokenPrice, interval time.Duration) (int64, error)
+
+	// GetGasPriceHistory returns the gas prices of the source chain observed by this node for the destination chain in [from, to), oldest first.
+	GetGasPriceHistory(ctx context.Context, destChainSelector uint64, sourceChainSelector uint64, from, to time.Time) ([]GasPriceHistory, error)
+	// GetTokenPriceHistory returns the token prices observed by this node for the destination chain in [from, to), oldest first.
+	// All the tokens are returned when tokenAddr is empty.
+	GetTokenPriceHistory(ctx context.Context, destChainSelector uint64, tokenAddr string, from, to time.Time) ([]TokenPriceHistory, error)
+	// PrunePriceHistory deletes the price history older than the retention and returns the number of deleted rows.
+	PrunePriceHistory(ctx context.Context, retention PriceHistoryRetention) (int64, error)
 }
 
 type orm struct {
@@ -101,18 +132,36 @@ func (o *orm) UpsertGasPricesForDestChain(ctx context.Context, destChainSelector
 		VALUES (:chain_selector, :source_chain_selector, :gas_price, statement_timestamp())
 		ON CONFLICT (source_chain_selector, chain_selector)
 		DO UPDATE SET gas_price = EXCLUDED.gas_price, updated_at = EXCLUDED.updated_at;`
+	historyStmt := `INSERT INTO ccip.node_gas_price_observations (chain_selector, source_chain_selector, gas_price, observed_at)
+		VALUES (:chain_selector, :source_chain_selector, :gas_price, statement_timestamp());`
 
-	result, err := o.ds.NamedExecContext(ctx, stmt, insertData)
+	// The history is written in the same transaction, so that it never misses a price of the table.
+	var rowsAffected int64
+	err := sqlutil.TransactDataSource(ctx, o.ds, nil, func(tx sqlutil.DataSource) error {
+		result, err := tx.NamedExecContext(ctx, stmt, insertData)
+		if err != nil {
+			return fmt.Errorf("error inserting gas prices %w", err)
+		}
+		if rowsAffected, err = result.RowsAffected(); err != nil {
+			return err
+		}
+
+		if _, err = tx.NamedExecContext(ctx, historyStmt, insertData); err != nil {
+			return fmt.Errorf("error inserting gas price history %w", err)
+		}
+		return nil
+	})
 	if err != nil {
-		return 0, fmt.Errorf("error inserting gas prices %w", err)
+		return 0, err
 	}
-	return result.RowsAffected()
+	return rowsAffected, nil
 }
 
 // UpsertTokenPricesForDestChain inserts or updates only relevant token prices.
 // In order to reduce locking an unnecessary writes to the table, we start with fetching current prices.
 // If price for a token doesn't change or was updated recently we don't include that token to the upsert query.
-// We don't run in TX intentionally, because we don't want to lock the table and conflicts are resolved on the insert level
+// We don't read in the TX intentionally, because we don't want to lock the table and conflicts are resolved on the insert level.
+// Only the upsert and the price history insert share a TX, so that the history never misses a price of the table.
 func (o *orm) UpsertTokenPricesForDestChain(ctx context.Context, destChainSelector uint64, tokenPrices []TokenPrice, interval time.Duration) (int64, error) {
 	if len(tokenPrices) == 0 {
 		return 0, nil
@@ -136,11 +185,110 @@ func (o *orm) UpsertTokenPricesForDestChain(ctx context.Context, destChainSelect
 		VALUES (:chain_selector, :token_addr, :token_price, statement_timestamp())
 		ON CONFLICT (token_addr, chain_selector) 
 		DO UPDATE SET token_price = EXCLUDED.token_price, updated_at = EXCLUDED.updated_at;`
-	result, err := o.ds.NamedExecContext(ctx, stmt, insertData)
+	historyStmt := `INSERT INTO ccip.node_token_price_observations (chain_selector, token_addr, token_price, observed_at)
+		VALUES (:chain_selector, :token_addr, :token_price, statement_timestamp());`
+
+	var rowsAffected int64
+	err = sqlutil.TransactDataSource(ctx, o.ds, nil, func(tx sqlutil.DataSource) error {
+		result, err := tx.NamedExecContext(ctx, stmt, insertData)
+		if err != nil {
+			return fmt.Errorf("error inserting token prices %w", err)
+		}
+		if rowsAffected, err = result.RowsAffected(); err != nil {
+			return err
+		}
+
+		if _, err = tx.NamedExecContext(ctx, historyStmt, insertData); err != nil {
+			return fmt.Errorf("error inserting token price history %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return rowsAffected, nil
+}
+
+func (o *orm) GetGasPriceHistory(ctx context.Context, destChainSelector uint64, sourceChainSelector uint64, from, to time.Time) ([]GasPriceHistory, error) {
+	var gasPrices []GasPriceHistory
+	stmt := `
+		SELECT source_chain_selector, gas_price, observed_at
+		FROM ccip.node_gas_price_observations
+		WHERE chain_selector = $1
+			AND source_chain_selector = $2
+			AND observed_at >= $3
+			AND observed_at < $4
+		ORDER BY observed_at, id;
+	`
+	err := o.ds.SelectContext(ctx, &gasPrices, stmt, destChainSelector, sourceChainSelector, from, to)
 	if err != nil {
-		return 0, fmt.Errorf("error inserting token prices %w", err)
+		return nil, err
+	}
+	return gasPrices, nil
+}
+
+func (o *orm) GetTokenPriceHistory(ctx context.Context, destChainSelector uint64, tokenAddr string, from, to time.Time) ([]TokenPriceHistory, error) {
+	var tokenPrices []TokenPriceHistory
+	stmt := `
+		SELECT token_addr, token_price, observed_at
+		FROM ccip.node_token_price_observations
+		WHERE chain_selector = $1
+			AND ($2 = '' OR token_addr = $3)
+			AND observed_at >= $4
+			AND observed_at < $5
+		ORDER BY observed_at, id;
+	`
+	err := o.ds.SelectContext(ctx, &tokenPrices, stmt, destChainSelector, tokenAddr, []byte(tokenAddr), from, to)
+	if err != nil {
+		return nil, err
+	}
+	return tokenPrices, nil
+}
+
+// PrunePriceHistory deletes the history older than the retention, in batches to avoid long locking or queries.
+// Every price service of a node prunes the same tables, which is harmless since the deletes are idempotent.
+func (o *orm) PrunePriceHistory(ctx context.Context, retention PriceHistoryRetention) (int64, error) {
+	var deleted int64
+	tables := []struct {
+		name      string
+		retention time.Duration
+	}{
+		{"ccip.node_gas_price_observations", retention.GasPrices},
+		{"ccip.node_token_price_observations", retention.TokenPrices},
+	}
+	for _, table := range tables {
+		if table.retention <= 0 {
+			continue
+		}
+
+		pgInterval := fmt.Sprintf("%d milliseconds", table.retention.Milliseconds())
+		stmt := fmt.Sprintf(`
+			WITH batch AS (
+				SELECT id FROM %[1]s
+				WHERE observed_at < statement_timestamp() - $1::interval
+				ORDER BY observed_at
+				LIMIT $2
+			)
+			DELETE FROM %[1]s
+			USING batch
+			WHERE %[1]s.id = batch.id;`, table.name)
+		err := pg.Batch(func(_, limit uint) (count uint, err error) {
+			result, err := o.ds.ExecContext(ctx, stmt, pgInterval, limit)
+			if err != nil {
+				return 0, fmt.Errorf("error pruning %s %w", table.name, err)
+			}
+			rows, err := result.RowsAffected()
+			if err != nil {
+				return 0, err
+			}
+			deleted += rows
+			return uint(rows), nil
+		})
+		if err != nil {
+			return deleted, err
+		}
 	}
-	return result.RowsAffected()
+	return deleted, nil
 }
 
 // pickOnlyRelevantTokensForUpdate returns only tokens that need to be updated. Multiple jobs can be updating the same tokens,
@@ -16,6 +16,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
 )
 
 var (
@@ -356,6 +357,139 @@ func TestORM_InsertTokenPricesWhenExpired(t *testing.T) {
 	}
 }
 
+func TestORM_GasPriceHistory(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	orm, _ := setupORM(t)
+
+	destSelector := rand.Uint64()
+	sourceSelector := rand.Uint64()
+	from := time.Now().Add(-time.Minute)
+
+	var expectedPrices []*assets.Wei
+	for i := 0; i < 3; i++ {
+		price := assets.NewWei(big.NewInt(int64(i + 1)))
+		expectedPrices = append(expectedPrices, price)
+		_, err := orm.UpsertGasPricesForDestChain(ctx, destSelector, []GasPrice{
+			{SourceChainSelector: sourceSelector, GasPrice: price},
+			{SourceChainSelector: sourceSelector + 1, GasPrice: price},
+		})
+		require.NoError(t, err)
+	}
+
+	history, err := orm.GetGasPriceHistory(ctx, destSelector, sourceSelector, from, time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	require.Len(t, history, len(expectedPrices))
+	for i, price := range history {
+		assert.Equal(t, sourceSelector, price.SourceChainSelector)
+		assert.Equal(t, expectedPrices[i], price.GasPrice)
+		if i > 0 {
+			assert.False(t, price.ObservedAt.Before(history[i-1].ObservedAt))
+		}
+	}
+
+	// Only the latest price is kept in the prices table
+	prices, err := orm.GetGasPricesByDestChain(ctx, destSelector)
+	require.NoError(t, err)
+	assert.Len(t, prices, 2)
+
+	history, err = orm.GetGasPriceHistory(ctx, destSelector, sourceSelector, from.Add(-time.Hour), from)
+	require.NoError(t, err)
+	assert.Empty(t, history)
+
+	history, err = orm.GetGasPriceHistory(ctx, destSelector+1, sourceSelector, from, time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	assert.Empty(t, history)
+}
+
+func TestORM_TokenPriceHistory(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	orm, _ := setupORM(t)
+
+	destSelector := rand.Uint64()
+	addrs := generateTokenAddresses(2)
+	from := time.Now().Add(-time.Minute)
+
+	_, err := orm.UpsertTokenPricesForDestChain(ctx, destSelector, generateRandomTokenPrices(addrs), time.Minute)
+	require.NoError(t, err)
+
+	// Prices that are not updated aren't recorded either
+	_, err = orm.UpsertTokenPricesForDestChain(ctx, destSelector, generateRandomTokenPrices(addrs), time.Minute)
+	require.NoError(t, err)
+
+	time.Sleep(10 * time.Millisecond)
+	newPrices := generateRandomTokenPrices(addrs[:1])
+	_, err = orm.UpsertTokenPricesForDestChain(ctx, destSelector, newPrices, time.Millisecond)
+	require.NoError(t, err)
+
+	to := time.Now().Add(time.Minute)
+	history, err := orm.GetTokenPriceHistory(ctx, destSelector, "", from, to)
+	require.NoError(t, err)
+	assert.Len(t, history, 3)
+
+	history, err = orm.GetTokenPriceHistory(ctx, destSelector, addrs[0], from, to)
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	assert.Equal(t, addrs[0], history[1].TokenAddr)
+	assert.Equal(t, newPrices[0].TokenPrice, history[1].TokenPrice)
+
+	history, err = orm.GetTokenPriceHistory(ctx, destSelector+1, "", from, to)
+	require.NoError(t, err)
+	assert.Empty(t, history)
+}
+
+func TestORM_PrunePriceHistory(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	orm, _ := setupORM(t)
+
+	destSelector := rand.Uint64()
+	_, err := orm.UpsertGasPricesForDestChain(ctx, destSelector, generateGasPrices(rand.Uint64(), 1))
+	require.NoError(t, err)
+	_, err = orm.UpsertTokenPricesForDestChain(ctx, destSelector, generateRandomTokenPrices(generateTokenAddresses(2)), time.Minute)
+	require.NoError(t, err)
+
+	// Nothing is pruned within the retention, or without retention
+	deleted, err := orm.PrunePriceHistory(ctx, PriceHistoryRetention{GasPrices: time.Hour, TokenPrices: time.Hour})
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), deleted)
+
+	time.Sleep(10 * time.Millisecond)
+	deleted, err = orm.PrunePriceHistory(ctx, PriceHistoryRetention{})
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), deleted)
+
+	deleted, err = orm.PrunePriceHistory(ctx, PriceHistoryRetention{TokenPrices: time.Millisecond})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), deleted)
+
+	deleted, err = orm.PrunePriceHistory(ctx, PriceHistoryRetention{GasPrices: time.Millisecond, TokenPrices: time.Millisecond})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+
+	prices, err := orm.GetGasPricesByDestChain(ctx, destSelector)
+	require.NoError(t, err)
+	assert.Len(t, prices, 1)
+}
+
+func TestORM_PrunePriceHistory_Batches(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	orm, db := setupORM(t)
+
+	// more rows than a single batch deletes
+	rows := int(pg.BatchSize) + 1
+	_, err := db.ExecContext(ctx, `
+		INSERT INTO ccip.node_gas_price_observations (chain_selector, source_chain_selector, gas_price, observed_at)
+		SELECT $1, n, n, NOW() - interval '2 hours' FROM generate_series(1, $2) AS n;`, rand.Uint64(), rows)
+	require.NoError(t, err)
+
+	deleted, err := orm.PrunePriceHistory(ctx, PriceHistoryRetention{GasPrices: time.Hour})
+	require.NoError(t, err)
+	assert.Equal(t, int64(rows), deleted)
+}
+
 func Benchmark_UpsertsTheSameTokenPrices(b *testing.B) {
 	db := pgtest.NewSqlxDB(b)
 	orm, err := NewORM(db, logger.NullLogger)
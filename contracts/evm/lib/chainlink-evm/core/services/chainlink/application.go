@@ -23,6 +23,7 @@ import (
 	"go.uber.org/multierr"
 	"go.uber.org/zap/zapcore"
 
+	"github.com/smartcontractkit/chainlink-ccip/execute/tokendata/observer"
 	"github.com/smartcontractkit/chainlink-common/pkg/beholder"
 	"github.com/smartcontractkit/chainlink-common/pkg/custmsg"
 	"github.com/smartcontractkit/chainlink-common/pkg/loop"
@@ -44,10 +45,14 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/build"
 	"github.com/smartcontractkit/chainlink/v2/core/capabilities"
 	"github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip"
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/lanestatus"
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/launcher"
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/msgstatus"
 	"github.com/smartcontractkit/chainlink/v2/core/capabilities/compute"
 	gatewayconnector "github.com/smartcontractkit/chainlink/v2/core/capabilities/gateway_connector"
 	"github.com/smartcontractkit/chainlink/v2/core/capabilities/remote"
 	remotetypes "github.com/smartcontractkit/chainlink/v2/core/capabilities/remote/types"
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/triggers/ccipmessage"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
 	"github.com/smartcontractkit/chainlink/v2/core/config"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
@@ -135,6 +140,15 @@ type Application interface {
 	// Feeds
 	GetFeedsService() feeds.Service
 
+	// GetCCIPLanes returns the tracker of the lanes served by the CCIP plugins of the node.
+	GetCCIPLanes() lanestatus.Reader
+	// GetCCIPLaneMonitor returns the handler serving the health of the CCIP lanes, nil when the lane monitor is disabled.
+	GetCCIPLaneMonitor() http.Handler
+	// GetCCIPLaunchers returns the capability launchers of the running CCIP jobs, keyed by job ID.
+	GetCCIPLaunchers() map[int32]launcher.Inspector
+	// GetCCIPMessageTracker returns the tracker of the CCIP messages and curses, shared by the requests of the node.
+	GetCCIPMessageTracker() *msgstatus.Tracker
+
 	// ReplayFromBlock replays logs from on or after the given block number. If forceBroadcast (evm only)
 	// is set to true, consumers will reprocess data even if it has already been processed.
 	ReplayFromBlock(ctx context.Context, chainFamily string, chainID string, number uint64, forceBroadcast bool) error
@@ -180,6 +194,8 @@ type ChainlinkApplication struct {
 	profiler                 *pyroscope.Profiler
 	loopRegistry             *plugins.LoopRegistry
 	loopRegistrarConfig      plugins.RegistrarConfig
+	ccipDelegate             *ccip.Delegate
+	ccipMessageTracker       *msgstatus.Tracker
 
 	started     bool
 	startStopMu sync.Mutex
@@ -617,10 +633,22 @@ func NewApplication(ctx context.Context, opts ApplicationOpts) (Application, err
 		globalLogger.Debug("Off-chain reporting disabled")
 	}
 
+	var ccipDelegate *ccip.Delegate
+	// the message tracker keeps its contract readers across the requests of the CCIP endpoints and triggers.
+	ccipMessageTracker := msgstatus.NewTracker(globalLogger, relayChainInterops.GetIDToRelayerMap(), msgstatus.TokenDataConfig{
+		Observers: msgstatus.LauncherTokenDataObservers(func() map[int32]launcher.Inspector {
+			if ccipDelegate == nil {
+				return nil
+			}
+			return ccipDelegate.Launchers()
+		}),
+		Node: observer.NodeConfig{Credentials: cfg.CCIP().AttestationCredentials()},
+	})
+	srvcs = append(srvcs, ccipMessageTracker)
 	if cfg.OCR2().Enabled() {
 		globalLogger.Debug("Off-chain reporting v2 enabled")
 
-		ocr2DelegateConfig := ocr2.NewDelegateConfig(cfg.OCR2(), cfg.Mercury(), cfg.Threshold(), cfg.Insecure(), cfg.JobPipeline(), loopRegistrarConfig)
+		ocr2DelegateConfig := ocr2.NewDelegateConfig(cfg.OCR2(), cfg.Mercury(), cfg.Threshold(), cfg.CCIP(), cfg.Insecure(), cfg.JobPipeline(), loopRegistrarConfig)
 
 		delegates[job.OffchainReporting2] = ocr2.NewDelegate(
 			ocr2.DelegateOpts{
@@ -652,7 +680,7 @@ func NewApplication(ctx context.Context, opts ApplicationOpts) (Application, err
 			cfg.Insecure(),
 			relayChainInterops,
 		)
-		delegates[job.CCIP] = ccip.NewDelegate(
+		ccipDelegate = ccip.NewDelegate(
 			globalLogger,
 			loopRegistrarConfig,
 			pipelineRunner,
@@ -663,7 +691,33 @@ func NewApplication(ctx context.Context, opts ApplicationOpts) (Application, err
 			telemetryManager,
 			cfg.Capabilities(),
 			cfg.EVMConfigs(),
+			cfg.CCIP(),
 		)
+		delegates[job.CCIP] = ccipDelegate
+		if laneMonitor := ccipDelegate.LaneMonitor(); laneMonitor != nil {
+			srvcs = append(srvcs, laneMonitor)
+		}
+
+		if cfg.CCIP().MessageTrigger().Enabled() {
+			ccipMessageTrigger, err := ccipmessage.NewTriggerService(
+				ctx,
+				globalLogger,
+				ccipMessageTracker,
+				ccipmessage.Config{
+					//nolint:gosec // this won't overflow
+					PollPeriod:      uint32(cfg.CCIP().MessageTrigger().PollPeriod().Milliseconds()),
+					QueryCount:      cfg.CCIP().MessageTrigger().QueryCount(),
+					LookbackSeqNums: cfg.CCIP().MessageTrigger().LookbackSeqNums(),
+				},
+			)
+			if err != nil {
+				return nil, fmt.Errorf("could not create CCIP message trigger: %w", err)
+			}
+			if err = opts.CapabilitiesRegistry.Add(ctx, ccipMessageTrigger); err != nil {
+				return nil, fmt.Errorf("could not register CCIP message trigger: %w", err)
+			}
+			srvcs = append(srvcs, ccipMessageTrigger)
+		}
 	} else {
 		globalLogger.Debug("Off-chain reporting v2 disabled")
 	}
@@ -742,6 +796,8 @@ func NewApplication(ctx context.Context, opts ApplicationOpts) (Application, err
 		profiler:                 profiler,
 		loopRegistry:             loopRegistry,
 		loopRegistrarConfig:      loopRegistrarConfig,
+		ccipDelegate:             ccipDelegate,
+		ccipMessageTracker:       ccipMessageTracker,
 
 		ds: opts.DS,
 
@@ -1274,6 +1330,35 @@ func (app *ChainlinkApplication) GetFeedsService() feeds.Service {
 	return app.FeedsService
 }
 
+// GetCCIPLanes implements the Application interface.
+func (app *ChainlinkApplication) GetCCIPLanes() lanestatus.Reader {
+	if app.ccipDelegate == nil {
+		return nil
+	}
+	return app.ccipDelegate.LaneTracker()
+}
+
+// GetCCIPLaneMonitor implements the Application interface.
+func (app *ChainlinkApplication) GetCCIPLaneMonitor() http.Handler {
+	if app.ccipDelegate == nil || app.ccipDelegate.LaneMonitor() == nil {
+		return nil
+	}
+	return app.ccipDelegate.LaneMonitor()
+}
+
+// GetCCIPLaunchers implements the Application interface.
+func (app *ChainlinkApplication) GetCCIPLaunchers() map[int32]launcher.Inspector {
+	if app.ccipDelegate == nil {
+		return nil
+	}
+	return app.ccipDelegate.Launchers()
+}
+
+// GetCCIPMessageTracker implements the Application interface.
+func (app *ChainlinkApplication) GetCCIPMessageTracker() *msgstatus.Tracker {
+	return app.ccipMessageTracker
+}
+
 // ReplayFromBlock implements the Application interface.
 func (app *ChainlinkApplication) ReplayFromBlock(ctx context.Context, chainFamily string, chainID string, number uint64, forceBroadcast bool) error {
 	switch chainFamily {
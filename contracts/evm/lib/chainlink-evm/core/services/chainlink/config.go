@@ -420,6 +420,10 @@ func (s *Secrets) SetFrom(f *Secrets) (err error) {
 		err = multierr.Append(err, commonconfig.NamedMultiErrorList(err2, "CRE"))
 	}
 
+	if err2 := s.CCIP.SetFrom(&f.CCIP); err2 != nil {
+		err = multierr.Append(err, commonconfig.NamedMultiErrorList(err2, "CCIP"))
+	}
+
 	_, err = commonconfig.MultiErrorList(err)
 
 	return err
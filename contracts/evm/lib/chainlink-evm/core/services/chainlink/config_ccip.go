@@ -0,0 +1,125 @@
+package chainlink
+
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/config/toml"
+)
+
+var _ config.CCIPTokenData = (*ccipTokenDataConfig)(nil)
+
+type ccipTokenDataConfig struct {
+	c toml.CCIPTokenData
+}
+
+func (c *ccipTokenDataConfig) PersistentCacheDir() string {
+	return *c.c.PersistentCacheDir
+}
+
+var _ config.CCIPPriceHistory = (*ccipPriceHistoryConfig)(nil)
+
+type ccipPriceHistoryConfig struct {
+	c toml.CCIPPriceHistory
+}
+
+func (c *ccipPriceHistoryConfig) Retention() time.Duration {
+	return c.c.Retention.Duration()
+}
+
+func (c *ccipPriceHistoryConfig) PruneInterval() time.Duration {
+	return c.c.PruneInterval.Duration()
+}
+
+var _ config.CCIPLaneMonitor = (*ccipLaneMonitorConfig)(nil)
+
+type ccipLaneMonitorConfig struct {
+	c toml.CCIPLaneMonitor
+}
+
+func (c *ccipLaneMonitorConfig) Enabled() bool {
+	return *c.c.Enabled
+}
+
+func (c *ccipLaneMonitorConfig) Interval() time.Duration {
+	return c.c.Interval.Duration()
+}
+
+func (c *ccipLaneMonitorConfig) DiscoveryInterval() time.Duration {
+	return c.c.DiscoveryInterval.Duration()
+}
+
+func (c *ccipLaneMonitorConfig) ExecScanLimit() uint64 {
+	return *c.c.ExecScanLimit
+}
+
+func (c *ccipLaneMonitorConfig) CommitLagThreshold() uint64 {
+	return *c.c.CommitLagThreshold
+}
+
+func (c *ccipLaneMonitorConfig) ExecLagThreshold() uint64 {
+	return *c.c.ExecLagThreshold
+}
+
+func (c *ccipLaneMonitorConfig) OldestPendingAgeThreshold() time.Duration {
+	return c.c.OldestPendingAgeThreshold.Duration()
+}
+
+func (c *ccipLaneMonitorConfig) AlertOnCurse() bool {
+	return *c.c.AlertOnCurse
+}
+
+var _ config.CCIPMessageTrigger = (*ccipMessageTriggerConfig)(nil)
+
+type ccipMessageTriggerConfig struct {
+	c toml.CCIPMessageTrigger
+}
+
+func (c *ccipMessageTriggerConfig) Enabled() bool {
+	return *c.c.Enabled
+}
+
+func (c *ccipMessageTriggerConfig) PollPeriod() time.Duration {
+	return c.c.PollPeriod.Duration()
+}
+
+func (c *ccipMessageTriggerConfig) QueryCount() uint64 {
+	return *c.c.QueryCount
+}
+
+func (c *ccipMessageTriggerConfig) LookbackSeqNums() uint64 {
+	return *c.c.LookbackSeqNums
+}
+
+var _ config.CCIP = (*ccipConfig)(nil)
+
+type ccipConfig struct {
+	c toml.CCIP
+	s toml.CCIPSecrets
+}
+
+func (c *ccipConfig) AttestationCredentials() map[string]string {
+	creds := make(map[string]string, len(c.s.AttestationCredentials))
+	for name, cred := range c.s.AttestationCredentials {
+		if cred.AuthHeaderValue != nil {
+			creds[name] = string(*cred.AuthHeaderValue)
+		}
+	}
+	return creds
+}
+
+func (c *ccipConfig) TokenData() config.CCIPTokenData {
+	return &ccipTokenDataConfig{c: c.c.TokenData}
+}
+
+func (c *ccipConfig) PriceHistory() config.CCIPPriceHistory {
+	return &ccipPriceHistoryConfig{c: c.c.PriceHistory}
+}
+
+func (c *ccipConfig) LaneMonitor() config.CCIPLaneMonitor {
+	return &ccipLaneMonitorConfig{c: c.c.LaneMonitor}
+}
+
+func (c *ccipConfig) MessageTrigger() config.CCIPMessageTrigger {
+	return &ccipMessageTriggerConfig{c: c.c.MessageTrigger}
+}
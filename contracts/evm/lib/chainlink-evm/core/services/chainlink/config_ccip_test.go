@@ -0,0 +1,52 @@
+package chainlink
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	secretsCCIP = `
+[CCIP.AttestationCredentials.api1]
+AuthHeaderValue = "api-key-1"
+
+[CCIP.AttestationCredentials.api2]
+AuthHeaderValue = "api-key-2"
+`
+	configCCIP = `
+[CCIP.TokenData]
+PersistentCacheDir = "/ccip/tokendata"
+
+[CCIP.PriceHistory]
+Retention = "168h"
+
+[CCIP.LaneMonitor]
+Enabled = true
+ExecLagThreshold = 50
+`
+)
+
+func TestCCIPConfig(t *testing.T) {
+	opts := GeneralConfigOpts{
+		SecretsStrings: []string{secretsCCIP},
+		ConfigStrings:  []string{configCCIP},
+	}
+	cfg, err := opts.New()
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]string{
+		"api1": "api-key-1",
+		"api2": "api-key-2",
+	}, cfg.CCIP().AttestationCredentials())
+	assert.Equal(t, "/ccip/tokendata", cfg.CCIP().TokenData().PersistentCacheDir())
+	assert.Equal(t, 7*24*time.Hour, cfg.CCIP().PriceHistory().Retention())
+	assert.Equal(t, time.Hour, cfg.CCIP().PriceHistory().PruneInterval())
+	assert.True(t, cfg.CCIP().LaneMonitor().Enabled())
+	assert.Equal(t, time.Minute, cfg.CCIP().LaneMonitor().Interval())
+	assert.Equal(t, uint64(1000), cfg.CCIP().LaneMonitor().ExecScanLimit())
+	assert.Equal(t, uint64(50), cfg.CCIP().LaneMonitor().ExecLagThreshold())
+	assert.Equal(t, time.Duration(0), cfg.CCIP().LaneMonitor().OldestPendingAgeThreshold())
+}
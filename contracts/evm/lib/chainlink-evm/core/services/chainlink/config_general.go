@@ -545,4 +545,8 @@ func (g *generalConfig) CRE() coreconfig.CRE {
 	return &creConfig{s: g.secrets.CRE, c: g.c.CRE}
 }
 
+func (g *generalConfig) CCIP() coreconfig.CCIP {
+	return &ccipConfig{c: g.c.CCIP, s: g.secrets.CCIP}
+}
+
 var zeroSha256Hash = models.Sha256Hash{}
@@ -574,6 +574,31 @@ func TestConfig_Marshal(t *testing.T) {
 			RestURL: ptr("streams.url"),
 		},
 	}
+	full.CCIP = toml.CCIP{
+		TokenData: toml.CCIPTokenData{
+			PersistentCacheDir: ptr("/ccip/tokendata"),
+		},
+		PriceHistory: toml.CCIPPriceHistory{
+			Retention:     commoncfg.MustNewDuration(7 * 24 * time.Hour),
+			PruneInterval: commoncfg.MustNewDuration(30 * time.Minute),
+		},
+		LaneMonitor: toml.CCIPLaneMonitor{
+			Enabled:                   ptr(true),
+			Interval:                  commoncfg.MustNewDuration(30 * time.Second),
+			DiscoveryInterval:         commoncfg.MustNewDuration(5 * time.Minute),
+			ExecScanLimit:             ptr[uint64](500),
+			CommitLagThreshold:        ptr[uint64](10),
+			ExecLagThreshold:          ptr[uint64](20),
+			OldestPendingAgeThreshold: commoncfg.MustNewDuration(time.Hour),
+			AlertOnCurse:              ptr(true),
+		},
+		MessageTrigger: toml.CCIPMessageTrigger{
+			Enabled:         ptr(true),
+			PollPeriod:      commoncfg.MustNewDuration(2 * time.Second),
+			QueryCount:      ptr[uint64](50),
+			LookbackSeqNums: ptr[uint64](100),
+		},
+	}
 	full.EVM = []*evmcfg.EVMConfig{
 		{
 			ChainID: ubig.NewI(1),
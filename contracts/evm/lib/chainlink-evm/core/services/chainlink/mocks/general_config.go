@@ -265,6 +265,53 @@ func (_c *GeneralConfig_AutoPprof_Call) RunAndReturn(run func() config.AutoPprof
 	return _c
 }
 
+// CCIP provides a mock function with no fields
+func (_m *GeneralConfig) CCIP() config.CCIP {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for CCIP")
+	}
+
+	var r0 config.CCIP
+	if rf, ok := ret.Get(0).(func() config.CCIP); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(config.CCIP)
+		}
+	}
+
+	return r0
+}
+
+// GeneralConfig_CCIP_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CCIP'
+type GeneralConfig_CCIP_Call struct {
+	*mock.Call
+}
+
+// CCIP is a helper method to define mock.On call
+func (_e *GeneralConfig_Expecter) CCIP() *GeneralConfig_CCIP_Call {
+	return &GeneralConfig_CCIP_Call{Call: _e.mock.On("CCIP")}
+}
+
+func (_c *GeneralConfig_CCIP_Call) Run(run func()) *GeneralConfig_CCIP_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GeneralConfig_CCIP_Call) Return(_a0 config.CCIP) *GeneralConfig_CCIP_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GeneralConfig_CCIP_Call) RunAndReturn(run func() config.CCIP) *GeneralConfig_CCIP_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CRE provides a mock function with no fields
 func (_m *GeneralConfig) CRE() config.CRE {
 	ret := _m.Called()
@@ -134,6 +134,7 @@ type DelegateConfig interface {
 	Insecure() insecureConfig
 	Mercury() coreconfig.Mercury
 	Threshold() coreconfig.Threshold
+	CCIP() coreconfig.CCIP
 }
 
 // concrete implementation of DelegateConfig so it can be explicitly composed
@@ -144,6 +145,7 @@ type delegateConfig struct {
 	insecure    insecureConfig
 	mercury     mercuryConfig
 	threshold   thresholdConfig
+	ccip        coreconfig.CCIP
 }
 
 func (d *delegateConfig) JobPipeline() jobPipelineConfig {
@@ -162,6 +164,10 @@ func (d *delegateConfig) Mercury() coreconfig.Mercury {
 	return d.mercury
 }
 
+func (d *delegateConfig) CCIP() coreconfig.CCIP {
+	return d.ccip
+}
+
 func (d *delegateConfig) OCR2() ocr2Config {
 	return d.ocr2
 }
@@ -202,7 +208,7 @@ type thresholdConfig interface {
 	ThresholdKeyShare() string
 }
 
-func NewDelegateConfig(ocr2Cfg ocr2Config, m coreconfig.Mercury, t coreconfig.Threshold, i insecureConfig, jp jobPipelineConfig, pluginProcessCfg plugins.RegistrarConfig) DelegateConfig {
+func NewDelegateConfig(ocr2Cfg ocr2Config, m coreconfig.Mercury, t coreconfig.Threshold, c coreconfig.CCIP, i insecureConfig, jp jobPipelineConfig, pluginProcessCfg plugins.RegistrarConfig) DelegateConfig {
 	return &delegateConfig{
 		ocr2:            ocr2Cfg,
 		RegistrarConfig: pluginProcessCfg,
@@ -210,6 +216,7 @@ func NewDelegateConfig(ocr2Cfg ocr2Config, m coreconfig.Mercury, t coreconfig.Th
 		insecure:        i,
 		mercury:         m,
 		threshold:       t,
+		ccip:            c,
 	}
 }
 
@@ -1686,6 +1693,7 @@ func (d *Delegate) newServicesCCIPCommit(ctx context.Context, lggr logger.Sugare
 		logError,
 		pluginJobSpecConfig,
 		d.RelayGetter,
+		d.cfg.CCIP().PriceHistory(),
 	)
 }
 
@@ -24,6 +24,7 @@ import (
 	cciptypes "github.com/smartcontractkit/chainlink-common/pkg/types/ccip"
 	"github.com/smartcontractkit/chainlink-evm/pkg/txmgr"
 
+	coreconfig "github.com/smartcontractkit/chainlink/v2/core/config"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	cciporm "github.com/smartcontractkit/chainlink/v2/core/services/ccip"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
@@ -65,6 +66,7 @@ func NewCommitServices(
 	logError func(string),
 	pluginJobSpecConfig ccipconfig.CommitPluginJobSpecConfig,
 	relayGetter RelayGetter,
+	priceHistoryConfig coreconfig.CCIPPriceHistory,
 ) ([]job.ServiceCtx, error) {
 	spec := jb.OCR2OracleSpec
 
@@ -189,6 +191,8 @@ func NewCommitServices(
 		sourceNative,
 		priceGetter,
 		offRampReader,
+		priceHistoryConfig.Retention(),
+		priceHistoryConfig.PruneInterval(),
 	)
 
 	wrappedPluginFactory := NewCommitReportingPluginFactory(CommitPluginStaticConfig{
@@ -55,6 +55,8 @@ const (
 type priceService struct {
 	gasUpdateInterval   time.Duration
 	tokenUpdateInterval time.Duration
+	pruneInterval       time.Duration
+	historyRetention    cciporm.PriceHistoryRetention
 
 	lggr              logger.Logger
 	orm               cciporm.ORM
@@ -84,10 +86,20 @@ func NewPriceService(
 	sourceNative cciptypes.Address,
 	priceGetter pricegetter.AllTokensPriceGetter,
 	offRampReader ccipdata.OffRampReader,
+
+	// The price history older than historyRetention is pruned every pruneInterval, it's kept forever when
+	// historyRetention is zero. Both come from the CCIP.PriceHistory node config.
+	historyRetention time.Duration,
+	pruneInterval time.Duration,
 ) PriceService {
 	pw := &priceService{
 		gasUpdateInterval:   gasPriceUpdateInterval,
 		tokenUpdateInterval: tokenPriceUpdateInterval,
+		pruneInterval:       pruneInterval,
+		historyRetention: cciporm.PriceHistoryRetention{
+			GasPrices:   historyRetention,
+			TokenPrices: historyRetention,
+		},
 
 		lggr:              lggr,
 		orm:               orm,
@@ -124,6 +136,7 @@ func (p *priceService) Close() error {
 func (p *priceService) run() {
 	gasUpdateTicker := time.NewTicker(utils.WithJitter(p.gasUpdateInterval))
 	tokenUpdateTicker := time.NewTicker(utils.WithJitter(p.tokenUpdateInterval))
+	pruneTicker := time.NewTicker(utils.WithJitter(p.pruneInterval))
 
 	go func() {
 		ctx, cancel := p.stopChan.NewCtx()
@@ -131,6 +144,7 @@ func (p *priceService) run() {
 		defer p.wg.Done()
 		defer gasUpdateTicker.Stop()
 		defer tokenUpdateTicker.Stop()
+		defer pruneTicker.Stop()
 
 		for {
 			select {
@@ -146,6 +160,11 @@ func (p *priceService) run() {
 				if err != nil {
 					p.lggr.Errorw("Error when updating token prices in the background", "err", err)
 				}
+			case <-pruneTicker.C:
+				err := p.runPriceHistoryPrune(ctx)
+				if err != nil {
+					p.lggr.Errorw("Error when pruning price history in the background", "err", err)
+				}
 			}
 		}
 	}()
@@ -267,6 +286,17 @@ func (p *priceService) runTokenPriceUpdate(ctx context.Context) error {
 	return nil
 }
 
+// runPriceHistoryPrune deletes the price history past the retention. Every lane of the node prunes the same tables,
+// which is cheap since only the rows that expired since the last prune are deleted.
+func (p *priceService) runPriceHistoryPrune(ctx context.Context) error {
+	deleted, err := p.orm.PrunePriceHistory(ctx, p.historyRetention)
+	if err != nil {
+		return fmt.Errorf("failed to prune price history: %w", err)
+	}
+	p.lggr.Debugw("Pruned price history", "deleted", deleted)
+	return nil
+}
+
 func (p *priceService) observeGasPriceUpdates(
 	ctx context.Context,
 	lggr logger.Logger,
@@ -31,6 +31,11 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ccip/prices"
 )
 
+const (
+	testHistoryRetention = 30 * 24 * time.Hour
+	testPruneInterval    = time.Hour
+)
+
 func TestPriceService_writeGasPrices(t *testing.T) {
 	lggr := logger.TestLogger(t)
 	jobId := int32(1)
@@ -84,6 +89,8 @@ func TestPriceService_writeGasPrices(t *testing.T) {
 				"",
 				nil,
 				nil,
+				testHistoryRetention,
+				testPruneInterval,
 			).(*priceService)
 			err := priceService.writeGasPricesToDB(ctx, gasPrice)
 			if tc.expectedErr {
@@ -156,6 +163,8 @@ func TestPriceService_writeTokenPrices(t *testing.T) {
 				"",
 				nil,
 				nil,
+				testHistoryRetention,
+				testPruneInterval,
 			).(*priceService)
 			err := priceService.writeTokenPricesToDB(ctx, tokenPrices)
 			if tc.expectedErr {
@@ -167,6 +176,19 @@ func TestPriceService_writeTokenPrices(t *testing.T) {
 	}
 }
 
+func TestPriceService_runPriceHistoryPrune(t *testing.T) {
+	ctx := tests.Context(t)
+	retention := cciporm.PriceHistoryRetention{GasPrices: testHistoryRetention, TokenPrices: testHistoryRetention}
+
+	mockOrm := ccipmocks.NewORM(t)
+	mockOrm.On("PrunePriceHistory", ctx, retention).Return(int64(10), nil).Once()
+	mockOrm.On("PrunePriceHistory", ctx, retention).Return(int64(0), errors.New("db error")).Once()
+
+	priceService := NewPriceService(logger.TestLogger(t), mockOrm, 1, 1, 2, "", nil, nil, testHistoryRetention, testPruneInterval).(*priceService)
+	assert.NoError(t, priceService.runPriceHistoryPrune(ctx))
+	assert.ErrorContains(t, priceService.runPriceHistoryPrune(ctx), "db error")
+}
+
 func TestPriceService_observeGasPriceUpdates(t *testing.T) {
 	lggr := logger.TestLogger(t)
 	jobId := int32(1)
@@ -267,6 +289,8 @@ func TestPriceService_observeGasPriceUpdates(t *testing.T) {
 				sourceNativeTokenID.TokenAddress,
 				priceGetter,
 				nil,
+				testHistoryRetention,
+				testPruneInterval,
 			).(*priceService)
 			priceService.gasPriceEstimator = gasPriceEstimator
 
@@ -488,6 +512,8 @@ func TestPriceService_observeTokenPriceUpdates(t *testing.T) {
 				tc.sourceNativeToken.TokenAddress,
 				priceGetter,
 				offRampReader,
+				testHistoryRetention,
+				testPruneInterval,
 			).(*priceService)
 			priceService.destPriceRegistryReader = destPriceReg
 
@@ -718,6 +744,8 @@ func TestPriceService_GetGasAndTokenPrices(t *testing.T) {
 				"",
 				nil,
 				nil,
+				testHistoryRetention,
+				testPruneInterval,
 			).(*priceService)
 			gasPricesResult, tokenPricesResult, err := priceService.GetGasAndTokenPrices(ctx, destChainSelector)
 			if tc.expectedErr {
@@ -834,6 +862,8 @@ func TestPriceService_priceWriteInBackground(t *testing.T) {
 		tokens[0].TokenAddress,
 		priceGetter,
 		nil,
+		testHistoryRetention,
+		testPruneInterval,
 	).(*priceService)
 
 	gasUpdateInterval := 2000 * time.Millisecond
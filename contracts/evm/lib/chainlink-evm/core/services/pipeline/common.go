@@ -311,6 +311,8 @@ const (
 	TaskTypeBase64Encode     TaskType = "base64encode"
 	TaskTypeBridge           TaskType = "bridge"
 	TaskTypeCBORParse        TaskType = "cborparse"
+	TaskTypeCCIPMessageID    TaskType = "ccipmessageid"
+	TaskTypeCCIPSend         TaskType = "ccipsend"
 	TaskTypeConditional      TaskType = "conditional"
 	TaskTypeDivide           TaskType = "divide"
 	TaskTypeETHABIDecode     TaskType = "ethabidecode"
@@ -402,6 +404,10 @@ func UnmarshalTaskFromMap(taskType TaskType, taskMap interface{}, ID int, dotID
 		task = &ETHCallTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeETHTx:
 		task = &ETHTxTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeCCIPSend:
+		task = &CCIPSendTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeCCIPMessageID:
+		task = &CCIPMessageIDTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeETHABIEncode:
 		task = &ETHABIEncodeTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeETHABIEncode2:
@@ -65,4 +65,15 @@ func (t *ETHTxTask) HelperSetDependencies(legacyChains legacyevm.LegacyChainCont
 	t.jobType = jobType
 }
 
+func (t *CCIPSendTask) HelperSetDependencies(legacyChains legacyevm.LegacyChainContainer, keyStore ETHKeyStore, specGasLimit *uint32, jobType string) {
+	t.legacyChains = legacyChains
+	t.keyStore = keyStore
+	t.specGasLimit = specGasLimit
+	t.jobType = jobType
+}
+
+func (t *CCIPMessageIDTask) HelperSetDependencies(legacyChains legacyevm.LegacyChainContainer) {
+	t.legacyChains = legacyChains
+}
+
 func (o *orm) Prune(ctx context.Context, pipelineSpecID int32) { o.prune(ctx, o.ds, pipelineSpecID) }
@@ -370,6 +370,14 @@ func (r *runner) InitializePipeline(spec Spec) (pipeline *Pipeline, err error) {
 			task.(*ETHTxTask).specGasLimit = spec.GasLimit
 			task.(*ETHTxTask).jobType = spec.JobType
 			task.(*ETHTxTask).forwardingAllowed = spec.ForwardingAllowed
+		case TaskTypeCCIPSend:
+			task.(*CCIPSendTask).keyStore = r.ethKeyStore
+			task.(*CCIPSendTask).legacyChains = r.legacyEVMChains
+			task.(*CCIPSendTask).specGasLimit = spec.GasLimit
+			task.(*CCIPSendTask).jobType = spec.JobType
+			task.(*CCIPSendTask).forwardingAllowed = spec.ForwardingAllowed
+		case TaskTypeCCIPMessageID:
+			task.(*CCIPMessageIDTask).legacyChains = r.legacyEVMChains
 		default:
 		}
 	}
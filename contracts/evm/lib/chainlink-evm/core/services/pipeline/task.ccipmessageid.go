@@ -0,0 +1,118 @@
+package pipeline
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink-ccip/chains/evm/gobindings/generated/v1_6_0/onramp"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	txmgrcommon "github.com/smartcontractkit/chainlink-framework/chains/txmgr"
+	txmgrtypes "github.com/smartcontractkit/chainlink-framework/chains/txmgr/types"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
+)
+
+// CCIPMessageIDTask returns the ID of the CCIP message sent by a transaction of the ccipsend task, read from the
+// CCIPMessageSent log of the transaction receipt. The task is retried until the transaction is confirmed.
+//
+// Return types:
+//
+//	string
+type CCIPMessageIDTask struct {
+	BaseTask   `mapstructure:",squash"`
+	TxID       string `json:"txID" mapstructure:"txID"`
+	EVMChainID string `json:"evmChainID" mapstructure:"evmChainID"`
+
+	legacyChains legacyevm.LegacyChainContainer
+}
+
+var _ Task = (*CCIPMessageIDTask)(nil)
+
+func (t *CCIPMessageIDTask) Type() TaskType {
+	return TaskTypeCCIPMessageID
+}
+
+func (t *CCIPMessageIDTask) getEvmChainID() string {
+	if t.EVMChainID == "" {
+		t.EVMChainID = "$(jobSpec.evmChainID)"
+	}
+	return t.EVMChainID
+}
+
+func (t *CCIPMessageIDTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inputs []Result) (Result, RunInfo) {
+	_, err := CheckInputs(inputs, -1, -1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, RunInfo{}
+	}
+
+	var (
+		chainID StringParam
+		txID    Uint64Param
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&chainID, From(VarExpr(t.getEvmChainID(), vars), NonemptyString(t.getEvmChainID()), "")), "evmChainID"),
+		errors.Wrap(ResolveParam(&txID, From(VarExpr(t.TxID, vars), NonemptyString(t.TxID), Input(inputs, 0))), "txID"),
+	)
+	if err != nil {
+		return Result{Error: err}, RunInfo{}
+	}
+
+	chain, err := t.legacyChains.Get(string(chainID))
+	if err != nil {
+		err = fmt.Errorf("%w: %s: %w", ErrInvalidEVMChainID, chainID, err)
+		return Result{Error: err}, retryableRunInfo()
+	}
+
+	txes, err := chain.TxManager().FindTxesWithAttemptsAndReceiptsByIdsAndState(ctx, []int64{int64(txID)},
+		[]txmgrtypes.TxState{txmgrcommon.TxConfirmed, txmgrcommon.TxFinalized, txmgrcommon.TxFatalError}, chain.ID())
+	if err != nil {
+		return Result{Error: errors.Wrapf(ErrTaskRunFailed, "while finding transaction %d: %v", txID, err)}, retryableRunInfo()
+	}
+	if len(txes) == 0 {
+		return Result{Error: errors.Wrapf(ErrTaskRunFailed, "transaction %d is not confirmed yet", txID)}, retryableRunInfo()
+	}
+	tx := txes[0]
+	if tx.State == txmgrcommon.TxFatalError {
+		return Result{Error: errors.Errorf("transaction %d failed: %s", txID, tx.Error.String)}, RunInfo{}
+	}
+
+	var receipt txmgrtypes.ChainReceipt[common.Hash, common.Hash]
+	for _, attempt := range tx.TxAttempts {
+		if len(attempt.Receipts) > 0 {
+			receipt = attempt.Receipts[0]
+			break
+		}
+	}
+	if receipt == nil {
+		return Result{Error: errors.Wrapf(ErrTaskRunFailed, "receipt of transaction %d is missing", txID)}, retryableRunInfo()
+	}
+	if receipt.GetStatus() == types.ReceiptStatusFailed {
+		return Result{Error: errors.Errorf("transaction %d reverted", txID)}, RunInfo{}
+	}
+
+	chainReceipt, err := chain.Client().TransactionReceipt(ctx, receipt.GetTxHash())
+	if err != nil {
+		return Result{Error: errors.Wrapf(ErrTaskRunFailed, "while fetching the receipt of transaction %d: %v", txID, err)}, retryableRunInfo()
+	}
+	onRamp, err := onramp.NewOnRampFilterer(common.Address{}, nil)
+	if err != nil {
+		return Result{Error: err}, RunInfo{}
+	}
+	for _, log := range chainReceipt.Logs {
+		if len(log.Topics) == 0 || log.Topics[0] != (onramp.OnRampCCIPMessageSent{}).Topic() {
+			continue
+		}
+		sent, err := onRamp.ParseCCIPMessageSent(*log)
+		if err != nil {
+			return Result{Error: errors.Wrapf(ErrTaskRunFailed, "while parsing the CCIPMessageSent log: %v", err)}, RunInfo{}
+		}
+		return Result{Value: cciptypes.Bytes32(sent.Message.Header.MessageId).String()}, RunInfo{}
+	}
+	return Result{Error: errors.Errorf("transaction %d didn't send a CCIP message", txID)}, RunInfo{}
+}
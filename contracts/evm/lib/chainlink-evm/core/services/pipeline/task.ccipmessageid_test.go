@@ -0,0 +1,123 @@
+package pipeline_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-ccip/chains/evm/gobindings/generated/v1_6_0/onramp"
+	"github.com/smartcontractkit/chainlink-evm/pkg/client/clienttest"
+	"github.com/smartcontractkit/chainlink-evm/pkg/txmgr"
+	evmtypes "github.com/smartcontractkit/chainlink-evm/pkg/types"
+	txmgrcommon "github.com/smartcontractkit/chainlink-framework/chains/txmgr"
+	txmgrtypes "github.com/smartcontractkit/chainlink-framework/chains/txmgr/types"
+
+	txmmocks "github.com/smartcontractkit/chainlink/v2/common/txmgr/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestCCIPMessageIDTask(t *testing.T) {
+	t.Parallel()
+
+	onRampABI, err := onramp.OnRampMetaData.GetAbi()
+	require.NoError(t, err)
+
+	const txID int64 = 7
+	txHash := common.HexToHash("0x1c2a56b4f1a3e3a3f2b9f5f1f0b5d7f0e9a1b2c3d4e5f60718293a4b5c6d7e8f")
+	messageID := common.HexToHash("0x5198616554d738d9485d1a7cf53b2f33e09c3bbc8fe9ac0020bd672cd2bc15d2")
+	states := []txmgrtypes.TxState{txmgrcommon.TxConfirmed, txmgrcommon.TxFinalized, txmgrcommon.TxFatalError}
+
+	sentData, err := onRampABI.Events["CCIPMessageSent"].Inputs.NonIndexed().Pack(onramp.InternalEVM2AnyRampMessage{
+		Header:         onramp.InternalRampMessageHeader{MessageId: messageID, SequenceNumber: 3},
+		FeeTokenAmount: big.NewInt(0),
+		FeeValueJuels:  big.NewInt(0),
+	})
+	require.NoError(t, err)
+	sentLog := &types.Log{
+		Topics: []common.Hash{
+			onramp.OnRampCCIPMessageSent{}.Topic(),
+			common.BigToHash(big.NewInt(1)),
+			common.BigToHash(big.NewInt(3)),
+		},
+		Data: sentData,
+	}
+	otherLog := &types.Log{Topics: []common.Hash{common.HexToHash("0x01")}}
+
+	// confirmedTx returns the transaction with a receipt of the given status.
+	confirmedTx := func(status uint64) []*txmgr.Tx {
+		return []*txmgr.Tx{{
+			ID:    txID,
+			State: txmgrcommon.TxConfirmed,
+			TxAttempts: []txmgr.TxAttempt{{
+				Hash:     txHash,
+				Receipts: []txmgr.ChainReceipt{&evmtypes.Receipt{TxHash: txHash, Status: status}},
+			}},
+		}}
+	}
+
+	tests := []struct {
+		name                  string
+		txes                  []*txmgr.Tx
+		logs                  []*types.Log
+		expectedMessageID     string
+		expectedErrorCause    error
+		expectedErrorContains string
+		expectedRetryable     bool
+	}{
+		{"happy", confirmedTx(types.ReceiptStatusSuccessful), []*types.Log{otherLog, sentLog}, messageID.Hex(), nil, "", false},
+		{"not confirmed", nil, nil, "", pipeline.ErrTaskRunFailed, "not confirmed yet", true},
+		{"reverted", confirmedTx(types.ReceiptStatusFailed), nil, "", nil, "reverted", false},
+		{"no message sent", confirmedTx(types.ReceiptStatusSuccessful), []*types.Log{otherLog}, "", nil, "didn't send a CCIP message", false},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			task := pipeline.CCIPMessageIDTask{
+				BaseTask:   pipeline.NewBaseTask(0, "ccipmessageid", nil, nil, 0),
+				TxID:       "$(txID)",
+				EVMChainID: testutils.FixtureChainID.String(),
+			}
+
+			ethClient := clienttest.NewClient(t)
+			txManager := txmmocks.NewMockEvmTxManager(t)
+			txManager.On("FindTxesWithAttemptsAndReceiptsByIdsAndState", mock.Anything, []int64{txID}, states, testutils.FixtureChainID).
+				Return(test.txes, nil)
+			if test.logs != nil {
+				ethClient.On("TransactionReceipt", mock.Anything, txHash).
+					Return(&types.Receipt{TxHash: txHash, Status: types.ReceiptStatusSuccessful, Logs: test.logs}, nil)
+			}
+
+			cfg := configtest.NewGeneralConfig(t, nil)
+			legacyChains := cltest.NewLegacyChainsWithMockChainAndTxManager(t, ethClient, cfg, txManager)
+			task.HelperSetDependencies(legacyChains)
+
+			vars := pipeline.NewVarsFrom(map[string]interface{}{"txID": txID})
+			result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), vars, nil)
+			require.False(t, runInfo.IsPending)
+			require.Equal(t, test.expectedRetryable, runInfo.IsRetryable)
+
+			if test.expectedErrorContains != "" {
+				require.Nil(t, result.Value)
+				if test.expectedErrorCause != nil {
+					require.Equal(t, test.expectedErrorCause, errors.Cause(result.Error))
+				}
+				require.Contains(t, result.Error.Error(), test.expectedErrorContains)
+			} else {
+				require.NoError(t, result.Error)
+				require.Equal(t, test.expectedMessageID, result.Value)
+			}
+		})
+	}
+}
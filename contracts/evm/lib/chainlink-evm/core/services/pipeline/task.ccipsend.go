@@ -0,0 +1,250 @@
+package pipeline
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	chainsel "github.com/smartcontractkit/chain-selectors"
+
+	"github.com/smartcontractkit/chainlink-ccip/chains/evm/gobindings/generated/v1_2_0/router"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-evm/pkg/txmgr"
+	"github.com/smartcontractkit/chainlink-evm/pkg/utils"
+	txmgrcommon "github.com/smartcontractkit/chainlink-framework/chains/txmgr"
+
+	defaults "github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/common/default"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
+)
+
+// CCIPSendTask sends a CCIP message through the Router of the chain. The fee is quoted with Router.getFee
+// and paid in native tokens unless a feeToken is given, in which case the Router must already be approved
+// to spend it, as well as the transferred tokens. The native fee is increased by feeBufferPercent so that the
+// message is still sent if the fee rises before the transaction is included, the Router keeps the excess.
+//
+// Router.ccipSend is simulated before the transaction is created so that a reverting message fails the task.
+// The ID of the created transaction is returned, the ID of the message is read from the CCIPMessageSent log
+// of its receipt by the ccipmessageid task.
+//
+// Return types:
+//
+//	int64
+type CCIPSendTask struct {
+	BaseTask          `mapstructure:",squash"`
+	Router            string `json:"router"`
+	From              string `json:"from"`
+	DestChainSelector string `json:"destChainSelector"`
+	// Receiver is the address of the receiver in the format of the destination chain family.
+	Receiver string `json:"receiver"`
+	Data     string `json:"data"`
+	// TokenAmounts is a list of {"token": <address>, "amount": <amount>} objects.
+	TokenAmounts string `json:"tokenAmounts"`
+	FeeToken     string `json:"feeToken"`
+	ExtraArgs    string `json:"extraArgs"`
+	// FeeBufferPercent is the percentage added to the quoted native fee, 0 by default.
+	FeeBufferPercent string `json:"feeBufferPercent"`
+	GasLimit         string `json:"gasLimit"`
+	EVMChainID       string `json:"evmChainID" mapstructure:"evmChainID"`
+
+	forwardingAllowed bool
+	specGasLimit      *uint32
+	keyStore          ETHKeyStore
+	legacyChains      legacyevm.LegacyChainContainer
+	jobType           string
+}
+
+var _ Task = (*CCIPSendTask)(nil)
+
+func (t *CCIPSendTask) Type() TaskType {
+	return TaskTypeCCIPSend
+}
+
+func (t *CCIPSendTask) getEvmChainID() string {
+	if t.EVMChainID == "" {
+		t.EVMChainID = "$(jobSpec.evmChainID)"
+	}
+	return t.EVMChainID
+}
+
+func (t *CCIPSendTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inputs []Result) (Result, RunInfo) {
+	var chainID StringParam
+	err := errors.Wrap(ResolveParam(&chainID, From(VarExpr(t.getEvmChainID(), vars), NonemptyString(t.getEvmChainID()), "")), "evmChainID")
+	if err != nil {
+		return Result{Error: err}, RunInfo{}
+	}
+
+	chain, err := t.legacyChains.Get(string(chainID))
+	if err != nil {
+		err = fmt.Errorf("%w: %s: %w", ErrInvalidEVMChainID, chainID, err)
+		return Result{Error: err}, retryableRunInfo()
+	}
+
+	_, err = CheckInputs(inputs, -1, -1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, RunInfo{}
+	}
+
+	maximumGasLimit := SelectGasLimit(chain.Config().EVM().GasEstimator(), t.jobType, t.specGasLimit)
+
+	var (
+		routerAddr        AddressParam
+		fromAddrs         AddressSliceParam
+		destChainSelector Uint64Param
+		receiver          StringParam
+		data              BytesParam
+		tokenAmounts      SliceParam
+		feeToken          AddressParam
+		extraArgs         BytesParam
+		feeBufferPercent  Uint64Param
+		gasLimit          Uint64Param
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&routerAddr, From(VarExpr(t.Router, vars), NonemptyString(t.Router))), "router"),
+		errors.Wrap(ResolveParam(&fromAddrs, From(VarExpr(t.From, vars), JSONWithVarExprs(t.From, vars, false), NonemptyString(t.From), nil)), "from"),
+		errors.Wrap(ResolveParam(&destChainSelector, From(VarExpr(t.DestChainSelector, vars), NonemptyString(t.DestChainSelector))), "destChainSelector"),
+		errors.Wrap(ResolveParam(&receiver, From(VarExpr(t.Receiver, vars), NonemptyString(t.Receiver))), "receiver"),
+		errors.Wrap(ResolveParam(&data, From(VarExpr(t.Data, vars), NonemptyString(t.Data), nil)), "data"),
+		errors.Wrap(ResolveParam(&tokenAmounts, From(VarExpr(t.TokenAmounts, vars), JSONWithVarExprs(t.TokenAmounts, vars, false), nil)), "tokenAmounts"),
+		errors.Wrap(ResolveParam(&feeToken, From(VarExpr(t.FeeToken, vars), NonemptyString(t.FeeToken), utils.ZeroAddress)), "feeToken"),
+		errors.Wrap(ResolveParam(&extraArgs, From(VarExpr(t.ExtraArgs, vars), NonemptyString(t.ExtraArgs), nil)), "extraArgs"),
+		errors.Wrap(ResolveParam(&feeBufferPercent, From(VarExpr(t.FeeBufferPercent, vars), NonemptyString(t.FeeBufferPercent), 0)), "feeBufferPercent"),
+		errors.Wrap(ResolveParam(&gasLimit, From(VarExpr(t.GasLimit, vars), NonemptyString(t.GasLimit), maximumGasLimit)), "gasLimit"),
+	)
+	if err != nil {
+		return Result{Error: err}, RunInfo{}
+	}
+
+	encodedReceiver, err := encodeCCIPReceiver(string(receiver), uint64(destChainSelector))
+	if err != nil {
+		return Result{Error: errors.Wrapf(ErrBadInput, "receiver: %v", err)}, RunInfo{}
+	}
+	amounts, err := decodeCCIPTokenAmounts(tokenAmounts)
+	if err != nil {
+		return Result{Error: errors.Wrapf(ErrBadInput, "tokenAmounts: %v", err)}, RunInfo{}
+	}
+	msg := router.ClientEVM2AnyMessage{
+		Receiver:     encodedReceiver,
+		Data:         []byte(data),
+		TokenAmounts: amounts,
+		FeeToken:     common.Address(feeToken),
+		ExtraArgs:    []byte(extraArgs),
+	}
+
+	routerABI, err := router.RouterMetaData.GetAbi()
+	if err != nil {
+		return Result{Error: err}, RunInfo{}
+	}
+	to := common.Address(routerAddr)
+
+	getFeeData, err := routerABI.Pack("getFee", uint64(destChainSelector), msg)
+	if err != nil {
+		return Result{Error: errors.Wrapf(ErrBadInput, "while packing getFee: %v", err)}, RunInfo{}
+	}
+	resp, err := chain.Client().CallContract(ctx, ethereum.CallMsg{To: &to, Data: getFeeData}, nil)
+	if err != nil {
+		return Result{Error: errors.Wrapf(ErrTaskRunFailed, "while quoting the fee: %v", err)}, retryableRunInfo()
+	}
+	var fee *big.Int
+	if err = routerABI.UnpackIntoInterface(&fee, "getFee", resp); err != nil {
+		return Result{Error: errors.Wrapf(ErrTaskRunFailed, "while unpacking the fee: %v", err)}, RunInfo{}
+	}
+	// fees in a fee token are pulled by the Router, only the native fee is sent along with the message.
+	value := big.NewInt(0)
+	if msg.FeeToken == utils.ZeroAddress {
+		value.Mul(fee, new(big.Int).SetUint64(100+uint64(feeBufferPercent)))
+		value.Div(value, big.NewInt(100))
+	}
+
+	fromAddr, err := t.keyStore.GetRoundRobinAddress(ctx, chain.ID(), fromAddrs...)
+	if err != nil {
+		err = errors.Wrap(err, "CCIPSendTask failed to get fromAddress")
+		lggr.Error(err)
+		return Result{Error: errors.Wrapf(ErrTaskRunFailed, "while querying keystore: %v", err)}, retryableRunInfo()
+	}
+
+	ccipSendData, err := routerABI.Pack("ccipSend", uint64(destChainSelector), msg)
+	if err != nil {
+		return Result{Error: errors.Wrapf(ErrBadInput, "while packing ccipSend: %v", err)}, RunInfo{}
+	}
+	// the returned message ID is not used, it can differ from the one of the sent message on busy lanes.
+	_, err = chain.Client().CallContract(ctx, ethereum.CallMsg{From: fromAddr, To: &to, Value: value, Data: ccipSendData}, nil)
+	if err != nil {
+		return Result{Error: errors.Wrapf(ErrTaskRunFailed, "while simulating ccipSend: %v", err)}, retryableRunInfo()
+	}
+
+	var forwarderAddress common.Address
+	if t.forwardingAllowed {
+		var fwderr error
+		forwarderAddress, fwderr = chain.TxManager().GetForwarderForEOA(ctx, fromAddr)
+		if fwderr != nil {
+			lggr.Warnw("Skipping forwarding for job, will fallback to default behavior", "err", fwderr)
+		}
+	}
+
+	txMeta := &txmgr.TxMeta{}
+	setJobIDOnMeta(lggr, vars, txMeta)
+
+	tx, err := chain.TxManager().CreateTransaction(ctx, txmgr.TxRequest{
+		FromAddress:      fromAddr,
+		ToAddress:        to,
+		EncodedPayload:   ccipSendData,
+		Value:            *value,
+		FeeLimit:         uint64(gasLimit),
+		Meta:             txMeta,
+		ForwarderAddress: forwarderAddress,
+		Strategy:         txmgrcommon.NewSendEveryStrategy(),
+	})
+	if err != nil {
+		return Result{Error: errors.Wrapf(ErrTaskRunFailed, "while creating transaction: %v", err)}, retryableRunInfo()
+	}
+
+	return Result{Value: tx.ID}, RunInfo{}
+}
+
+// encodeCCIPReceiver encodes the receiver with the address codec of the destination chain family. EVM receivers
+// are abi encoded, as expected by the onRamp.
+func encodeCCIPReceiver(receiver string, destChainSelector uint64) ([]byte, error) {
+	family, err := chainsel.GetSelectorFamily(destChainSelector)
+	if err != nil {
+		return nil, err
+	}
+	addr, err := defaults.DefaultAddressCodec.AddressStringToBytes(receiver, cciptypes.ChainSelector(destChainSelector))
+	if err != nil {
+		return nil, err
+	}
+	if family == chainsel.FamilyEVM {
+		return common.LeftPadBytes(addr, 32), nil
+	}
+	return addr, nil
+}
+
+func decodeCCIPTokenAmounts(tokenAmounts SliceParam) ([]router.ClientEVMTokenAmount, error) {
+	amounts := make([]router.ClientEVMTokenAmount, 0, len(tokenAmounts))
+	for i, v := range tokenAmounts {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("expected an object at index %d, got %T", i, v)
+		}
+		var (
+			token  AddressParam
+			amount MaybeBigIntParam
+		)
+		if err := token.UnmarshalPipelineParam(m["token"]); err != nil {
+			return nil, fmt.Errorf("token at index %d: %w", i, err)
+		}
+		if err := amount.UnmarshalPipelineParam(m["amount"]); err != nil {
+			return nil, fmt.Errorf("amount at index %d: %w", i, err)
+		}
+		if amount.BigInt() == nil || amount.BigInt().Sign() <= 0 {
+			return nil, fmt.Errorf("amount at index %d must be positive", i)
+		}
+		amounts = append(amounts, router.ClientEVMTokenAmount{Token: common.Address(token), Amount: amount.BigInt()})
+	}
+	return amounts, nil
+}
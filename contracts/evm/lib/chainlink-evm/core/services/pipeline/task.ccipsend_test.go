@@ -0,0 +1,209 @@
+package pipeline_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	chainsel "github.com/smartcontractkit/chain-selectors"
+
+	"github.com/smartcontractkit/chainlink-ccip/chains/evm/gobindings/generated/v1_2_0/router"
+	"github.com/smartcontractkit/chainlink-evm/pkg/client/clienttest"
+	"github.com/smartcontractkit/chainlink-evm/pkg/txmgr"
+	txmgrcommon "github.com/smartcontractkit/chainlink-framework/chains/txmgr"
+
+	txmmocks "github.com/smartcontractkit/chainlink/v2/common/txmgr/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	keystoremocks "github.com/smartcontractkit/chainlink/v2/core/services/keystore/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestCCIPSendTask(t *testing.T) {
+	t.Parallel()
+
+	routerABI, err := router.RouterMetaData.GetAbi()
+	require.NoError(t, err)
+
+	const gasLimit uint64 = 500_000
+	destChainSelector := chainsel.ETHEREUM_TESTNET_SEPOLIA.Selector
+	from := common.HexToAddress("0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c")
+	routerAddr := common.HexToAddress("0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF")
+	receiver := common.HexToAddress("0x2E396ecbc8223Ebc16EC45136228AE5EDB649943")
+	token := common.HexToAddress("0x779877A7B0D9E8603169DdbD7836e478b4624789")
+	fee := big.NewInt(1_000)
+	messageID := common.HexToHash("0x5198616554d738d9485d1a7cf53b2f33e09c3bbc8fe9ac0020bd672cd2bc15d2")
+	const txID int64 = 7
+
+	// setupMocks expects the fee quote, the simulation of the send and the transaction of the given message.
+	setupMocks := func(msg router.ClientEVM2AnyMessage, value *big.Int) func(*clienttest.Client, *keystoremocks.Eth, *txmmocks.MockEvmTxManager) {
+		return func(ethClient *clienttest.Client, keyStore *keystoremocks.Eth, txManager *txmmocks.MockEvmTxManager) {
+			getFeeData, err := routerABI.Pack("getFee", destChainSelector, msg)
+			require.NoError(t, err)
+			feeResp, err := routerABI.Methods["getFee"].Outputs.Pack(fee)
+			require.NoError(t, err)
+			ethClient.On("CallContract", mock.Anything, ethereum.CallMsg{To: &routerAddr, Data: getFeeData}, (*big.Int)(nil)).
+				Return(feeResp, nil)
+
+			keyStore.On("GetRoundRobinAddress", mock.Anything, testutils.FixtureChainID, from).Return(from, nil)
+
+			ccipSendData, err := routerABI.Pack("ccipSend", destChainSelector, msg)
+			require.NoError(t, err)
+			sendResp, err := routerABI.Methods["ccipSend"].Outputs.Pack([32]byte(messageID))
+			require.NoError(t, err)
+			ethClient.On("CallContract", mock.Anything, ethereum.CallMsg{From: from, To: &routerAddr, Value: value, Data: ccipSendData}, (*big.Int)(nil)).
+				Return(sendResp, nil)
+
+			txManager.On("CreateTransaction", mock.Anything, txmgr.TxRequest{
+				FromAddress:    from,
+				ToAddress:      routerAddr,
+				EncodedPayload: ccipSendData,
+				Value:          *value,
+				FeeLimit:       gasLimit,
+				Meta:           &txmgr.TxMeta{},
+				Strategy:       txmgrcommon.NewSendEveryStrategy(),
+			}).Return(txmgr.Tx{ID: txID}, nil)
+		}
+	}
+	noMocks := func(*clienttest.Client, *keystoremocks.Eth, *txmmocks.MockEvmTxManager) {}
+
+	tests := []struct {
+		name                  string
+		receiver              string
+		data                  string
+		tokenAmounts          string
+		feeToken              string
+		extraArgs             string
+		feeBufferPercent      string
+		vars                  pipeline.Vars
+		setupMocks            func(*clienttest.Client, *keystoremocks.Eth, *txmmocks.MockEvmTxManager)
+		expectedErrorCause    error
+		expectedErrorContains string
+	}{
+		{
+			"happy (native fee)",
+			receiver.Hex(),
+			"0x1234",
+			"",
+			"",
+			"0x97a657c9",
+			"",
+			pipeline.NewVarsFrom(nil),
+			setupMocks(router.ClientEVM2AnyMessage{
+				Receiver:     common.LeftPadBytes(receiver.Bytes(), 32),
+				Data:         []byte{0x12, 0x34},
+				TokenAmounts: []router.ClientEVMTokenAmount{},
+				ExtraArgs:    []byte{0x97, 0xa6, 0x57, 0xc9},
+			}, fee),
+			nil, "",
+		},
+		{
+			"happy (native fee with buffer)",
+			receiver.Hex(),
+			"",
+			"",
+			"",
+			"",
+			"10",
+			pipeline.NewVarsFrom(nil),
+			setupMocks(router.ClientEVM2AnyMessage{
+				Receiver:     common.LeftPadBytes(receiver.Bytes(), 32),
+				TokenAmounts: []router.ClientEVMTokenAmount{},
+			}, big.NewInt(1_100)),
+			nil, "",
+		},
+		{
+			"happy (fee token, with vars)",
+			"$(receiver)",
+			"",
+			`[{"token": $(token), "amount": "5"}]`,
+			"$(token)",
+			"",
+			"50",
+			pipeline.NewVarsFrom(map[string]interface{}{
+				"receiver": receiver.Hex(),
+				"token":    token.Hex(),
+			}),
+			setupMocks(router.ClientEVM2AnyMessage{
+				Receiver:     common.LeftPadBytes(receiver.Bytes(), 32),
+				TokenAmounts: []router.ClientEVMTokenAmount{{Token: token, Amount: big.NewInt(5)}},
+				FeeToken:     token,
+			}, big.NewInt(0)),
+			nil, "",
+		},
+		{
+			"invalid receiver",
+			"not an address",
+			"",
+			"",
+			"",
+			"",
+			"",
+			pipeline.NewVarsFrom(nil),
+			noMocks,
+			pipeline.ErrBadInput, "receiver",
+		},
+		{
+			"invalid token amount",
+			receiver.Hex(),
+			"",
+			`[{"token": "0x779877A7B0D9E8603169DdbD7836e478b4624789", "amount": "0"}]`,
+			"",
+			"",
+			"",
+			pipeline.NewVarsFrom(nil),
+			noMocks,
+			pipeline.ErrBadInput, "must be positive",
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			task := pipeline.CCIPSendTask{
+				BaseTask:          pipeline.NewBaseTask(0, "ccipsend", nil, nil, 0),
+				Router:            routerAddr.Hex(),
+				From:              `["` + from.Hex() + `"]`,
+				DestChainSelector: new(big.Int).SetUint64(destChainSelector).String(),
+				Receiver:          test.receiver,
+				Data:              test.data,
+				TokenAmounts:      test.tokenAmounts,
+				FeeToken:          test.feeToken,
+				ExtraArgs:         test.extraArgs,
+				FeeBufferPercent:  test.feeBufferPercent,
+				EVMChainID:        testutils.FixtureChainID.String(),
+			}
+
+			ethClient := clienttest.NewClient(t)
+			keyStore := keystoremocks.NewEth(t)
+			txManager := txmmocks.NewMockEvmTxManager(t)
+			test.setupMocks(ethClient, keyStore, txManager)
+
+			cfg := configtest.NewGeneralConfig(t, nil)
+			legacyChains := cltest.NewLegacyChainsWithMockChainAndTxManager(t, ethClient, cfg, txManager)
+			specGasLimit := uint32(gasLimit)
+			task.HelperSetDependencies(legacyChains, keyStore, &specGasLimit, pipeline.WebhookJobType)
+
+			result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), test.vars, nil)
+			require.False(t, runInfo.IsPending)
+
+			if test.expectedErrorCause != nil {
+				require.Nil(t, result.Value)
+				require.Equal(t, test.expectedErrorCause, errors.Cause(result.Error))
+				require.Contains(t, result.Error.Error(), test.expectedErrorContains)
+			} else {
+				require.NoError(t, result.Error)
+				require.Equal(t, txID, result.Value)
+			}
+		})
+	}
+}
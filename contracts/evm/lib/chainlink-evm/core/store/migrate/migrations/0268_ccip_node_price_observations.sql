@@ -0,0 +1,36 @@
+-- +goose Up
+
+-- Every price this node observed and wrote to ccip.observed_gas_prices and ccip.observed_token_prices,
+-- kept for fee auditing and pruned according to the CCIP.PriceHistory retention.
+-- These are the observations of this node only, not the prices agreed by the DON and committed onchain,
+-- which are found in the UsdPerUnitGasUpdated and UsdPerTokenUpdated logs of the FeeQuoter/PriceRegistry.
+CREATE TABLE ccip.node_gas_price_observations
+(
+    id                    BIGSERIAL PRIMARY KEY,
+    chain_selector        NUMERIC(20, 0) NOT NULL,
+    source_chain_selector NUMERIC(20, 0) NOT NULL,
+    gas_price             NUMERIC(78, 0) NOT NULL,
+    observed_at           TIMESTAMPTZ    NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE ccip.node_token_price_observations
+(
+    id             BIGSERIAL PRIMARY KEY,
+    chain_selector NUMERIC(20, 0) NOT NULL,
+    token_addr     BYTEA          NOT NULL,
+    token_price    NUMERIC(78, 0) NOT NULL,
+    observed_at    TIMESTAMPTZ    NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_ccip_node_gas_price_observations_chains_observed_at
+    ON ccip.node_gas_price_observations (chain_selector, source_chain_selector, observed_at);
+CREATE INDEX idx_ccip_node_gas_price_observations_observed_at
+    ON ccip.node_gas_price_observations (observed_at);
+CREATE INDEX idx_ccip_node_token_price_observations_token_observed_at
+    ON ccip.node_token_price_observations (chain_selector, token_addr, observed_at);
+CREATE INDEX idx_ccip_node_token_price_observations_observed_at
+    ON ccip.node_token_price_observations (observed_at);
+
+-- +goose Down
+DROP TABLE ccip.node_token_price_observations;
+DROP TABLE ccip.node_gas_price_observations;
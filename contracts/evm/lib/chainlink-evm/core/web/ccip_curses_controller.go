@@ -0,0 +1,41 @@
+package web
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// CCIPCursesController shows the RMN curses applying to CCIP destination chains.
+type CCIPCursesController struct {
+	App chainlink.Application
+}
+
+// Show returns the RMN curses applying to the destination chain, read from the RMNRemote discovered from its offRamp.
+// Example:
+//
+//	"<application>/v2/ccip/curses?destChainSelector=2&offRamp=0x..."
+func (cc *CCIPCursesController) Show(c *gin.Context) {
+	destChainSelector, err := chainSelectorFromQuery(c, "destChainSelector")
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	offRamp := c.Query("offRamp")
+	if offRamp == "" {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("offRamp is required"))
+		return
+	}
+
+	curseInfo, err := cc.App.GetCCIPMessageTracker().CurseInfo(c.Request.Context(), destChainSelector, offRamp)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewCCIPCurseStateResource(destChainSelector, curseInfo), "ccip_curse_state")
+}
@@ -0,0 +1,51 @@
+package web
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/lanestatus"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// CCIPLanesController shows the state of the CCIP lanes served by the node.
+type CCIPLanesController struct {
+	App chainlink.Application
+}
+
+// Index returns the lanes served by the CCIP plugins of the node, with the last observed prices of each lane and,
+// for each plugin reporting to its destination chain, the exec plugin state, the reported messages and the recent
+// report transmissions.
+// Example:
+//
+//	"<application>/v2/ccip/lanes"
+func (lc *CCIPLanesController) Index(c *gin.Context) {
+	var lanes []lanestatus.LaneStatus
+	if reader := lc.App.GetCCIPLanes(); reader != nil {
+		var err error
+		lanes, err = reader.Lanes(c.Request.Context())
+		if err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+	}
+
+	jsonAPIResponse(c, presenters.NewCCIPLaneStatusResources(lanes), "ccip_lane_status")
+}
+
+// Health returns the health of the lanes checked by the CCIP lane monitor, optionally filtered with the source and dest
+// chain selector query parameters.
+// Example:
+//
+//	"<application>/v2/ccip/lanes/health?dest=5009297550715157269"
+func (lc *CCIPLanesController) Health(c *gin.Context) {
+	monitor := lc.App.GetCCIPLaneMonitor()
+	if monitor == nil {
+		jsonAPIError(c, http.StatusNotFound, errors.New("CCIP lane monitor is disabled, see CCIP.LaneMonitor.Enabled"))
+		return
+	}
+	monitor.ServeHTTP(c.Writer, c.Request)
+}
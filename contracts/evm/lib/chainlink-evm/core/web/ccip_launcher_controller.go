@@ -0,0 +1,125 @@
+package web
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink-ccip/pluginconfig"
+
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/launcher"
+	cctypes "github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/types"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/registrysyncer"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// CCIPLauncherController shows the CCIP DONs and OCR instances run by the CCIP capability launchers of the node.
+type CCIPLauncherController struct {
+	App chainlink.Application
+}
+
+// Index returns the status of the launcher of every CCIP job.
+// Example:
+//
+//	"<application>/v2/ccip/launchers"
+func (lc *CCIPLauncherController) Index(c *gin.Context) {
+	launchers := lc.App.GetCCIPLaunchers()
+	jobIDs := sortedJobIDs(launchers)
+
+	resources := make([]presenters.CCIPLauncherStatusResource, 0, len(jobIDs))
+	for _, jobID := range jobIDs {
+		resources = append(resources, presenters.NewCCIPLauncherStatusResource(jobID, launchers[jobID].Status()))
+	}
+
+	jsonAPIResponse(c, resources, "ccip_launcher_status")
+}
+
+// WhatIf returns the launch, shutdown and promote actions the launcher of a CCIP job would take if the
+// capability registry changed to the state in the request body, without executing them.
+// The body uses the format of the states stored by the registry syncer.
+// Example:
+//
+//	"POST <application>/v2/ccip/launchers/:jobID/what-if"
+func (lc *CCIPLauncherController) WhatIf(c *gin.Context) {
+	jobID, err := strconv.ParseInt(c.Param("jobID"), 10, 32)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, fmt.Errorf("invalid job ID: %w", err))
+		return
+	}
+	l, ok := lc.App.GetCCIPLaunchers()[int32(jobID)]
+	if !ok {
+		jsonAPIError(c, http.StatusNotFound, fmt.Errorf("no CCIP launcher running for job %d", jobID))
+		return
+	}
+
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+	var proposed registrysyncer.LocalRegistry
+	if err = proposed.UnmarshalJSON(body); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, fmt.Errorf("invalid capability registry state: %w", err))
+		return
+	}
+
+	plan, err := l.WhatIf(c.Request.Context(), proposed)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewCCIPLauncherPlanResource(int32(jobID), plan), "ccip_launcher_plan")
+}
+
+// OffchainConfigs returns the decoded commit and exec offchain configs of the OCR instances run by the launchers.
+// Example:
+//
+//	"<application>/v2/ccip/offchain_configs"
+func (lc *CCIPLauncherController) OffchainConfigs(c *gin.Context) {
+	launchers := lc.App.GetCCIPLaunchers()
+
+	var resources []presenters.CCIPOffchainConfigResource
+	for _, jobID := range sortedJobIDs(launchers) {
+		for _, don := range launchers[jobID].Status().DONs {
+			for _, plugin := range don.Plugins {
+				offchainConfig, err := decodeOffchainConfig(plugin)
+				if err != nil {
+					jsonAPIError(c, http.StatusInternalServerError, fmt.Errorf("failed to decode offchain config %s: %w",
+						plugin.ConfigDigest.Hex(), err))
+					return
+				}
+				resources = append(resources, presenters.NewCCIPOffchainConfigResource(jobID, don.ID, plugin, offchainConfig))
+			}
+		}
+	}
+
+	jsonAPIResponse(c, resources, "ccip_offchain_config")
+}
+
+// decodeOffchainConfig decodes the offchain config of the plugin with pluginconfig, nil is returned for
+// instances whose config was not recorded by the launcher.
+func decodeOffchainConfig(plugin launcher.PluginStatus) (any, error) {
+	switch plugin.PluginType {
+	case cctypes.PluginTypeCCIPCommit.String():
+		return pluginconfig.DecodeCommitOffchainConfig(plugin.OffchainConfig)
+	case cctypes.PluginTypeCCIPExec.String():
+		return pluginconfig.DecodeExecuteOffchainConfig(plugin.OffchainConfig)
+	default:
+		return nil, nil
+	}
+}
+
+func sortedJobIDs(launchers map[int32]launcher.Inspector) []int32 {
+	jobIDs := make([]int32, 0, len(launchers))
+	for jobID := range launchers {
+		jobIDs = append(jobIDs, jobID)
+	}
+	sort.Slice(jobIDs, func(i, j int) bool { return jobIDs[i] < jobIDs[j] })
+	return jobIDs
+}
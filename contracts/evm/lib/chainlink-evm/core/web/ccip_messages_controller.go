@@ -0,0 +1,124 @@
+package web
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	ccipreaderpkg "github.com/smartcontractkit/chainlink-ccip/pkg/reader"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/msgstatus"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// CCIPMessagesController shows the status of CCIP messages.
+type CCIPMessagesController struct {
+	App chainlink.Application
+}
+
+// Show returns the lifecycle of a CCIP message across the source chain, commit and execution.
+// Example:
+//
+//	"<application>/v2/ccip/messages/:msgID?sourceChainSelector=1&destChainSelector=2&offRamp=0x..."
+func (cc *CCIPMessagesController) Show(c *gin.Context) {
+	q, ok := parseCCIPMessageQuery(c)
+	if !ok {
+		return
+	}
+
+	lifecycle, err := cc.App.GetCCIPMessageTracker().MessageStatus(c.Request.Context(), q.sourceChainSelector, q.destChainSelector, q.offRamp, q.msgID)
+	if errors.Is(err, ccipreaderpkg.ErrMessageNotFound) {
+		jsonAPIError(c, http.StatusNotFound, err)
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewCCIPMessageStatusResource(lifecycle), "ccip_message_status")
+}
+
+// ManualExec returns an execution report for a committed message that is not successfully executed yet,
+// to be submitted to the manuallyExecute function of the offRamp. Nothing is sent to the chain. A message transferring
+// tokens is only proposed when the node runs the exec plugin of the destination chain and its token data is ready.
+// Example:
+//
+//	"POST <application>/v2/ccip/messages/:msgID/manual_exec?sourceChainSelector=1&destChainSelector=2&offRamp=0x..."
+func (cc *CCIPMessagesController) ManualExec(c *gin.Context) {
+	q, ok := parseCCIPMessageQuery(c)
+	if !ok {
+		return
+	}
+
+	// the token data of the message is fetched like the exec plugin of the destination chain run by the node does.
+	proposal, err := cc.App.GetCCIPMessageTracker().ManualExecProposal(c.Request.Context(), q.sourceChainSelector, q.destChainSelector, q.offRamp, q.msgID)
+	switch {
+	case errors.Is(err, ccipreaderpkg.ErrMessageNotFound):
+		jsonAPIError(c, http.StatusNotFound, err)
+		return
+	case errors.Is(err, msgstatus.ErrNotCommitted), errors.Is(err, msgstatus.ErrAlreadyExecuted),
+		errors.Is(err, msgstatus.ErrTokenDataNotReady):
+		jsonAPIError(c, http.StatusConflict, err)
+		return
+	case errors.Is(err, msgstatus.ErrTokenDataConfigUnknown):
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	case err != nil:
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewCCIPManualExecProposalResource(proposal), "ccip_manual_exec_proposal")
+}
+
+type ccipMessageQuery struct {
+	msgID               cciptypes.Bytes32
+	sourceChainSelector cciptypes.ChainSelector
+	destChainSelector   cciptypes.ChainSelector
+	offRamp             string
+}
+
+// parseCCIPMessageQuery parses the message ID and the lane of a message request. An error response is written
+// when the request is invalid.
+func parseCCIPMessageQuery(c *gin.Context) (q ccipMessageQuery, ok bool) {
+	var err error
+	q.msgID, err = cciptypes.NewBytes32FromString(c.Param("msgID"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, fmt.Errorf("invalid message ID: %w", err))
+		return q, false
+	}
+	q.sourceChainSelector, err = chainSelectorFromQuery(c, "sourceChainSelector")
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return q, false
+	}
+	q.destChainSelector, err = chainSelectorFromQuery(c, "destChainSelector")
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return q, false
+	}
+	q.offRamp = c.Query("offRamp")
+	if q.offRamp == "" {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("offRamp is required"))
+		return q, false
+	}
+	return q, true
+}
+
+func chainSelectorFromQuery(c *gin.Context, name string) (cciptypes.ChainSelector, error) {
+	value := c.Query(name)
+	if value == "" {
+		return 0, fmt.Errorf("%s is required", name)
+	}
+	selector, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %s: %w", name, value, err)
+	}
+	return cciptypes.ChainSelector(selector), nil
+}
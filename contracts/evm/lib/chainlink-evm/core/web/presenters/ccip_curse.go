@@ -0,0 +1,43 @@
+package presenters
+
+import (
+	"sort"
+	"strconv"
+
+	ccipreaderpkg "github.com/smartcontractkit/chainlink-ccip/pkg/reader"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+)
+
+// CCIPCurseStateResource is the RMN curse state of a CCIP destination chain JSONAPI resource.
+type CCIPCurseStateResource struct {
+	JAID
+	GlobalCurse        bool     `json:"globalCurse"`
+	CursedDestination  bool     `json:"cursedDestination"`
+	CursedSourceChains []uint64 `json:"cursedSourceChains"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r CCIPCurseStateResource) GetName() string {
+	return "ccip_curse_state"
+}
+
+// NewCCIPCurseStateResource returns a new CCIPCurseStateResource for the curses applying to the destination chain.
+func NewCCIPCurseStateResource(
+	destChainSelector cciptypes.ChainSelector,
+	curseInfo ccipreaderpkg.CurseInfo,
+) CCIPCurseStateResource {
+	cursedSourceChains := make([]uint64, 0, len(curseInfo.CursedSourceChains))
+	for chainSelector, cursed := range curseInfo.CursedSourceChains {
+		if cursed {
+			cursedSourceChains = append(cursedSourceChains, uint64(chainSelector))
+		}
+	}
+	sort.Slice(cursedSourceChains, func(i, j int) bool { return cursedSourceChains[i] < cursedSourceChains[j] })
+
+	return CCIPCurseStateResource{
+		JAID:               NewJAID(strconv.FormatUint(uint64(destChainSelector), 10)),
+		GlobalCurse:        curseInfo.GlobalCurse,
+		CursedDestination:  curseInfo.CursedDestination,
+		CursedSourceChains: cursedSourceChains,
+	}
+}
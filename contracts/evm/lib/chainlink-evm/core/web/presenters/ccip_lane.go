@@ -0,0 +1,141 @@
+package presenters
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/assets"
+
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/lanestatus"
+	cctypes "github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/types"
+)
+
+// CCIPLaneTokenPrice is the last price of a token observed for the destination chain of a lane, in wei.
+type CCIPLaneTokenPrice struct {
+	Token string `json:"token"`
+	Price string `json:"price"`
+}
+
+// CCIPLaneExecState is the state of an exec plugin of the destination chain of a lane.
+type CCIPLaneExecState struct {
+	State     string    `json:"state"`
+	SeqNr     uint64    `json:"seqNr"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// CCIPLaneReportedMessage is a message of a lane reported by an exec plugin, kept until the inflight cache expiry
+// of the plugin even when executed earlier.
+type CCIPLaneReportedMessage struct {
+	MessageID  string    `json:"messageID"`
+	SeqNum     uint64    `json:"seqNum"`
+	ReportedAt time.Time `json:"reportedAt"`
+	ExpiresAt  time.Time `json:"expiresAt"`
+}
+
+// CCIPLaneTransmission is a report transmitted by a plugin to the destination chain of a lane.
+type CCIPLaneTransmission struct {
+	SeqNr         uint64    `json:"seqNr"`
+	TransmittedAt time.Time `json:"transmittedAt"`
+	Error         string    `json:"error,omitempty"`
+}
+
+// CCIPLanePlugin is a plugin reporting to the destination chain of a lane, identified by its config digest.
+type CCIPLanePlugin struct {
+	PluginType   string `json:"pluginType"`
+	ConfigDigest string `json:"configDigest"`
+	// State is active, candidate or unknown.
+	State string `json:"state"`
+	// Exec is only set for an exec plugin.
+	Exec          *CCIPLaneExecState        `json:"exec,omitempty"`
+	Reported      []CCIPLaneReportedMessage `json:"reported"`
+	Transmissions []CCIPLaneTransmission    `json:"transmissions"`
+}
+
+// CCIPLaneStatusResource is the state of a CCIP lane served by the node JSONAPI resource.
+type CCIPLaneStatusResource struct {
+	JAID
+	SourceChainSelector uint64               `json:"sourceChainSelector"`
+	DestChainSelector   uint64               `json:"destChainSelector"`
+	GasPrice            string               `json:"gasPrice,omitempty"`
+	TokenPrices         []CCIPLaneTokenPrice `json:"tokenPrices"`
+	Plugins             []CCIPLanePlugin     `json:"plugins"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r CCIPLaneStatusResource) GetName() string {
+	return "ccip_lane_status"
+}
+
+// NewCCIPLaneStatusResource returns a new CCIPLaneStatusResource for the lane.
+func NewCCIPLaneStatusResource(lane lanestatus.LaneStatus) CCIPLaneStatusResource {
+	tokenPrices := make([]CCIPLaneTokenPrice, 0, len(lane.TokenPrices))
+	for _, p := range lane.TokenPrices {
+		tokenPrices = append(tokenPrices, CCIPLaneTokenPrice{Token: p.TokenAddr, Price: weiString(p.TokenPrice)})
+	}
+	plugins := make([]CCIPLanePlugin, 0, len(lane.Plugins))
+	for _, p := range lane.Plugins {
+		plugins = append(plugins, newCCIPLanePlugin(p))
+	}
+
+	return CCIPLaneStatusResource{
+		JAID:                NewJAID(fmt.Sprintf("%d-%d", lane.SourceChainSelector, lane.DestChainSelector)),
+		SourceChainSelector: uint64(lane.SourceChainSelector),
+		DestChainSelector:   uint64(lane.DestChainSelector),
+		GasPrice:            weiString(lane.GasPrice),
+		TokenPrices:         tokenPrices,
+		Plugins:             plugins,
+	}
+}
+
+func newCCIPLanePlugin(p lanestatus.PluginStatus) CCIPLanePlugin {
+	reported := make([]CCIPLaneReportedMessage, 0, len(p.Reported))
+	for _, msg := range p.Reported {
+		reported = append(reported, CCIPLaneReportedMessage{
+			MessageID:  msg.MessageID.String(),
+			SeqNum:     uint64(msg.SeqNum),
+			ReportedAt: msg.ReportedAt,
+			ExpiresAt:  msg.ExpiresAt,
+		})
+	}
+	transmissions := make([]CCIPLaneTransmission, 0, len(p.Transmissions))
+	for _, t := range p.Transmissions {
+		transmissions = append(transmissions, CCIPLaneTransmission{
+			SeqNr:         t.SeqNr,
+			TransmittedAt: t.TransmittedAt,
+			Error:         t.Error,
+		})
+	}
+
+	plugin := CCIPLanePlugin{
+		PluginType:    p.PluginType,
+		ConfigDigest:  p.ConfigDigest.Hex(),
+		State:         string(p.State),
+		Reported:      reported,
+		Transmissions: transmissions,
+	}
+	if p.PluginType == cctypes.PluginTypeCCIPExec.String() {
+		plugin.Exec = &CCIPLaneExecState{
+			State:     string(p.Exec.State),
+			SeqNr:     p.Exec.SeqNr,
+			UpdatedAt: p.Exec.UpdatedAt,
+		}
+	}
+	return plugin
+}
+
+// NewCCIPLaneStatusResources returns a slice of CCIPLaneStatusResources for the lanes.
+func NewCCIPLaneStatusResources(lanes []lanestatus.LaneStatus) []CCIPLaneStatusResource {
+	rs := make([]CCIPLaneStatusResource, 0, len(lanes))
+	for _, lane := range lanes {
+		rs = append(rs, NewCCIPLaneStatusResource(lane))
+	}
+	return rs
+}
+
+// weiString returns the amount in wei as a decimal string, or an empty string if there is none.
+func weiString(w *assets.Wei) string {
+	if w == nil {
+		return ""
+	}
+	return w.ToInt().String()
+}
@@ -0,0 +1,152 @@
+package presenters
+
+import (
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/launcher"
+	"github.com/smartcontractkit/chainlink/v2/core/services/registrysyncer"
+)
+
+// CCIPLauncherPlugin is an OCR instance of a CCIP DON.
+type CCIPLauncherPlugin struct {
+	ConfigDigest  string `json:"configDigest"`
+	PluginType    string `json:"pluginType"`
+	State         string `json:"state"`
+	ConfigVersion uint32 `json:"configVersion"`
+	ChainSelector uint64 `json:"chainSelector"`
+}
+
+// CCIPLauncherDON is a CCIP DON run by a launcher.
+type CCIPLauncherDON struct {
+	ID            uint32               `json:"id"`
+	ConfigVersion uint32               `json:"configVersion"`
+	IsMember      bool                 `json:"isMember"`
+	Plugins       []CCIPLauncherPlugin `json:"plugins"`
+}
+
+// CCIPLauncherStatusResource is the status of the CCIP capability launcher of a job JSONAPI resource.
+type CCIPLauncherStatusResource struct {
+	JAID
+	CapabilityID string            `json:"capabilityID"`
+	PeerID       string            `json:"peerID"`
+	IsBootstrap  bool              `json:"isBootstrap"`
+	DONs         []CCIPLauncherDON `json:"dons"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r CCIPLauncherStatusResource) GetName() string {
+	return "ccip_launcher_status"
+}
+
+// NewCCIPLauncherStatusResource returns a new CCIPLauncherStatusResource for the launcher of the job.
+func NewCCIPLauncherStatusResource(jobID int32, status launcher.Status) CCIPLauncherStatusResource {
+	dons := make([]CCIPLauncherDON, 0, len(status.DONs))
+	for _, don := range status.DONs {
+		plugins := make([]CCIPLauncherPlugin, 0, len(don.Plugins))
+		for _, p := range don.Plugins {
+			plugins = append(plugins, newCCIPLauncherPlugin(p))
+		}
+		dons = append(dons, CCIPLauncherDON{
+			ID:            uint32(don.ID),
+			ConfigVersion: don.ConfigVersion,
+			IsMember:      don.IsMember,
+			Plugins:       plugins,
+		})
+	}
+
+	return CCIPLauncherStatusResource{
+		JAID:         NewJAIDInt32(jobID),
+		CapabilityID: status.CapabilityID,
+		PeerID:       status.PeerID,
+		IsBootstrap:  status.IsBootstrap,
+		DONs:         dons,
+	}
+}
+
+// CCIPLauncherAction is an action a launcher would take on an OCR instance of a CCIP DON.
+type CCIPLauncherAction struct {
+	DonID  uint32             `json:"donID"`
+	Action string             `json:"action"`
+	Plugin CCIPLauncherPlugin `json:"plugin"`
+}
+
+// CCIPLauncherPlanResource is the outcome of a what-if run of the CCIP capability launcher of a job JSONAPI resource.
+type CCIPLauncherPlanResource struct {
+	JAID
+	Added   []uint32             `json:"added"`
+	Removed []uint32             `json:"removed"`
+	Updated []uint32             `json:"updated"`
+	Actions []CCIPLauncherAction `json:"actions"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r CCIPLauncherPlanResource) GetName() string {
+	return "ccip_launcher_plan"
+}
+
+// NewCCIPLauncherPlanResource returns a new CCIPLauncherPlanResource for the what-if run of the launcher of the job.
+func NewCCIPLauncherPlanResource(jobID int32, plan launcher.Plan) CCIPLauncherPlanResource {
+	actions := make([]CCIPLauncherAction, 0, len(plan.Actions))
+	for _, a := range plan.Actions {
+		actions = append(actions, CCIPLauncherAction{
+			DonID:  uint32(a.DonID),
+			Action: string(a.Type),
+			Plugin: newCCIPLauncherPlugin(a.Plugin),
+		})
+	}
+
+	return CCIPLauncherPlanResource{
+		JAID:    NewJAIDInt32(jobID),
+		Added:   donIDs(plan.Added),
+		Removed: donIDs(plan.Removed),
+		Updated: donIDs(plan.Updated),
+		Actions: actions,
+	}
+}
+
+// CCIPOffchainConfigResource is the decoded offchain config of an OCR instance of a CCIP DON JSONAPI resource.
+type CCIPOffchainConfigResource struct {
+	JAID
+	JobID  int32              `json:"jobID"`
+	DonID  uint32             `json:"donID"`
+	Plugin CCIPLauncherPlugin `json:"plugin"`
+	// OffchainConfig is either a pluginconfig.CommitOffchainConfig or a pluginconfig.ExecuteOffchainConfig.
+	OffchainConfig any `json:"offchainConfig"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r CCIPOffchainConfigResource) GetName() string {
+	return "ccip_offchain_config"
+}
+
+// NewCCIPOffchainConfigResource returns a new CCIPOffchainConfigResource for the plugin of the DON.
+func NewCCIPOffchainConfigResource(
+	jobID int32,
+	donID registrysyncer.DonID,
+	plugin launcher.PluginStatus,
+	offchainConfig any,
+) CCIPOffchainConfigResource {
+	return CCIPOffchainConfigResource{
+		JAID:           NewJAID(plugin.ConfigDigest.Hex()),
+		JobID:          jobID,
+		DonID:          uint32(donID),
+		Plugin:         newCCIPLauncherPlugin(plugin),
+		OffchainConfig: offchainConfig,
+	}
+}
+
+func newCCIPLauncherPlugin(p launcher.PluginStatus) CCIPLauncherPlugin {
+	return CCIPLauncherPlugin{
+		ConfigDigest:  p.ConfigDigest.Hex(),
+		PluginType:    p.PluginType,
+		State:         string(p.State),
+		ConfigVersion: p.ConfigVersion,
+		ChainSelector: uint64(p.ChainSelector),
+	}
+}
+
+func donIDs(ids []registrysyncer.DonID) []uint32 {
+	ret := make([]uint32, 0, len(ids))
+	for _, id := range ids {
+		ret = append(ret, uint32(id))
+	}
+	return ret
+}
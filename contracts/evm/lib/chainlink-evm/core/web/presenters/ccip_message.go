@@ -0,0 +1,74 @@
+package presenters
+
+import (
+	"encoding/hex"
+
+	ccipreaderpkg "github.com/smartcontractkit/chainlink-ccip/pkg/reader"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/msgstatus"
+)
+
+// CCIPMessageStatusResource is the lifecycle of a CCIP message JSONAPI resource.
+type CCIPMessageStatusResource struct {
+	JAID
+	SourceChainSelector cciptypes.ChainSelector              `json:"sourceChainSelector"`
+	DestChainSelector   cciptypes.ChainSelector              `json:"destChainSelector"`
+	Stage               string                               `json:"stage"`
+	Sent                ccipreaderpkg.MessageSent            `json:"sent"`
+	Committed           *ccipreaderpkg.MessageCommitted      `json:"committed,omitempty"`
+	Executed            *ccipreaderpkg.MessageExecuted       `json:"executed,omitempty"`
+	PendingReasons      []ccipreaderpkg.MessagePendingReason `json:"pendingReasons,omitempty"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r CCIPMessageStatusResource) GetName() string {
+	return "ccip_message_status"
+}
+
+// NewCCIPMessageStatusResource returns a new CCIPMessageStatusResource for the message lifecycle.
+func NewCCIPMessageStatusResource(lifecycle ccipreaderpkg.MessageLifecycle) CCIPMessageStatusResource {
+	return CCIPMessageStatusResource{
+		JAID:                NewJAID(lifecycle.Message.Header.MessageID.String()),
+		SourceChainSelector: lifecycle.Message.Header.SourceChainSelector,
+		DestChainSelector:   lifecycle.Message.Header.DestChainSelector,
+		Stage:               lifecycle.Stage(),
+		Sent:                lifecycle.Sent,
+		Committed:           lifecycle.Committed,
+		Executed:            lifecycle.Executed,
+		PendingReasons:      lifecycle.PendingReasons,
+	}
+}
+
+// CCIPManualExecProposalResource is a manual execution report for a CCIP message JSONAPI resource.
+type CCIPManualExecProposalResource struct {
+	JAID
+	SourceChainSelector cciptypes.ChainSelector                  `json:"sourceChainSelector"`
+	DestChainSelector   cciptypes.ChainSelector                  `json:"destChainSelector"`
+	SeqNum              cciptypes.SeqNum                         `json:"seqNum"`
+	MerkleRoot          cciptypes.Bytes32                        `json:"merkleRoot"`
+	Report              cciptypes.ExecutePluginReportSingleChain `json:"report"`
+	EncodedReport       string                                   `json:"encodedReport"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r CCIPManualExecProposalResource) GetName() string {
+	return "ccip_manual_exec_proposal"
+}
+
+// NewCCIPManualExecProposalResource returns a new CCIPManualExecProposalResource for the manual execution proposal.
+func NewCCIPManualExecProposalResource(proposal msgstatus.ManualExecProposal) CCIPManualExecProposalResource {
+	header := proposal.Lifecycle.Message.Header
+	r := CCIPManualExecProposalResource{
+		JAID:                NewJAID(header.MessageID.String()),
+		SourceChainSelector: header.SourceChainSelector,
+		DestChainSelector:   header.DestChainSelector,
+		SeqNum:              header.SequenceNumber,
+		Report:              proposal.Report,
+		EncodedReport:       "0x" + hex.EncodeToString(proposal.EncodedReport),
+	}
+	if proposal.Lifecycle.Committed != nil {
+		r.MerkleRoot = proposal.Lifecycle.Committed.MerkleRoot
+	}
+	return r
+}
@@ -0,0 +1,210 @@
+package resolver
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/graph-gophers/graphql-go"
+
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/lanestatus"
+	cctypes "github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/types"
+	cciporm "github.com/smartcontractkit/chainlink/v2/core/services/ccip"
+)
+
+// CCIPLaneResolver resolves the state of a CCIP lane served by the node.
+type CCIPLaneResolver struct {
+	lane lanestatus.LaneStatus
+}
+
+func NewCCIPLane(lane lanestatus.LaneStatus) *CCIPLaneResolver {
+	return &CCIPLaneResolver{lane: lane}
+}
+
+func NewCCIPLanes(lanes []lanestatus.LaneStatus) []*CCIPLaneResolver {
+	var resolvers []*CCIPLaneResolver
+	for _, lane := range lanes {
+		resolvers = append(resolvers, NewCCIPLane(lane))
+	}
+	return resolvers
+}
+
+// ID resolves the lane as its source and destination chain selectors.
+func (r *CCIPLaneResolver) ID() graphql.ID {
+	return graphql.ID(fmt.Sprintf("%d-%d", r.lane.SourceChainSelector, r.lane.DestChainSelector))
+}
+
+// SourceChainSelector resolves the selector of the source chain of the lane.
+func (r *CCIPLaneResolver) SourceChainSelector() string {
+	return strconv.FormatUint(uint64(r.lane.SourceChainSelector), 10)
+}
+
+// DestChainSelector resolves the selector of the destination chain of the lane.
+func (r *CCIPLaneResolver) DestChainSelector() string {
+	return strconv.FormatUint(uint64(r.lane.DestChainSelector), 10)
+}
+
+// GasPrice resolves the last gas price of the source chain observed for the destination chain, in wei.
+func (r *CCIPLaneResolver) GasPrice() *string {
+	if r.lane.GasPrice == nil {
+		return nil
+	}
+	price := r.lane.GasPrice.ToInt().String()
+	return &price
+}
+
+// TokenPrices resolves the last token prices observed for the destination chain.
+func (r *CCIPLaneResolver) TokenPrices() []*CCIPLaneTokenPriceResolver {
+	var resolvers []*CCIPLaneTokenPriceResolver
+	for _, p := range r.lane.TokenPrices {
+		resolvers = append(resolvers, &CCIPLaneTokenPriceResolver{price: p})
+	}
+	return resolvers
+}
+
+// Plugins resolves the plugins reporting to the destination chain, the active ones first.
+func (r *CCIPLaneResolver) Plugins() []*CCIPLanePluginResolver {
+	var resolvers []*CCIPLanePluginResolver
+	for _, p := range r.lane.Plugins {
+		resolvers = append(resolvers, &CCIPLanePluginResolver{plugin: p})
+	}
+	return resolvers
+}
+
+// CCIPLaneTokenPriceResolver resolves the last price of a token observed for a destination chain.
+type CCIPLaneTokenPriceResolver struct {
+	price cciporm.TokenPrice
+}
+
+func (r *CCIPLaneTokenPriceResolver) Token() string {
+	return r.price.TokenAddr
+}
+
+// Price resolves the price of the token in wei.
+func (r *CCIPLaneTokenPriceResolver) Price() string {
+	if r.price.TokenPrice == nil {
+		return "0"
+	}
+	return r.price.TokenPrice.ToInt().String()
+}
+
+// CCIPLanePluginResolver resolves a plugin reporting to a destination chain.
+type CCIPLanePluginResolver struct {
+	plugin lanestatus.PluginStatus
+}
+
+func (r *CCIPLanePluginResolver) PluginType() string {
+	return r.plugin.PluginType
+}
+
+func (r *CCIPLanePluginResolver) ConfigDigest() string {
+	return r.plugin.ConfigDigest.Hex()
+}
+
+// State resolves the role of the OCR config of the plugin in CCIPHome: active, candidate or unknown.
+func (r *CCIPLanePluginResolver) State() string {
+	return string(r.plugin.State)
+}
+
+// Exec resolves the state of an exec plugin, null for a commit plugin.
+func (r *CCIPLanePluginResolver) Exec() *CCIPLaneExecStateResolver {
+	if r.plugin.PluginType != cctypes.PluginTypeCCIPExec.String() {
+		return nil
+	}
+	return &CCIPLaneExecStateResolver{state: r.plugin.Exec}
+}
+
+// Reported resolves the messages of the lane reported by an exec plugin, they are kept until the inflight cache
+// expiry of the plugin even when executed earlier.
+func (r *CCIPLanePluginResolver) Reported() []*CCIPLaneReportedMessageResolver {
+	var resolvers []*CCIPLaneReportedMessageResolver
+	for _, msg := range r.plugin.Reported {
+		resolvers = append(resolvers, &CCIPLaneReportedMessageResolver{msg: msg})
+	}
+	return resolvers
+}
+
+// Transmissions resolves the recent reports transmitted by the plugin, newest first.
+func (r *CCIPLanePluginResolver) Transmissions() []*CCIPLaneTransmissionResolver {
+	var resolvers []*CCIPLaneTransmissionResolver
+	for _, t := range r.plugin.Transmissions {
+		resolvers = append(resolvers, &CCIPLaneTransmissionResolver{transmission: t})
+	}
+	return resolvers
+}
+
+// CCIPLaneExecStateResolver resolves the state of an exec plugin of a destination chain.
+type CCIPLaneExecStateResolver struct {
+	state lanestatus.ExecState
+}
+
+func (r *CCIPLaneExecStateResolver) State() string {
+	return string(r.state.State)
+}
+
+func (r *CCIPLaneExecStateResolver) SeqNr() string {
+	return strconv.FormatUint(r.state.SeqNr, 10)
+}
+
+// UpdatedAt resolves the time of the last OCR round of the exec plugin, null if there was none.
+func (r *CCIPLaneExecStateResolver) UpdatedAt() *graphql.Time {
+	if r.state.UpdatedAt.IsZero() {
+		return nil
+	}
+	return &graphql.Time{Time: r.state.UpdatedAt}
+}
+
+// CCIPLaneReportedMessageResolver resolves a message reported by an exec plugin.
+type CCIPLaneReportedMessageResolver struct {
+	msg lanestatus.ReportedMessage
+}
+
+func (r *CCIPLaneReportedMessageResolver) MessageID() string {
+	return r.msg.MessageID.String()
+}
+
+func (r *CCIPLaneReportedMessageResolver) SeqNum() string {
+	return strconv.FormatUint(uint64(r.msg.SeqNum), 10)
+}
+
+func (r *CCIPLaneReportedMessageResolver) ReportedAt() graphql.Time {
+	return graphql.Time{Time: r.msg.ReportedAt}
+}
+
+func (r *CCIPLaneReportedMessageResolver) ExpiresAt() graphql.Time {
+	return graphql.Time{Time: r.msg.ExpiresAt}
+}
+
+// CCIPLaneTransmissionResolver resolves a report transmitted by a plugin to a destination chain.
+type CCIPLaneTransmissionResolver struct {
+	transmission lanestatus.Transmission
+}
+
+func (r *CCIPLaneTransmissionResolver) SeqNr() string {
+	return strconv.FormatUint(r.transmission.SeqNr, 10)
+}
+
+func (r *CCIPLaneTransmissionResolver) TransmittedAt() graphql.Time {
+	return graphql.Time{Time: r.transmission.TransmittedAt}
+}
+
+// Error resolves the error of the transmission, null if it succeeded.
+func (r *CCIPLaneTransmissionResolver) Error() *string {
+	if r.transmission.Error == "" {
+		return nil
+	}
+	return &r.transmission.Error
+}
+
+// -- CCIPLanes Query --
+
+type CCIPLanesPayloadResolver struct {
+	lanes []lanestatus.LaneStatus
+}
+
+func NewCCIPLanesPayload(lanes []lanestatus.LaneStatus) *CCIPLanesPayloadResolver {
+	return &CCIPLanesPayloadResolver{lanes: lanes}
+}
+
+func (r *CCIPLanesPayloadResolver) Results() []*CCIPLaneResolver {
+	return NewCCIPLanes(r.lanes)
+}
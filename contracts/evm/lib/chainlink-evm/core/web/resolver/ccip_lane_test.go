@@ -0,0 +1,184 @@
+package resolver
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	gqlerrors "github.com/graph-gophers/graphql-go/errors"
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink-ccip/execute/exectypes"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/assets"
+
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/lanestatus"
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/launcher"
+	cciporm "github.com/smartcontractkit/chainlink/v2/core/services/ccip"
+)
+
+type fakeLaneReader struct {
+	lanes []lanestatus.LaneStatus
+	err   error
+}
+
+func (f fakeLaneReader) Lanes(context.Context) ([]lanestatus.LaneStatus, error) {
+	return f.lanes, f.err
+}
+
+func Test_CCIPLanesQuery(t *testing.T) {
+	query := `
+	query GetCCIPLanes {
+		ccipLanes {
+			results {
+				id
+				sourceChainSelector
+				destChainSelector
+				gasPrice
+				tokenPrices {
+					token
+					price
+				}
+				plugins {
+					pluginType
+					configDigest
+					state
+					exec {
+						state
+						seqNr
+						updatedAt
+					}
+					reported {
+						messageID
+						seqNum
+						reportedAt
+						expiresAt
+					}
+					transmissions {
+						seqNr
+						error
+					}
+				}
+			}
+		}
+	}`
+
+	updatedAt := time.Date(2025, 5, 1, 10, 0, 0, 0, time.UTC)
+	msgID := cciptypes.Bytes32{1}
+	commitDigest := ocrtypes.ConfigDigest{1}
+	execDigest := ocrtypes.ConfigDigest{2}
+	lanes := []lanestatus.LaneStatus{
+		{
+			SourceChainSelector: 1,
+			DestChainSelector:   2,
+			GasPrice:            assets.NewWeiI(100),
+			TokenPrices:         []cciporm.TokenPrice{{TokenAddr: "0xabc", TokenPrice: assets.NewWeiI(5)}},
+			Plugins: []lanestatus.PluginStatus{
+				{
+					PluginType:   "CCIPCommit",
+					ConfigDigest: commitDigest,
+					State:        launcher.PluginStateActive,
+				},
+				{
+					PluginType:   "CCIPExec",
+					ConfigDigest: execDigest,
+					State:        launcher.PluginStateCandidate,
+					Exec:         lanestatus.ExecState{State: exectypes.Filter, SeqNr: 7, UpdatedAt: updatedAt},
+					Reported: []lanestatus.ReportedMessage{
+						{MessageID: msgID, SeqNum: 3, ReportedAt: updatedAt, ExpiresAt: updatedAt.Add(time.Minute)},
+					},
+					Transmissions: []lanestatus.Transmission{
+						{SeqNr: 6, TransmittedAt: updatedAt, Error: "reverted"},
+					},
+				},
+			},
+		},
+		{
+			SourceChainSelector: 3,
+			DestChainSelector:   2,
+		},
+	}
+
+	gError := errors.New("db down")
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: query}, "ccipLanes"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("GetCCIPLanes").Return(fakeLaneReader{lanes: lanes})
+			},
+			query: query,
+			result: `
+			{
+				"ccipLanes": {
+					"results": [{
+						"id": "1-2",
+						"sourceChainSelector": "1",
+						"destChainSelector": "2",
+						"gasPrice": "100",
+						"tokenPrices": [{"token": "0xabc", "price": "5"}],
+						"plugins": [{
+							"pluginType": "CCIPCommit",
+							"configDigest": "` + commitDigest.Hex() + `",
+							"state": "active",
+							"exec": null,
+							"reported": [],
+							"transmissions": []
+						}, {
+							"pluginType": "CCIPExec",
+							"configDigest": "` + execDigest.Hex() + `",
+							"state": "candidate",
+							"exec": {"state": "Filter", "seqNr": "7", "updatedAt": "2025-05-01T10:00:00Z"},
+							"reported": [{
+								"messageID": "` + msgID.String() + `",
+								"seqNum": "3",
+								"reportedAt": "2025-05-01T10:00:00Z",
+								"expiresAt": "2025-05-01T10:01:00Z"
+							}],
+							"transmissions": [{"seqNr": "6", "error": "reverted"}]
+						}]
+					}, {
+						"id": "3-2",
+						"sourceChainSelector": "3",
+						"destChainSelector": "2",
+						"gasPrice": null,
+						"tokenPrices": [],
+						"plugins": []
+					}]
+				}
+			}`,
+		},
+		{
+			name:          "CCIP disabled",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("GetCCIPLanes").Return(nil)
+			},
+			query:  query,
+			result: `{"ccipLanes": {"results": []}}`,
+		},
+		{
+			name:          "reader error",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("GetCCIPLanes").Return(fakeLaneReader{err: gError})
+			},
+			query:  query,
+			result: `null`,
+			errors: []*gqlerrors.QueryError{
+				{
+					Extensions:    nil,
+					ResolverError: gError,
+					Path:          []interface{}{"ccipLanes"},
+					Message:       "db down",
+				},
+			},
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
@@ -66,6 +66,24 @@ func (r *Resolver) Bridges(ctx context.Context, args struct {
 	return NewBridgesPayload(brdgs, int32(count)), nil
 }
 
+// CCIPLanes retrieves the lanes served by the CCIP plugins of the node.
+func (r *Resolver) CCIPLanes(ctx context.Context) (*CCIPLanesPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	reader := r.App.GetCCIPLanes()
+	if reader == nil {
+		return NewCCIPLanesPayload(nil), nil
+	}
+	lanes, err := reader.Lanes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewCCIPLanesPayload(lanes), nil
+}
+
 // Chain retrieves a chain by id.
 func (r *Resolver) Chain(ctx context.Context,
 	args struct {
@@ -294,6 +294,22 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		lcaC := LCAController{app}
 		authv2.GET("/find_lca", auth.RequiresRunRole(lcaC.FindLCA))
 
+		ccipmc := CCIPMessagesController{app}
+		authv2.GET("/ccip/messages/:msgID", auth.RequiresRunRole(ccipmc.Show))
+		authv2.POST("/ccip/messages/:msgID/manual_exec", auth.RequiresRunRole(ccipmc.ManualExec))
+
+		ccipcc := CCIPCursesController{app}
+		authv2.GET("/ccip/curses", auth.RequiresRunRole(ccipcc.Show))
+
+		cciplc := CCIPLauncherController{app}
+		authv2.GET("/ccip/launchers", cciplc.Index)
+		authv2.POST("/ccip/launchers/:jobID/what-if", auth.RequiresRunRole(cciplc.WhatIf))
+		authv2.GET("/ccip/offchain_configs", cciplc.OffchainConfigs)
+
+		ccipllc := CCIPLanesController{app}
+		authv2.GET("/ccip/lanes", ccipllc.Index)
+		authv2.GET("/ccip/lanes/health", ccipllc.Health)
+
 		csakc := CSAKeysController{app}
 		authv2.GET("/keys/csa", csakc.Index)
 		authv2.POST("/keys/csa", auth.RequiresEditRole(csakc.Create))
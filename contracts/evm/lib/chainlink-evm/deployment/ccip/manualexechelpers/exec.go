@@ -16,11 +16,14 @@ import (
 	cldf "github.com/smartcontractkit/chainlink-deployments-framework/deployment"
 
 	"github.com/smartcontractkit/chainlink/deployment/ccip/shared/stateview"
+	"github.com/smartcontractkit/chainlink/deployment/ccip/view/v1_6"
 	ccipcommon "github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/common"
 	defaults "github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/common/default"
 
 	"github.com/smartcontractkit/chainlink-ccip/chains/evm/gobindings/generated/v1_6_0/offramp"
 	"github.com/smartcontractkit/chainlink-ccip/chains/evm/gobindings/generated/v1_6_0/onramp"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+	"github.com/smartcontractkit/chainlink-ccip/pluginconfig"
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 
 	"github.com/smartcontractkit/chainlink/deployment/ccip/changeset/testhelpers"
@@ -299,44 +302,20 @@ func getCCIPMessageSentEvents(
 	return ret, blockNumbers, nil
 }
 
-// manuallyExecuteSingle manually executes a single message on the destination chain.
-func manuallyExecuteSingle(
+// getCommitRootAcceptedEventCached returns the merkle root committed on the EVM offramp that contains msgSeqNr,
+// serving it from the cache when possible and fetching it from the destination chain otherwise.
+func getCommitRootAcceptedEventCached(
 	ctx context.Context,
 	lggr logger.Logger,
-	state stateview.CCIPOnChainState,
 	env cldf.Environment,
+	state stateview.CCIPOnChainState,
 	srcChainSel uint64,
 	destChainSel uint64,
 	msgSeqNr uint64,
-	lookbackDuration,
 	lookbackDurationCommitReport,
 	stepDuration time.Duration,
-	reExecuteIfFailed bool,
-	extraDataCodec ccipcommon.ExtraDataCodec,
-	messageSentCache *MessageSentCache,
 	commitRootCache *RootCache,
-) error {
-	onRampAddress := state.Chains[srcChainSel].OnRamp.Address()
-
-	execState, err := state.Chains[destChainSel].OffRamp.GetExecutionState(&bind.CallOpts{
-		Context: ctx,
-	}, srcChainSel, msgSeqNr)
-	if err != nil {
-		return fmt.Errorf("failed to get execution state: %w", err)
-	}
-
-	if execState == testhelpers.EXECUTION_STATE_SUCCESS ||
-		(execState == testhelpers.EXECUTION_STATE_FAILURE && !reExecuteIfFailed) {
-		lggr.Infow("message already executed", "execState", execState, "msgSeqNr", msgSeqNr)
-		return nil
-	}
-
-	lggr.Infow("contract addresses",
-		"offRampAddress", state.Chains[destChainSel].OffRamp.Address(),
-		"onRampAddress", onRampAddress,
-		"execState", execState,
-	)
-
+) (offramp.InternalMerkleRoot, error) {
 	merkleRoot, inCache := commitRootCache.Get(msgSeqNr)
 	if !inCache {
 		latestBlockNumber := commitRootCache.GetLatestBlockNumber()
@@ -359,7 +338,7 @@ func manuallyExecuteSingle(
 			latestBlockNumber,
 		)
 		if err != nil {
-			return fmt.Errorf("failed to get merkle root: %w", err)
+			return offramp.InternalMerkleRoot{}, fmt.Errorf("failed to get merkle root: %w", err)
 		}
 
 		// add to the cache for faster fetching.
@@ -372,15 +351,29 @@ func manuallyExecuteSingle(
 		lggr.Infow("found merkle root in cache", "msgSeqNr", msgSeqNr, "merkleRoot", merkleRoot)
 	}
 
-	lggr.Infow("merkle root",
-		"merkleRoot", hexutil.Encode(merkleRoot.MerkleRoot[:]),
-		"minSeqNr", merkleRoot.MinSeqNr,
-		"maxSeqNr", merkleRoot.MaxSeqNr,
-		"sourceChainSel", merkleRoot.SourceChainSelector,
-	)
+	return merkleRoot, nil
+}
 
+// getCCIPMessageSentEventsCached returns the CCIPMessageSent events of all messages in the given merkle root,
+// serving them from the cache when possible and fetching them from the source chain otherwise.
+func getCCIPMessageSentEventsCached(
+	ctx context.Context,
+	lggr logger.Logger,
+	env cldf.Environment,
+	state stateview.CCIPOnChainState,
+	srcChainSel uint64,
+	destChainSel uint64,
+	msgSeqNr uint64,
+	merkleRoot offramp.InternalMerkleRoot,
+	lookbackDuration,
+	stepDuration time.Duration,
+	messageSentCache *MessageSentCache,
+) ([]onramp.OnRampCCIPMessageSent, error) {
 	merkleRootSize := merkleRoot.MaxSeqNr - merkleRoot.MinSeqNr + 1
-	var ccipMessageSentEvents []onramp.OnRampCCIPMessageSent
+	var (
+		ccipMessageSentEvents []onramp.OnRampCCIPMessageSent
+		err                   error
+	)
 	if _, ok := messageSentCache.Get(msgSeqNr); ok {
 		lggr.Infow("found message in cache, fetching the rest", "msgSeqNr", msgSeqNr)
 		for start := merkleRoot.MinSeqNr; start <= merkleRoot.MaxSeqNr; start++ {
@@ -395,7 +388,6 @@ func manuallyExecuteSingle(
 		if uint64(len(ccipMessageSentEvents)) != merkleRootSize {
 			latestBlockNumber := messageSentCache.GetLatestBlockNumber()
 			lggr.Infow("not all messages found in cache, fetching from the chain", "msgSeqNr", msgSeqNr, "latestBlockNumber", latestBlockNumber)
-			var err error
 			var blockNumbers []uint64
 			ccipMessageSentEvents, blockNumbers, err = getCCIPMessageSentEvents(
 				ctx,
@@ -410,11 +402,11 @@ func manuallyExecuteSingle(
 				latestBlockNumber,
 			)
 			if err != nil {
-				return fmt.Errorf("failed to get ccip message sent event: %w", err)
+				return nil, fmt.Errorf("failed to get ccip message sent event: %w", err)
 			}
 
 			if len(ccipMessageSentEvents) != len(blockNumbers) {
-				return fmt.Errorf("unexpected mismatch in message count (%d) and block number count (%d), msgSeqNr: %d",
+				return nil, fmt.Errorf("unexpected mismatch in message count (%d) and block number count (%d), msgSeqNr: %d",
 					len(ccipMessageSentEvents), len(blockNumbers), msgSeqNr)
 			}
 
@@ -426,7 +418,6 @@ func manuallyExecuteSingle(
 	} else {
 		latestBlockNumber := messageSentCache.GetLatestBlockNumber()
 		lggr.Infow("not found in cache, fetching from the chain", "msgSeqNr", msgSeqNr, "latestBlockNumber", latestBlockNumber)
-		var err error
 		var blockNumbers []uint64
 		ccipMessageSentEvents, blockNumbers, err = getCCIPMessageSentEvents(
 			ctx,
@@ -441,11 +432,11 @@ func manuallyExecuteSingle(
 			latestBlockNumber,
 		)
 		if err != nil {
-			return fmt.Errorf("failed to get ccip message sent event: %w", err)
+			return nil, fmt.Errorf("failed to get ccip message sent event: %w", err)
 		}
 
 		if len(ccipMessageSentEvents) != len(blockNumbers) {
-			return fmt.Errorf("unexpected mismatch in message count (%d) and block number count (%d), msgSeqNr: %d",
+			return nil, fmt.Errorf("unexpected mismatch in message count (%d) and block number count (%d), msgSeqNr: %d",
 				len(ccipMessageSentEvents), len(blockNumbers), msgSeqNr)
 		}
 
@@ -455,6 +446,88 @@ func manuallyExecuteSingle(
 		}
 	}
 
+	return ccipMessageSentEvents, nil
+}
+
+// manuallyExecuteSingle manually executes a single message on the destination chain.
+func manuallyExecuteSingle(
+	ctx context.Context,
+	lggr logger.Logger,
+	state stateview.CCIPOnChainState,
+	env cldf.Environment,
+	srcChainSel uint64,
+	destChainSel uint64,
+	msgSeqNr uint64,
+	lookbackDuration,
+	lookbackDurationCommitReport,
+	stepDuration time.Duration,
+	reExecuteIfFailed bool,
+	extraDataCodec ccipcommon.ExtraDataCodec,
+	offchainTokenDataPools []cciptypes.UnknownAddress,
+	messageSentCache *MessageSentCache,
+	commitRootCache *RootCache,
+) error {
+	onRampAddress := state.Chains[srcChainSel].OnRamp.Address()
+
+	execState, err := state.Chains[destChainSel].OffRamp.GetExecutionState(&bind.CallOpts{
+		Context: ctx,
+	}, srcChainSel, msgSeqNr)
+	if err != nil {
+		return fmt.Errorf("failed to get execution state: %w", err)
+	}
+
+	if execState == testhelpers.EXECUTION_STATE_SUCCESS ||
+		(execState == testhelpers.EXECUTION_STATE_FAILURE && !reExecuteIfFailed) {
+		lggr.Infow("message already executed", "execState", execState, "msgSeqNr", msgSeqNr)
+		return nil
+	}
+
+	lggr.Infow("contract addresses",
+		"offRampAddress", state.Chains[destChainSel].OffRamp.Address(),
+		"onRampAddress", onRampAddress,
+		"execState", execState,
+	)
+
+	merkleRoot, err := getCommitRootAcceptedEventCached(
+		ctx,
+		lggr,
+		env,
+		state,
+		srcChainSel,
+		destChainSel,
+		msgSeqNr,
+		lookbackDurationCommitReport,
+		stepDuration,
+		commitRootCache,
+	)
+	if err != nil {
+		return err
+	}
+
+	lggr.Infow("merkle root",
+		"merkleRoot", hexutil.Encode(merkleRoot.MerkleRoot[:]),
+		"minSeqNr", merkleRoot.MinSeqNr,
+		"maxSeqNr", merkleRoot.MaxSeqNr,
+		"sourceChainSel", merkleRoot.SourceChainSelector,
+	)
+
+	ccipMessageSentEvents, err := getCCIPMessageSentEventsCached(
+		ctx,
+		lggr,
+		env,
+		state,
+		srcChainSel,
+		destChainSel,
+		msgSeqNr,
+		merkleRoot,
+		lookbackDuration,
+		stepDuration,
+		messageSentCache,
+	)
+	if err != nil {
+		return err
+	}
+
 	messageHashes, err := manualexeclib.GetMessageHashes(
 		ctx,
 		lggr,
@@ -500,11 +573,28 @@ func manuallyExecuteSingle(
 		hashes,
 		flags,
 		extraDataCodec,
+		offchainTokenDataPools,
 	)
 	if err != nil {
 		return fmt.Errorf("failed to create execution report: %w", err)
 	}
 
+	if err := manuallyExecuteOnEVM(env, state, destChainSel, execReport); err != nil {
+		return err
+	}
+
+	lggr.Infow("successfully manually executed msg", "msgSeqNr", msgSeqNr)
+
+	return nil
+}
+
+// manuallyExecuteOnEVM submits the given execution report to the EVM offramp of destChainSel.
+func manuallyExecuteOnEVM(
+	env cldf.Environment,
+	state stateview.CCIPOnChainState,
+	destChainSel uint64,
+	execReport offramp.InternalExecutionReport,
+) error {
 	txOpts := &bind.TransactOpts{
 		From:   env.Chains[destChainSel].DeployerKey.From,
 		Nonce:  nil,
@@ -532,14 +622,14 @@ func manuallyExecuteSingle(
 		return fmt.Errorf("failed to execute message: %w", err)
 	}
 
-	lggr.Infow("successfully manually executed msg", "msgSeqNr", msgSeqNr)
-
 	return nil
 }
 
 // ManuallyExecuteAll will manually execute the provided messages if they were not already executed.
-// At the moment offchain token data (i.e USDC/Lombard attestations) is not supported,
-// and only EVM is supported as a source or dest chain.
+// At the moment offchain token data (i.e USDC/Lombard attestations) is not supported, messages transferring tokens
+// through the source pools of the token data observers of the destination exec plugin are rejected.
+// EVM -> EVM, EVM -> SVM and SVM -> EVM lanes are supported.
+// On SVM chains the stepDuration is unused, the transactions of the ramp are scanned instead.
 func ManuallyExecuteAll(
 	ctx context.Context,
 	lggr logger.Logger,
@@ -553,27 +643,84 @@ func ManuallyExecuteAll(
 	stepDuration time.Duration,
 	reExecuteIfFailed bool,
 ) error {
+	srcFamily, err := chainsel.GetSelectorFamily(srcChainSel)
+	if err != nil {
+		return fmt.Errorf("failed to get family of source chain %d: %w", srcChainSel, err)
+	}
+	destFamily, err := chainsel.GetSelectorFamily(destChainSel)
+	if err != nil {
+		return fmt.Errorf("failed to get family of dest chain %d: %w", destChainSel, err)
+	}
+
 	extraDataCodec := defaults.DefaultExtraDataCodec
+	offchainTokenDataPools, err := getOffchainTokenDataPools(state, srcChainSel, destChainSel)
+	if err != nil {
+		return err
+	}
 	// the chain multiple times for the same root/messages.
 	messageSentCache := NewMessageSentCache()
+	svmMessageSentCache := make(svmMessageSentCache)
 	commitRootCache := NewRootCache()
 	for _, seqNr := range msgSeqNrs {
-		err := manuallyExecuteSingle(
-			ctx,
-			lggr,
-			state,
-			env,
-			srcChainSel,
-			destChainSel,
-			uint64(seqNr), //nolint:gosec // seqNr is never <= 0.
-			lookbackDurationMsgs,
-			lookbackDurationCommitReport,
-			stepDuration,
-			reExecuteIfFailed,
-			extraDataCodec,
-			messageSentCache,
-			commitRootCache,
-		)
+		msgSeqNr := uint64(seqNr) //nolint:gosec // seqNr is never <= 0.
+		switch {
+		case srcFamily == chainsel.FamilyEVM && destFamily == chainsel.FamilyEVM:
+			err = manuallyExecuteSingle(
+				ctx,
+				lggr,
+				state,
+				env,
+				srcChainSel,
+				destChainSel,
+				msgSeqNr,
+				lookbackDurationMsgs,
+				lookbackDurationCommitReport,
+				stepDuration,
+				reExecuteIfFailed,
+				extraDataCodec,
+				offchainTokenDataPools,
+				messageSentCache,
+				commitRootCache,
+			)
+		case srcFamily == chainsel.FamilyEVM && destFamily == chainsel.FamilySolana:
+			err = manuallyExecuteSingleEVMToSVM(
+				ctx,
+				lggr,
+				state,
+				env,
+				srcChainSel,
+				destChainSel,
+				msgSeqNr,
+				lookbackDurationMsgs,
+				lookbackDurationCommitReport,
+				stepDuration,
+				reExecuteIfFailed,
+				extraDataCodec,
+				offchainTokenDataPools,
+				messageSentCache,
+				commitRootCache,
+			)
+		case srcFamily == chainsel.FamilySolana && destFamily == chainsel.FamilyEVM:
+			err = manuallyExecuteSingleSVMToEVM(
+				ctx,
+				lggr,
+				state,
+				env,
+				srcChainSel,
+				destChainSel,
+				msgSeqNr,
+				lookbackDurationMsgs,
+				lookbackDurationCommitReport,
+				stepDuration,
+				reExecuteIfFailed,
+				extraDataCodec,
+				offchainTokenDataPools,
+				svmMessageSentCache,
+				commitRootCache,
+			)
+		default:
+			return fmt.Errorf("manual execution from %s to %s is not supported", srcFamily, destFamily)
+		}
 		if err != nil {
 			return err
 		}
@@ -582,7 +729,68 @@ func ManuallyExecuteAll(
 	return nil
 }
 
+// getOffchainTokenDataPools returns the pools of srcChainSel whose token transfers to destChainSel require offchain
+// token data, i.e. the source pools of the token data observers of the active exec plugin config of destChainSel.
+func getOffchainTokenDataPools(
+	state stateview.CCIPOnChainState,
+	srcChainSel uint64,
+	destChainSel uint64,
+) ([]cciptypes.UnknownAddress, error) {
+	homeChainSel, err := state.HomeChainSelector()
+	if err != nil {
+		return nil, err
+	}
+	homeChain := state.Chains[homeChainSel]
+	ccipHome, err := v1_6.GenerateCCIPHomeView(homeChain.CapabilityRegistry, homeChain.CCIPHome)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the exec plugin configs from CCIPHome: %w", err)
+	}
+
+	for _, don := range ccipHome.Dons {
+		execConfig := don.ExecConfigs.ActiveConfig.Config
+		if execConfig.ChainSelector != destChainSel || execConfig.ExecuteOffChainConfig == nil {
+			continue
+		}
+		return offchainTokenDataPools(execConfig.ExecuteOffChainConfig.TokenDataObservers, srcChainSel)
+	}
+
+	return nil, fmt.Errorf("no active exec plugin config found for chain %d", destChainSel)
+}
+
+// offchainTokenDataPools returns the pools of srcChainSel the given token data observers provide token data for.
+func offchainTokenDataPools(
+	observers []pluginconfig.TokenDataObserverConfig,
+	srcChainSel uint64,
+) ([]cciptypes.UnknownAddress, error) {
+	sourceChain := cciptypes.ChainSelector(srcChainSel)
+
+	var pools []cciptypes.UnknownAddress
+	for _, observer := range observers {
+		var pool string
+		switch {
+		case observer.IsUSDC() && observer.USDCCCTPObserverConfig != nil:
+			pool = observer.USDCCCTPObserverConfig.Tokens[sourceChain].SourcePoolAddress
+		case observer.IsLBTC() && observer.LBTCObserverConfig != nil:
+			pool = observer.LBTCObserverConfig.SourcePoolAddressByChain[sourceChain]
+		case observer.IsHTTPAttestation() && observer.HTTPAttestationObserverConfig != nil:
+			pool = observer.HTTPAttestationObserverConfig.SourcePoolAddressByChain[sourceChain]
+		}
+		if pool == "" {
+			continue
+		}
+
+		poolAddress, err := defaults.DefaultAddressCodec.AddressStringToBytes(pool, sourceChain)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s source pool address %s: %w", observer.Type, pool, err)
+		}
+		pools = append(pools, poolAddress)
+	}
+
+	return pools, nil
+}
+
 // CheckAlreadyExecuted will check the execution state of the provided messages and log if they were already executed.
+// Only EVM destination chains are supported, the SVM execution state is tracked per commit report.
 func CheckAlreadyExecuted(
 	ctx context.Context,
 	lggr logger.Logger,
@@ -0,0 +1,768 @@
+package manualexechelpers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	bin "github.com/gagliardetto/binary"
+	"github.com/gagliardetto/solana-go"
+	solrpc "github.com/gagliardetto/solana-go/rpc"
+
+	"github.com/smartcontractkit/chainlink-ccip/chains/evm/gobindings/generated/v1_6_0/offramp"
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/gobindings/ccip_common"
+	"github.com/smartcontractkit/chainlink-ccip/chains/solana/gobindings/ccip_offramp"
+	solccip "github.com/smartcontractkit/chainlink-ccip/chains/solana/utils/ccip"
+	solcommon "github.com/smartcontractkit/chainlink-ccip/chains/solana/utils/common"
+	solstate "github.com/smartcontractkit/chainlink-ccip/chains/solana/utils/state"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	cldf "github.com/smartcontractkit/chainlink-deployments-framework/deployment"
+
+	"github.com/smartcontractkit/chainlink/deployment/ccip/changeset/testhelpers"
+	"github.com/smartcontractkit/chainlink/deployment/ccip/shared/stateview"
+	solanastateview "github.com/smartcontractkit/chainlink/deployment/ccip/shared/stateview/solana"
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/ccipevm"
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/ccipevm/manualexeclib"
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/ccipsolana"
+	solmanualexeclib "github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/ccipsolana/manualexeclib"
+	ccipcommon "github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/common"
+)
+
+const (
+	// solanaSlotDuration is the target slot time on Solana, used to convert lookback durations to slots.
+	solanaSlotDuration = 400 * time.Millisecond
+
+	// solanaSignaturesPageSize is the maximum number of signatures returned by getSignaturesForAddress.
+	solanaSignaturesPageSize = 1000
+
+	// solanaManualExecComputeUnits is the compute unit limit of the manual execution transaction.
+	// Similar to the EVM gas limit this is just a big value, the maximum allowed per transaction.
+	solanaManualExecComputeUnits = 1_400_000
+)
+
+// scanSolanaEvents scans the successful transactions referencing address from the newest to the oldest,
+// going back lookbackDuration worth of slots, and calls onEvent for every event of the given type.
+// The scan stops early once onEvent returns true.
+func scanSolanaEvents[T any](
+	ctx context.Context,
+	lggr logger.Logger,
+	chain cldf.SolChain,
+	address solana.PublicKey,
+	eventType string,
+	lookbackDuration time.Duration,
+	onEvent func(event T, slot uint64) bool,
+) error {
+	currentSlot, err := chain.Client.GetSlot(ctx, solrpc.CommitmentConfirmed)
+	if err != nil {
+		return fmt.Errorf("failed to get slot: %w", err)
+	}
+
+	var startSlot uint64
+	if lookbackSlots := uint64(lookbackDuration / solanaSlotDuration); lookbackSlots < currentSlot {
+		startSlot = currentSlot - lookbackSlots
+	}
+
+	lggr.Infow("Scanning solana transactions", "address", address, "eventType", eventType,
+		"startSlot", startSlot, "currentSlot", currentSlot)
+
+	limit := solanaSignaturesPageSize
+	var before solana.Signature
+	for {
+		txSigs, err := chain.Client.GetSignaturesForAddressWithOpts(ctx, address, &solrpc.GetSignaturesForAddressOpts{
+			Limit:      &limit,
+			Before:     before,
+			Commitment: solrpc.CommitmentConfirmed,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to get signatures for address %s: %w", address, err)
+		}
+
+		// values are returned ordered newest to oldest
+		for _, txSig := range txSigs {
+			if txSig.Slot < startSlot {
+				return nil
+			}
+			if txSig.Err != nil {
+				// We're not interested in failed transactions.
+				continue
+			}
+
+			v := uint64(0) // v0 = latest, supports address table lookups
+			tx, err := chain.Client.GetTransaction(ctx, txSig.Signature, &solrpc.GetTransactionOpts{
+				Commitment:                     solrpc.CommitmentConfirmed,
+				Encoding:                       solana.EncodingBase64,
+				MaxSupportedTransactionVersion: &v,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to get transaction %s: %w", txSig.Signature, err)
+			}
+			if tx.Meta == nil {
+				continue
+			}
+
+			events, err := solcommon.ParseMultipleEvents[T](tx.Meta.LogMessages, eventType, false)
+			if err != nil && strings.Contains(err.Error(), "event not found") {
+				continue
+			}
+			if err != nil {
+				return fmt.Errorf("failed to parse %s events of transaction %s: %w", eventType, txSig.Signature, err)
+			}
+
+			for _, event := range events {
+				if onEvent(event, txSig.Slot) {
+					return nil
+				}
+			}
+		}
+
+		if len(txSigs) < limit {
+			return nil
+		}
+		before = txSigs[len(txSigs)-1].Signature
+	}
+}
+
+// getSVMCommitRootAcceptedEvent retrieves the merkle root committed on the SVM offramp for the provided
+// (srcChainSel, destChainSel, msgSeqNr) triplet. The root is returned in its EVM representation so that
+// it can be stored in the RootCache.
+func getSVMCommitRootAcceptedEvent(
+	ctx context.Context,
+	lggr logger.Logger,
+	env cldf.Environment,
+	state stateview.CCIPOnChainState,
+	srcChainSel uint64,
+	destChainSel uint64,
+	msgSeqNr uint64,
+	lookbackDuration time.Duration,
+) (offramp.InternalMerkleRoot, uint64, error) {
+	var (
+		root  offramp.InternalMerkleRoot
+		slot  uint64
+		found bool
+	)
+	err := scanSolanaEvents(
+		ctx,
+		lggr,
+		env.SolChains[destChainSel],
+		state.SolChains[destChainSel].OffRamp,
+		"CommitReportAccepted",
+		lookbackDuration,
+		func(event solccip.EventCommitReportAccepted, eventSlot uint64) bool {
+			if event.Report == nil {
+				// price updates only, can skip this event.
+				return false
+			}
+			if event.Report.SourceChainSelector != srcChainSel {
+				return false
+			}
+			lggr.Infow("checking commit root",
+				"minSeqNr", event.Report.MinSeqNr,
+				"maxSeqNr", event.Report.MaxSeqNr,
+				"slot", eventSlot,
+			)
+			if msgSeqNr < event.Report.MinSeqNr || msgSeqNr > event.Report.MaxSeqNr {
+				return false
+			}
+			root, slot, found = toEVMMerkleRoot(*event.Report), eventSlot, true
+			lggr.Infow("found commit root", "root", root, "slot", slot)
+			return true
+		},
+	)
+	if err != nil {
+		return offramp.InternalMerkleRoot{}, 0, fmt.Errorf("failed to scan commit reports: %w", err)
+	}
+
+	if !found {
+		lggr.Infow("didn't find commit root, maybe increase lookback duration")
+		return offramp.InternalMerkleRoot{}, 0, errors.New("commit root not found")
+	}
+
+	return root, slot, nil
+}
+
+// getSVMCommitRootAcceptedEventCached returns the merkle root committed on the SVM offramp that contains msgSeqNr,
+// serving it from the cache when possible and fetching it from the destination chain otherwise.
+func getSVMCommitRootAcceptedEventCached(
+	ctx context.Context,
+	lggr logger.Logger,
+	env cldf.Environment,
+	state stateview.CCIPOnChainState,
+	srcChainSel uint64,
+	destChainSel uint64,
+	msgSeqNr uint64,
+	lookbackDurationCommitReport time.Duration,
+	commitRootCache *RootCache,
+) (offramp.InternalMerkleRoot, error) {
+	if merkleRoot, ok := commitRootCache.Get(msgSeqNr); ok {
+		lggr.Infow("found merkle root in cache", "msgSeqNr", msgSeqNr, "merkleRoot", merkleRoot)
+		return merkleRoot, nil
+	}
+
+	lggr.Infow("merkle root not found in cache, fetching from the chain", "msgSeqNr", msgSeqNr)
+	merkleRoot, slot, err := getSVMCommitRootAcceptedEvent(
+		ctx,
+		lggr,
+		env,
+		state,
+		srcChainSel,
+		destChainSel,
+		msgSeqNr,
+		lookbackDurationCommitReport,
+	)
+	if err != nil {
+		return offramp.InternalMerkleRoot{}, fmt.Errorf("failed to get merkle root: %w", err)
+	}
+
+	commitRootCache.Add(RootCacheEntry{
+		Root:        merkleRoot,
+		BlockNumber: slot,
+	})
+	commitRootCache.Build()
+
+	return merkleRoot, nil
+}
+
+// getSVMCCIPMessageSentEventsCached returns the messages sent by the SVM router for all sequence numbers in
+// the given merkle root, serving them from the cache when possible and fetching them from the source chain otherwise.
+func getSVMCCIPMessageSentEventsCached(
+	ctx context.Context,
+	lggr logger.Logger,
+	env cldf.Environment,
+	state stateview.CCIPOnChainState,
+	srcChainSel uint64,
+	destChainSel uint64,
+	merkleRoot offramp.InternalMerkleRoot,
+	lookbackDuration time.Duration,
+	messageSentCache svmMessageSentCache,
+) ([]cciptypes.Message, error) {
+	if msgs, ok := messageSentCache.getRange(merkleRoot.MinSeqNr, merkleRoot.MaxSeqNr); ok {
+		lggr.Infow("found all messages for root in cache", "merkleRoot", merkleRoot)
+		return msgs, nil
+	}
+
+	router := state.SolChains[srcChainSel].Router
+	err := scanSolanaEvents(
+		ctx,
+		lggr,
+		env.SolChains[srcChainSel],
+		router,
+		"CCIPMessageSent",
+		lookbackDuration,
+		func(event solccip.EventCCIPMessageSent, slot uint64) bool {
+			if event.DestinationChainSelector != destChainSel {
+				return false
+			}
+			lggr.Infow("checking message", "seqNr", event.SequenceNumber, "destChain", event.DestinationChainSelector,
+				"slot", slot)
+			messageSentCache[event.SequenceNumber] = ccipsolana.SVM2AnyToCCIPMsg(router, event.Message)
+			_, done := messageSentCache.getRange(merkleRoot.MinSeqNr, merkleRoot.MaxSeqNr)
+			return done
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan ccip message sent events: %w", err)
+	}
+
+	msgs, ok := messageSentCache.getRange(merkleRoot.MinSeqNr, merkleRoot.MaxSeqNr)
+	if !ok {
+		return nil, fmt.Errorf("not all messages found for root with range [%d, %d], maybe increase lookback duration",
+			merkleRoot.MinSeqNr, merkleRoot.MaxSeqNr)
+	}
+
+	lggr.Infow("found all messages for root", "merkleRoot", merkleRoot, "messages", len(msgs))
+
+	return msgs, nil
+}
+
+// manuallyExecuteSingleEVMToSVM manually executes a single message sent from an EVM chain on the SVM offramp.
+func manuallyExecuteSingleEVMToSVM(
+	ctx context.Context,
+	lggr logger.Logger,
+	state stateview.CCIPOnChainState,
+	env cldf.Environment,
+	srcChainSel uint64,
+	destChainSel uint64,
+	msgSeqNr uint64,
+	lookbackDuration,
+	lookbackDurationCommitReport,
+	stepDuration time.Duration,
+	reExecuteIfFailed bool,
+	extraDataCodec ccipcommon.ExtraDataCodec,
+	offchainTokenDataPools []cciptypes.UnknownAddress,
+	messageSentCache *MessageSentCache,
+	commitRootCache *RootCache,
+) error {
+	onRampAddress := state.Chains[srcChainSel].OnRamp.Address()
+	offRampAddress := state.SolChains[destChainSel].OffRamp
+	destChain := env.SolChains[destChainSel]
+
+	lggr.Infow("contract addresses",
+		"offRampAddress", offRampAddress,
+		"onRampAddress", onRampAddress,
+	)
+
+	// the execution state lives in the commit report account, so the root has to be found first.
+	merkleRoot, err := getSVMCommitRootAcceptedEventCached(
+		ctx,
+		lggr,
+		env,
+		state,
+		srcChainSel,
+		destChainSel,
+		msgSeqNr,
+		lookbackDurationCommitReport,
+		commitRootCache,
+	)
+	if err != nil {
+		return err
+	}
+
+	commitReportPDA, err := solstate.FindOfframpCommitReportPDA(srcChainSel, merkleRoot.MerkleRoot, offRampAddress)
+	if err != nil {
+		return fmt.Errorf("failed to find commit report PDA: %w", err)
+	}
+	var commitReport ccip_offramp.CommitReport
+	if err = destChain.GetAccountDataBorshInto(ctx, commitReportPDA, &commitReport); err != nil {
+		return fmt.Errorf("failed to get commit report account %s: %w", commitReportPDA, err)
+	}
+
+	execState := svmExecutionState(commitReport.ExecutionStates, msgSeqNr-commitReport.MinMsgNr)
+	if execState == testhelpers.EXECUTION_STATE_SUCCESS ||
+		(execState == testhelpers.EXECUTION_STATE_FAILURE && !reExecuteIfFailed) {
+		lggr.Infow("message already executed", "execState", execState, "msgSeqNr", msgSeqNr)
+		return nil
+	}
+
+	lggr.Infow("merkle root",
+		"merkleRoot", hexutil.Encode(merkleRoot.MerkleRoot[:]),
+		"minSeqNr", merkleRoot.MinSeqNr,
+		"maxSeqNr", merkleRoot.MaxSeqNr,
+		"sourceChainSel", merkleRoot.SourceChainSelector,
+		"execState", execState,
+	)
+
+	ccipMessageSentEvents, err := getCCIPMessageSentEventsCached(
+		ctx,
+		lggr,
+		env,
+		state,
+		srcChainSel,
+		destChainSel,
+		msgSeqNr,
+		merkleRoot,
+		lookbackDuration,
+		stepDuration,
+		messageSentCache,
+	)
+	if err != nil {
+		return err
+	}
+
+	var (
+		ccipMsgs []cciptypes.Message
+		msg      cciptypes.Message
+		msgFound bool
+	)
+	for _, event := range ccipMessageSentEvents {
+		ccipMsg := ccipevm.EVM2AnyToCCIPMsg(onRampAddress, event.Message)
+		ccipMsgs = append(ccipMsgs, ccipMsg)
+		if event.Message.Header.SequenceNumber == msgSeqNr {
+			msg, msgFound = ccipMsg, true
+		}
+	}
+
+	// sanity check, should not be possible at this point.
+	if !msgFound {
+		return fmt.Errorf("no message found for seqNr %d", msgSeqNr)
+	}
+
+	messageHashes, err := solmanualexeclib.GetMessageHashes(ctx, lggr, ccipMsgs, extraDataCodec)
+	if err != nil {
+		return fmt.Errorf("failed to get message hashes: %w", err)
+	}
+
+	proofs, err := solmanualexeclib.GetMerkleProof(lggr, toSVMMerkleRoot(merkleRoot), messageHashes, msgSeqNr)
+	if err != nil {
+		return fmt.Errorf("failed to get merkle proof: %w", err)
+	}
+
+	lggr.Infow("got proofs", "proofs", proofs)
+
+	execReport, err := solmanualexeclib.CreateExecutionReport(
+		ctx,
+		srcChainSel,
+		msg,
+		proofs,
+		extraDataCodec,
+		offchainTokenDataPools,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create execution report: %w", err)
+	}
+
+	remainingAccounts, err := ccipsolana.ExecuteRemainingAccounts(offRampAddress, msg, extraDataCodec)
+	if err != nil {
+		return fmt.Errorf("failed to get remaining accounts: %w", err)
+	}
+
+	tokenAccounts, tokenIndexes, tokenLookupTables, err := getSVMTokenTransferAccounts(
+		ctx,
+		state.SolChains[destChainSel],
+		destChain,
+		srcChainSel,
+		msg,
+		len(remainingAccounts),
+		extraDataCodec,
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := manuallyExecuteOnSVM(
+		ctx,
+		state,
+		destChain,
+		srcChainSel,
+		commitReportPDA,
+		execReport,
+		append(remainingAccounts, tokenAccounts...),
+		tokenIndexes,
+		tokenLookupTables,
+	); err != nil {
+		return err
+	}
+
+	lggr.Infow("successfully manually executed msg", "msgSeqNr", msgSeqNr)
+
+	return nil
+}
+
+// getSVMTokenTransferAccounts returns the token pool accounts of every token transferred by msg, the start index
+// of each token in the remaining accounts, which follow the numMessagingAccounts messaging accounts, and the pool
+// lookup tables of the tokens.
+func getSVMTokenTransferAccounts(
+	ctx context.Context,
+	chainState solanastateview.CCIPChainState,
+	destChain cldf.SolChain,
+	srcChainSel uint64,
+	msg cciptypes.Message,
+	numMessagingAccounts int,
+	extraDataCodec ccipcommon.ExtraDataCodec,
+) (solana.AccountMetaSlice, []byte, map[solana.PublicKey]solana.PublicKeySlice, error) {
+	if len(msg.TokenAmounts) == 0 {
+		return nil, []byte{}, nil, nil
+	}
+
+	tokenReceiver, err := ccipsolana.ExecuteTokenReceiver(msg, extraDataCodec)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to get token receiver: %w", err)
+	}
+
+	var (
+		accounts     solana.AccountMetaSlice
+		tokenIndexes = make([]byte, 0, len(msg.TokenAmounts))
+		lookupTables = make(map[solana.PublicKey]solana.PublicKeySlice)
+	)
+	for _, tokenAmount := range msg.TokenAmounts {
+		mint := solana.PublicKeyFromBytes(tokenAmount.DestTokenAddress)
+		registryPDA, _, err := solstate.FindTokenAdminRegistryPDA(mint, chainState.Router)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("failed to find token admin registry PDA of token %s: %w", mint, err)
+		}
+		var registry ccip_common.TokenAdminRegistry
+		if err = destChain.GetAccountDataBorshInto(ctx, registryPDA, &registry); err != nil {
+			return nil, nil, nil, fmt.Errorf("failed to get token admin registry %s of token %s: %w", registryPDA, mint, err)
+		}
+		poolLookupTable, err := solcommon.GetAddressLookupTable(ctx, destChain.Client, registry.LookupTable)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("failed to get pool lookup table %s of token %s: %w", registry.LookupTable, mint, err)
+		}
+
+		tokenAccounts, err := ccipsolana.ExecuteTokenAccounts(
+			chainState.OffRamp,
+			chainState.FeeQuoter,
+			srcChainSel,
+			tokenReceiver,
+			registry,
+			poolLookupTable,
+		)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		tokenIndex, err := svmTokenIndex(numMessagingAccounts + len(accounts))
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		tokenIndexes = append(tokenIndexes, tokenIndex)
+		accounts = append(accounts, tokenAccounts...)
+		lookupTables[registry.LookupTable] = poolLookupTable
+	}
+
+	return accounts, tokenIndexes, lookupTables, nil
+}
+
+// svmTokenIndex returns the start index of the accounts of a token in the remaining accounts of the execute
+// instruction, the offramp encodes them as single bytes.
+func svmTokenIndex(index int) (byte, error) {
+	if index > math.MaxUint8 {
+		return 0, fmt.Errorf("token accounts start at remaining account %d, which exceeds the maximum of %d",
+			index, math.MaxUint8)
+	}
+	return byte(index), nil //nolint:gosec // checked above.
+}
+
+// manuallyExecuteOnSVM submits the given borsh encoded execution report to the SVM offramp of destChain,
+// resolving the offramp accounts through its address lookup table and the token pool accounts through
+// tokenLookupTables.
+func manuallyExecuteOnSVM(
+	ctx context.Context,
+	state stateview.CCIPOnChainState,
+	destChain cldf.SolChain,
+	srcChainSel uint64,
+	commitReportPDA solana.PublicKey,
+	execReport []byte,
+	remainingAccounts solana.AccountMetaSlice,
+	tokenIndexes []byte,
+	tokenLookupTables map[solana.PublicKey]solana.PublicKeySlice,
+) error {
+	chainState := state.SolChains[destChain.Selector]
+
+	configPDA, _, err := solstate.FindOfframpConfigPDA(chainState.OffRamp)
+	if err != nil {
+		return fmt.Errorf("failed to find offramp config PDA: %w", err)
+	}
+	referenceAddressesPDA, _, err := solstate.FindOfframpReferenceAddressesPDA(chainState.OffRamp)
+	if err != nil {
+		return fmt.Errorf("failed to find offramp reference addresses PDA: %w", err)
+	}
+	sourceChainPDA, _, err := solstate.FindOfframpSourceChainPDA(srcChainSel, chainState.OffRamp)
+	if err != nil {
+		return fmt.Errorf("failed to find offramp source chain PDA: %w", err)
+	}
+	allowedOfframpPDA, err := solstate.FindAllowedOfframpPDA(srcChainSel, chainState.OffRamp, chainState.Router)
+	if err != nil {
+		return fmt.Errorf("failed to find allowed offramp PDA: %w", err)
+	}
+	rmnRemoteCursesPDA, _, err := solstate.FindRMNRemoteCursesPDA(chainState.RMNRemote)
+	if err != nil {
+		return fmt.Errorf("failed to find rmn remote curses PDA: %w", err)
+	}
+	rmnRemoteConfigPDA, _, err := solstate.FindRMNRemoteConfigPDA(chainState.RMNRemote)
+	if err != nil {
+		return fmt.Errorf("failed to find rmn remote config PDA: %w", err)
+	}
+
+	raw := ccip_offramp.NewManuallyExecuteInstruction(
+		execReport,
+		tokenIndexes,
+		configPDA,
+		referenceAddressesPDA,
+		sourceChainPDA,
+		commitReportPDA,
+		chainState.OffRamp,
+		allowedOfframpPDA,
+		destChain.DeployerKey.PublicKey(),
+		solana.SystemProgramID,
+		solana.SysVarInstructionsPubkey,
+		chainState.RMNRemote,
+		rmnRemoteCursesPDA,
+		rmnRemoteConfigPDA,
+	)
+	raw.AccountMetaSlice = append(raw.AccountMetaSlice, remainingAccounts...)
+	ix, err := raw.ValidateAndBuild()
+	if err != nil {
+		return fmt.Errorf("failed to build manually execute instruction: %w", err)
+	}
+
+	lookupTable, err := solanastateview.FetchOfframpLookupTable(ctx, destChain, chainState.OffRamp)
+	if err != nil {
+		return err
+	}
+	lookupTableAddresses, err := solcommon.GetAddressLookupTable(ctx, destChain.Client, lookupTable)
+	if err != nil {
+		return fmt.Errorf("failed to get offramp lookup table %s: %w", lookupTable, err)
+	}
+
+	lookupTables := map[solana.PublicKey]solana.PublicKeySlice{lookupTable: lookupTableAddresses}
+	maps.Copy(lookupTables, tokenLookupTables)
+
+	_, err = solcommon.SendAndConfirmWithLookupTables(
+		ctx,
+		destChain.Client,
+		[]solana.Instruction{ix},
+		*destChain.DeployerKey,
+		cldf.SolDefaultCommitment,
+		lookupTables,
+		solcommon.AddComputeUnitLimit(solanaManualExecComputeUnits),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to execute message: %w", err)
+	}
+
+	return nil
+}
+
+// manuallyExecuteSingleSVMToEVM manually executes a single message sent from an SVM chain on the EVM offramp.
+func manuallyExecuteSingleSVMToEVM(
+	ctx context.Context,
+	lggr logger.Logger,
+	state stateview.CCIPOnChainState,
+	env cldf.Environment,
+	srcChainSel uint64,
+	destChainSel uint64,
+	msgSeqNr uint64,
+	lookbackDuration,
+	lookbackDurationCommitReport,
+	stepDuration time.Duration,
+	reExecuteIfFailed bool,
+	extraDataCodec ccipcommon.ExtraDataCodec,
+	offchainTokenDataPools []cciptypes.UnknownAddress,
+	messageSentCache svmMessageSentCache,
+	commitRootCache *RootCache,
+) error {
+	execState, err := state.Chains[destChainSel].OffRamp.GetExecutionState(&bind.CallOpts{
+		Context: ctx,
+	}, srcChainSel, msgSeqNr)
+	if err != nil {
+		return fmt.Errorf("failed to get execution state: %w", err)
+	}
+
+	if execState == testhelpers.EXECUTION_STATE_SUCCESS ||
+		(execState == testhelpers.EXECUTION_STATE_FAILURE && !reExecuteIfFailed) {
+		lggr.Infow("message already executed", "execState", execState, "msgSeqNr", msgSeqNr)
+		return nil
+	}
+
+	lggr.Infow("contract addresses",
+		"offRampAddress", state.Chains[destChainSel].OffRamp.Address(),
+		"routerAddress", state.SolChains[srcChainSel].Router,
+		"execState", execState,
+	)
+
+	merkleRoot, err := getCommitRootAcceptedEventCached(
+		ctx,
+		lggr,
+		env,
+		state,
+		srcChainSel,
+		destChainSel,
+		msgSeqNr,
+		lookbackDurationCommitReport,
+		stepDuration,
+		commitRootCache,
+	)
+	if err != nil {
+		return err
+	}
+
+	lggr.Infow("merkle root",
+		"merkleRoot", hexutil.Encode(merkleRoot.MerkleRoot[:]),
+		"minSeqNr", merkleRoot.MinSeqNr,
+		"maxSeqNr", merkleRoot.MaxSeqNr,
+		"sourceChainSel", merkleRoot.SourceChainSelector,
+	)
+
+	ccipMsgs, err := getSVMCCIPMessageSentEventsCached(
+		ctx,
+		lggr,
+		env,
+		state,
+		srcChainSel,
+		destChainSel,
+		merkleRoot,
+		lookbackDuration,
+		messageSentCache,
+	)
+	if err != nil {
+		return err
+	}
+
+	messageHashes, err := manualexeclib.GetMessageHashesFromMessages(ctx, lggr, ccipMsgs, extraDataCodec)
+	if err != nil {
+		return fmt.Errorf("failed to get message hashes: %w", err)
+	}
+
+	hashes, flags, err := manualexeclib.GetMerkleProof(lggr, merkleRoot, messageHashes, msgSeqNr)
+	if err != nil {
+		return fmt.Errorf("failed to get merkle proof: %w", err)
+	}
+
+	lggr.Infow("got hashes and flags", "hashes", hashes, "flags", flags)
+
+	// since we're only executing one message, we need to only include that message
+	// in the report. The messages are ordered by sequence number.
+	execReport, err := manualexeclib.CreateExecutionReportFromMessages(
+		srcChainSel,
+		[]cciptypes.Message{ccipMsgs[msgSeqNr-merkleRoot.MinSeqNr]},
+		hashes,
+		flags,
+		extraDataCodec,
+		offchainTokenDataPools,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create execution report: %w", err)
+	}
+
+	if err := manuallyExecuteOnEVM(env, state, destChainSel, execReport); err != nil {
+		return err
+	}
+
+	lggr.Infow("successfully manually executed msg", "msgSeqNr", msgSeqNr)
+
+	return nil
+}
+
+// svmExecutionState returns the execution state of the message at index idx of a commit report,
+// which is stored on the SVM offramp as 2 bits per message.
+func svmExecutionState(states bin.Uint128, idx uint64) uint8 {
+	shift := 2 * idx
+	if shift >= 64 {
+		return uint8((states.Hi >> (shift - 64)) & 0b11) //nolint:gosec // masked to 2 bits.
+	}
+	return uint8((states.Lo >> shift) & 0b11) //nolint:gosec // masked to 2 bits.
+}
+
+func toEVMMerkleRoot(root ccip_offramp.MerkleRoot) offramp.InternalMerkleRoot {
+	return offramp.InternalMerkleRoot{
+		SourceChainSelector: root.SourceChainSelector,
+		OnRampAddress:       root.OnRampAddress,
+		MinSeqNr:            root.MinSeqNr,
+		MaxSeqNr:            root.MaxSeqNr,
+		MerkleRoot:          root.MerkleRoot,
+	}
+}
+
+func toSVMMerkleRoot(root offramp.InternalMerkleRoot) ccip_offramp.MerkleRoot {
+	return ccip_offramp.MerkleRoot{
+		SourceChainSelector: root.SourceChainSelector,
+		OnRampAddress:       root.OnRampAddress,
+		MinSeqNr:            root.MinSeqNr,
+		MaxSeqNr:            root.MaxSeqNr,
+		MerkleRoot:          root.MerkleRoot,
+	}
+}
+
+// svmMessageSentCache caches the messages sent by an SVM router by sequence number.
+type svmMessageSentCache map[uint64]cciptypes.Message
+
+// getRange returns the cached messages in [minSeqNr, maxSeqNr] ordered by sequence number,
+// and false if any of them is missing.
+func (c svmMessageSentCache) getRange(minSeqNr, maxSeqNr uint64) ([]cciptypes.Message, bool) {
+	msgs := make([]cciptypes.Message, 0, maxSeqNr-minSeqNr+1)
+	for seqNr := minSeqNr; seqNr <= maxSeqNr; seqNr++ {
+		msg, ok := c[seqNr]
+		if !ok {
+			return nil, false
+		}
+		msgs = append(msgs, msg)
+	}
+	return msgs, true
+}
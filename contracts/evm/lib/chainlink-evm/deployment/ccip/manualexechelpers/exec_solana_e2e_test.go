@@ -0,0 +1,121 @@
+package manualexechelpers_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gagliardetto/solana-go"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-ccip/chains/evm/gobindings/generated/v1_6_0/message_hasher"
+	solconfig "github.com/smartcontractkit/chainlink-ccip/chains/solana/contracts/tests/config"
+	solccip "github.com/smartcontractkit/chainlink-ccip/chains/solana/utils/ccip"
+	solcommon "github.com/smartcontractkit/chainlink-ccip/chains/solana/utils/common"
+	cldf "github.com/smartcontractkit/chainlink-deployments-framework/deployment"
+
+	ccipChangesetSolana "github.com/smartcontractkit/chainlink/deployment/ccip/changeset/solana"
+	"github.com/smartcontractkit/chainlink/deployment/ccip/changeset/testhelpers"
+	mt "github.com/smartcontractkit/chainlink/deployment/ccip/changeset/testhelpers/messagingtest"
+	soltesthelpers "github.com/smartcontractkit/chainlink/deployment/ccip/changeset/testhelpers/solana"
+	"github.com/smartcontractkit/chainlink/deployment/ccip/changeset/v1_6"
+	"github.com/smartcontractkit/chainlink/deployment/ccip/manualexechelpers"
+	"github.com/smartcontractkit/chainlink/deployment/ccip/shared/stateview"
+	commonchangeset "github.com/smartcontractkit/chainlink/deployment/common/changeset"
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/ccipevm"
+)
+
+func TestManuallyExecuteAll_EVM2SVM(t *testing.T) {
+	ctx := testhelpers.Context(t)
+	e, _ := testhelpers.NewMemoryEnvironment(t,
+		testhelpers.WithSolChains(1),
+		// no message fits in an exec report, the DON only commits them.
+		testhelpers.WithOCRConfigOverride(func(params v1_6.CCIPOCRParams) v1_6.CCIPOCRParams {
+			if params.ExecuteOffChainConfig != nil {
+				params.ExecuteOffChainConfig.BatchGasLimit = 1
+			}
+			return params
+		}),
+	)
+	testhelpers.DeploySolanaCcipReceiver(t, e.Env)
+
+	state, err := stateview.LoadOnchainState(e.Env)
+	require.NoError(t, err)
+	sourceChain := e.Env.AllChainSelectors()[0]
+	destChain := e.Env.AllChainSelectorsSolana()[0]
+	testhelpers.AddLaneWithDefaultPricesAndFeeQuoterConfig(t, &e, state, sourceChain, destChain, false)
+
+	// allow manual execution right after the commit instead of after the permissionless execution threshold.
+	e.Env, _, err = commonchangeset.ApplyChangesetsV2(t, e.Env, []commonchangeset.ConfiguredChangeSet{
+		commonchangeset.Configure(
+			cldf.CreateLegacyChangeSet(ccipChangesetSolana.UpdateEnableManualExecutionAfter),
+			ccipChangesetSolana.UpdateEnableManualExecutionAfterConfig{
+				ChainSelector:         destChain,
+				EnableManualExecution: 0,
+			},
+		),
+	})
+	require.NoError(t, err)
+
+	receiverProgram := state.SolChains[destChain].Receiver
+	receiverTargetAccountPDA, _, _ := solana.FindProgramAddress([][]byte{[]byte("counter")}, receiverProgram)
+	receiverExternalExecutionConfigPDA, _, _ := solana.FindProgramAddress([][]byte{[]byte("external_execution_config")}, receiverProgram)
+	extraArgs, err := ccipevm.SerializeClientSVMExtraArgsV1(message_hasher.ClientSVMExtraArgsV1{
+		AccountIsWritableBitmap: solccip.GenerateBitMapForIndexes([]int{0, 1}),
+		Accounts: [][32]byte{
+			receiverExternalExecutionConfigPDA,
+			receiverTargetAccountPDA,
+			solana.SystemProgramID,
+		},
+		ComputeUnits: 80_000,
+	})
+	require.NoError(t, err)
+
+	out := mt.Run(
+		t,
+		mt.TestCase{
+			ValidationType: mt.ValidationTypeCommit,
+			TestSetup: mt.NewTestSetupWithDeployedEnv(
+				t,
+				e,
+				state,
+				sourceChain,
+				destChain,
+				common.LeftPadBytes(e.Env.Chains[sourceChain].DeployerKey.From.Bytes(), 32),
+				false, // testRouter
+			),
+			Nonce:     nil, // Solana nonce check is skipped
+			Receiver:  receiverProgram.Bytes(),
+			MsgData:   []byte("hello manual execution"),
+			ExtraArgs: extraArgs,
+		},
+	)
+
+	// the offramp only allows manual execution once more than EnableManualExecutionAfter seconds passed since the commit.
+	time.Sleep(2 * time.Second)
+
+	seqNrs := []int64{int64(out.MsgSentEvent.Message.Header.SequenceNumber)} //nolint:gosec // seqNr fits in int64
+	for range 2 {
+		// the second run finds the message executed and doesn't execute it again.
+		err = manualexechelpers.ManuallyExecuteAll(
+			ctx,
+			e.Env.Logger,
+			state,
+			e.Env,
+			sourceChain,
+			destChain,
+			seqNrs,
+			time.Hour, // lookbackDurationMsgs
+			time.Hour, // lookbackDurationCommitReport
+			time.Hour, // stepDuration
+			false,     // reExecuteIfFailed
+		)
+		require.NoError(t, err)
+	}
+
+	var counter soltesthelpers.ReceiverCounter
+	err = solcommon.GetAccountDataBorshInto(ctx, e.Env.SolChains[destChain].Client, receiverTargetAccountPDA,
+		solconfig.DefaultCommitment, &counter)
+	require.NoError(t, err)
+	require.Equal(t, uint8(1), counter.Value, "the message should be executed once")
+}
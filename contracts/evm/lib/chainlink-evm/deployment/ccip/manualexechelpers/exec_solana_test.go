@@ -0,0 +1,133 @@
+package manualexechelpers
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	bin "github.com/gagliardetto/binary"
+	"github.com/gagliardetto/solana-go"
+	"github.com/stretchr/testify/require"
+
+	chainsel "github.com/smartcontractkit/chain-selectors"
+
+	"github.com/smartcontractkit/chainlink-ccip/chains/evm/gobindings/generated/v1_6_0/offramp"
+	cciptypes "github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+	"github.com/smartcontractkit/chainlink-ccip/pluginconfig"
+
+	"github.com/smartcontractkit/chainlink/deployment/ccip/changeset/testhelpers"
+)
+
+func TestSVMExecutionState(t *testing.T) {
+	// message 0 succeeded, message 1 failed, message 2 is untouched,
+	// messages 32 and 63 are stored in the high bits and are in progress and successful.
+	states := bin.Uint128{
+		Lo: testhelpers.EXECUTION_STATE_SUCCESS | testhelpers.EXECUTION_STATE_FAILURE<<2,
+		Hi: testhelpers.EXECUTION_STATE_INPROGRESS | testhelpers.EXECUTION_STATE_SUCCESS<<62,
+	}
+
+	tests := []struct {
+		idx      uint64
+		expected uint8
+	}{
+		{idx: 0, expected: testhelpers.EXECUTION_STATE_SUCCESS},
+		{idx: 1, expected: testhelpers.EXECUTION_STATE_FAILURE},
+		{idx: 2, expected: testhelpers.EXECUTION_STATE_UNTOUCHED},
+		{idx: 31, expected: testhelpers.EXECUTION_STATE_UNTOUCHED},
+		{idx: 32, expected: testhelpers.EXECUTION_STATE_INPROGRESS},
+		{idx: 63, expected: testhelpers.EXECUTION_STATE_SUCCESS},
+	}
+
+	for _, test := range tests {
+		require.Equal(t, test.expected, svmExecutionState(states, test.idx), "idx %d", test.idx)
+	}
+}
+
+func TestMerkleRootConversion(t *testing.T) {
+	root := offramp.InternalMerkleRoot{
+		SourceChainSelector: 1,
+		OnRampAddress:       []byte{1, 2, 3},
+		MinSeqNr:            10,
+		MaxSeqNr:            20,
+		MerkleRoot:          [32]byte{4, 5, 6},
+	}
+
+	svmRoot := toSVMMerkleRoot(root)
+	require.Equal(t, root.SourceChainSelector, svmRoot.SourceChainSelector)
+	require.Equal(t, root.OnRampAddress, svmRoot.OnRampAddress)
+	require.Equal(t, root.MinSeqNr, svmRoot.MinSeqNr)
+	require.Equal(t, root.MaxSeqNr, svmRoot.MaxSeqNr)
+	require.Equal(t, root.MerkleRoot, svmRoot.MerkleRoot)
+	require.Equal(t, root, toEVMMerkleRoot(svmRoot))
+}
+
+func TestSVMMessageSentCache_GetRange(t *testing.T) {
+	cache := make(svmMessageSentCache)
+	for _, seqNr := range []uint64{1, 2, 3, 5} {
+		cache[seqNr] = cciptypes.Message{Header: cciptypes.RampMessageHeader{SequenceNumber: cciptypes.SeqNum(seqNr)}}
+	}
+
+	msgs, ok := cache.getRange(1, 3)
+	require.True(t, ok)
+	require.Len(t, msgs, 3)
+	for i, msg := range msgs {
+		require.Equal(t, cciptypes.SeqNum(i+1), msg.Header.SequenceNumber)
+	}
+
+	_, ok = cache.getRange(3, 5)
+	require.False(t, ok, "sequence number 4 is missing")
+
+	_, ok = cache.getRange(5, 6)
+	require.False(t, ok, "sequence number 6 is missing")
+}
+
+func TestSVMTokenIndex(t *testing.T) {
+	idx, err := svmTokenIndex(0)
+	require.NoError(t, err)
+	require.Equal(t, byte(0), idx)
+
+	idx, err = svmTokenIndex(255)
+	require.NoError(t, err)
+	require.Equal(t, byte(255), idx)
+
+	_, err = svmTokenIndex(256)
+	require.ErrorContains(t, err, "exceeds the maximum of 255")
+}
+
+func TestOffchainTokenDataPools(t *testing.T) {
+	evmSource := chainsel.ETHEREUM_MAINNET.Selector
+	svmSource := chainsel.SOLANA_MAINNET.Selector
+	usdcPool := common.HexToAddress("0x2e03388D351BF87CF2409EFf18C45Df59775Fbb2")
+	lbtcPool := solana.MustPublicKeyFromBase58("7UVimffxr9ow1uXYxsr4LHAcV58mLzhmwaeKvJ1pjLiE")
+	observers := []pluginconfig.TokenDataObserverConfig{
+		{
+			Type:    pluginconfig.USDCCCTPHandlerType,
+			Version: "1.0",
+			USDCCCTPObserverConfig: &pluginconfig.USDCCCTPObserverConfig{
+				Tokens: map[cciptypes.ChainSelector]pluginconfig.USDCCCTPTokenConfig{
+					cciptypes.ChainSelector(evmSource): {SourcePoolAddress: usdcPool.Hex()},
+				},
+			},
+		},
+		{
+			Type:    pluginconfig.LBTCHandlerType,
+			Version: "1.0",
+			LBTCObserverConfig: &pluginconfig.LBTCObserverConfig{
+				SourcePoolAddressByChain: map[cciptypes.ChainSelector]string{
+					cciptypes.ChainSelector(svmSource): lbtcPool.String(),
+				},
+			},
+		},
+	}
+
+	pools, err := offchainTokenDataPools(observers, evmSource)
+	require.NoError(t, err)
+	require.Equal(t, []cciptypes.UnknownAddress{usdcPool.Bytes()}, pools)
+
+	pools, err = offchainTokenDataPools(observers, svmSource)
+	require.NoError(t, err)
+	require.Equal(t, []cciptypes.UnknownAddress{lbtcPool.Bytes()}, pools)
+
+	pools, err = offchainTokenDataPools(observers, chainsel.TEST_90000001.Selector)
+	require.NoError(t, err)
+	require.Empty(t, pools)
+}
@@ -5,6 +5,12 @@ go 1.24.2
 // Make sure we're working with the latest chainlink libs
 replace github.com/smartcontractkit/chainlink/v2 => ../
 
+// Use the chainlink-ccip checkout next to this repo, it provides the message lifecycle readers
+replace github.com/smartcontractkit/chainlink-ccip => ../../chainlink-ccip
+
+// Use the Solana bindings of the same checkout, the program error enums match its IDLs
+replace github.com/smartcontractkit/chainlink-ccip/chains/solana => ../../chainlink-ccip/chains/solana
+
 // Using a separate inline `require` here to avoid surrounding line changes
 // creating potential merge conflicts.
 require github.com/smartcontractkit/chainlink/v2 v2.22.1-por-beta.5.0.20250430150202-611699e34308
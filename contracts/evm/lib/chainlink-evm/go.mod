@@ -381,3 +381,9 @@ require (
 )
 
 replace github.com/fbsobreira/gotron-sdk => github.com/smartcontractkit/chainlink-tron/relayer/gotron-sdk v0.0.5-0.20250422175525-b7575d96bd4d
+
+// Use the chainlink-ccip checkout next to this repo, it provides the message lifecycle readers
+replace github.com/smartcontractkit/chainlink-ccip => ../chainlink-ccip
+
+// Use the Solana bindings of the same checkout, the program error enums match its IDLs
+replace github.com/smartcontractkit/chainlink-ccip/chains/solana => ../chainlink-ccip/chains/solana
@@ -5,6 +5,9 @@ go 1.24.2
 // Make sure we're working with the latest chainlink libs
 replace github.com/smartcontractkit/chainlink/v2 => ../
 
+// Use the chainlink-ccip checkout next to this repo, it provides the message lifecycle readers
+replace github.com/smartcontractkit/chainlink-ccip => ../../chainlink-ccip
+
 replace github.com/smartcontractkit/chainlink/deployment => ../deployment
 
 // Using a separate `require` here to avoid surrounding line changes